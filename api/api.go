@@ -1,7 +1,6 @@
 package api
 
 import (
-	"encoding/hex"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -16,6 +15,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing/factory"
 	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/api/graphql"
+	"github.com/multiversx/mx-chain-proxy-go/api/jsonrpc"
 	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -24,12 +25,21 @@ import (
 
 var log = logger.GetOrCreate("api")
 
+// graphQLPath is registered in each version group, the route access and the rate limits of the endpoint being
+// configured in the graphql package of the version's API config
+const graphQLPath = "/graphql"
+
+// jsonRPCPath is registered in each version group, the route access and the rate limits of the endpoint being
+// configured in the jsonrpc package of the version's API config
+const jsonRPCPath = "/jsonrpc"
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
 }
 
-// CreateServer creates a HTTP server
+// CreateServer creates a HTTP server. The returned settings handler can be used for changing the settings of the
+// server while it is running
 func CreateServer(
 	versionsRegistry data.VersionsRegistryHandler,
 	port int,
@@ -37,28 +47,78 @@ func CreateServer(
 	credentialsConfig config.CredentialsConfig,
 	statusMetricsExtractor middleware.StatusMetricsExtractor,
 	rateLimitTimeWindowInSeconds int,
+	rateLimitsConfig config.RateLimitsConfig,
+	batchRequestsConfig config.BatchRequestsConfig,
+	graphQLConfig config.GraphQLConfig,
+	jsonRPCConfig config.JSONRPCConfig,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
-) (*http.Server, error) {
+) (*http.Server, ServerSettingsHandler, error) {
 	ws := gin.Default()
 	ws.Use(cors.Default())
 
 	err := registerValidators()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, statusMetricsExtractor, rateLimitTimeWindowInSeconds, isProfileModeActivated, shouldStartSwaggerUI)
+	apiConfigs, err := getApiConfigs(versionsRegistry)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	rateLimitTimeWindowDuration := time.Duration(rateLimitTimeWindowInSeconds) * time.Second
+	rateLimiter, err := middleware.NewRateLimiter(getLimitsMap(apiConfigs), rateLimitTimeWindowDuration, rateLimitsConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authenticator, err := createAuthenticator(credentialsConfig, apiConfigs)
+	if err != nil {
+		_ = rateLimiter.Close()
+		return nil, nil, err
+	}
+
+	routeAccess, err := middleware.NewRouteAccess(getRoutesMap(apiConfigs), authenticator)
+	if err != nil {
+		_ = rateLimiter.Close()
+		return nil, nil, err
+	}
+
+	loggingThreshold := getLoggingThreshold(apiLoggingConfig)
+	responseLogger := middleware.NewResponseLoggerMiddleware(loggingThreshold)
+	responseLogger.SetSettings(apiLoggingConfig.LoggingEnabled, loggingThreshold)
+
+	err = registerRoutes(ws, versionsRegistry, responseLogger, routeAccess, statusMetricsExtractor, rateLimiter, batchRequestsConfig, graphQLConfig, jsonRPCConfig, isProfileModeActivated, shouldStartSwaggerUI)
+	if err != nil {
+		_ = rateLimiter.Close()
+		return nil, nil, err
+	}
+
+	settingsHandler := &serverSettingsHandler{
+		versionsRegistry: versionsRegistry,
+		authenticator:    authenticator,
+		routeAccess:      routeAccess,
+		rateLimiter:      rateLimiter,
+		responseLogger:   responseLogger,
+		currentSettings: ServerSettings{
+			ApiConfigs:      apiConfigs,
+			ApiLogging:      apiLoggingConfig,
+			Credentials:     credentialsConfig,
+			RateLimitWindow: rateLimitTimeWindowDuration,
+			RateLimits:      rateLimitsConfig,
+		},
 	}
 
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", port),
 		Handler: ws,
 	}
+	httpServer.RegisterOnShutdown(func() {
+		_ = rateLimiter.Close()
+	})
 
-	return httpServer, nil
+	return httpServer, settingsHandler, nil
 }
 
 func registerValidators() error {
@@ -79,10 +139,13 @@ func registerValidators() error {
 func registerRoutes(
 	ws *gin.Engine,
 	versionsRegistry data.VersionsRegistryHandler,
-	apiLoggingConfig config.ApiLoggingConfig,
-	credentialsConfig config.CredentialsConfig,
+	responseLogger middleware.MiddlewareProcessor,
+	routeAccess middleware.MiddlewareProcessor,
 	statusMetricsExtractor middleware.StatusMetricsExtractor,
-	rateLimitTimeWindowInSeconds int,
+	rateLimiter middleware.RateLimiterHandler,
+	batchRequestsConfig config.BatchRequestsConfig,
+	graphQLConfig config.GraphQLConfig,
+	jsonRPCConfig config.JSONRPCConfig,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
 ) error {
@@ -95,10 +158,8 @@ func registerRoutes(
 		ws.Use(static.ServeRoot("/", "config/swagger"))
 	}
 
-	if apiLoggingConfig.LoggingEnabled {
-		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(apiLoggingConfig.ThresholdInMicroSeconds) * time.Microsecond)
-		ws.Use(responseLoggerMiddleware.MiddlewareHandlerFunc())
-	}
+	// the response logger is always registered, so that the logging can be enabled without a restart
+	ws.Use(responseLogger.MiddlewareHandlerFunc())
 
 	// TODO: maybe add a flag when starting proxy if metrics should be exposed or not
 	metricsMiddleware, err := middleware.NewMetricsMiddleware(statusMetricsExtractor)
@@ -107,26 +168,58 @@ func registerRoutes(
 	}
 
 	for version, versionData := range versionsMap {
-		limitsMap := getLimitsMapForVersion(versionData)
-		rateLimitTimeWindowDuration := time.Duration(rateLimitTimeWindowInSeconds) * time.Second
-		rateLimiter, err := middleware.NewRateLimiter(limitsMap, rateLimitTimeWindowDuration)
-		if err != nil {
-			return err
-		}
-		startRateLimiterReset(rateLimitTimeWindowInSeconds, rateLimiter, version)
 		versionGroup := ws.Group(version)
 		for path, group := range versionData.ApiHandler.GetAllGroups() {
 			subGroup := versionGroup.Group(path)
 			group.RegisterRoutes(
 				subGroup,
 				versionData.ApiConfig,
-				getAuthenticationFunc(credentialsConfig),
+				routeAccess.MiddlewareHandlerFunc(),
+				rateLimiter.MiddlewareHandlerFunc(),
+				metricsMiddleware.MiddlewareHandlerFunc(),
+			)
+		}
+
+		if graphQLConfig.Enabled {
+			graphQLHandler, errGraphQL := createGraphQLHandler(versionData.Facade, graphQLConfig)
+			if errGraphQL != nil {
+				return fmt.Errorf("%w for version %s", errGraphQL, version)
+			}
+
+			versionGroup.POST(
+				graphQLPath,
+				routeAccess.MiddlewareHandlerFunc(),
+				rateLimiter.MiddlewareHandlerFunc(),
+				metricsMiddleware.MiddlewareHandlerFunc(),
+				graphQLHandler,
+			)
+		}
+
+		if jsonRPCConfig.Enabled {
+			jsonRPCHandler, errJSONRPC := createJSONRPCHandler(versionData.Facade, jsonRPCConfig)
+			if errJSONRPC != nil {
+				return fmt.Errorf("%w for version %s", errJSONRPC, version)
+			}
+
+			versionGroup.POST(
+				jsonRPCPath,
+				routeAccess.MiddlewareHandlerFunc(),
 				rateLimiter.MiddlewareHandlerFunc(),
 				metricsMiddleware.MiddlewareHandlerFunc(),
+				jsonRPCHandler,
 			)
 		}
 	}
 
+	if batchRequestsConfig.Enabled {
+		batch, errBatch := newBatchHandler(ws, batchRequestsConfig)
+		if errBatch != nil {
+			return errBatch
+		}
+
+		ws.POST(batchPath, batch.handle)
+	}
+
 	if isProfileModeActivated {
 		pprof.Register(ws)
 	}
@@ -134,20 +227,35 @@ func registerRoutes(
 	return nil
 }
 
-func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.HandlerFunc {
-	if len(credentialsConfig.Credentials) == 0 {
-		return func(c *gin.Context) {
-			c.AbortWithStatusJSON(
-				http.StatusInternalServerError,
-				data.GenericAPIResponse{
-					Data:  nil,
-					Error: "no credentials found on server",
-					Code:  data.ReturnCodeInternalError,
-				},
-			)
-		}
+func createGraphQLHandler(facadeHandler data.FacadeHandler, graphQLConfig config.GraphQLConfig) (gin.HandlerFunc, error) {
+	facade, ok := facadeHandler.(graphql.FacadeHandler)
+	if !ok {
+		return nil, ErrFacadeWithoutGraphQLSupport
+	}
+
+	graphQLHandler, err := graphql.NewGraphQLHandler(facade, graphQLConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return graphQLHandler.Handle, nil
+}
+
+func createJSONRPCHandler(facadeHandler data.FacadeHandler, jsonRPCConfig config.JSONRPCConfig) (gin.HandlerFunc, error) {
+	facade, ok := facadeHandler.(jsonrpc.FacadeHandler)
+	if !ok {
+		return nil, ErrFacadeWithoutJSONRPCSupport
+	}
+
+	jsonRPCHandler, err := jsonrpc.NewJSONRPCHandler(facade, jsonRPCConfig)
+	if err != nil {
+		return nil, err
 	}
 
+	return jsonRPCHandler.Handle, nil
+}
+
+func createAuthenticator(credentialsConfig config.CredentialsConfig, apiConfigs map[string]data.ApiRoutesConfig) (middleware.AuthenticatorHandler, error) {
 	var hasher hashing.Hasher
 	var err error
 	hasher, err = factory.NewHasher(credentialsConfig.Hasher.Type)
@@ -156,66 +264,78 @@ func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.Handl
 		hasher = sha256.NewSha256() // fallback in case the hasher creation failed
 	}
 
-	accounts := gin.Accounts{}
-	for _, pair := range credentialsConfig.Credentials {
-		accounts[pair.Username] = pair.Password
+	return middleware.NewAuthenticator(credentialsConfig, hasher, getRouteScopesMap(apiConfigs))
+}
+
+func getLoggingThreshold(apiLoggingConfig config.ApiLoggingConfig) time.Duration {
+	return time.Duration(apiLoggingConfig.ThresholdInMicroSeconds) * time.Microsecond
+}
+
+// getApiConfigs returns the api routes configs of all the versions, keyed by version
+func getApiConfigs(versionsRegistry data.VersionsRegistryHandler) (map[string]data.ApiRoutesConfig, error) {
+	versionsMap, err := versionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	apiConfigs := make(map[string]data.ApiRoutesConfig, len(versionsMap))
+	for version, versionData := range versionsMap {
+		apiConfigs[version] = versionData.ApiConfig
 	}
 
-	authenticationFunction := func(c *gin.Context) {
-		user, pass, ok := c.Request.BasicAuth()
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "this endpoint requires Basic Authentication",
-				Code:  data.ReturnCodeRequestError,
-			})
-			return
-		}
+	return apiConfigs, nil
+}
 
-		userPassword, ok := accounts[user]
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "username does not exist",
-				Code:  data.ReturnCodeRequestError,
-			})
-			return
-		}
+// getRoutesMap returns the config of each route of all the versions
+func getRoutesMap(apiConfigs map[string]data.ApiRoutesConfig) map[string]data.RouteConfig {
+	routesMap := make(map[string]data.RouteConfig)
+	forEachRoute(apiConfigs, func(fullPath string, routeConfig data.RouteConfig) {
+		routesMap[fullPath] = routeConfig
+	})
+
+	return routesMap
+}
 
-		if userPassword != hex.EncodeToString(hasher.Compute(pass)) {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "invalid password",
-				Code:  data.ReturnCodeRequestError,
-			})
-			return
+// getRouteScopesMap returns the scopes required by each route of all the versions
+func getRouteScopesMap(apiConfigs map[string]data.ApiRoutesConfig) map[string][]string {
+	routeScopes := make(map[string][]string)
+	forEachRoute(apiConfigs, func(fullPath string, routeConfig data.RouteConfig) {
+		if len(routeConfig.Scopes) > 0 {
+			routeScopes[fullPath] = routeConfig.Scopes
 		}
-	}
+	})
 
-	return authenticationFunction
+	return routeScopes
 }
 
-func getLimitsMapForVersion(versionData *data.VersionData) map[string]uint64 {
+// getLimitsMap returns the per endpoint rate limits of all the versions
+func getLimitsMap(apiConfigs map[string]data.ApiRoutesConfig) map[string]uint64 {
 	limitsMap := make(map[string]uint64)
-	for packageName, packageConfig := range versionData.ApiConfig.APIPackages {
-		for _, routeConfig := range packageConfig.Routes {
-			if routeConfig.RateLimit > 0 {
-				mapKey := fmt.Sprintf("/%s%s", packageName, routeConfig.Name)
-				limitsMap[mapKey] = routeConfig.RateLimit
-			}
+	forEachRoute(apiConfigs, func(fullPath string, routeConfig data.RouteConfig) {
+		if routeConfig.RateLimit > 0 {
+			limitsMap[fullPath] = routeConfig.RateLimit
 		}
-	}
+	})
 
 	return limitsMap
 }
 
-func startRateLimiterReset(rateLimiterDuration int, rl middleware.RateLimiterHandler, version string) {
-	go func() {
-		for {
-			time.Sleep(time.Duration(rateLimiterDuration) * time.Second)
-			rl.ResetMap(version)
+// forEachRoute calls the handler for each configured route of all the versions. The provided paths are the full
+// paths of the endpoints, as they are registered in the web server
+func forEachRoute(apiConfigs map[string]data.ApiRoutesConfig, handler func(fullPath string, routeConfig data.RouteConfig)) {
+	for version, apiConfig := range apiConfigs {
+		versionPrefix := ""
+		if len(version) > 0 {
+			versionPrefix = "/" + version
 		}
-	}()
+
+		for packageName, packageConfig := range apiConfig.APIPackages {
+			for _, routeConfig := range packageConfig.Routes {
+				fullPath := fmt.Sprintf("%s/%s%s", versionPrefix, packageName, routeConfig.Name)
+				handler(fullPath, routeConfig)
+			}
+		}
+	}
 }
 
 // skValidator validates a secret key from user input for correctness
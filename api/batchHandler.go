@@ -0,0 +1,203 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const batchPath = "/batch"
+
+// unsupportedSubRequestPaths are the endpoints that cannot be called from a batch: the streaming ones, which only
+// return when the client disconnects, and the ones executing batches or queries of their own, which would escape the
+// limits of the batch
+var unsupportedSubRequestPaths = []string{batchPath, "/hyperblock/stream", "/hyperblock/activity", graphQLPath, jsonRPCPath}
+
+// hopByHopHeaders are not forwarded to the sub-requests, so that none of them is upgraded to a WebSocket connection
+var hopByHopHeaders = []string{"Connection", "Upgrade", "Content-Length", "Transfer-Encoding"}
+
+// batchHandler executes the sub-requests of a batch through the web server itself, so that each of them passes through
+// the middlewares of its endpoint: the route access, the authentication and the rate limiting
+type batchHandler struct {
+	server                   http.Handler
+	maxSubRequests           int
+	maxConcurrentSubRequests int
+	subRequestTimeout        time.Duration
+}
+
+func newBatchHandler(server http.Handler, batchRequestsConfig config.BatchRequestsConfig) (*batchHandler, error) {
+	if batchRequestsConfig.MaxSubRequests <= 0 {
+		return nil, fmt.Errorf("%w: MaxSubRequests must be greater than zero", ErrInvalidBatchRequestsConfig)
+	}
+	if batchRequestsConfig.MaxConcurrentSubRequests <= 0 {
+		return nil, fmt.Errorf("%w: MaxConcurrentSubRequests must be greater than zero", ErrInvalidBatchRequestsConfig)
+	}
+	if batchRequestsConfig.SubRequestTimeoutSec <= 0 {
+		return nil, fmt.Errorf("%w: SubRequestTimeoutSec must be greater than zero", ErrInvalidBatchRequestsConfig)
+	}
+
+	return &batchHandler{
+		server:                   server,
+		maxSubRequests:           batchRequestsConfig.MaxSubRequests,
+		maxConcurrentSubRequests: batchRequestsConfig.MaxConcurrentSubRequests,
+		subRequestTimeout:        time.Duration(batchRequestsConfig.SubRequestTimeoutSec) * time.Second,
+	}, nil
+}
+
+// handle executes the sub-requests concurrently and responds with their responses, in the order of the sub-requests.
+// The concurrency cap is applied per batch
+func (bh *batchHandler) handle(c *gin.Context) {
+	var subRequests []data.BatchSubRequest
+	err := c.ShouldBindJSON(&subRequests)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, fmt.Sprintf("invalid batch: %s", err.Error()), data.ReturnCodeRequestError)
+		return
+	}
+	if len(subRequests) == 0 || len(subRequests) > bh.maxSubRequests {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("invalid batch: the number of sub-requests must be between 1 and %d", bh.maxSubRequests),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	concurrencyTokens := make(chan struct{}, bh.maxConcurrentSubRequests)
+	responses := make([]*data.GenericAPIResponse, len(subRequests))
+	wg := sync.WaitGroup{}
+	wg.Add(len(subRequests))
+	for idx, subRequest := range subRequests {
+		concurrencyTokens <- struct{}{}
+		go func(idx int, subRequest data.BatchSubRequest) {
+			defer func() {
+				<-concurrencyTokens
+				wg.Done()
+			}()
+
+			responses[idx] = bh.execute(c.Request, subRequest)
+		}(idx, subRequest)
+	}
+	wg.Wait()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"responses": responses}, "", data.ReturnCodeSuccess)
+}
+
+// execute sends the sub-request to the web server on behalf of the client of the batch, with its headers and address
+func (bh *batchHandler) execute(batchRequest *http.Request, subRequest data.BatchSubRequest) *data.GenericAPIResponse {
+	method := strings.ToUpper(subRequest.Method)
+	if method != http.MethodGet && method != http.MethodPost {
+		return newSubRequestError(fmt.Sprintf("unsupported method %q, only GET and POST are allowed", subRequest.Method))
+	}
+	if !strings.HasPrefix(subRequest.Path, "/") {
+		return newSubRequestError("the path must start with /")
+	}
+	if hasFragmentOrEscapedPath(subRequest.Path) {
+		return newSubRequestError("the path cannot hold a fragment or escaped characters")
+	}
+
+	ctx, cancel := context.WithTimeout(batchRequest.Context(), bh.subRequestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, method, subRequest.Path, bytes.NewReader(subRequest.Body))
+	if err != nil {
+		return newSubRequestError(err.Error())
+	}
+	// the endpoints are routed on the decoded path, so that is the one checked
+	unsupportedPath, isUnsupported := getUnsupportedSubRequestPath(request.URL.Path)
+	if isUnsupported {
+		return newSubRequestError(fmt.Sprintf("the %s endpoint cannot be called from a batch", unsupportedPath))
+	}
+	request.Header = batchRequest.Header.Clone()
+	for _, header := range hopByHopHeaders {
+		request.Header.Del(header)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.RemoteAddr = batchRequest.RemoteAddr
+
+	recorder := newSubRequestRecorder()
+	bh.server.ServeHTTP(recorder, request)
+
+	response := &data.GenericAPIResponse{}
+	err = json.Unmarshal(recorder.body.Bytes(), response)
+	if err != nil {
+		return newSubRequestError(fmt.Sprintf("the endpoint responded with status %d and a body which is not a JSON object", recorder.status))
+	}
+
+	return response
+}
+
+// hasFragmentOrEscapedPath tells whether the sub-request path holds a fragment or percent-escapes before its query,
+// which would make the routed path differ from the one sent
+func hasFragmentOrEscapedPath(subRequestPath string) bool {
+	if strings.Contains(subRequestPath, "#") {
+		return true
+	}
+
+	return strings.Contains(strings.SplitN(subRequestPath, "?", 2)[0], "%")
+}
+
+// getUnsupportedSubRequestPath returns the unsupported endpoint matched by the decoded path of the sub-request, with
+// or without the version prefix
+func getUnsupportedSubRequestPath(subRequestPath string) (string, bool) {
+	cleanPath := path.Clean(subRequestPath)
+	for _, unsupportedPath := range unsupportedSubRequestPaths {
+		if strings.HasSuffix(cleanPath, unsupportedPath) {
+			return unsupportedPath, true
+		}
+	}
+
+	return "", false
+}
+
+// subRequestRecorder holds the response written by the endpoint of a sub-request
+type subRequestRecorder struct {
+	header http.Header
+	body   bytes.Buffer
+	status int
+}
+
+func newSubRequestRecorder() *subRequestRecorder {
+	return &subRequestRecorder{
+		header: make(http.Header),
+		status: http.StatusOK,
+	}
+}
+
+// Header returns the headers of the response
+func (recorder *subRequestRecorder) Header() http.Header {
+	return recorder.header
+}
+
+// Write appends the data to the body of the response
+func (recorder *subRequestRecorder) Write(data []byte) (int, error) {
+	return recorder.body.Write(data)
+}
+
+// WriteHeader records the status of the response
+func (recorder *subRequestRecorder) WriteHeader(status int) {
+	recorder.status = status
+}
+
+// Flush does nothing, as the response is read only after the endpoint returns
+func (recorder *subRequestRecorder) Flush() {
+}
+
+func newSubRequestError(message string) *data.GenericAPIResponse {
+	return &data.GenericAPIResponse{
+		Error: fmt.Sprintf("invalid sub-request: %s", message),
+		Code:  data.ReturnCodeRequestError,
+	}
+}
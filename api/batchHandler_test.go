@@ -0,0 +1,273 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type batchResponse struct {
+	Data struct {
+		Responses []*data.GenericAPIResponse `json:"responses"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func createTestBatchRequestsConfig() config.BatchRequestsConfig {
+	return config.BatchRequestsConfig{
+		Enabled:                  true,
+		MaxSubRequests:           5,
+		MaxConcurrentSubRequests: 2,
+		SubRequestTimeoutSec:     1,
+	}
+}
+
+func startBatchServer(t *testing.T, batchRequestsConfig config.BatchRequestsConfig, registerRoutes func(ws *gin.Engine)) *gin.Engine {
+	ws := gin.New()
+	registerRoutes(ws)
+
+	batch, err := newBatchHandler(ws, batchRequestsConfig)
+	require.NoError(t, err)
+	ws.POST(batchPath, batch.handle)
+
+	return ws
+}
+
+func sendBatch(ws *gin.Engine, body string) (*httptest.ResponseRecorder, *batchResponse) {
+	req, _ := http.NewRequest(http.MethodPost, batchPath, bytes.NewBufferString(body))
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Custom", "custom")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &batchResponse{}
+	_ = json.Unmarshal(resp.Body.Bytes(), response)
+
+	return resp, response
+}
+
+func TestNewBatchHandler(t *testing.T) {
+	t.Parallel()
+
+	cfg := createTestBatchRequestsConfig()
+	cfg.MaxSubRequests = 0
+	bh, err := newBatchHandler(gin.New(), cfg)
+	require.Nil(t, bh)
+	require.True(t, errors.Is(err, ErrInvalidBatchRequestsConfig))
+
+	cfg = createTestBatchRequestsConfig()
+	cfg.MaxConcurrentSubRequests = 0
+	bh, err = newBatchHandler(gin.New(), cfg)
+	require.Nil(t, bh)
+	require.True(t, errors.Is(err, ErrInvalidBatchRequestsConfig))
+
+	cfg = createTestBatchRequestsConfig()
+	cfg.SubRequestTimeoutSec = 0
+	bh, err = newBatchHandler(gin.New(), cfg)
+	require.Nil(t, bh)
+	require.True(t, errors.Is(err, ErrInvalidBatchRequestsConfig))
+
+	bh, err = newBatchHandler(gin.New(), createTestBatchRequestsConfig())
+	require.NoError(t, err)
+	require.NotNil(t, bh)
+}
+
+func TestBatchHandler_InvalidBatchShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startBatchServer(t, createTestBatchRequestsConfig(), func(ws *gin.Engine) {})
+
+	resp, response := sendBatch(ws, `{"method": "GET"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, "invalid batch")
+
+	resp, response = sendBatch(ws, `[]`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, "between 1 and 5")
+
+	resp, response = sendBatch(ws, `[{}, {}, {}, {}, {}, {}]`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, "between 1 and 5")
+}
+
+func TestBatchHandler_ShouldExecuteTheSubRequestsInOrder(t *testing.T) {
+	t.Parallel()
+
+	numRunning := int32(0)
+	maxRunning := int32(0)
+	ws := startBatchServer(t, createTestBatchRequestsConfig(), func(ws *gin.Engine) {
+		ws.GET("/network/config", func(c *gin.Context) {
+			running := atomic.AddInt32(&numRunning, 1)
+			defer atomic.AddInt32(&numRunning, -1)
+			for {
+				currentMax := atomic.LoadInt32(&maxRunning)
+				if running <= currentMax || atomic.CompareAndSwapInt32(&maxRunning, currentMax, running) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond * 20)
+
+			c.JSON(http.StatusOK, data.GenericAPIResponse{
+				Data: gin.H{"query": c.Query("q"), "header": c.GetHeader("X-Custom"), "ip": c.ClientIP()},
+				Code: data.ReturnCodeSuccess,
+			})
+		})
+		ws.POST("/vm-values/query", func(c *gin.Context) {
+			body, _ := io.ReadAll(c.Request.Body)
+			c.JSON(http.StatusOK, data.GenericAPIResponse{
+				Data: gin.H{"body": string(body)},
+				Code: data.ReturnCodeSuccess,
+			})
+		})
+	})
+
+	resp, response := sendBatch(ws, `[
+		{"method": "GET", "path": "/network/config?q=0"},
+		{"method": "post", "path": "/vm-values/query", "body": {"funcName": "get"}},
+		{"method": "GET", "path": "/network/config?q=2"},
+		{"method": "GET", "path": "/network/config?q=3"},
+		{"method": "GET", "path": "/network/config?q=4"}
+	]`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Responses, 5)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+
+	for _, idx := range []int{0, 2, 3, 4} {
+		subResponse := response.Data.Responses[idx]
+		assert.Equal(t, data.ReturnCodeSuccess, subResponse.Code)
+		assert.Equal(t, map[string]interface{}{
+			"query":  string(rune('0' + idx)),
+			"header": "custom",
+			"ip":     "10.0.0.1",
+		}, subResponse.Data)
+	}
+	assert.Equal(t, map[string]interface{}{"body": `{"funcName": "get"}`}, response.Data.Responses[1].Data)
+}
+
+func TestBatchHandler_InvalidSubRequestsShouldBeReported(t *testing.T) {
+	t.Parallel()
+
+	ws := startBatchServer(t, createTestBatchRequestsConfig(), func(ws *gin.Engine) {
+		ws.GET("/text", func(c *gin.Context) {
+			c.String(http.StatusOK, "not json")
+		})
+	})
+
+	resp, response := sendBatch(ws, `[
+		{"method": "PUT", "path": "/text"},
+		{"method": "GET", "path": "text"},
+		{"method": "POST", "path": "/batch"},
+		{"method": "GET", "path": "/text"},
+		null
+	]`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Responses, 5)
+	assert.Contains(t, response.Data.Responses[0].Error, "unsupported method")
+	assert.Contains(t, response.Data.Responses[1].Error, "must start with /")
+	assert.Contains(t, response.Data.Responses[2].Error, "the /batch endpoint cannot be called from a batch")
+	assert.Contains(t, response.Data.Responses[3].Error, "not a JSON object")
+	assert.Contains(t, response.Data.Responses[4].Error, "unsupported method")
+	for _, subResponse := range response.Data.Responses {
+		assert.Equal(t, data.ReturnCodeRequestError, subResponse.Code)
+	}
+}
+
+func TestBatchHandler_UnsupportedEndpointsShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	batchRequestsConfig := createTestBatchRequestsConfig()
+	batchRequestsConfig.MaxSubRequests = 8
+	numCalls := int32(0)
+	ws := startBatchServer(t, batchRequestsConfig, func(ws *gin.Engine) {
+		handler := func(c *gin.Context) {
+			atomic.AddInt32(&numCalls, 1)
+			c.JSON(http.StatusOK, data.GenericAPIResponse{Code: data.ReturnCodeSuccess})
+		}
+		ws.GET("/v1.0/hyperblock/stream", handler)
+		ws.GET("/hyperblock/activity", handler)
+		ws.POST("/graphql", handler)
+		ws.POST("/v_next/jsonrpc", handler)
+	})
+
+	resp, response := sendBatch(ws, `[
+		{"method": "GET", "path": "/v1.0/hyperblock/stream"},
+		{"method": "GET", "path": "/hyperblock/activity?address=erd1"},
+		{"method": "POST", "path": "/graphql/"},
+		{"method": "POST", "path": "//v_next/./jsonrpc"},
+		{"method": "POST", "path": "/v1.0/batch"},
+		{"method": "POST", "path": "/batch#x"},
+		{"method": "POST", "path": "/v1.0/graphq%6C"},
+		{"method": "GET", "path": "/v1.0/hyperblock%2Fstream?x=1"}
+	]`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Responses, 8)
+	assert.Contains(t, response.Data.Responses[0].Error, "the /hyperblock/stream endpoint cannot be called from a batch")
+	assert.Contains(t, response.Data.Responses[1].Error, "the /hyperblock/activity endpoint cannot be called from a batch")
+	assert.Contains(t, response.Data.Responses[2].Error, "the /graphql endpoint cannot be called from a batch")
+	assert.Contains(t, response.Data.Responses[3].Error, "the /jsonrpc endpoint cannot be called from a batch")
+	assert.Contains(t, response.Data.Responses[4].Error, "the /batch endpoint cannot be called from a batch")
+	assert.Contains(t, response.Data.Responses[5].Error, "the path cannot hold a fragment or escaped characters")
+	assert.Contains(t, response.Data.Responses[6].Error, "the path cannot hold a fragment or escaped characters")
+	assert.Contains(t, response.Data.Responses[7].Error, "the path cannot hold a fragment or escaped characters")
+	for _, subResponse := range response.Data.Responses {
+		assert.Equal(t, data.ReturnCodeRequestError, subResponse.Code)
+	}
+	assert.Zero(t, atomic.LoadInt32(&numCalls))
+}
+
+func TestBatchHandler_ShouldApplyTheMiddlewaresPerSubRequest(t *testing.T) {
+	t.Parallel()
+
+	rateLimiter, err := middleware.NewRateLimiter(map[string]uint64{"/limited": 1}, time.Minute, config.RateLimitsConfig{})
+	require.NoError(t, err)
+	defer func() {
+		_ = rateLimiter.Close()
+	}()
+
+	ws := startBatchServer(t, createTestBatchRequestsConfig(), func(ws *gin.Engine) {
+		handler := func(c *gin.Context) {
+			c.JSON(http.StatusOK, data.GenericAPIResponse{Code: data.ReturnCodeSuccess})
+		}
+		ws.GET("/limited", rateLimiter.MiddlewareHandlerFunc(), handler)
+		ws.GET("/secured", func(c *gin.Context) {
+			if c.GetHeader("Authorization") != "Basic good" {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
+					Error: "unauthorized",
+					Code:  data.ReturnCodeRequestError,
+				})
+			}
+		}, handler)
+	})
+
+	resp, response := sendBatch(ws, `[
+		{"method": "GET", "path": "/limited"},
+		{"method": "GET", "path": "/limited"},
+		{"method": "GET", "path": "/secured"}
+	]`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Responses, 3)
+
+	numLimited := 0
+	for _, subResponse := range response.Data.Responses[:2] {
+		if subResponse.Code != data.ReturnCodeSuccess {
+			numLimited++
+			assert.Contains(t, subResponse.Error, "exceeded the limit")
+		}
+	}
+	assert.Equal(t, 1, numLimited)
+	assert.Equal(t, "unauthorized", response.Data.Responses[2].Error)
+}
@@ -13,3 +13,25 @@ var ErrGroupDoesNotExist = errors.New("group does not exist")
 
 // ErrNilFacade signals that a nil facade has been provided
 var ErrNilFacade = errors.New("nil facade")
+
+// ErrVersionNotRegistered signals that the provided version is not registered in the web server
+var ErrVersionNotRegistered = errors.New("version not registered")
+
+// ErrHasherChangeNotSupported signals that the credentials hasher cannot be changed while the server is running
+var ErrHasherChangeNotSupported = errors.New("the credentials hasher cannot be changed without a restart")
+
+// ErrInvalidBatchRequestsConfig signals that an invalid batch requests config has been provided
+var ErrInvalidBatchRequestsConfig = errors.New("invalid batch requests config")
+
+// ErrFacadeWithoutGraphQLSupport signals that the facade of an API version does not support the GraphQL queries
+var ErrFacadeWithoutGraphQLSupport = errors.New("the facade does not support the GraphQL queries")
+
+// ErrFacadeWithoutJSONRPCSupport signals that the facade of an API version does not support the JSON-RPC methods
+var ErrFacadeWithoutJSONRPCSupport = errors.New("the facade does not support the JSON-RPC methods")
+
+// ErrFacadeWithoutGRPCSupport signals that the facade of the API version mirrored by the gRPC API does not support
+// the gRPC calls
+var ErrFacadeWithoutGRPCSupport = errors.New("the facade does not support the gRPC calls")
+
+// ErrWrongTypeAssertion signals that a wrong type assertion occurred
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
@@ -110,6 +110,36 @@ var ErrTransactionHashMissing = errors.New("transaction hash missing")
 // ErrFaucetNotEnabled signals that the faucet mechanism is not enabled
 var ErrFaucetNotEnabled = errors.New("faucet not enabled")
 
+// ErrHyperblockStreamNotEnabled signals that the stream of the new hyperblocks is not enabled
+var ErrHyperblockStreamNotEnabled = errors.New("hyperblock stream not enabled")
+
+// ErrTooManyHyperblockSubscribers signals that the maximum number of subscribers to the hyperblocks stream has been reached
+var ErrTooManyHyperblockSubscribers = errors.New("too many hyperblock stream subscribers")
+
+// ErrHyperblockResumeNonceTooOld signals that the nonce to resume the hyperblocks stream from is too old
+var ErrHyperblockResumeNonceTooOld = errors.New("the nonce to resume the hyperblock stream from is too old")
+
+// ErrInvalidActivityFilters signals that the filters of an address activity subscription are invalid
+var ErrInvalidActivityFilters = errors.New("invalid address activity filters")
+
+// ErrNonceReservationNotEnabled signals that the reservation of the senders' nonces is not enabled
+var ErrNonceReservationNotEnabled = errors.New("nonce reservation not enabled")
+
+// ErrTooManyNonceReservations signals that the maximum number of nonces reserved for a sender has been reached
+var ErrTooManyNonceReservations = errors.New("too many nonce reservations for the sender")
+
+// ErrInvalidReplacementTransactions signals that the replacement transactions cannot fill the nonce gaps of the sender
+var ErrInvalidReplacementTransactions = errors.New("invalid replacement transactions")
+
+// ErrTooManyNonceReservationSenders signals that the maximum number of senders holding nonce reservations has been reached
+var ErrTooManyNonceReservationSenders = errors.New("too many senders with nonce reservations")
+
+// ErrTransactionTrackingNotEnabled signals that the tracking of the sent transactions is not enabled
+var ErrTransactionTrackingNotEnabled = errors.New("transaction tracking not enabled")
+
+// ErrTransactionTrackingFailed signals that the transaction has been sent, but it cannot be tracked
+var ErrTransactionTrackingFailed = errors.New("transaction sent, but it cannot be tracked")
+
 // ErrInvalidBlockNonceParam signals that an invalid block's nonce parameter has been provided
 var ErrInvalidBlockNonceParam = errors.New("invalid block nonce parameter")
 
@@ -146,6 +176,13 @@ var ErrOperationNotAllowed = errors.New("operation not allowed")
 // ErrIsDataTrieMigrated signals that an error occurred while trying to verify the migration status of the data trie
 var ErrIsDataTrieMigrated = errors.New("could not verify the migration status of the data trie")
 
+// ErrNoBlockAtHistoricalCoordinates signals that no final block of the queried shard matches the timestamp or the
+// epoch of a historical query
+var ErrNoBlockAtHistoricalCoordinates = errors.New("no final block matches the historical coordinates")
+
+// ErrGetAccountDiff signals an error in computing the changes of an account between two blocks
+var ErrGetAccountDiff = errors.New("cannot get account diff")
+
 // ErrInvalidTxFields signals that one or more field of a transaction are invalid
 type ErrInvalidTxFields struct {
 	Message string
@@ -0,0 +1,285 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// esdtToken is an ESDT, an SFT or an NFT held by an account, as returned by the observers
+type esdtToken struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Balance         string   `json:"balance"`
+	Nonce           Uint64   `json:"nonce"`
+	Name            string   `json:"name"`
+	Creator         string   `json:"creator"`
+	Royalties       string   `json:"royalties"`
+	Hash            string   `json:"hash"`
+	Attributes      string   `json:"attributes"`
+	URIs            []string `json:"uris"`
+	Properties      string   `json:"properties"`
+}
+
+type tokenRoles struct {
+	TokenIdentifier string
+	Roles           []string
+}
+
+type keyValuePair struct {
+	Key   string
+	Value string
+}
+
+type guardian struct {
+	Address         string
+	ActivationEpoch Uint64
+	ServiceUID      string
+}
+
+type guardianData struct {
+	Guarded         bool
+	ActiveGuardian  *guardian
+	PendingGuardian *guardian
+}
+
+// accountResolver resolves the fields of an account. The nested fields are fetched with the options the account was
+// queried with, each of them making one request to the observers
+type accountResolver struct {
+	Address         string
+	Nonce           Uint64
+	Balance         string
+	Username        string
+	Code            string
+	CodeHash        string
+	CodeMetadata    string
+	DeveloperReward string
+	OwnerAddress    string
+
+	facade  FacadeHandler
+	options common.AccountQueryOptions
+}
+
+func newAccountResolver(facade FacadeHandler, account *data.Account, options common.AccountQueryOptions) *accountResolver {
+	return &accountResolver{
+		Address:         account.Address,
+		Nonce:           Uint64(account.Nonce),
+		Balance:         account.Balance,
+		Username:        account.Username,
+		Code:            account.Code,
+		CodeHash:        base64.StdEncoding.EncodeToString(account.CodeHash),
+		CodeMetadata:    base64.StdEncoding.EncodeToString(account.CodeMetadata),
+		DeveloperReward: account.DeveloperReward,
+		OwnerAddress:    account.OwnerAddress,
+		facade:          facade,
+		options:         options,
+	}
+}
+
+// Esdts returns all the tokens held by the account, sorted by their identifier
+func (ar *accountResolver) Esdts(ctx context.Context) ([]*esdtToken, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ar.facade.GetAllESDTTokens(ctx, ar.Address, ar.options)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		Esdts map[string]*esdtToken `json:"esdts"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*esdtToken, 0, len(payload.Esdts))
+	for _, token := range payload.Esdts {
+		if token != nil {
+			token.URIs = nonNilStrings(token.URIs)
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].TokenIdentifier != tokens[j].TokenIdentifier {
+			return tokens[i].TokenIdentifier < tokens[j].TokenIdentifier
+		}
+		return tokens[i].Nonce < tokens[j].Nonce
+	})
+
+	return tokens, nil
+}
+
+// Esdt returns a fungible token held by the account
+func (ar *accountResolver) Esdt(ctx context.Context, args struct{ TokenIdentifier string }) (*esdtToken, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ar.facade.GetESDTTokenData(ctx, ar.Address, args.TokenIdentifier, ar.options)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTokenData(response)
+}
+
+// Nft returns a non-fungible or a semi-fungible token held by the account
+func (ar *accountResolver) Nft(ctx context.Context, args struct {
+	TokenIdentifier string
+	Nonce           Uint64
+}) (*esdtToken, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ar.facade.GetESDTNftTokenData(ctx, ar.Address, args.TokenIdentifier, uint64(args.Nonce), ar.options)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTokenData(response)
+}
+
+func decodeTokenData(response *data.GenericAPIResponse) (*esdtToken, error) {
+	payload := struct {
+		TokenData *esdtToken `json:"tokenData"`
+	}{}
+	err := decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload.TokenData != nil {
+		payload.TokenData.URIs = nonNilStrings(payload.TokenData.URIs)
+	}
+
+	return payload.TokenData, nil
+}
+
+// Roles returns the roles the account has for each token, sorted by the token identifier
+func (ar *accountResolver) Roles(ctx context.Context) ([]*tokenRoles, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ar.facade.GetESDTsRoles(ctx, ar.Address, ar.options)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		Roles map[string][]string `json:"roles"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*tokenRoles, 0, len(payload.Roles))
+	for tokenIdentifier, tokenRolesList := range payload.Roles {
+		roles = append(roles, &tokenRoles{
+			TokenIdentifier: tokenIdentifier,
+			Roles:           nonNilStrings(tokenRolesList),
+		})
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].TokenIdentifier < roles[j].TokenIdentifier
+	})
+
+	return roles, nil
+}
+
+// GuardianData returns the guardians of the account
+func (ar *accountResolver) GuardianData(ctx context.Context) (*guardianData, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ar.facade.GetGuardianData(ctx, ar.Address, ar.options)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		GuardianData *api.GuardianData `json:"guardianData"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload.GuardianData == nil {
+		return nil, nil
+	}
+
+	return &guardianData{
+		Guarded:         payload.GuardianData.Guarded,
+		ActiveGuardian:  newGuardian(payload.GuardianData.ActiveGuardian),
+		PendingGuardian: newGuardian(payload.GuardianData.PendingGuardian),
+	}, nil
+}
+
+func newGuardian(apiGuardian *api.Guardian) *guardian {
+	if apiGuardian == nil {
+		return nil
+	}
+
+	return &guardian{
+		Address:         apiGuardian.Address,
+		ActivationEpoch: Uint64(apiGuardian.ActivationEpoch),
+		ServiceUID:      apiGuardian.ServiceUID,
+	}
+}
+
+// KeyValuePairs returns the storage of the account, sorted by key
+func (ar *accountResolver) KeyValuePairs(ctx context.Context) ([]*keyValuePair, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ar.facade.GetKeyValuePairs(ctx, ar.Address, ar.options)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		Pairs map[string]string `json:"pairs"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]*keyValuePair, 0, len(payload.Pairs))
+	for key, value := range payload.Pairs {
+		pairs = append(pairs, &keyValuePair{
+			Key:   key,
+			Value: value,
+		})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].Key < pairs[j].Key
+	})
+
+	return pairs, nil
+}
+
+// Value returns the value stored by the account under the hex encoded key
+func (ar *accountResolver) Value(ctx context.Context, args struct{ Key string }) (string, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return "", err
+	}
+
+	return ar.facade.GetValueForKey(ctx, ar.Address, args.Key, ar.options)
+}
@@ -0,0 +1,49 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+type budgetContextKey struct{}
+
+// queryBudget counts the requests a query makes to the observers, backing up the cost computed out of the document of
+// the query before executing it. The resolvers of a query run concurrently, hence the atomic counter
+type queryBudget struct {
+	remaining int64
+	limit     int
+}
+
+func withQueryBudget(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, budgetContextKey{}, &queryBudget{
+		remaining: int64(limit),
+		limit:     limit,
+	})
+}
+
+// spend consumes the given number of requests from the budget of the query, erroring when it is exhausted
+func spend(ctx context.Context, requests int) error {
+	budget, ok := ctx.Value(budgetContextKey{}).(*queryBudget)
+	if !ok {
+		return nil
+	}
+
+	remaining := atomic.AddInt64(&budget.remaining, -int64(requests))
+	if remaining < 0 {
+		return fmt.Errorf("%w: it needs more than %d requests to the observers", ErrQueryTooComplex, budget.limit)
+	}
+
+	return nil
+}
+
+// checkListLength rejects the lists of items longer than the budget of the query, such as the addresses of the
+// accounts fetched at once
+func checkListLength(ctx context.Context, length int) error {
+	budget, ok := ctx.Value(budgetContextKey{}).(*queryBudget)
+	if !ok || length <= budget.limit {
+		return nil
+	}
+
+	return fmt.Errorf("%w: it lists %d items, more than the maximum of %d", ErrQueryTooComplex, length, budget.limit)
+}
@@ -0,0 +1,21 @@
+package graphql
+
+import "errors"
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrInvalidGraphQLConfig signals that the GraphQL config is invalid
+var ErrInvalidGraphQLConfig = errors.New("invalid GraphQL config")
+
+// ErrQueryTooComplex signals that a query needs more requests to the observers than allowed
+var ErrQueryTooComplex = errors.New("the query is too complex")
+
+// ErrInvalidBlockCoordinates signals that a block was queried by both its nonce and its hash, or by none of them
+var ErrInvalidBlockCoordinates = errors.New("exactly one of nonce and hash must be provided")
+
+// ErrInvalidUint64 signals that a value cannot be read as an unsigned 64 bits integer
+var ErrInvalidUint64 = errors.New("invalid Uint64 value")
+
+// ErrNilResponse signals that the facade returned a nil response
+var ErrNilResponse = errors.New("nil response")
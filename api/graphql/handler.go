@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	graphqlGo "github.com/graph-gophers/graphql-go"
+	graphqlErrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+// queryRequest is the body of a GraphQL request sent over HTTP
+type queryRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// graphQLHandler executes the GraphQL queries against the facade. The responses have the standard GraphQL shape,
+// holding the data and the errors of the query
+type graphQLHandler struct {
+	schema         *graphqlGo.Schema
+	maxComplexity  int
+	maxQueryLength int
+}
+
+// NewGraphQLHandler returns a new instance of graphQLHandler
+func NewGraphQLHandler(facade FacadeHandler, graphQLConfig config.GraphQLConfig) (*graphQLHandler, error) {
+	if facade == nil {
+		return nil, ErrNilFacade
+	}
+	if graphQLConfig.MaxDepth <= 0 {
+		return nil, fmt.Errorf("%w: MaxDepth must be greater than zero", ErrInvalidGraphQLConfig)
+	}
+	if graphQLConfig.MaxComplexity <= 0 {
+		return nil, fmt.Errorf("%w: MaxComplexity must be greater than zero", ErrInvalidGraphQLConfig)
+	}
+	if graphQLConfig.MaxQueryLength <= 0 {
+		return nil, fmt.Errorf("%w: MaxQueryLength must be greater than zero", ErrInvalidGraphQLConfig)
+	}
+
+	parsedSchema, err := graphqlGo.ParseSchema(
+		schema,
+		&queryResolver{facade: facade},
+		graphqlGo.UseFieldResolvers(),
+		graphqlGo.MaxDepth(graphQLConfig.MaxDepth),
+		graphqlGo.MaxQueryLength(graphQLConfig.MaxQueryLength),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphQLHandler{
+		schema:         parsedSchema,
+		maxComplexity:  graphQLConfig.MaxComplexity,
+		maxQueryLength: graphQLConfig.MaxQueryLength,
+	}, nil
+}
+
+// Handle executes the query of the request, within the complexity budget of a query. The queries exceeding the budget
+// are rejected out of their document, before any request to the observers, while the budget counted by the resolvers
+// only backs up this check
+func (gh *graphQLHandler) Handle(c *gin.Context) {
+	request := queryRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, &graphqlGo.Response{
+			Errors: []*graphqlErrors.QueryError{graphqlErrors.Errorf("invalid request: %s", err.Error())},
+		})
+		return
+	}
+
+	// the queries too long are rejected by the schema, before being parsed
+	if len(request.Query) <= gh.maxQueryLength {
+		err = checkQueryCost(request.Query, request.OperationName, request.Variables, gh.maxComplexity)
+		if err != nil {
+			c.JSON(http.StatusOK, &graphqlGo.Response{
+				Errors: []*graphqlErrors.QueryError{graphqlErrors.Errorf("%s", err.Error())},
+			})
+			return
+		}
+	}
+
+	ctx := withQueryBudget(c.Request.Context(), gh.maxComplexity)
+	response := gh.schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+
+	c.JSON(http.StatusOK, response)
+}
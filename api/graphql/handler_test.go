@@ -0,0 +1,489 @@
+package graphql_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/api/graphql"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type graphQLResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func createTestGraphQLConfig() config.GraphQLConfig {
+	return config.GraphQLConfig{
+		Enabled:        true,
+		MaxDepth:       6,
+		MaxComplexity:  5,
+		MaxQueryLength: 2000,
+	}
+}
+
+func sendQuery(t *testing.T, facade graphql.FacadeHandler, query string, variables map[string]interface{}) (int, *graphQLResponse) {
+	handler, err := graphql.NewGraphQLHandler(facade, createTestGraphQLConfig())
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.POST("/graphql", handler.Handle)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	req, _ := http.NewRequest(http.MethodPost, "/graphql", bytes.NewBuffer(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &graphQLResponse{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), response))
+
+	return resp.Code, response
+}
+
+// toJSON normalizes the expected values to their decoded JSON form
+func toJSON(value interface{}) interface{} {
+	buff, _ := json.Marshal(value)
+	var decoded interface{}
+	_ = json.Unmarshal(buff, &decoded)
+
+	return decoded
+}
+
+// createUnreachableFacade returns a facade failing the test when called, for the queries rejected before execution
+func createUnreachableFacade(t *testing.T) *mock.FacadeStub {
+	return &mock.FacadeStub{
+		GetAccountHandler: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		GetAccountsHandler: func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+}
+
+func TestNewGraphQLHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := graphql.NewGraphQLHandler(nil, createTestGraphQLConfig())
+		require.Nil(t, handler)
+		require.Equal(t, graphql.ErrNilFacade, err)
+	})
+	t.Run("invalid limits should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, update := range []func(cfg *config.GraphQLConfig){
+			func(cfg *config.GraphQLConfig) { cfg.MaxDepth = 0 },
+			func(cfg *config.GraphQLConfig) { cfg.MaxComplexity = 0 },
+			func(cfg *config.GraphQLConfig) { cfg.MaxQueryLength = 0 },
+		} {
+			cfg := createTestGraphQLConfig()
+			update(&cfg)
+			handler, err := graphql.NewGraphQLHandler(&mock.FacadeStub{}, cfg)
+			require.Nil(t, handler)
+			require.True(t, errors.Is(err, graphql.ErrInvalidGraphQLConfig))
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := graphql.NewGraphQLHandler(&mock.FacadeStub{}, createTestGraphQLConfig())
+		require.NoError(t, err)
+		require.NotNil(t, handler)
+	})
+}
+
+func TestGraphQLHandler_Account(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+			assert.True(t, options.BlockNonce.HasValue)
+			assert.Equal(t, uint64(100), options.BlockNonce.Value)
+
+			return &data.AccountModel{
+				Account: data.Account{Address: address, Nonce: 7, Balance: "1000"},
+			}, nil
+		},
+		GetAllESDTTokensCalled: func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+			assert.Equal(t, uint64(100), options.BlockNonce.Value)
+
+			return &data.GenericAPIResponse{Data: map[string]interface{}{
+				"esdts": map[string]interface{}{
+					"TKN-0002": map[string]interface{}{"tokenIdentifier": "TKN-0002", "balance": "2"},
+					"NFT-0001-01": map[string]interface{}{
+						"tokenIdentifier": "NFT-0001",
+						"balance":         "1",
+						"nonce":           1,
+						"uris":            []string{"dXJp"},
+					},
+				},
+			}}, nil
+		},
+		GetESDTsRolesCalled: func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{Data: map[string]interface{}{
+				"roles": map[string]interface{}{"TKN-0002": []string{"ESDTRoleLocalMint"}},
+			}}, nil
+		},
+		GetGuardianDataCalled: func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{Data: map[string]interface{}{
+				"guardianData": api.GuardianData{
+					ActiveGuardian: &api.Guardian{Address: "guardian", ActivationEpoch: 3, ServiceUID: "uid"},
+					Guarded:        true,
+				},
+			}}, nil
+		},
+		GetKeyValuePairsHandler: func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{Data: map[string]interface{}{
+				"pairs": map[string]string{"bb": "02", "aa": "01"},
+			}}, nil
+		},
+	}
+
+	query := `query($address: String!) {
+		account(address: $address, blockNonce: 100) {
+			address nonce balance
+			esdts { tokenIdentifier balance nonce uris }
+			roles { tokenIdentifier roles }
+			guardianData { guarded activeGuardian { address activationEpoch serviceUID } pendingGuardian { address } }
+			keyValuePairs { key value }
+		}
+	}`
+	code, response := sendQuery(t, facade, query, map[string]interface{}{"address": "alice"})
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, response.Errors)
+	assert.Equal(t, toJSON(map[string]interface{}{
+		"address": "alice",
+		"nonce":   7,
+		"balance": "1000",
+		"esdts": []interface{}{
+			map[string]interface{}{"tokenIdentifier": "NFT-0001", "balance": "1", "nonce": 1, "uris": []string{"dXJp"}},
+			map[string]interface{}{"tokenIdentifier": "TKN-0002", "balance": "2", "nonce": 0, "uris": []string{}},
+		},
+		"roles": []interface{}{
+			map[string]interface{}{"tokenIdentifier": "TKN-0002", "roles": []string{"ESDTRoleLocalMint"}},
+		},
+		"guardianData": map[string]interface{}{
+			"guarded":         true,
+			"activeGuardian":  map[string]interface{}{"address": "guardian", "activationEpoch": 3, "serviceUID": "uid"},
+			"pendingGuardian": nil,
+		},
+		"keyValuePairs": []interface{}{
+			map[string]interface{}{"key": "aa", "value": "01"},
+			map[string]interface{}{"key": "bb", "value": "02"},
+		},
+	}), response.Data["account"])
+}
+
+func TestGraphQLHandler_Accounts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should fetch the accounts at once and keep the order", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		facade := &mock.FacadeStub{
+			GetAccountsHandler: func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+				numCalls++
+				assert.Equal(t, []string{"bob", "unknown", "alice"}, addresses)
+				assert.True(t, options.OnFinalBlock)
+
+				return &data.AccountsModel{Accounts: map[string]*data.Account{
+					"alice": {Address: "alice", Balance: "1"},
+					"bob":   {Address: "bob", Balance: "2"},
+				}}, nil
+			},
+		}
+
+		query := `{ accounts(addresses: ["bob", "unknown", "alice"], onFinalBlock: true) { address balance } }`
+		_, response := sendQuery(t, facade, query, nil)
+		require.Empty(t, response.Errors)
+		assert.Equal(t, 1, numCalls)
+		assert.Equal(t, toJSON([]interface{}{
+			map[string]interface{}{"address": "bob", "balance": "2"},
+			map[string]interface{}{"address": "alice", "balance": "1"},
+		}), response.Data["accounts"])
+	})
+	t.Run("too many addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ accounts(addresses: ["a", "b", "c", "d", "e", "f"]) { address } }`
+		_, response := sendQuery(t, &mock.FacadeStub{}, query, nil)
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0].Message, graphql.ErrQueryTooComplex.Error())
+	})
+}
+
+func TestGraphQLHandler_Transaction(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			assert.Equal(t, "hash", txHash)
+			assert.True(t, withResults)
+
+			return &transaction.ApiTransactionResult{
+				Hash:     txHash,
+				Nonce:    3,
+				Status:   transaction.TxStatusSuccess,
+				Data:     []byte("data"),
+				GasLimit: 50000,
+				SmartContractResults: []*transaction.ApiSmartContractResult{
+					{Hash: "scr", Value: big.NewInt(10), Data: "@6f6b"},
+				},
+				Logs: &transaction.ApiLogs{
+					Address: "sc",
+					Events:  []*transaction.Events{{Identifier: "transfer", Topics: [][]byte{[]byte("topic")}}},
+				},
+			}, nil
+		},
+	}
+
+	query := `{
+		transaction(hash: "hash", withResults: true) {
+			hash nonce status data gasLimit
+			smartContractResults { hash value data }
+			logs { address events { identifier topics data additionalData } }
+		}
+	}`
+	_, response := sendQuery(t, facade, query, nil)
+	require.Empty(t, response.Errors)
+	assert.Equal(t, toJSON(map[string]interface{}{
+		"hash":                 "hash",
+		"nonce":                3,
+		"status":               "success",
+		"data":                 "ZGF0YQ==",
+		"gasLimit":             50000,
+		"smartContractResults": []interface{}{map[string]interface{}{"hash": "scr", "value": "10", "data": "@6f6b"}},
+		"logs": map[string]interface{}{
+			"address": "sc",
+			"events": []interface{}{map[string]interface{}{
+				"identifier":     "transfer",
+				"topics":         []string{"dG9waWM="},
+				"data":           "",
+				"additionalData": []string{},
+			}},
+		},
+	}), response.Data["transaction"])
+}
+
+func TestGraphQLHandler_Blocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("block by nonce should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				assert.Equal(t, uint32(4294967295), shardID)
+				assert.Equal(t, uint64(5), nonce)
+				assert.True(t, options.WithTransactions)
+
+				return &data.BlockApiResponse{Data: data.BlockApiResponsePayload{Block: api.Block{
+					Nonce: nonce,
+					Shard: shardID,
+					MiniBlocks: []*api.MiniBlock{
+						{Hash: "mb", Transactions: []*transaction.ApiTransactionResult{{Hash: "tx"}}},
+					},
+				}}}, nil
+			},
+		}
+
+		query := `{ block(shard: "4294967295", nonce: 5, withTransactions: true) { nonce shard miniBlocks { hash transactions { hash } } } }`
+		_, response := sendQuery(t, facade, query, nil)
+		require.Empty(t, response.Errors)
+		assert.Equal(t, toJSON(map[string]interface{}{
+			"nonce": 5,
+			"shard": 4294967295,
+			"miniBlocks": []interface{}{
+				map[string]interface{}{"hash": "mb", "transactions": []interface{}{map[string]interface{}{"hash": "tx"}}},
+			},
+		}), response.Data["block"])
+	})
+	t.Run("hyperblock by hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetHyperBlockByHashCalled: func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return &data.HyperblockApiResponse{Data: data.HyperblockApiResponsePayload{Hyperblock: api.Hyperblock{
+					Hash:        hash,
+					ShardBlocks: []*api.NotarizedBlock{{Hash: "shard block", Shard: 1}},
+				}}}, nil
+			},
+		}
+
+		query := `{ hyperblock(hash: "abcd") { hash shardBlocks { hash shard } transactions { hash } } }`
+		_, response := sendQuery(t, facade, query, nil)
+		require.Empty(t, response.Errors)
+		assert.Equal(t, toJSON(map[string]interface{}{
+			"hash":         "abcd",
+			"shardBlocks":  []interface{}{map[string]interface{}{"hash": "shard block", "shard": 1}},
+			"transactions": []interface{}{},
+		}), response.Data["hyperblock"])
+	})
+	t.Run("both nonce and hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ block(shard: 0, nonce: 5, hash: "abcd") { nonce } }`
+		_, response := sendQuery(t, &mock.FacadeStub{}, query, nil)
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0].Message, graphql.ErrInvalidBlockCoordinates.Error())
+	})
+}
+
+func TestGraphQLHandler_NetworkAndVmQuery(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetConfigMetricsHandler: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{Data: map[string]interface{}{
+				"config": map[string]interface{}{"erd_chain_id": "T"},
+			}}, nil
+		},
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			assert.Equal(t, "sc", query.ScAddress)
+			assert.Equal(t, "getSum", query.FuncName)
+			assert.Equal(t, [][]byte{{0x0a}}, query.Arguments)
+
+			return &vm.VMOutputApi{ReturnData: [][]byte{{0x0b}}, ReturnCode: "ok"}, data.BlockInfo{}, nil
+		},
+	}
+
+	query := `{
+		networkConfig
+		vmQuery(scAddress: "sc", funcName: "getSum", args: ["0a"]) { returnData returnCode }
+	}`
+	_, response := sendQuery(t, facade, query, nil)
+	require.Empty(t, response.Errors)
+	assert.Equal(t, toJSON(map[string]interface{}{"erd_chain_id": "T"}), response.Data["networkConfig"])
+	assert.Equal(t, toJSON(map[string]interface{}{"returnData": []string{"Cw=="}, "returnCode": "ok"}), response.Data["vmQuery"])
+
+	query = `{ vmQuery(scAddress: "sc", funcName: "getSum", args: ["zz"]) { returnCode } }`
+	_, response = sendQuery(t, facade, query, nil)
+	require.Len(t, response.Errors, 1)
+	assert.Contains(t, response.Errors[0].Message, "not a valid hex string")
+}
+
+func TestGraphQLHandler_Limits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("exhausted complexity budget should error", func(t *testing.T) {
+		t.Parallel()
+
+		// the account and the 5 values need 6 requests, while the budget is 5
+		query := `{ account(address: "alice") {
+			a: value(key: "01") b: value(key: "02") c: value(key: "03") d: value(key: "04") e: value(key: "05")
+		} }`
+		_, response := sendQuery(t, createUnreachableFacade(t), query, nil)
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0].Message, graphql.ErrQueryTooComplex.Error())
+		assert.Nil(t, response.Data)
+	})
+	t.Run("exhausted complexity budget through fragments should error", func(t *testing.T) {
+		t.Parallel()
+
+		// each of the 2 accounts needs 3 requests, while the budget is 5
+		query := `query Balances($addresses: [String!]!) {
+			accounts(addresses: $addresses) { ...tokens }
+		}
+		fragment tokens on Account { esdts { balance } ... on Account { roles { roles } value(key: "01") } }`
+		variables := map[string]interface{}{"addresses": []string{"alice", "bob"}}
+		_, response := sendQuery(t, createUnreachableFacade(t), query, variables)
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0].Message, graphql.ErrQueryTooComplex.Error())
+	})
+	t.Run("too many addresses in a variable should error", func(t *testing.T) {
+		t.Parallel()
+
+		query := `query Balances($addresses: [String!]! = ["a", "b", "c", "d", "e", "f"]) {
+			accounts(addresses: $addresses) { address }
+		}`
+		_, response := sendQuery(t, createUnreachableFacade(t), query, nil)
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0].Message, graphql.ErrQueryTooComplex.Error())
+	})
+	t.Run("query within the complexity budget should work", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				atomic.AddUint32(&numCalls, 1)
+				return &data.AccountModel{Account: data.Account{Address: address}}, nil
+			},
+			GetValueForKeyHandler: func(address string, key string, options common.AccountQueryOptions) (string, error) {
+				atomic.AddUint32(&numCalls, 1)
+				return key, nil
+			},
+		}
+
+		// the account and the 4 values need 5 requests, the budget
+		query := `# the values of the account
+		{ account(address: "alice") { address ...values } }
+		fragment values on Account { a: value(key: "01") b: value(key: "02") c: value(key: "03") d: value(key: "04") }`
+		_, response := sendQuery(t, facade, query, nil)
+		require.Empty(t, response.Errors)
+		assert.Equal(t, uint32(5), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("invalid query should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, response := sendQuery(t, createUnreachableFacade(t), `{ account(address: "alice") { address }`, nil)
+		require.Len(t, response.Errors, 1)
+		assert.Nil(t, response.Data)
+	})
+	t.Run("too deep query should error", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ a: transaction(hash: "h") { logs { events { identifier } } }
+			b: block(shard: 0, nonce: 1) { miniBlocks { transactions { smartContractResults { logs { events { data } } } } } } }`
+		_, response := sendQuery(t, &mock.FacadeStub{}, query, nil)
+		require.NotEmpty(t, response.Errors)
+		assert.Nil(t, response.Data)
+	})
+	t.Run("too long query should error", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ networkConfig }` + strings.Repeat(" ", 2000)
+		_, response := sendQuery(t, &mock.FacadeStub{}, query, nil)
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0].Message, "exceeds the maximum allowed query length")
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := graphql.NewGraphQLHandler(&mock.FacadeStub{}, createTestGraphQLConfig())
+		ws := gin.New()
+		ws.POST("/graphql", handler.Handle)
+
+		req, _ := http.NewRequest(http.MethodPost, "/graphql", bytes.NewBufferString("not json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
@@ -0,0 +1,217 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	accountField  = "account"
+	accountsField = "accounts"
+	addressesArg  = "addresses"
+)
+
+// accountFieldsWithRequests are the fields of an account fetched with a request to the observers of their own, the
+// other ones coming along with the account
+var accountFieldsWithRequests = map[string]struct{}{
+	"esdts":         {},
+	"esdt":          {},
+	"nft":           {},
+	"roles":         {},
+	"guardianData":  {},
+	"keyValuePairs": {},
+	"value":         {},
+}
+
+// selectionLevel tells whether the selections are the root fields of the query or the fields of an account
+type selectionLevel int
+
+const (
+	queryLevel selectionLevel = iota
+	accountLevel
+)
+
+type fragmentKey struct {
+	level selectionLevel
+	name  string
+}
+
+// queryCostCalculator computes the number of requests a query makes to the observers, out of its document. Each root
+// field makes one request, while each account makes one more for each of its nested fields with requests. The cost of
+// each fragment is computed once, so that the fragments spreading others many times cannot slow down the computation
+type queryCostCalculator struct {
+	document      *queryDocument
+	variables     map[string]interface{}
+	defaults      map[string]*queryValue
+	limit         int
+	fragmentCosts map[fragmentKey]int
+	spreading     map[string]bool
+}
+
+// checkQueryCost rejects the queries needing more requests to the observers than the limit, before they are executed.
+// The queries the schema rejects anyway, such as the ones with an unknown operation, are let through
+func checkQueryCost(query string, operationName string, variables map[string]interface{}, limit int) error {
+	document, err := parseQueryDocument(query)
+	if err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+
+	operation := document.getOperation(operationName)
+	if operation == nil {
+		return nil
+	}
+
+	calculator := &queryCostCalculator{
+		document:      document,
+		variables:     variables,
+		defaults:      operation.variableValue,
+		limit:         limit,
+		fragmentCosts: make(map[fragmentKey]int),
+		spreading:     make(map[string]bool),
+	}
+	cost, err := calculator.computeCost(queryLevel, operation.selections)
+	if err != nil {
+		return err
+	}
+	if cost > limit {
+		return fmt.Errorf("%w: it needs more than %d requests to the observers", ErrQueryTooComplex, limit)
+	}
+
+	return nil
+}
+
+func (qd *queryDocument) getOperation(operationName string) *queryOperation {
+	if len(operationName) == 0 {
+		if len(qd.operations) != 1 {
+			return nil
+		}
+
+		return qd.operations[0]
+	}
+
+	for _, operation := range qd.operations {
+		if operation.name == operationName {
+			return operation
+		}
+	}
+
+	return nil
+}
+
+// computeCost returns the cost of the selections, stopping as soon as it exceeds the limit. The fields with the same
+// alias are merged when the query is executed, hence the cost might be slightly overestimated
+func (qcc *queryCostCalculator) computeCost(level selectionLevel, selections []*querySelection) (int, error) {
+	cost := 0
+	for _, selection := range selections {
+		var selectionCost int
+		var err error
+		switch {
+		case selection.field != nil:
+			selectionCost, err = qcc.computeFieldCost(level, selection.field)
+		case len(selection.fragmentName) > 0:
+			selectionCost, err = qcc.computeFragmentCost(level, selection.fragmentName)
+		default:
+			selectionCost, err = qcc.computeCost(level, selection.inlineFragment)
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		cost += selectionCost
+		if cost > qcc.limit {
+			return cost, nil
+		}
+	}
+
+	return cost, nil
+}
+
+func (qcc *queryCostCalculator) computeFieldCost(level selectionLevel, field *queryField) (int, error) {
+	if level == accountLevel {
+		_, hasRequest := accountFieldsWithRequests[field.name]
+		if hasRequest {
+			return 1, nil
+		}
+
+		return 0, nil
+	}
+
+	switch {
+	case strings.HasPrefix(field.name, "__"):
+		// the introspection fields are answered by the schema itself
+		return 0, nil
+	case field.name == accountField:
+		accountCost, err := qcc.computeCost(accountLevel, field.selections)
+		return 1 + accountCost, err
+	case field.name == accountsField:
+		numAccounts := qcc.listLength(field.arguments[addressesArg])
+		if numAccounts > qcc.limit {
+			return 0, fmt.Errorf("%w: it lists %d items, more than the maximum of %d", ErrQueryTooComplex, numAccounts, qcc.limit)
+		}
+
+		accountCost, err := qcc.computeCost(accountLevel, field.selections)
+		return 1 + numAccounts*accountCost, err
+	default:
+		return 1, nil
+	}
+}
+
+func (qcc *queryCostCalculator) computeFragmentCost(level selectionLevel, name string) (int, error) {
+	key := fragmentKey{level: level, name: name}
+	cost, computed := qcc.fragmentCosts[key]
+	if computed {
+		return cost, nil
+	}
+
+	selections, found := qcc.document.fragments[name]
+	if !found {
+		return 0, fmt.Errorf("invalid query: unknown fragment %q", name)
+	}
+	if qcc.spreading[name] {
+		return 0, fmt.Errorf("invalid query: fragment %q spreads itself", name)
+	}
+
+	qcc.spreading[name] = true
+	cost, err := qcc.computeCost(level, selections)
+	delete(qcc.spreading, name)
+	if err != nil {
+		return 0, err
+	}
+
+	qcc.fragmentCosts[key] = cost
+
+	return cost, nil
+}
+
+// listLength returns the number of items of a list argument. A single value is read as a list of one item, as the
+// GraphQL input coercion does
+func (qcc *queryCostCalculator) listLength(value *queryValue) int {
+	if value == nil {
+		return 0
+	}
+	if value.isList {
+		return len(value.items)
+	}
+	if len(value.variable) == 0 {
+		return 1
+	}
+
+	variable, provided := qcc.variables[value.variable]
+	if !provided {
+		defaultValue := qcc.defaults[value.variable]
+		if defaultValue == nil || len(defaultValue.variable) > 0 {
+			return 0
+		}
+
+		return qcc.listLength(defaultValue)
+	}
+
+	switch items := variable.(type) {
+	case nil:
+		return 0
+	case []interface{}:
+		return len(items)
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,587 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// queryDocument is the executable document of a query, reduced to what the complexity of the query depends on: the
+// selected fields, their arguments and the fragments they are spread from
+type queryDocument struct {
+	operations []*queryOperation
+	fragments  map[string][]*querySelection
+}
+
+type queryOperation struct {
+	name          string
+	selections    []*querySelection
+	variableValue map[string]*queryValue
+}
+
+// querySelection is either a field, a spread of a named fragment or an inline fragment
+type querySelection struct {
+	field          *queryField
+	fragmentName   string
+	inlineFragment []*querySelection
+}
+
+type queryField struct {
+	name       string
+	arguments  map[string]*queryValue
+	selections []*querySelection
+}
+
+// queryValue is the value of an argument or the default value of a variable. Only the lists and the variables are
+// kept, the scalars and the objects being read as single values
+type queryValue struct {
+	variable string
+	isList   bool
+	items    []*queryValue
+}
+
+const (
+	tokenEOF = iota
+	tokenPunctuator
+	tokenName
+	tokenNumber
+	tokenString
+)
+
+const byteOrderMark = "\uFEFF"
+
+type queryToken struct {
+	kind  int
+	value string
+	start int
+}
+
+// queryParser parses the GraphQL executable documents, as specified by https://spec.graphql.org/October2021
+type queryParser struct {
+	input string
+	pos   int
+	token queryToken
+}
+
+func parseQueryDocument(input string) (*queryDocument, error) {
+	parser := &queryParser{input: input}
+	err := parser.next()
+	if err != nil {
+		return nil, err
+	}
+
+	return parser.parseDocument()
+}
+
+func (qp *queryParser) parseDocument() (*queryDocument, error) {
+	document := &queryDocument{
+		fragments: make(map[string][]*querySelection),
+	}
+	for qp.token.kind != tokenEOF {
+		if qp.isName("fragment") {
+			name, selections, err := qp.parseFragmentDefinition()
+			if err != nil {
+				return nil, err
+			}
+
+			document.fragments[name] = selections
+			continue
+		}
+
+		operation, err := qp.parseOperationDefinition()
+		if err != nil {
+			return nil, err
+		}
+
+		document.operations = append(document.operations, operation)
+	}
+
+	return document, nil
+}
+
+func (qp *queryParser) parseOperationDefinition() (*queryOperation, error) {
+	operation := &queryOperation{
+		variableValue: make(map[string]*queryValue),
+	}
+	if qp.isPunctuator("{") {
+		var err error
+		operation.selections, err = qp.parseSelectionSet()
+		return operation, err
+	}
+
+	operationType, err := qp.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if operationType != "query" && operationType != "mutation" && operationType != "subscription" {
+		return nil, fmt.Errorf("unexpected %q, expecting an operation", operationType)
+	}
+	if qp.token.kind == tokenName {
+		operation.name = qp.token.value
+		err = qp.next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if qp.isPunctuator("(") {
+		err = qp.parseVariableDefinitions(operation.variableValue)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = qp.parseDirectives()
+	if err != nil {
+		return nil, err
+	}
+
+	operation.selections, err = qp.parseSelectionSet()
+
+	return operation, err
+}
+
+func (qp *queryParser) parseVariableDefinitions(defaultValues map[string]*queryValue) error {
+	err := qp.expectPunctuator("(")
+	if err != nil {
+		return err
+	}
+
+	for !qp.isPunctuator(")") {
+		err = qp.expectPunctuator("$")
+		if err != nil {
+			return err
+		}
+		name, err := qp.expectName()
+		if err != nil {
+			return err
+		}
+		err = qp.expectPunctuator(":")
+		if err != nil {
+			return err
+		}
+		err = qp.parseType()
+		if err != nil {
+			return err
+		}
+		if qp.isPunctuator("=") {
+			err = qp.next()
+			if err != nil {
+				return err
+			}
+			defaultValues[name], err = qp.parseValue()
+			if err != nil {
+				return err
+			}
+		}
+		err = qp.parseDirectives()
+		if err != nil {
+			return err
+		}
+	}
+
+	return qp.next()
+}
+
+func (qp *queryParser) parseType() error {
+	if qp.isPunctuator("[") {
+		err := qp.next()
+		if err != nil {
+			return err
+		}
+		err = qp.parseType()
+		if err != nil {
+			return err
+		}
+		err = qp.expectPunctuator("]")
+		if err != nil {
+			return err
+		}
+	} else {
+		_, err := qp.expectName()
+		if err != nil {
+			return err
+		}
+	}
+
+	if qp.isPunctuator("!") {
+		return qp.next()
+	}
+
+	return nil
+}
+
+func (qp *queryParser) parseFragmentDefinition() (string, []*querySelection, error) {
+	err := qp.next()
+	if err != nil {
+		return "", nil, err
+	}
+	name, err := qp.expectName()
+	if err != nil {
+		return "", nil, err
+	}
+	err = qp.parseTypeCondition()
+	if err != nil {
+		return "", nil, err
+	}
+	err = qp.parseDirectives()
+	if err != nil {
+		return "", nil, err
+	}
+
+	selections, err := qp.parseSelectionSet()
+
+	return name, selections, err
+}
+
+func (qp *queryParser) parseTypeCondition() error {
+	if !qp.isName("on") {
+		return fmt.Errorf("unexpected %q, expecting \"on\"", qp.token.value)
+	}
+	err := qp.next()
+	if err != nil {
+		return err
+	}
+
+	_, err = qp.expectName()
+
+	return err
+}
+
+func (qp *queryParser) parseSelectionSet() ([]*querySelection, error) {
+	err := qp.expectPunctuator("{")
+	if err != nil {
+		return nil, err
+	}
+
+	selections := make([]*querySelection, 0)
+	for !qp.isPunctuator("}") {
+		selection, err := qp.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+
+		selections = append(selections, selection)
+	}
+
+	return selections, qp.next()
+}
+
+func (qp *queryParser) parseSelection() (*querySelection, error) {
+	if !qp.isPunctuator("...") {
+		field, err := qp.parseField()
+		return &querySelection{field: field}, err
+	}
+
+	err := qp.next()
+	if err != nil {
+		return nil, err
+	}
+	if qp.token.kind == tokenName && !qp.isName("on") {
+		fragmentName := qp.token.value
+		err = qp.next()
+		if err != nil {
+			return nil, err
+		}
+
+		return &querySelection{fragmentName: fragmentName}, qp.parseDirectives()
+	}
+
+	if qp.isName("on") {
+		err = qp.parseTypeCondition()
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = qp.parseDirectives()
+	if err != nil {
+		return nil, err
+	}
+
+	selections, err := qp.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return &querySelection{inlineFragment: selections}, nil
+}
+
+func (qp *queryParser) parseField() (*queryField, error) {
+	name, err := qp.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if qp.isPunctuator(":") {
+		err = qp.next()
+		if err != nil {
+			return nil, err
+		}
+		name, err = qp.expectName()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	field := &queryField{
+		name:       name,
+		selections: make([]*querySelection, 0),
+	}
+	field.arguments, err = qp.parseArguments()
+	if err != nil {
+		return nil, err
+	}
+	err = qp.parseDirectives()
+	if err != nil {
+		return nil, err
+	}
+	if qp.isPunctuator("{") {
+		field.selections, err = qp.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return field, nil
+}
+
+func (qp *queryParser) parseArguments() (map[string]*queryValue, error) {
+	arguments := make(map[string]*queryValue)
+	if !qp.isPunctuator("(") {
+		return arguments, nil
+	}
+
+	err := qp.next()
+	if err != nil {
+		return nil, err
+	}
+	for !qp.isPunctuator(")") {
+		name, err := qp.expectName()
+		if err != nil {
+			return nil, err
+		}
+		err = qp.expectPunctuator(":")
+		if err != nil {
+			return nil, err
+		}
+		arguments[name], err = qp.parseValue()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return arguments, qp.next()
+}
+
+func (qp *queryParser) parseDirectives() error {
+	for qp.isPunctuator("@") {
+		err := qp.next()
+		if err != nil {
+			return err
+		}
+		_, err = qp.expectName()
+		if err != nil {
+			return err
+		}
+		_, err = qp.parseArguments()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (qp *queryParser) parseValue() (*queryValue, error) {
+	switch {
+	case qp.isPunctuator("$"):
+		err := qp.next()
+		if err != nil {
+			return nil, err
+		}
+		name, err := qp.expectName()
+		if err != nil {
+			return nil, err
+		}
+
+		return &queryValue{variable: name}, nil
+	case qp.isPunctuator("["):
+		err := qp.next()
+		if err != nil {
+			return nil, err
+		}
+		value := &queryValue{isList: true}
+		for !qp.isPunctuator("]") {
+			item, err := qp.parseValue()
+			if err != nil {
+				return nil, err
+			}
+
+			value.items = append(value.items, item)
+		}
+
+		return value, qp.next()
+	case qp.isPunctuator("{"):
+		err := qp.next()
+		if err != nil {
+			return nil, err
+		}
+		for !qp.isPunctuator("}") {
+			_, err = qp.expectName()
+			if err != nil {
+				return nil, err
+			}
+			err = qp.expectPunctuator(":")
+			if err != nil {
+				return nil, err
+			}
+			_, err = qp.parseValue()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &queryValue{}, qp.next()
+	case qp.token.kind == tokenName || qp.token.kind == tokenNumber || qp.token.kind == tokenString:
+		return &queryValue{}, qp.next()
+	default:
+		return nil, qp.unexpectedToken("a value")
+	}
+}
+
+func (qp *queryParser) isPunctuator(value string) bool {
+	return qp.token.kind == tokenPunctuator && qp.token.value == value
+}
+
+func (qp *queryParser) isName(value string) bool {
+	return qp.token.kind == tokenName && qp.token.value == value
+}
+
+func (qp *queryParser) expectPunctuator(value string) error {
+	if !qp.isPunctuator(value) {
+		return qp.unexpectedToken(fmt.Sprintf("%q", value))
+	}
+
+	return qp.next()
+}
+
+func (qp *queryParser) expectName() (string, error) {
+	if qp.token.kind != tokenName {
+		return "", qp.unexpectedToken("a name")
+	}
+
+	name := qp.token.value
+
+	return name, qp.next()
+}
+
+func (qp *queryParser) unexpectedToken(expected string) error {
+	if qp.token.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of the query, expecting %s", expected)
+	}
+
+	return fmt.Errorf("unexpected %q at position %d, expecting %s", qp.token.value, qp.token.start, expected)
+}
+
+// next reads the following token, skipping the ignored characters: the white spaces, the line terminators, the
+// commas, the unicode BOM and the comments
+func (qp *queryParser) next() error {
+	for qp.pos < len(qp.input) {
+		char := qp.input[qp.pos]
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == ',':
+			qp.pos++
+		case strings.HasPrefix(qp.input[qp.pos:], byteOrderMark):
+			qp.pos += len(byteOrderMark)
+		case char == '#':
+			for qp.pos < len(qp.input) && qp.input[qp.pos] != '\n' && qp.input[qp.pos] != '\r' {
+				qp.pos++
+			}
+		default:
+			return qp.readToken()
+		}
+	}
+
+	qp.token = queryToken{kind: tokenEOF}
+
+	return nil
+}
+
+func (qp *queryParser) readToken() error {
+	start := qp.pos
+	char := qp.input[qp.pos]
+	switch {
+	case strings.HasPrefix(qp.input[start:], "..."):
+		qp.pos += len("...")
+		qp.token = queryToken{kind: tokenPunctuator, value: "..."}
+	case strings.ContainsRune("!$&():=@[]{}|", rune(char)):
+		qp.pos++
+		qp.token = queryToken{kind: tokenPunctuator, value: string(char)}
+	case isNameStart(char):
+		for qp.pos < len(qp.input) && (isNameStart(qp.input[qp.pos]) || isDigit(qp.input[qp.pos])) {
+			qp.pos++
+		}
+		qp.token = queryToken{kind: tokenName, value: qp.input[start:qp.pos]}
+	case char == '-' || isDigit(char):
+		qp.pos++
+		for qp.pos < len(qp.input) && (isDigit(qp.input[qp.pos]) || strings.ContainsRune(".eE+-", rune(qp.input[qp.pos]))) {
+			qp.pos++
+		}
+		qp.token = queryToken{kind: tokenNumber, value: qp.input[start:qp.pos]}
+	case strings.HasPrefix(qp.input[start:], `"""`):
+		end := findBlockStringEnd(qp.input, start+len(`"""`))
+		if end < 0 {
+			return fmt.Errorf("unterminated block string at position %d", start)
+		}
+		qp.pos = end + len(`"""`)
+		qp.token = queryToken{kind: tokenString, value: qp.input[start:qp.pos]}
+	case char == '"':
+		end := findStringEnd(qp.input, start+1)
+		if end < 0 {
+			return fmt.Errorf("unterminated string at position %d", start)
+		}
+		qp.pos = end + 1
+		qp.token = queryToken{kind: tokenString, value: qp.input[start:qp.pos]}
+	default:
+		return fmt.Errorf("unexpected character %q at position %d", char, start)
+	}
+	qp.token.start = start
+
+	return nil
+}
+
+func findStringEnd(input string, pos int) int {
+	for pos < len(input) {
+		switch input[pos] {
+		case '\\':
+			pos += 2
+		case '"':
+			return pos
+		case '\n', '\r':
+			return -1
+		default:
+			pos++
+		}
+	}
+
+	return -1
+}
+
+func findBlockStringEnd(input string, pos int) int {
+	for pos < len(input) {
+		if strings.HasPrefix(input[pos:], `\"""`) {
+			pos += len(`\"""`)
+			continue
+		}
+		if strings.HasPrefix(input[pos:], `"""`) {
+			return pos
+		}
+		pos++
+	}
+
+	return -1
+}
+
+func isNameStart(char byte) bool {
+	return char == '_' || (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+}
+
+func isDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
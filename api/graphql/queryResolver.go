@@ -0,0 +1,290 @@
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// queryResolver resolves the root fields of the schema. Each root field makes one request to the observers
+type queryResolver struct {
+	facade FacadeHandler
+}
+
+// Account returns an account, optionally as it was at the given block nonce
+func (qr *queryResolver) Account(ctx context.Context, args struct {
+	Address      string
+	BlockNonce   *Uint64
+	OnFinalBlock *bool
+}) (*accountResolver, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	options := common.AccountQueryOptions{
+		OnFinalBlock: isSet(args.OnFinalBlock),
+	}
+	if args.BlockNonce != nil {
+		options.BlockNonce = core.OptionalUint64{Value: uint64(*args.BlockNonce), HasValue: true}
+	}
+
+	accountModel, err := qr.facade.GetAccount(ctx, args.Address, options)
+	if err != nil {
+		return nil, err
+	}
+	if accountModel == nil {
+		return nil, ErrNilResponse
+	}
+
+	return newAccountResolver(qr.facade, &accountModel.Account, options), nil
+}
+
+// Accounts returns many accounts, fetched with one request to each shard. The accounts are returned in the order of
+// the provided addresses, the unknown ones being skipped
+func (qr *queryResolver) Accounts(ctx context.Context, args struct {
+	Addresses    []string
+	OnFinalBlock *bool
+}) ([]*accountResolver, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	err = checkListLength(ctx, len(args.Addresses))
+	if err != nil {
+		return nil, err
+	}
+
+	options := common.AccountQueryOptions{
+		OnFinalBlock: isSet(args.OnFinalBlock),
+	}
+	accountsModel, err := qr.facade.GetAccounts(ctx, args.Addresses, options)
+	if err != nil {
+		return nil, err
+	}
+	if accountsModel == nil {
+		return nil, ErrNilResponse
+	}
+
+	accounts := make([]*accountResolver, 0, len(args.Addresses))
+	for _, address := range args.Addresses {
+		account, found := accountsModel.Accounts[address]
+		if !found || account == nil {
+			continue
+		}
+
+		accounts = append(accounts, newAccountResolver(qr.facade, account, options))
+	}
+
+	return accounts, nil
+}
+
+// Transaction returns a transaction, optionally along with its smart contract results and logs
+func (qr *queryResolver) Transaction(ctx context.Context, args struct {
+	Hash        string
+	WithResults *bool
+}) (*transactionResult, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := qr.facade.GetTransaction(ctx, args.Hash, isSet(args.WithResults))
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, ErrNilResponse
+	}
+
+	return newTransactionResult(tx), nil
+}
+
+// Block returns a block of a shard, by its nonce or by its hash
+func (qr *queryResolver) Block(ctx context.Context, args struct {
+	Shard            Uint64
+	Nonce            *Uint64
+	Hash             *string
+	WithTransactions *bool
+	WithLogs         *bool
+}) (*blockResult, error) {
+	err := checkBlockCoordinates(args.Nonce, args.Hash)
+	if err != nil {
+		return nil, err
+	}
+	err = spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	options := common.BlockQueryOptions{
+		WithTransactions: isSet(args.WithTransactions),
+		WithLogs:         isSet(args.WithLogs),
+	}
+	shardID := uint32(args.Shard)
+	var response *data.BlockApiResponse
+	if args.Nonce != nil {
+		response, err = qr.facade.GetBlockByNonce(ctx, shardID, uint64(*args.Nonce), options)
+	} else {
+		response, err = qr.facade.GetBlockByHash(ctx, shardID, *args.Hash, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, ErrNilResponse
+	}
+
+	return newBlockResult(&response.Data.Block), nil
+}
+
+// Hyperblock returns a hyperblock, by its nonce or by its hash
+func (qr *queryResolver) Hyperblock(ctx context.Context, args struct {
+	Nonce    *Uint64
+	Hash     *string
+	WithLogs *bool
+}) (*hyperblockResult, error) {
+	err := checkBlockCoordinates(args.Nonce, args.Hash)
+	if err != nil {
+		return nil, err
+	}
+	err = spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	options := common.HyperblockQueryOptions{
+		WithLogs: isSet(args.WithLogs),
+	}
+	var response *data.HyperblockApiResponse
+	if args.Nonce != nil {
+		response, err = qr.facade.GetHyperBlockByNonce(ctx, uint64(*args.Nonce), options)
+	} else {
+		response, err = qr.facade.GetHyperBlockByHash(ctx, *args.Hash, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, ErrNilResponse
+	}
+
+	return newHyperblockResult(&response.Data.Hyperblock), nil
+}
+
+// NetworkConfig returns the configuration metrics of the network
+func (qr *queryResolver) NetworkConfig(ctx context.Context) (*JSON, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := qr.facade.GetNetworkConfigMetrics(ctx)
+
+	return unwrapMetrics(response, err, "config")
+}
+
+// NetworkStatus returns the status metrics of a shard
+func (qr *queryResolver) NetworkStatus(ctx context.Context, args struct{ Shard Uint64 }) (*JSON, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := qr.facade.GetNetworkStatusMetrics(ctx, uint32(args.Shard))
+
+	return unwrapMetrics(response, err, "status")
+}
+
+// Economics returns the economics metrics of the network
+func (qr *queryResolver) Economics(ctx context.Context) (*JSON, error) {
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := qr.facade.GetEconomicsDataMetrics(ctx)
+
+	return unwrapMetrics(response, err, "metrics")
+}
+
+// unwrapMetrics returns the metrics held by the response under the given field
+func unwrapMetrics(response *data.GenericAPIResponse, err error, field string) (*JSON, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make(map[string]interface{})
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSON{Value: payload[field]}, nil
+}
+
+// VmQuery executes a read-only function of a smart contract. The arguments are hex encoded
+func (qr *queryResolver) VmQuery(ctx context.Context, args struct {
+	ScAddress  string
+	FuncName   string
+	Caller     *string
+	Value      *string
+	Args       *[]string
+	BlockNonce *Uint64
+}) (*vmQueryResult, error) {
+	query := &data.SCQuery{
+		ScAddress: args.ScAddress,
+		FuncName:  args.FuncName,
+		Arguments: make([][]byte, 0),
+	}
+	if args.Caller != nil {
+		query.CallerAddr = *args.Caller
+	}
+	if args.Value != nil {
+		query.CallValue = *args.Value
+	}
+	if args.BlockNonce != nil {
+		query.BlockNonce = core.OptionalUint64{Value: uint64(*args.BlockNonce), HasValue: true}
+	}
+	if args.Args != nil {
+		for _, arg := range *args.Args {
+			argBytes, err := hex.DecodeString(arg)
+			if err != nil {
+				return nil, fmt.Errorf("'%s' is not a valid hex string: %s", arg, err.Error())
+			}
+
+			query.Arguments = append(query.Arguments, argBytes)
+		}
+	}
+
+	err := spend(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput, _, err := qr.facade.ExecuteSCQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput == nil {
+		return nil, ErrNilResponse
+	}
+
+	return newVmQueryResult(vmOutput), nil
+}
+
+func checkBlockCoordinates(nonce *Uint64, hash *string) error {
+	if (nonce == nil) == (hash == nil) {
+		return ErrInvalidBlockCoordinates
+	}
+
+	return nil
+}
+
+func isSet(flag *bool) bool {
+	return flag != nil && *flag
+}
@@ -0,0 +1,337 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// the types below hold the data fetched from the observers in the shape of the GraphQL schema, their fields being
+// resolved by name
+
+type transactionResult struct {
+	Hash                 string
+	Type                 string
+	Status               string
+	Nonce                Uint64
+	Round                Uint64
+	Epoch                Uint64
+	Value                string
+	Sender               string
+	Receiver             string
+	GasPrice             Uint64
+	GasLimit             Uint64
+	GasUsed              Uint64
+	Data                 string
+	Signature            string
+	SourceShard          Uint64
+	DestinationShard     Uint64
+	BlockNonce           Uint64
+	BlockHash            string
+	MiniBlockHash        string
+	HyperblockNonce      Uint64
+	HyperblockHash       string
+	Timestamp            Uint64
+	Function             string
+	Operation            string
+	Fee                  string
+	InitiallyPaidFee     string
+	SmartContractResults []*smartContractResult
+	Logs                 *logsResult
+}
+
+type smartContractResult struct {
+	Hash           string
+	Nonce          Uint64
+	Value          string
+	Sender         string
+	Receiver       string
+	Data           string
+	PrevTxHash     string
+	OriginalTxHash string
+	GasLimit       Uint64
+	GasPrice       Uint64
+	CallType       int32
+	ReturnMessage  string
+	Logs           *logsResult
+}
+
+type logsResult struct {
+	Address string
+	Events  []*eventResult
+}
+
+type eventResult struct {
+	Address        string
+	Identifier     string
+	Topics         []string
+	Data           string
+	AdditionalData []string
+}
+
+type blockResult struct {
+	Hash            string
+	Nonce           Uint64
+	Round           Uint64
+	Epoch           Uint64
+	Shard           Uint64
+	NumTxs          Uint64
+	PrevBlockHash   string
+	StateRootHash   string
+	AccumulatedFees string
+	DeveloperFees   string
+	Status          string
+	Timestamp       Uint64
+	MiniBlocks      []*miniBlockResult
+}
+
+type miniBlockResult struct {
+	Hash             string
+	Type             string
+	SourceShard      Uint64
+	DestinationShard Uint64
+	Transactions     []*transactionResult
+}
+
+type hyperblockResult struct {
+	Hash            string
+	Nonce           Uint64
+	Round           Uint64
+	Epoch           Uint64
+	NumTxs          Uint64
+	PrevBlockHash   string
+	StateRootHash   string
+	AccumulatedFees string
+	DeveloperFees   string
+	Status          string
+	Timestamp       Uint64
+	ShardBlocks     []*shardBlockResult
+	Transactions    []*transactionResult
+}
+
+type shardBlockResult struct {
+	Hash     string
+	Nonce    Uint64
+	Round    Uint64
+	Shard    Uint64
+	RootHash string
+}
+
+type vmQueryResult struct {
+	ReturnData    []string
+	ReturnCode    string
+	ReturnMessage string
+	GasRemaining  Uint64
+}
+
+func newTransactionResult(tx *transaction.ApiTransactionResult) *transactionResult {
+	scrs := make([]*smartContractResult, 0, len(tx.SmartContractResults))
+	for _, scr := range tx.SmartContractResults {
+		if scr == nil {
+			continue
+		}
+
+		scrs = append(scrs, &smartContractResult{
+			Hash:           scr.Hash,
+			Nonce:          Uint64(scr.Nonce),
+			Value:          bigIntToString(scr.Value),
+			Sender:         scr.SndAddr,
+			Receiver:       scr.RcvAddr,
+			Data:           scr.Data,
+			PrevTxHash:     scr.PrevTxHash,
+			OriginalTxHash: scr.OriginalTxHash,
+			GasLimit:       Uint64(scr.GasLimit),
+			GasPrice:       Uint64(scr.GasPrice),
+			CallType:       int32(scr.CallType),
+			ReturnMessage:  scr.ReturnMessage,
+			Logs:           newLogsResult(scr.Logs),
+		})
+	}
+
+	return &transactionResult{
+		Hash:                 tx.Hash,
+		Type:                 tx.Type,
+		Status:               string(tx.Status),
+		Nonce:                Uint64(tx.Nonce),
+		Round:                Uint64(tx.Round),
+		Epoch:                Uint64(tx.Epoch),
+		Value:                tx.Value,
+		Sender:               tx.Sender,
+		Receiver:             tx.Receiver,
+		GasPrice:             Uint64(tx.GasPrice),
+		GasLimit:             Uint64(tx.GasLimit),
+		GasUsed:              Uint64(tx.GasUsed),
+		Data:                 base64.StdEncoding.EncodeToString(tx.Data),
+		Signature:            tx.Signature,
+		SourceShard:          Uint64(tx.SourceShard),
+		DestinationShard:     Uint64(tx.DestinationShard),
+		BlockNonce:           Uint64(tx.BlockNonce),
+		BlockHash:            tx.BlockHash,
+		MiniBlockHash:        tx.MiniBlockHash,
+		HyperblockNonce:      Uint64(tx.HyperblockNonce),
+		HyperblockHash:       tx.HyperblockHash,
+		Timestamp:            Uint64(tx.Timestamp),
+		Function:             tx.Function,
+		Operation:            tx.Operation,
+		Fee:                  tx.Fee,
+		InitiallyPaidFee:     tx.InitiallyPaidFee,
+		SmartContractResults: scrs,
+		Logs:                 newLogsResult(tx.Logs),
+	}
+}
+
+func newTransactionResults(txs []*transaction.ApiTransactionResult) []*transactionResult {
+	results := make([]*transactionResult, 0, len(txs))
+	for _, tx := range txs {
+		if tx != nil {
+			results = append(results, newTransactionResult(tx))
+		}
+	}
+
+	return results
+}
+
+func newLogsResult(logs *transaction.ApiLogs) *logsResult {
+	if logs == nil {
+		return nil
+	}
+
+	events := make([]*eventResult, 0, len(logs.Events))
+	for _, event := range logs.Events {
+		if event == nil {
+			continue
+		}
+
+		events = append(events, &eventResult{
+			Address:        event.Address,
+			Identifier:     event.Identifier,
+			Topics:         encodeBase64List(event.Topics),
+			Data:           base64.StdEncoding.EncodeToString(event.Data),
+			AdditionalData: encodeBase64List(event.AdditionalData),
+		})
+	}
+
+	return &logsResult{
+		Address: logs.Address,
+		Events:  events,
+	}
+}
+
+func newBlockResult(block *api.Block) *blockResult {
+	miniBlocks := make([]*miniBlockResult, 0, len(block.MiniBlocks))
+	for _, miniBlock := range block.MiniBlocks {
+		if miniBlock == nil {
+			continue
+		}
+
+		miniBlocks = append(miniBlocks, &miniBlockResult{
+			Hash:             miniBlock.Hash,
+			Type:             miniBlock.Type,
+			SourceShard:      Uint64(miniBlock.SourceShard),
+			DestinationShard: Uint64(miniBlock.DestinationShard),
+			Transactions:     newTransactionResults(miniBlock.Transactions),
+		})
+	}
+
+	return &blockResult{
+		Hash:            block.Hash,
+		Nonce:           Uint64(block.Nonce),
+		Round:           Uint64(block.Round),
+		Epoch:           Uint64(block.Epoch),
+		Shard:           Uint64(block.Shard),
+		NumTxs:          Uint64(block.NumTxs),
+		PrevBlockHash:   block.PrevBlockHash,
+		StateRootHash:   block.StateRootHash,
+		AccumulatedFees: block.AccumulatedFees,
+		DeveloperFees:   block.DeveloperFees,
+		Status:          block.Status,
+		Timestamp:       Uint64(block.Timestamp),
+		MiniBlocks:      miniBlocks,
+	}
+}
+
+func newHyperblockResult(hyperblock *api.Hyperblock) *hyperblockResult {
+	shardBlocks := make([]*shardBlockResult, 0, len(hyperblock.ShardBlocks))
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		if shardBlock == nil {
+			continue
+		}
+
+		shardBlocks = append(shardBlocks, &shardBlockResult{
+			Hash:     shardBlock.Hash,
+			Nonce:    Uint64(shardBlock.Nonce),
+			Round:    Uint64(shardBlock.Round),
+			Shard:    Uint64(shardBlock.Shard),
+			RootHash: shardBlock.RootHash,
+		})
+	}
+
+	return &hyperblockResult{
+		Hash:            hyperblock.Hash,
+		Nonce:           Uint64(hyperblock.Nonce),
+		Round:           Uint64(hyperblock.Round),
+		Epoch:           Uint64(hyperblock.Epoch),
+		NumTxs:          Uint64(hyperblock.NumTxs),
+		PrevBlockHash:   hyperblock.PrevBlockHash,
+		StateRootHash:   hyperblock.StateRootHash,
+		AccumulatedFees: hyperblock.AccumulatedFees,
+		DeveloperFees:   hyperblock.DeveloperFees,
+		Status:          hyperblock.Status,
+		Timestamp:       Uint64(hyperblock.Timestamp),
+		ShardBlocks:     shardBlocks,
+		Transactions:    newTransactionResults(hyperblock.Transactions),
+	}
+}
+
+func newVmQueryResult(vmOutput *vm.VMOutputApi) *vmQueryResult {
+	return &vmQueryResult{
+		ReturnData:    encodeBase64List(vmOutput.ReturnData),
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  Uint64(vmOutput.GasRemaining),
+	}
+}
+
+func encodeBase64List(values [][]byte) []string {
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, base64.StdEncoding.EncodeToString(value))
+	}
+
+	return encoded
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return make([]string, 0)
+	}
+
+	return values
+}
+
+// decodeResponseData decodes the payload of a generic response, which holds the observer response as decoded JSON
+func decodeResponseData(response *data.GenericAPIResponse, payload interface{}) error {
+	if response == nil {
+		return ErrNilResponse
+	}
+
+	buff, err := json.Marshal(response.Data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, payload)
+}
@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Uint64 is the GraphQL scalar of the unsigned integers of the chain: nonces, rounds, epochs, shard IDs and gas values.
+// It is serialized as a JSON number and accepts both numbers and decimal strings as input
+type Uint64 uint64
+
+// ImplementsGraphQLType maps the type to the Uint64 scalar of the schema
+func (Uint64) ImplementsGraphQLType(name string) bool {
+	return name == "Uint64"
+}
+
+// UnmarshalGraphQL reads the value of an argument or of a variable
+func (u *Uint64) UnmarshalGraphQL(input interface{}) error {
+	switch value := input.(type) {
+	case int32:
+		if value < 0 {
+			return fmt.Errorf("%w: %d", ErrInvalidUint64, value)
+		}
+		*u = Uint64(value)
+	case float64:
+		if value < 0 || value > math.MaxUint64 || value != math.Trunc(value) {
+			return fmt.Errorf("%w: %v", ErrInvalidUint64, value)
+		}
+		*u = Uint64(value)
+	case string:
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidUint64, value)
+		}
+		*u = Uint64(parsed)
+	default:
+		return fmt.Errorf("%w: %v", ErrInvalidUint64, input)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes the value as a JSON number
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(u), 10)), nil
+}
+
+// JSON is the GraphQL scalar holding the free form metrics of the network, as returned by the observers
+type JSON struct {
+	Value interface{}
+}
+
+// ImplementsGraphQLType maps the type to the JSON scalar of the schema
+func (JSON) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+// UnmarshalGraphQL reads the value of an argument or of a variable
+func (j *JSON) UnmarshalGraphQL(input interface{}) error {
+	j.Value = input
+	return nil
+}
+
+// MarshalJSON serializes the wrapped value
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.Value)
+}
@@ -0,0 +1,195 @@
+package graphql
+
+// schema describes the data which can be queried through the GraphQL endpoint. All the unsigned integers of the chain,
+// shard IDs included, use the Uint64 scalar, since the metachain shard ID does not fit the GraphQL Int. The values
+// above the GraphQL Int range are provided as decimal strings or through variables. The byte arrays are base64
+// encoded, as in the REST API
+const schema = `
+scalar Uint64
+scalar JSON
+
+schema {
+	query: Query
+}
+
+type Query {
+	account(address: String!, blockNonce: Uint64, onFinalBlock: Boolean): Account
+	accounts(addresses: [String!]!, onFinalBlock: Boolean): [Account!]!
+	transaction(hash: String!, withResults: Boolean): Transaction
+	block(shard: Uint64!, nonce: Uint64, hash: String, withTransactions: Boolean, withLogs: Boolean): Block
+	hyperblock(nonce: Uint64, hash: String, withLogs: Boolean): Hyperblock
+	networkConfig: JSON
+	networkStatus(shard: Uint64!): JSON
+	economics: JSON
+	vmQuery(scAddress: String!, funcName: String!, caller: String, value: String, args: [String!], blockNonce: Uint64): VmQueryResult
+}
+
+type Account {
+	address: String!
+	nonce: Uint64!
+	balance: String!
+	username: String!
+	code: String!
+	codeHash: String!
+	codeMetadata: String!
+	developerReward: String!
+	ownerAddress: String!
+	esdts: [Esdt!]!
+	esdt(tokenIdentifier: String!): Esdt
+	nft(tokenIdentifier: String!, nonce: Uint64!): Esdt
+	roles: [TokenRoles!]!
+	guardianData: GuardianData
+	keyValuePairs: [KeyValuePair!]!
+	value(key: String!): String!
+}
+
+type Esdt {
+	tokenIdentifier: String!
+	balance: String!
+	nonce: Uint64!
+	name: String!
+	creator: String!
+	royalties: String!
+	hash: String!
+	attributes: String!
+	uris: [String!]!
+	properties: String!
+}
+
+type TokenRoles {
+	tokenIdentifier: String!
+	roles: [String!]!
+}
+
+type GuardianData {
+	guarded: Boolean!
+	activeGuardian: Guardian
+	pendingGuardian: Guardian
+}
+
+type Guardian {
+	address: String!
+	activationEpoch: Uint64!
+	serviceUID: String!
+}
+
+type KeyValuePair {
+	key: String!
+	value: String!
+}
+
+type Transaction {
+	hash: String!
+	type: String!
+	status: String!
+	nonce: Uint64!
+	round: Uint64!
+	epoch: Uint64!
+	value: String!
+	sender: String!
+	receiver: String!
+	gasPrice: Uint64!
+	gasLimit: Uint64!
+	gasUsed: Uint64!
+	data: String!
+	signature: String!
+	sourceShard: Uint64!
+	destinationShard: Uint64!
+	blockNonce: Uint64!
+	blockHash: String!
+	miniBlockHash: String!
+	hyperblockNonce: Uint64!
+	hyperblockHash: String!
+	timestamp: Uint64!
+	function: String!
+	operation: String!
+	fee: String!
+	initiallyPaidFee: String!
+	smartContractResults: [SmartContractResult!]!
+	logs: Logs
+}
+
+type SmartContractResult {
+	hash: String!
+	nonce: Uint64!
+	value: String!
+	sender: String!
+	receiver: String!
+	data: String!
+	prevTxHash: String!
+	originalTxHash: String!
+	gasLimit: Uint64!
+	gasPrice: Uint64!
+	callType: Int!
+	returnMessage: String!
+	logs: Logs
+}
+
+type Logs {
+	address: String!
+	events: [Event!]!
+}
+
+type Event {
+	address: String!
+	identifier: String!
+	topics: [String!]!
+	data: String!
+	additionalData: [String!]!
+}
+
+type Block {
+	hash: String!
+	nonce: Uint64!
+	round: Uint64!
+	epoch: Uint64!
+	shard: Uint64!
+	numTxs: Uint64!
+	prevBlockHash: String!
+	stateRootHash: String!
+	accumulatedFees: String!
+	developerFees: String!
+	status: String!
+	timestamp: Uint64!
+	miniBlocks: [MiniBlock!]!
+}
+
+type MiniBlock {
+	hash: String!
+	type: String!
+	sourceShard: Uint64!
+	destinationShard: Uint64!
+	transactions: [Transaction!]!
+}
+
+type Hyperblock {
+	hash: String!
+	nonce: Uint64!
+	round: Uint64!
+	epoch: Uint64!
+	numTxs: Uint64!
+	prevBlockHash: String!
+	stateRootHash: String!
+	accumulatedFees: String!
+	developerFees: String!
+	status: String!
+	timestamp: Uint64!
+	shardBlocks: [ShardBlock!]!
+	transactions: [Transaction!]!
+}
+
+type ShardBlock {
+	hash: String!
+	nonce: Uint64!
+	round: Uint64!
+	shard: Uint64!
+	rootHash: String!
+}
+
+type VmQueryResult {
+	returnData: [String!]!
+	returnCode: String!
+	returnMessage: String!
+	gasRemaining: Uint64!
+}
+`
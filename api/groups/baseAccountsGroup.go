@@ -44,6 +44,7 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		{Path: "/:address/nft/:tokenIdentifier/nonce/:nonce", Handler: ag.getESDTNftTokenData, Method: http.MethodGet},
 		{Path: "/:address/guardian-data", Handler: ag.getGuardianData, Method: http.MethodGet},
 		{Path: "/:address/is-data-trie-migrated", Handler: ag.isDataTrieMigrated, Method: http.MethodGet},
+		{Path: "/:address/diff", Handler: ag.getAccountDiff, Method: http.MethodGet},
 		{Path: "/bulk", Handler: ag.getAccounts, Method: http.MethodPost},
 	}
 	ag.baseGroup.endpoints = baseRoutesHandlers
@@ -60,7 +61,7 @@ func (group *accountsGroup) respondWithAccount(c *gin.Context, transform func(*d
 		return
 	}
 
-	model, err := group.facade.GetAccount(address, options)
+	model, err := group.facade.GetAccount(c.Request.Context(), address, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetAccount, err)
 		return
@@ -108,7 +109,7 @@ func (group *accountsGroup) getCodeHash(c *gin.Context) {
 		return
 	}
 
-	codeHashResponse, err := group.facade.GetCodeHash(address, options)
+	codeHashResponse, err := group.facade.GetCodeHash(c.Request.Context(), address, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetCodeHash, err)
 		return
@@ -137,7 +138,7 @@ func (group *accountsGroup) getAccounts(c *gin.Context) {
 		return
 	}
 
-	response, err := group.facade.GetAccounts(addresses, options)
+	response, err := group.facade.GetAccounts(c.Request.Context(), addresses, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrCannotGetAddresses, err)
 		return
@@ -160,7 +161,7 @@ func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	keyValuePairs, err := group.facade.GetKeyValuePairs(addr, options)
+	keyValuePairs, err := group.facade.GetKeyValuePairs(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
 		return
@@ -189,7 +190,7 @@ func (group *accountsGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := group.facade.GetValueForKey(addr, key, options)
+	value, err := group.facade.GetValueForKey(c.Request.Context(), addr, key, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetValueForKey, err)
 		return
@@ -241,7 +242,7 @@ func (group *accountsGroup) getESDTTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTTokenData(addr, tokenIdentifier, options)
+	esdtTokenResponse, err := group.facade.GetESDTTokenData(c.Request.Context(), addr, tokenIdentifier, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
 		return
@@ -263,7 +264,7 @@ func (group *accountsGroup) getESDTsRoles(c *gin.Context) {
 		return
 	}
 
-	tokensRoles, err := group.facade.GetESDTsRoles(addr, options)
+	tokensRoles, err := group.facade.GetESDTsRoles(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrEmptyTokenIdentifier, err)
 		return
@@ -292,7 +293,7 @@ func (group *accountsGroup) getESDTsWithRole(c *gin.Context) {
 		return
 	}
 
-	esdtsWithRole, err := group.facade.GetESDTsWithRole(addr, role, options)
+	esdtsWithRole, err := group.facade.GetESDTsWithRole(c.Request.Context(), addr, role, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTsWithRole, err)
 		return
@@ -315,7 +316,7 @@ func (group *accountsGroup) getRegisteredNFTs(c *gin.Context) {
 		return
 	}
 
-	tokens, err := group.facade.GetNFTTokenIDsRegisteredByAddress(addr, options)
+	tokens, err := group.facade.GetNFTTokenIDsRegisteredByAddress(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetNFTTokenIDsRegisteredByAddress, err)
 		return
@@ -350,7 +351,7 @@ func (group *accountsGroup) getESDTNftTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTNftTokenData(addr, tokenIdentifier, nonce, options)
+	esdtTokenResponse, err := group.facade.GetESDTNftTokenData(c.Request.Context(), addr, tokenIdentifier, nonce, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
 		return
@@ -372,7 +373,7 @@ func (group *accountsGroup) getGuardianData(c *gin.Context) {
 		return
 	}
 
-	guardianData, err := group.facade.GetGuardianData(addr, options)
+	guardianData, err := group.facade.GetGuardianData(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetGuardianData, err)
 		return
@@ -394,7 +395,7 @@ func (group *accountsGroup) getESDTTokens(c *gin.Context) {
 		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
 		return
 	}
-	tokens, err := group.facade.GetAllESDTTokens(addr, options)
+	tokens, err := group.facade.GetAllESDTTokens(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
 		return
@@ -416,7 +417,7 @@ func (group *accountsGroup) isDataTrieMigrated(c *gin.Context) {
 		return
 	}
 
-	isMigrated, err := group.facade.IsDataTrieMigrated(addr, options)
+	isMigrated, err := group.facade.IsDataTrieMigrated(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrIsDataTrieMigrated, err)
 		return
@@ -424,3 +425,27 @@ func (group *accountsGroup) isDataTrieMigrated(c *gin.Context) {
 
 	c.JSON(http.StatusOK, isMigrated)
 }
+
+// getAccountDiff returns the changes of the account between the two blocks: the EGLD and ESDT balance deltas, along
+// with the nonce and username changes
+func (group *accountsGroup) getAccountDiff(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountDiff, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseAccountDiffOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountDiff, err)
+		return
+	}
+
+	diff, err := group.facade.GetAccountDiff(c.Request.Context(), addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountDiff, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"diff": diff}, "", data.ReturnCodeSuccess)
+}
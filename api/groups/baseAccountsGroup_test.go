@@ -1083,3 +1083,98 @@ func TestAccountsGroup_IsDataTrieMigrated(t *testing.T) {
 		assert.Empty(t, actualResponse.Error)
 	})
 }
+
+type accountDiffResponseData struct {
+	Diff *data.AccountDiff `json:"diff"`
+}
+
+type accountDiffResponse struct {
+	GeneralResponse
+	Data accountDiffResponseData `json:"data"`
+}
+
+func TestAccountsGroup_GetAccountDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing nonces should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountDiffCalled: func(_ string, _ common.AccountDiffOptions) (*data.AccountDiff, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		for _, query := range []string{"", "?fromNonce=100", "?fromNonce=200&toNonce=100", "?fromNonce=a&toNonce=100"} {
+			req, _ := http.NewRequest("GET", "/address/test/diff"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := accountDiffResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAccountDiff.Error()))
+		}
+	})
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetAccountDiffCalled: func(_ string, _ common.AccountDiffOptions) (*data.AccountDiff, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/diff?fromNonce=100&toNonce=200", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := accountDiffResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDiff := &data.AccountDiff{
+			Address: "test",
+			Balance: data.BalanceDiff{From: "1000", To: "400", Delta: "-600"},
+			Nonce:   data.NonceDiff{From: 5, To: 8, Delta: 3},
+			Tokens: []*data.TokenBalanceDiff{
+				{Identifier: "TKN-aaaaaa", TokenIdentifier: "TKN-aaaaaa", From: "50", To: "80", Delta: "30"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetAccountDiffCalled: func(address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+				assert.Equal(t, "test", address)
+				assert.Equal(t, common.AccountDiffOptions{FromNonce: 100, ToNonce: 200}, options)
+				return expectedDiff, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/diff?fromNonce=100&toNonce=200", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := accountDiffResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedDiff, response.Data.Diff)
+		assert.Empty(t, response.Error)
+	})
+}
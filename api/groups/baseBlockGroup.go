@@ -69,7 +69,7 @@ func (group *blockGroup) byHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetBlockByHash(shardID, hash, options)
+	blockByHashResponse, err := group.facade.GetBlockByHash(c.Request.Context(), shardID, hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -110,7 +110,7 @@ func (group *blockGroup) byNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetBlockByNonce(shardID, nonce, options)
+	blockByNonceResponse, err := group.facade.GetBlockByNonce(c.Request.Context(), shardID, nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -138,7 +138,7 @@ func (group *blockGroup) alteredAccountsByNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetAlteredAccountsByNonce(shardID, nonce, options)
+	blockByNonceResponse, err := group.facade.GetAlteredAccountsByNonce(c.Request.Context(), shardID, nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -166,7 +166,7 @@ func (group *blockGroup) alteredAccountsByHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetAlteredAccountsByHash(shardID, hash, options)
+	blockByHashResponse, err := group.facade.GetAlteredAccountsByHash(c.Request.Context(), shardID, hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -16,13 +16,6 @@ type baseGroup struct {
 	sync.RWMutex
 }
 
-type endpointProperties struct {
-	isOpen           bool
-	isSecured        bool
-	isFoundInConfig  bool
-	rateLimiterPerIP uint64
-}
-
 // AddEndpoint will add the handler data for the given path inside the map
 func (bg *baseGroup) AddEndpoint(path string, handlerData data.EndpointHandlerData) error {
 	if handlerData.Handler == nil {
@@ -78,11 +71,13 @@ func (bg *baseGroup) RemoveEndpoint(path string) error {
 	return nil
 }
 
-// RegisterRoutes will register all the endpoints to the given web server
+// RegisterRoutes will register all the endpoints to the given web server. The endpoints found in the config are
+// registered even if they are not opened, the route access middleware deciding on each request if the endpoint is
+// opened and secured, so that these flags can be changed at runtime
 func (bg *baseGroup) RegisterRoutes(
 	ws *gin.RouterGroup,
 	apiConfig data.ApiRoutesConfig,
-	authenticationFunc gin.HandlerFunc,
+	routeAccessFunc gin.HandlerFunc,
 	rateLimiter gin.HandlerFunc,
 	statusMetricsExtractor gin.HandlerFunc,
 ) {
@@ -90,26 +85,15 @@ func (bg *baseGroup) RegisterRoutes(
 	defer bg.RUnlock()
 
 	for _, handlerData := range bg.endpoints {
-		properties := getEndpointProperties(ws, handlerData.Path, apiConfig)
-		if !properties.isFoundInConfig {
+		if !isEndpointInConfig(ws, handlerData.Path, apiConfig) {
 			log.Warn("endpoint not found in config", "path", handlerData.Path)
 			ws.Handle(handlerData.Method, handlerData.Path, handlerData.Handler)
 			continue
 		}
 
-		if !properties.isOpen {
-			log.Debug("endpoint is not opened", "path", handlerData.Path)
-			continue
-		}
-
 		middlewares := make([]gin.HandlerFunc, 0)
-		if properties.isSecured {
-			middlewares = append(middlewares, authenticationFunc)
-		}
-
-		if properties.rateLimiterPerIP > 0 {
-			middlewares = append(middlewares, rateLimiter)
-		}
+		middlewares = append(middlewares, routeAccessFunc)
+		middlewares = append(middlewares, rateLimiter)
 
 		middlewares = append(middlewares, statusMetricsExtractor)
 		middlewares = append(middlewares, handlerData.Handler)
@@ -118,7 +102,7 @@ func (bg *baseGroup) RegisterRoutes(
 	}
 }
 
-func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig) endpointProperties {
+func isEndpointInConfig(ws *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig) bool {
 	basePath := ws.BasePath()
 
 	// ws.BasePath will return paths like /group or /v1.0/group so we need the last token after splitting by /
@@ -127,25 +111,16 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig data.ApiR
 
 	group, ok := apiConfig.APIPackages[basePath]
 	if !ok {
-		return endpointProperties{
-			isFoundInConfig: false,
-		}
+		return false
 	}
 
 	for _, route := range group.Routes {
 		if route.Name == path {
-			return endpointProperties{
-				isOpen:           route.Open,
-				isSecured:        route.Secured,
-				isFoundInConfig:  true,
-				rateLimiterPerIP: route.RateLimit,
-			}
+			return true
 		}
 	}
 
-	return endpointProperties{
-		isFoundInConfig: false,
-	}
+	return false
 }
 
 func (bg *baseGroup) isEndpointRegistered(endpoint string) bool {
@@ -1,17 +1,23 @@
 package groups_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
 )
 
 const hyperBlockPath = "/hyperblock"
@@ -108,3 +114,210 @@ func doGet(t *testing.T, facade interface{}, url string, response interface{}) i
 	loadResponse(responseRecorder.Body, &response)
 	return responseRecorder.Code
 }
+
+type hyperblockSubscriptionStub struct {
+	ch  chan *api.Hyperblock
+	err error
+}
+
+func newHyperblockSubscriptionStub(err error, hyperblocks ...*api.Hyperblock) *hyperblockSubscriptionStub {
+	ch := make(chan *api.Hyperblock, len(hyperblocks))
+	for _, hyperblock := range hyperblocks {
+		ch <- hyperblock
+	}
+	close(ch)
+
+	return &hyperblockSubscriptionStub{ch: ch, err: err}
+}
+
+func (stub *hyperblockSubscriptionStub) Hyperblocks() <-chan *api.Hyperblock {
+	return stub.ch
+}
+
+func (stub *hyperblockSubscriptionStub) Err() error {
+	return stub.err
+}
+
+func (stub *hyperblockSubscriptionStub) Close() {
+}
+
+func TestHyperblockStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := data.GenericAPIResponse{}
+		statusCode := doGet(t, &mock.FacadeStub{}, "/hyperblock/stream", &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, apiErrors.ErrHyperblockStreamNotEnabled.Error(), response.Error)
+	})
+	t.Run("subscription errors should be mapped to status codes", func(t *testing.T) {
+		t.Parallel()
+
+		subscribeErr := apiErrors.ErrTooManyHyperblockSubscribers
+		facade := &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				return true
+			},
+			SubscribeToHyperblocksHandler: func(options common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+				return nil, subscribeErr
+			},
+		}
+
+		response := data.GenericAPIResponse{}
+		statusCode := doGet(t, facade, "/hyperblock/stream", &response)
+		require.Equal(t, http.StatusServiceUnavailable, statusCode)
+
+		subscribeErr = fmt.Errorf("%w: too old", apiErrors.ErrHyperblockResumeNonceTooOld)
+		statusCode = doGet(t, facade, "/hyperblock/stream?fromNonce=1", &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+
+		statusCode = doGet(t, facade, "/hyperblock/stream?fromNonce=abc", &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+	})
+	t.Run("should stream as server-sent events", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedOptions common.HyperblockStreamOptions
+		facade := &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				return true
+			},
+			SubscribeToHyperblocksHandler: func(options common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+				receivedOptions = options
+				return newHyperblockSubscriptionStub(errors.New("slow subscriber"), &api.Hyperblock{Nonce: 42}, &api.Hyperblock{Nonce: 43}), nil
+			},
+		}
+		hyperBlockGroup, _ := groups.NewHyperBlockGroup(facade)
+		server := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		httpRequest, _ := http.NewRequest(http.MethodGet, "/hyperblock/stream?withLogs=true", nil)
+		httpRequest.Header.Set("Last-Event-ID", "41")
+		responseRecorder := httptest.NewRecorder()
+		server.ServeHTTP(responseRecorder, httpRequest)
+
+		require.Equal(t, http.StatusOK, responseRecorder.Code)
+		require.Equal(t, "text/event-stream", responseRecorder.Header().Get("Content-Type"))
+		require.Equal(t, common.HyperblockStreamOptions{
+			FromNonce: core.OptionalUint64{Value: 42, HasValue: true},
+			WithLogs:  true,
+		}, receivedOptions)
+
+		events := strings.Split(strings.TrimSpace(responseRecorder.Body.String()), "\n\n")
+		require.Len(t, events, 3)
+		require.True(t, strings.HasPrefix(events[0], "id: 42\nevent: hyperblock\ndata: "))
+		require.True(t, strings.HasPrefix(events[1], "id: 43\nevent: hyperblock\ndata: "))
+		require.Equal(t, `event: error`+"\n"+`data: {"error":"slow subscriber"}`, events[2])
+
+		message := data.HyperblockStreamMessage{}
+		require.Nil(t, json.Unmarshal([]byte(strings.SplitN(events[1], "data: ", 2)[1]), &message))
+		require.Equal(t, uint64(43), message.Hyperblock.Nonce)
+	})
+	t.Run("should stream over websocket", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				return true
+			},
+			SubscribeToHyperblocksHandler: func(options common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+				return newHyperblockSubscriptionStub(nil, &api.Hyperblock{Nonce: 42}), nil
+			},
+		}
+		hyperBlockGroup, _ := groups.NewHyperBlockGroup(facade)
+		server := httptest.NewServer(startProxyServer(hyperBlockGroup, hyperBlockPath))
+		defer server.Close()
+
+		wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/hyperblock/stream"
+		conn, err := websocket.Dial(wsURL, "", server.URL)
+		require.Nil(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		message := data.HyperblockStreamMessage{}
+		require.Nil(t, websocket.JSON.Receive(conn, &message))
+		require.Equal(t, uint64(42), message.Hyperblock.Nonce)
+
+		message = data.HyperblockStreamMessage{}
+		require.Nil(t, websocket.JSON.Receive(conn, &message))
+		require.Nil(t, message.Hyperblock)
+		require.NotEmpty(t, message.Error)
+	})
+}
+
+type addressActivitySubscriptionStub struct {
+	ch chan *data.AddressActivity
+}
+
+func (stub *addressActivitySubscriptionStub) Activities() <-chan *data.AddressActivity {
+	return stub.ch
+}
+
+func (stub *addressActivitySubscriptionStub) Err() error {
+	return nil
+}
+
+func (stub *addressActivitySubscriptionStub) Close() {
+}
+
+func TestAddressActivity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid filters should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				return true
+			},
+			SubscribeToAddressActivityHandler: func(options common.AddressActivityOptions) (data.AddressActivitySubscription, error) {
+				return nil, apiErrors.ErrInvalidActivityFilters
+			},
+		}
+
+		response := data.GenericAPIResponse{}
+		statusCode := doGet(t, facade, "/hyperblock/activity", &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, apiErrors.ErrInvalidActivityFilters.Error(), response.Error)
+	})
+	t.Run("should stream the matching activity", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedOptions common.AddressActivityOptions
+		facade := &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				return true
+			},
+			SubscribeToAddressActivityHandler: func(options common.AddressActivityOptions) (data.AddressActivitySubscription, error) {
+				receivedOptions = options
+				ch := make(chan *data.AddressActivity, 1)
+				ch <- &data.AddressActivity{HyperblockNonce: 7, HyperblockHash: "hash"}
+				close(ch)
+
+				return &addressActivitySubscriptionStub{ch: ch}, nil
+			},
+		}
+		hyperBlockGroup, _ := groups.NewHyperBlockGroup(facade)
+		server := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		url := "/hyperblock/activity?addresses=erd1a,erd1b&addresses=erd1c&tokens=TKN-abcdef&events=signalError&fromNonce=3"
+		httpRequest, _ := http.NewRequest(http.MethodGet, url, nil)
+		responseRecorder := httptest.NewRecorder()
+		server.ServeHTTP(responseRecorder, httpRequest)
+
+		require.Equal(t, http.StatusOK, responseRecorder.Code)
+		require.Equal(t, common.AddressActivityOptions{
+			FromNonce: core.OptionalUint64{Value: 3, HasValue: true},
+			Addresses: []string{"erd1a", "erd1b", "erd1c"},
+			Tokens:    []string{"TKN-abcdef"},
+			Events:    []string{"signalError"},
+		}, receivedOptions)
+
+		events := strings.Split(strings.TrimSpace(responseRecorder.Body.String()), "\n\n")
+		require.Len(t, events, 2)
+		require.Equal(t, "id: 7\nevent: activity\ndata: "+`{"activity":{"hyperblockNonce":7,"hyperblockHash":"hash"}}`, events[0])
+		require.Equal(t, "event: error\ndata: "+`{"error":"the subscription has ended"}`, events[1])
+	})
+}
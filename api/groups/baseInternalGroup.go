@@ -71,7 +71,7 @@ func (group *internalGroup) internalBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetInternalBlockByHash(shardID, hash, common.Internal)
+	blockByHashResponse, err := group.facade.GetInternalBlockByHash(c.Request.Context(), shardID, hash, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -106,7 +106,7 @@ func (group *internalGroup) internalBlockbyNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(shardID, nonce, common.Internal)
+	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(c.Request.Context(), shardID, nonce, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -142,7 +142,7 @@ func (group *internalGroup) rawBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetInternalBlockByHash(shardID, hash, common.Proto)
+	blockByHashResponse, err := group.facade.GetInternalBlockByHash(c.Request.Context(), shardID, hash, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -177,7 +177,7 @@ func (group *internalGroup) rawBlockbyNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(shardID, nonce, common.Proto)
+	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(c.Request.Context(), shardID, nonce, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -225,7 +225,7 @@ func (group *internalGroup) internalMiniBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(shardID, hash, epoch, common.Internal)
+	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(c.Request.Context(), shardID, hash, epoch, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -273,7 +273,7 @@ func (group *internalGroup) rawMiniBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(shardID, hash, epoch, common.Proto)
+	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(c.Request.Context(), shardID, hash, epoch, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -296,7 +296,7 @@ func (group *internalGroup) internalStartOfEpochMetaBlock(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(epoch, common.Internal)
+	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(c.Request.Context(), epoch, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -319,7 +319,7 @@ func (group *internalGroup) rawStartOfEpochMetaBlock(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(epoch, common.Proto)
+	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(c.Request.Context(), epoch, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -342,7 +342,7 @@ func (group *internalGroup) internalStartOfEpochValidatorsInfo(c *gin.Context) {
 		return
 	}
 
-	validatorsInfo, err := group.facade.GetInternalStartOfEpochValidatorsInfo(epoch)
+	validatorsInfo, err := group.facade.GetInternalStartOfEpochValidatorsInfo(c.Request.Context(), epoch)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
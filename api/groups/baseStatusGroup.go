@@ -28,6 +28,7 @@ func NewStatusGroup(facadeHandler data.FacadeHandler) (*statusGroup, error) {
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/metrics", Handler: ng.getMetrics, Method: http.MethodGet},
 		{Path: "/prometheus-metrics", Handler: ng.getPrometheusMetrics, Method: http.MethodGet},
+		{Path: "/observers-health", Handler: ng.getObserversHealth, Method: http.MethodGet},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -47,3 +48,10 @@ func (group *statusGroup) getPrometheusMetrics(c *gin.Context) {
 
 	c.String(http.StatusOK, metricsResults)
 }
+
+// getObserversHealth will expose the circuit breaker state and the health score of the observers
+func (group *statusGroup) getObserversHealth(c *gin.Context) {
+	observersHealth := group.facade.GetObserversHealth()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"observers": observersHealth}, "", data.ReturnCodeSuccess)
+}
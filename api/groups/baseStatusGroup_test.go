@@ -20,6 +20,14 @@ type statusMetricsResponse struct {
 	Code  string `json:"code"`
 }
 
+type observersHealthResponse struct {
+	Data struct {
+		Observers []*data.NodeHealth `json:"observers"`
+	}
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 const statusPath = "/status"
 
 func TestNewStatusGroup_WrongFacadeShouldErr(t *testing.T) {
@@ -88,3 +96,38 @@ func TestGetPrometheusMetrics_ShouldWork(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.Code)
 	require.Equal(t, expectedMetrics, string(bodyBytes))
 }
+
+func TestGetObserversHealth_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHealth := []*data.NodeHealth{
+		{
+			Address:             "http://observer0",
+			State:               data.CircuitBreakerOpen,
+			HealthScore:         0.4,
+			ConsecutiveFailures: 5,
+			NumSuccesses:        10,
+			NumFailures:         6,
+			NumTimesOpened:      1,
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetObserversHealthCalled: func() []*data.NodeHealth {
+			return expectedHealth
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(statusGroup, statusPath)
+
+	req, _ := http.NewRequest("GET", "/status/observers-health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp observersHealthResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedHealth, apiResp.Data.Observers)
+}
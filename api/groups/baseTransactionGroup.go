@@ -32,9 +32,14 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/send", Handler: tg.sendTransaction, Method: http.MethodPost},
 		{Path: "/simulate", Handler: tg.simulateTransaction, Method: http.MethodPost},
+		{Path: "/validate", Handler: tg.validateTransaction, Method: http.MethodPost},
 		{Path: "/send-multiple", Handler: tg.sendMultipleTransactions, Method: http.MethodPost},
 		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost},
 		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost},
+		{Path: "/estimate-fee", Handler: tg.estimateTransactionFee, Method: http.MethodPost},
+		{Path: "/next-nonce/:address", Handler: tg.reserveNextNonce, Method: http.MethodPost},
+		{Path: "/nonce-gaps/:sender", Handler: tg.diagnoseNonceGaps, Method: http.MethodGet},
+		{Path: "/nonce-gaps/:sender/repair", Handler: tg.repairNonceGaps, Method: http.MethodPost},
 		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet},
 		{Path: "/:txhash/process-status", Handler: tg.getProcessedTransactionStatus, Method: http.MethodGet},
 		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet},
@@ -60,13 +65,87 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
-	statusCode, txHash, err := group.facade.SendTransaction(&tx)
+	options, err := parseTransactionBroadcastOptions(c, group.facade.IsTransactionBroadcastEnabled())
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrBadUrlParams.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	callbackURL := parseStringUrlParam(c, common.UrlParameterCallbackURL)
+	err = group.checkTransactionTracking(callbackURL)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	if options.Broadcast {
+		group.broadcastTransaction(c, &tx, options, callbackURL)
+		return
+	}
+
+	statusCode, txHash, err := group.facade.SendTransaction(c.Request.Context(), &tx)
 	if err != nil {
 		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
+	group.respondWithTrackedTransaction(c, txHash, callbackURL, gin.H{"txHash": txHash})
+}
+
+// broadcastTransaction will send the transaction to multiple observers and will reply with the observers that accepted it
+func (group *transactionGroup) broadcastTransaction(
+	c *gin.Context,
+	tx *data.Transaction,
+	options common.TransactionBroadcastOptions,
+	callbackURL string,
+) {
+	statusCode, response, err := group.facade.BroadcastTransaction(c.Request.Context(), tx, options)
+	if err != nil {
+		// the response, if any, holds the observers that accepted or rejected the transaction
+		shared.RespondWith(c, statusCode, response, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	group.respondWithTrackedTransaction(c, response.TxHash, callbackURL, response)
+}
+
+// checkTransactionTracking returns nil if no callback URL was provided or if the transaction can be tracked with it
+func (group *transactionGroup) checkTransactionTracking(callbackURL string) error {
+	if len(callbackURL) == 0 {
+		return nil
+	}
+	if !group.facade.IsTransactionTrackingEnabled() {
+		return errors.ErrTransactionTrackingNotEnabled
+	}
+
+	return group.facade.CheckTransactionTracking(callbackURL)
+}
+
+// respondWithTrackedTransaction will start tracking the sent transaction, if a callback URL was provided, and will reply
+// with the response data
+func (group *transactionGroup) respondWithTrackedTransaction(c *gin.Context, txHash string, callbackURL string, responseData interface{}) {
+	if len(callbackURL) > 0 {
+		err := group.facade.TrackTransaction(txHash, callbackURL)
+		if err != nil {
+			// the transaction has already been sent, so the response data still holds its hash
+			shared.RespondWith(
+				c,
+				http.StatusInternalServerError,
+				responseData,
+				fmt.Sprintf("%s: %s", errors.ErrTransactionTrackingFailed.Error(), err.Error()),
+				data.ReturnCodeInternalError,
+			)
+			return
+		}
+	}
+
+	shared.RespondWith(c, http.StatusOK, responseData, "", data.ReturnCodeSuccess)
 }
 
 // sendUserFunds will receive an address from the client and propagate a transaction for sending some ERD to that address
@@ -95,7 +174,7 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 		return
 	}
 
-	err = group.facade.SendUserFunds(gtx.Receiver, gtx.Value)
+	err = group.facade.SendUserFunds(c.Request.Context(), gtx.Receiver, gtx.Value)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -125,7 +204,7 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
-	response, err := group.facade.SendMultipleTransactions(txs)
+	response, err := group.facade.SendMultipleTransactions(c.Request.Context(), txs)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -143,12 +222,38 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		gin.H{
 			"numOfSentTxs": response.NumOfTxs,
 			"txsHashes":    response.TxsHashes,
+			"txsErrors":    response.TxsErrors,
 		},
 		"",
 		data.ReturnCodeSuccess,
 	)
 }
 
+// validateTransaction will receive a transaction from the client and will report all the problems which can be found
+// without sending it to the observers
+func (group *transactionGroup) validateTransaction(c *gin.Context) {
+	var tx = data.Transaction{}
+	err := c.ShouldBindJSON(&tx)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	result, err := group.facade.ValidateTransaction(c.Request.Context(), &tx)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"validation": result}, "", data.ReturnCodeSuccess)
+}
+
 // simulateTransaction will receive a transaction from the client and will send it for simulation purpose
 func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 	var tx = data.Transaction{}
@@ -170,7 +275,7 @@ func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	simulationResponse, err := group.facade.SimulateTransaction(&tx, options.CheckSignature)
+	simulationResponse, err := group.facade.SimulateTransaction(c.Request.Context(), &tx, options.CheckSignature)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -197,7 +302,7 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 		return
 	}
 
-	cost, err := group.facade.TransactionCostRequest(&tx)
+	cost, err := group.facade.TransactionCostRequest(c.Request.Context(), &tx)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -206,11 +311,36 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, cost, "", data.ReturnCodeSuccess)
 }
 
+// estimateTransactionFee will receive a transaction from the client and will return the estimated gas units and fee,
+// along with gas limit suggestions having safety margins
+func (group *transactionGroup) estimateTransactionFee(c *gin.Context) {
+	var tx = data.Transaction{}
+	err := c.ShouldBindJSON(&tx)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	estimation, err := group.facade.EstimateTransactionFee(c.Request.Context(), &tx)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"estimation": estimation}, "", data.ReturnCodeSuccess)
+}
+
 // getTransactionStatus will return the transaction's status
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
 	sender := c.Request.URL.Query().Get("sender")
-	txStatus, err := group.facade.GetTransactionStatus(txHash, sender)
+	txStatus, err := group.facade.GetTransactionStatus(c.Request.Context(), txHash, sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -239,7 +369,7 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, err := group.facade.GetTransaction(txHash, options.WithResults)
+	tx, err := group.facade.GetTransaction(c.Request.Context(), txHash, options.WithResults)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -255,7 +385,7 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 		return
 	}
 
-	status, err := group.facade.GetProcessedTransactionStatus(txHash)
+	status, err := group.facade.GetProcessedTransactionStatus(c.Request.Context(), txHash)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -265,7 +395,7 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 }
 
 func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
-	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(c.Request.Context(), txHash, sndAddr, withEvents)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
 		if statusCode == http.StatusBadRequest {
@@ -365,7 +495,7 @@ func validateFields(fields string) error {
 }
 
 func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
-	txPool, err := ef.GetTransactionsPool(fields)
+	txPool, err := ef.GetTransactionsPool(c.Request.Context(), fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -375,7 +505,7 @@ func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
 }
 
 func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string) {
-	txPool, err := ef.GetTransactionsPoolForShard(shardID, fields)
+	txPool, err := ef.GetTransactionsPoolForShard(c.Request.Context(), shardID, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -385,7 +515,7 @@ func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint
 }
 
 func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
-	lastNonce, err := ef.GetLastPoolNonceForSender(sender)
+	lastNonce, err := ef.GetLastPoolNonceForSender(c.Request.Context(), sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -395,7 +525,7 @@ func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, se
 }
 
 func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
-	nonceGaps, err := ef.GetTransactionsPoolNonceGapsForSender(sender)
+	nonceGaps, err := ef.GetTransactionsPoolNonceGapsForSender(c.Request.Context(), sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -405,7 +535,7 @@ func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, se
 }
 
 func getTxPoolForSender(c *gin.Context, ef TransactionFacadeHandler, sender, fields string) {
-	txPool, err := ef.GetTransactionsPoolForSender(sender, fields)
+	txPool, err := ef.GetTransactionsPoolForSender(c.Request.Context(), sender, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
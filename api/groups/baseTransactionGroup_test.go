@@ -7,11 +7,14 @@ import (
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +32,11 @@ type TxHashResponse struct {
 	Data txHashResponseData
 }
 
+type txBroadcastResp struct {
+	GeneralResponse
+	Data *data.TransactionBroadcastResponse `json:"data"`
+}
+
 type numOfSentTxsResponseData struct {
 	Num uint64 `json:"numOfSentTxs"`
 }
@@ -79,6 +87,27 @@ type txProcessedStatusResp struct {
 	} `json:"data"`
 }
 
+type nonceReservationResp struct {
+	GeneralResponse
+	Data struct {
+		Reservation data.NonceReservation `json:"reservation"`
+	} `json:"data"`
+}
+
+type nonceDiagnosisResp struct {
+	GeneralResponse
+	Data struct {
+		Diagnosis data.SenderNonceDiagnosis `json:"diagnosis"`
+	} `json:"data"`
+}
+
+type nonceGapsRepairResp struct {
+	GeneralResponse
+	Data struct {
+		Repair data.NonceGapsRepairResponse `json:"repair"`
+	} `json:"data"`
+}
+
 func TestNewTransactionGroup_WrongFacadeShouldErr(t *testing.T) {
 	wrongFacade := &mock.WrongFacade{}
 	group, err := groups.NewTransactionGroup(wrongFacade)
@@ -199,6 +228,254 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, string(data.ReturnCodeSuccess), response.GeneralResponse.Code)
 }
 
+func TestSendTransaction_Broadcast(t *testing.T) {
+	t.Parallel()
+
+	jsonStr := `{"nonce": 1, "sender": "erd1sender", "receiver": "erd1receiver", "value": "10", "signature": "aabbccdd"}`
+	broadcastResponse := &data.TransactionBroadcastResponse{
+		TxHash:   "tx hash",
+		Quorum:   2,
+		Accepted: []string{"observer1", "observer2"},
+		Rejected: []*data.TransactionBroadcastRejection{{Observer: "observer3", Code: http.StatusNotFound, Error: "not found"}},
+	}
+
+	t.Run("invalid broadcast parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?broadcast=true&broadcastQuorum=x", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("broadcast requested by query parameter should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			BroadcastTransactionHandler: func(tx *data.Transaction, options common.TransactionBroadcastOptions) (int, *data.TransactionBroadcastResponse, error) {
+				assert.True(t, options.Broadcast)
+				assert.Equal(t, core.OptionalUint32{Value: 3, HasValue: true}, options.NumObservers)
+				assert.Equal(t, core.OptionalUint32{Value: 2, HasValue: true}, options.Quorum)
+				return http.StatusOK, broadcastResponse, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?broadcast=true&broadcastObservers=3&broadcastQuorum=2", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txBroadcastResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, broadcastResponse, response.Data)
+	})
+	t.Run("broadcast enabled by config should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			IsTransactionBroadcastEnabledHandler: func() bool {
+				return true
+			},
+			BroadcastTransactionHandler: func(tx *data.Transaction, options common.TransactionBroadcastOptions) (int, *data.TransactionBroadcastResponse, error) {
+				wasCalled = true
+				assert.False(t, options.NumObservers.HasValue)
+				assert.False(t, options.Quorum.HasValue)
+				return http.StatusOK, broadcastResponse, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+	t.Run("broadcast disabled by query parameter should send to a single observer", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsTransactionBroadcastEnabledHandler: func() bool {
+				return true
+			},
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusOK, "tx hash", nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?broadcast=false", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("quorum not reached should return the observers answers", func(t *testing.T) {
+		t.Parallel()
+
+		errorString := "broadcast quorum not reached"
+		facade := &mock.FacadeStub{
+			BroadcastTransactionHandler: func(tx *data.Transaction, options common.TransactionBroadcastOptions) (int, *data.TransactionBroadcastResponse, error) {
+				return http.StatusInternalServerError, broadcastResponse, errors.New(errorString)
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?broadcast=true", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txBroadcastResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, errorString, response.Error)
+		assert.Equal(t, broadcastResponse, response.Data)
+	})
+}
+
+func TestSendTransaction_Tracking(t *testing.T) {
+	t.Parallel()
+
+	jsonStr := `{"nonce": 1, "sender": "erd1sender", "receiver": "erd1receiver", "value": "10", "signature": "aabbccdd"}`
+	callbackURL := "https://wallet.example.com/callback"
+	sendPath := "/transaction/send?callbackUrl=" + url.QueryEscape(callbackURL)
+
+	t.Run("tracking not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				assert.Fail(t, "should have not been called")
+				return http.StatusOK, "", nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", sendPath, bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrTransactionTrackingNotEnabled.Error(), response.Error)
+	})
+	t.Run("invalid callback should error before sending", func(t *testing.T) {
+		t.Parallel()
+
+		errorString := "invalid callback URL"
+		facade := &mock.FacadeStub{
+			IsTransactionTrackingEnabledHandler: func() bool {
+				return true
+			},
+			CheckTransactionTrackingHandler: func(providedCallbackURL string) error {
+				assert.Equal(t, callbackURL, providedCallbackURL)
+				return errors.New(errorString)
+			},
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				assert.Fail(t, "should have not been called")
+				return http.StatusOK, "", nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", sendPath, bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, errorString, response.Error)
+	})
+	t.Run("tracking failure should still return the hash", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsTransactionTrackingEnabledHandler: func() bool {
+				return true
+			},
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusOK, "tx hash", nil
+			},
+			TrackTransactionHandler: func(txHash string, callbackURL string) error {
+				return errors.New("too many tracked transactions")
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", sendPath, bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txBroadcastResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrTransactionTrackingFailed.Error())
+		assert.Equal(t, "tx hash", response.Data.TxHash)
+	})
+	t.Run("should track the sent transaction", func(t *testing.T) {
+		t.Parallel()
+
+		trackedHashes := make([]string, 0)
+		facade := &mock.FacadeStub{
+			IsTransactionTrackingEnabledHandler: func() bool {
+				return true
+			},
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusOK, "tx hash", nil
+			},
+			TrackTransactionHandler: func(txHash string, providedCallbackURL string) error {
+				assert.Equal(t, callbackURL, providedCallbackURL)
+				trackedHashes = append(trackedHashes, txHash)
+				return nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", sendPath, bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []string{"tx hash"}, trackedHashes)
+	})
+}
+
 func TestSimulateTransaction_WrongParametersShouldErrorOnValidation(t *testing.T) {
 	t.Parallel()
 
@@ -318,6 +595,180 @@ func TestSimulateTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, expectedResult.Data, response.Data)
 }
 
+func TestValidateTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/validate", bytes.NewBuffer([]byte(`{"value": 10}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		errorString := "network config error"
+		facade := &mock.FacadeStub{
+			ValidateTransactionHandler: func(tx *data.Transaction) (*data.TransactionValidationResult, error) {
+				return nil, errors.New(errorString)
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/validate", bytes.NewBuffer([]byte(`{"value": "10"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, errorString)
+	})
+	t.Run("should return the problems", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResult := &data.TransactionValidationResult{
+			Valid: false,
+			Problems: []*data.TransactionValidationProblem{
+				{Field: "gasLimit", Message: "too low"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			ValidateTransactionHandler: func(tx *data.Transaction) (*data.TransactionValidationResult, error) {
+				assert.Equal(t, uint64(3), tx.Nonce)
+				return expectedResult, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/validate", bytes.NewBuffer([]byte(`{"nonce": 3, "value": "10"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type validationResponse struct {
+			Data struct {
+				Validation *data.TransactionValidationResult `json:"validation"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := validationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedResult, response.Data.Validation)
+	})
+}
+
+func TestEstimateTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/estimate-fee", bytes.NewBuffer([]byte(`{"value": 10}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		errorString := "cost error"
+		facade := &mock.FacadeStub{
+			EstimateTransactionFeeHandler: func(tx *data.Transaction) (*data.TransactionFeeEstimation, error) {
+				return nil, errors.New(errorString)
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/estimate-fee", bytes.NewBuffer([]byte(`{"value": "10"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, errorString)
+	})
+	t.Run("should return the estimation", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEstimation := &data.TransactionFeeEstimation{
+			GasUnits:            50000,
+			MoveBalanceGasUnits: 50000,
+			GasPrice:            1000000000,
+			GasPriceModifier:    0.01,
+			Fee:                 &data.FeeAmount{Value: "50000000000000", Denominated: "0.00005"},
+			GasLimitSuggestions: []*data.GasLimitSuggestion{
+				{
+					GasLimit:        50000,
+					MaxFee:          &data.FeeAmount{Value: "50000000000000", Denominated: "0.00005"},
+					EstimatedRefund: &data.FeeAmount{Value: "0", Denominated: "0"},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			EstimateTransactionFeeHandler: func(tx *data.Transaction) (*data.TransactionFeeEstimation, error) {
+				assert.Equal(t, uint64(3), tx.Nonce)
+				return expectedEstimation, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/estimate-fee", bytes.NewBuffer([]byte(`{"nonce": 3, "value": "10"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type estimationResponse struct {
+			Data struct {
+				Estimation *data.TransactionFeeEstimation `json:"estimation"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := estimationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedEstimation, response.Data.Estimation)
+	})
+}
+
 func TestSendMultipleTransactions_WrongParametersShouldErrorOnValidation(t *testing.T) {
 	t.Parallel()
 
@@ -400,6 +851,44 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, uint64(10), response.Data.Num)
 }
 
+func TestSendMultipleTransactions_ShouldReportTheErrors(t *testing.T) {
+	t.Parallel()
+
+	providedErrors := map[int]*data.TransactionSendError{
+		1: {Code: data.TxSendErrorNoObserver, Reason: "missing observer"},
+	}
+	facade := &mock.FacadeStub{
+		SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			return data.MultipleTransactionsResponseData{
+				NumOfTxs:  1,
+				TxsHashes: map[int]string{0: "hash"},
+				TxsErrors: providedErrors,
+			}, nil
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBufferString(`[{"nonce": 1}, {"nonce": 2}]`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		GeneralResponse
+		Data struct {
+			TxsHashes map[int]string                     `json:"txsHashes"`
+			TxsErrors map[int]*data.TransactionSendError `json:"txsErrors"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, map[int]string{0: "hash"}, response.Data.TxsHashes)
+	assert.Equal(t, providedErrors, response.Data.TxsErrors)
+}
+
 func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
 	t.Parallel()
 
@@ -829,3 +1318,252 @@ func TestTransactionGroup_getProcessedTransactionStatus(t *testing.T) {
 		assert.Equal(t, status.Reason, response.Data.Reason)
 	})
 }
+
+func TestReserveNextNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ReserveNextNonceHandler: func(sender string) (*data.NonceReservation, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		resp := requestNextNonce(t, facade, "/transaction/next-nonce/erd1alice")
+
+		response := nonceReservationResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrNonceReservationNotEnabled.Error(), response.Error)
+	})
+	t.Run("too many reservations should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsNonceReservationEnabledHandler: func() bool {
+				return true
+			},
+			ReserveNextNonceHandler: func(sender string) (*data.NonceReservation, error) {
+				return nil, fmt.Errorf("%w: the limit is 1", apiErrors.ErrTooManyNonceReservations)
+			},
+		}
+		resp := requestNextNonce(t, facade, "/transaction/next-nonce/erd1alice")
+
+		response := nonceReservationResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrTooManyNonceReservations.Error())
+	})
+	t.Run("too many senders should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsNonceReservationEnabledHandler: func() bool {
+				return true
+			},
+			ReserveNextNonceHandler: func(sender string) (*data.NonceReservation, error) {
+				return nil, apiErrors.ErrTooManyNonceReservationSenders
+			},
+		}
+		resp := requestNextNonce(t, facade, "/transaction/next-nonce/erd1alice")
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			IsNonceReservationEnabledHandler: func() bool {
+				return true
+			},
+			ReserveNextNonceHandler: func(sender string) (*data.NonceReservation, error) {
+				return nil, expectedErr
+			},
+		}
+		resp := requestNextNonce(t, facade, "/transaction/next-nonce/erd1alice")
+
+		response := nonceReservationResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedReservation := data.NonceReservation{
+			Address:      "erd1alice",
+			Nonce:        7,
+			AccountNonce: 5,
+			ExpiresAt:    1700000000,
+		}
+		facade := &mock.FacadeStub{
+			IsNonceReservationEnabledHandler: func() bool {
+				return true
+			},
+			ReserveNextNonceHandler: func(sender string) (*data.NonceReservation, error) {
+				assert.Equal(t, providedReservation.Address, sender)
+				reservation := providedReservation
+				return &reservation, nil
+			},
+		}
+		resp := requestNextNonce(t, facade, "/transaction/next-nonce/erd1alice")
+
+		response := nonceReservationResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, providedReservation, response.Data.Reservation)
+	})
+}
+
+func requestNextNonce(t *testing.T, facade *mock.FacadeStub, path string) *httptest.ResponseRecorder {
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest(http.MethodPost, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestDiagnoseNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			DiagnoseSenderNoncesHandler: func(sender string) (*data.SenderNonceDiagnosis, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/nonce-gaps/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nonceDiagnosisResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedDiagnosis := data.SenderNonceDiagnosis{
+			Address:                "erd1alice",
+			AccountNonce:           5,
+			MissingNonces:          []data.NonceGap{{From: 6, To: 6}},
+			ExecutableTransactions: []data.PoolTransaction{{Hash: "h5", Nonce: 5}},
+			StuckTransactions:      []data.PoolTransaction{{Hash: "h7", Nonce: 7}},
+			StaleTransactions:      []data.PoolTransaction{{Hash: "h2", Nonce: 2}},
+		}
+		facade := &mock.FacadeStub{
+			DiagnoseSenderNoncesHandler: func(sender string) (*data.SenderNonceDiagnosis, error) {
+				assert.Equal(t, providedDiagnosis.Address, sender)
+				diagnosis := providedDiagnosis
+				return &diagnosis, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/nonce-gaps/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nonceDiagnosisResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, providedDiagnosis, response.Data.Diagnosis)
+	})
+}
+
+func TestRepairNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest(http.MethodPost, "/transaction/nonce-gaps/erd1alice/repair", bytes.NewBufferString("invalid"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nonceGapsRepairResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("invalid replacement transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			RepairSenderNonceGapsHandler: func(sender string, txs []*data.Transaction) (*data.NonceGapsRepairResponse, error) {
+				return nil, fmt.Errorf("%w: nonce 3 is not missing", apiErrors.ErrInvalidReplacementTransactions)
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest(http.MethodPost, "/transaction/nonce-gaps/erd1alice/repair", bytes.NewBufferString(`[{"sender":"erd1alice","nonce":3}]`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nonceGapsRepairResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidReplacementTransactions.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedResponse := data.NonceGapsRepairResponse{
+			Diagnosis: &data.SenderNonceDiagnosis{
+				Address:       "erd1alice",
+				AccountNonce:  5,
+				MissingNonces: []data.NonceGap{{From: 6, To: 6}},
+			},
+			NumOfTxs:  1,
+			TxsHashes: map[int]string{0: "hash"},
+		}
+		facade := &mock.FacadeStub{
+			RepairSenderNonceGapsHandler: func(sender string, txs []*data.Transaction) (*data.NonceGapsRepairResponse, error) {
+				assert.Equal(t, "erd1alice", sender)
+				require.Len(t, txs, 1)
+				assert.Equal(t, uint64(6), txs[0].Nonce)
+				return &providedResponse, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest(http.MethodPost, "/transaction/nonce-gaps/erd1alice/repair", bytes.NewBufferString(`[{"sender":"erd1alice","nonce":6}]`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := nonceGapsRepairResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, uint64(1), response.Data.Repair.NumOfTxs)
+		assert.Equal(t, providedResponse.TxsHashes, response.Data.Repair.TxsHashes)
+		assert.Equal(t, providedResponse.Diagnosis.MissingNonces, response.Data.Repair.Diagnosis.MissingNonces)
+	})
+}
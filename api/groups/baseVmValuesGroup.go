@@ -113,7 +113,15 @@ func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputAp
 		return nil, data.BlockInfo{}, err
 	}
 
-	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(command)
+	command.Historical, err = parseHistoricalCoordinates(context)
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
+	if command.Historical.IsSet() && (command.BlockNonce.HasValue || len(command.BlockHash) > 0) {
+		return nil, data.BlockInfo{}, fmt.Errorf("%w: the timestamp and the epoch cannot be used along with the block coordinates", ErrInvalidHistoricalCoordinates)
+	}
+
+	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(context.Request.Context(), command)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
 	}
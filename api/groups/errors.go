@@ -16,3 +16,9 @@ var ErrWrongTypeAssertion = errors.New("wrong type assertion")
 
 // ErrForcedShardIDCannotBeProvided signals that the forced shard id cannot be provided for a different address other than the system account address
 var ErrForcedShardIDCannotBeProvided = errors.New("forced shard id parameter can only be provided for system accounts")
+
+// ErrInvalidHistoricalCoordinates signals that the timestamp or the epoch of a historical query are not used properly
+var ErrInvalidHistoricalCoordinates = errors.New("invalid historical coordinates")
+
+// ErrInvalidAccountDiffNonces signals that the block nonces of an account diff query are missing or not ordered
+var ErrInvalidAccountDiffNonces = errors.New("invalid account diff nonces")
@@ -0,0 +1,97 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	hyperblockEventName = "hyperblock"
+	activityEventName   = "activity"
+)
+
+// hyperBlockStreamHandler handles "stream" requests. The new hyperblocks are pushed over a WebSocket connection if the
+// client requests the upgrade, otherwise as server-sent events
+func (group *hyperBlockGroup) hyperBlockStreamHandler(c *gin.Context) {
+	if !group.facade.IsHyperblockStreamEnabled() {
+		shared.RespondWith(c, http.StatusBadRequest, nil, apiErrors.ErrHyperblockStreamNotEnabled.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	options, err := parseHyperblockStreamOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	subscription, err := group.facade.SubscribeToHyperblocks(c.Request.Context(), options)
+	if err != nil {
+		respondWithSubscriptionError(c, err)
+		return
+	}
+	defer subscription.Close()
+
+	streamed := &streamedSubscription[*api.Hyperblock]{
+		items:     subscription.Hyperblocks(),
+		eventName: hyperblockEventName,
+		toMessage: func(hyperblock *api.Hyperblock) (interface{}, string) {
+			return &data.HyperblockStreamMessage{Hyperblock: hyperblock}, strconv.FormatUint(hyperblock.Nonce, 10)
+		},
+		endMessage: func() interface{} {
+			return &data.HyperblockStreamMessage{Error: subscriptionEndReason(subscription.Err())}
+		},
+	}
+	streamed.stream(c)
+}
+
+// addressActivityHandler handles "activity" requests. Only the transactions, smart contract results and log events of
+// the new hyperblocks involving the requested addresses, tokens or events are pushed, the same way as for "stream"
+func (group *hyperBlockGroup) addressActivityHandler(c *gin.Context) {
+	if !group.facade.IsHyperblockStreamEnabled() {
+		shared.RespondWith(c, http.StatusBadRequest, nil, apiErrors.ErrHyperblockStreamNotEnabled.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	options, err := parseAddressActivityOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	subscription, err := group.facade.SubscribeToAddressActivity(c.Request.Context(), options)
+	if err != nil {
+		respondWithSubscriptionError(c, err)
+		return
+	}
+	defer subscription.Close()
+
+	streamed := &streamedSubscription[*data.AddressActivity]{
+		items:     subscription.Activities(),
+		eventName: activityEventName,
+		toMessage: func(activity *data.AddressActivity) (interface{}, string) {
+			return &data.AddressActivityMessage{Activity: activity}, strconv.FormatUint(activity.HyperblockNonce, 10)
+		},
+		endMessage: func() interface{} {
+			return &data.AddressActivityMessage{Error: subscriptionEndReason(subscription.Err())}
+		},
+	}
+	streamed.stream(c)
+}
+
+func respondWithSubscriptionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, apiErrors.ErrTooManyHyperblockSubscribers):
+		shared.RespondWith(c, http.StatusServiceUnavailable, nil, err.Error(), data.ReturnCodeInternalError)
+	case errors.Is(err, apiErrors.ErrHyperblockResumeNonceTooOld), errors.Is(err, apiErrors.ErrInvalidActivityFilters):
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+	default:
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+	}
+}
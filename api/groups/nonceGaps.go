@@ -0,0 +1,66 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// diagnoseNonceGaps returns the missing nonces of the sender, along with the transactions from the pool which are
+// stuck behind them or will never execute
+func (group *transactionGroup) diagnoseNonceGaps(c *gin.Context) {
+	sender := c.Param("sender")
+	if sender == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, apiErrors.ErrEmptyAddress.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	diagnosis, err := group.facade.DiagnoseSenderNonces(c.Request.Context(), sender)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"diagnosis": diagnosis}, "", data.ReturnCodeSuccess)
+}
+
+// repairNonceGaps receives pre-signed transactions filling the missing nonces of the sender and sends them in nonce
+// order
+func (group *transactionGroup) repairNonceGaps(c *gin.Context) {
+	sender := c.Param("sender")
+	if sender == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, apiErrors.ErrEmptyAddress.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	var txs []*data.Transaction
+	err := c.ShouldBindJSON(&txs)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", apiErrors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	response, err := group.facade.RepairSenderNonceGaps(c.Request.Context(), sender, txs)
+	if err != nil {
+		if errors.Is(err, apiErrors.ErrInvalidReplacementTransactions) {
+			shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"repair": response}, "", data.ReturnCodeSuccess)
+}
@@ -0,0 +1,41 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// reserveNextNonce will reserve the next nonce of the address, so the concurrent senders using the same address do not
+// collide
+func (group *transactionGroup) reserveNextNonce(c *gin.Context) {
+	if !group.facade.IsNonceReservationEnabled() {
+		shared.RespondWith(c, http.StatusBadRequest, nil, apiErrors.ErrNonceReservationNotEnabled.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, apiErrors.ErrEmptyAddress.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	reservation, err := group.facade.ReserveNextNonce(c.Request.Context(), address)
+	if err != nil {
+		switch {
+		case errors.Is(err, apiErrors.ErrTooManyNonceReservations):
+			shared.RespondWith(c, http.StatusTooManyRequests, nil, err.Error(), data.ReturnCodeRequestError)
+		case errors.Is(err, apiErrors.ErrTooManyNonceReservationSenders):
+			shared.RespondWith(c, http.StatusServiceUnavailable, nil, err.Error(), data.ReturnCodeInternalError)
+		default:
+			shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		}
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"reservation": reservation}, "", data.ReturnCodeSuccess)
+}
@@ -0,0 +1,153 @@
+package groups
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	lastEventIDHeader        = "Last-Event-ID"
+	errorEventName           = "error"
+	streamWriteTimeout       = 10 * time.Second
+	subscriptionEndedMessage = "the subscription has ended"
+)
+
+// streamedSubscription adapts a subscription to the WebSocket and server-sent events transports
+type streamedSubscription[T any] struct {
+	items     <-chan T
+	eventName string
+	// toMessage returns the message carrying the item and the event id the clients can resume after
+	toMessage func(item T) (interface{}, string)
+	// endMessage returns the message telling the client why the subscription ended
+	endMessage func() interface{}
+}
+
+// stream pushes the items over a WebSocket connection if the client requests the upgrade, otherwise as server-sent
+// events, until the subscription ends or the client goes away
+func (ss *streamedSubscription[T]) stream(c *gin.Context) {
+	if strings.EqualFold(c.GetHeader("Upgrade"), "websocket") {
+		ss.streamOverWebSocket(c)
+		return
+	}
+
+	ss.streamAsServerSentEvents(c)
+}
+
+func (ss *streamedSubscription[T]) streamOverWebSocket(c *gin.Context) {
+	server := websocket.Server{
+		// the streams are read-only and public, so the connections are accepted from any origin
+		Handshake: func(_ *websocket.Config, _ *http.Request) error {
+			return nil
+		},
+		Handler: ss.pushOverWebSocket,
+	}
+
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+func (ss *streamedSubscription[T]) pushOverWebSocket(conn *websocket.Conn) {
+	// the clients are not expected to send anything, so the reads only detect the closed connections
+	clientGone := make(chan struct{})
+	go func() {
+		defer close(clientGone)
+
+		var message string
+		for {
+			err := websocket.Message.Receive(conn, &message)
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case item, ok := <-ss.items:
+			if !ok {
+				_ = sendWebSocketMessage(conn, ss.endMessage())
+				return
+			}
+
+			message, _ := ss.toMessage(item)
+			err := sendWebSocketMessage(conn, message)
+			if err != nil {
+				return
+			}
+		case <-clientGone:
+			return
+		}
+	}
+}
+
+func sendWebSocketMessage(conn *websocket.Conn, message interface{}) error {
+	err := conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	if err != nil {
+		return err
+	}
+
+	return websocket.JSON.Send(conn, message)
+}
+
+func (ss *streamedSubscription[T]) streamAsServerSentEvents(c *gin.Context) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	for {
+		select {
+		case item, ok := <-ss.items:
+			if !ok {
+				_ = writeServerSentEvent(c, errorEventName, "", ss.endMessage())
+				return
+			}
+
+			message, id := ss.toMessage(item)
+			err := writeServerSentEvent(c, ss.eventName, id, message)
+			if err != nil {
+				return
+			}
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
+}
+
+func writeServerSentEvent(c *gin.Context, event string, id string, message interface{}) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	var builder strings.Builder
+	if len(id) > 0 {
+		builder.WriteString("id: " + id + "\n")
+	}
+	builder.WriteString("event: " + event + "\n")
+	builder.WriteString("data: ")
+	builder.Write(payload)
+	builder.WriteString("\n\n")
+
+	_, err = c.Writer.WriteString(builder.String())
+	if err != nil {
+		return err
+	}
+	c.Writer.Flush()
+
+	return nil
+}
+
+func subscriptionEndReason(err error) string {
+	if err != nil {
+		return err.Error()
+	}
+
+	return subscriptionEndedMessage
+}
@@ -2,7 +2,9 @@ package groups
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -105,6 +107,11 @@ func parseAccountQueryOptions(c *gin.Context, address string) (common.AccountQue
 		return common.AccountQueryOptions{}, err
 	}
 
+	historical, err := parseHistoricalCoordinates(c)
+	if err != nil {
+		return common.AccountQueryOptions{}, err
+	}
+
 	if shardID.HasValue && address != SystemAccountAddressBech {
 		return common.AccountQueryOptions{}, ErrForcedShardIDCannotBeProvided
 	}
@@ -118,11 +125,73 @@ func parseAccountQueryOptions(c *gin.Context, address string) (common.AccountQue
 		HintEpoch:      hintEpoch,
 		ForcedShardID:  shardID,
 		WithKeys:       withKeys,
+		Historical:     historical,
+	}
+
+	isBlockSet := onStartOfEpoch.HasValue || blockNonce.HasValue || len(blockHash) > 0 || len(blockRootHash) > 0 || hintEpoch.HasValue
+	if historical.IsSet() && isBlockSet {
+		return common.AccountQueryOptions{}, fmt.Errorf("%w: the timestamp and the epoch cannot be used along with the block coordinates", ErrInvalidHistoricalCoordinates)
 	}
 
 	return options, nil
 }
 
+// parseHistoricalCoordinates parses the timestamp, in unix seconds, or the epoch which the proxy resolves to a block
+func parseHistoricalCoordinates(c *gin.Context) (common.HistoricalCoordinates, error) {
+	timestamp, err := parseUint64UrlParam(c, common.UrlParameterTimestamp)
+	if err != nil {
+		return common.HistoricalCoordinates{}, err
+	}
+
+	epoch, err := parseUint32UrlParam(c, common.UrlParameterEpoch)
+	if err != nil {
+		return common.HistoricalCoordinates{}, err
+	}
+
+	atEpochStart, err := parseBoolUrlParam(c, common.UrlParameterAtEpochStart)
+	if err != nil {
+		return common.HistoricalCoordinates{}, err
+	}
+
+	if timestamp.HasValue && epoch.HasValue {
+		return common.HistoricalCoordinates{}, fmt.Errorf("%w: the timestamp and the epoch cannot be used together", ErrInvalidHistoricalCoordinates)
+	}
+	if atEpochStart && !epoch.HasValue {
+		return common.HistoricalCoordinates{}, fmt.Errorf("%w: atEpochStart requires the epoch", ErrInvalidHistoricalCoordinates)
+	}
+
+	return common.HistoricalCoordinates{
+		Timestamp:    timestamp,
+		Epoch:        epoch,
+		AtEpochStart: atEpochStart,
+	}, nil
+}
+
+// parseAccountDiffOptions parses the nonces of the two blocks between which the account changes are computed
+func parseAccountDiffOptions(c *gin.Context) (common.AccountDiffOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return common.AccountDiffOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		return common.AccountDiffOptions{}, err
+	}
+
+	if !fromNonce.HasValue || !toNonce.HasValue {
+		return common.AccountDiffOptions{}, fmt.Errorf("%w: both fromNonce and toNonce are required", ErrInvalidAccountDiffNonces)
+	}
+	if fromNonce.Value > toNonce.Value {
+		return common.AccountDiffOptions{}, fmt.Errorf("%w: fromNonce must not be greater than toNonce", ErrInvalidAccountDiffNonces)
+	}
+
+	return common.AccountDiffOptions{
+		FromNonce: fromNonce.Value,
+		ToNonce:   toNonce.Value,
+	}, nil
+}
+
 func parseTransactionQueryOptions(c *gin.Context) (common.TransactionQueryOptions, error) {
 	withResults, err := parseBoolUrlParam(c, common.UrlParameterWithResults)
 	if err != nil {
@@ -143,6 +212,87 @@ func parseTransactionSimulationOptions(c *gin.Context) (common.TransactionSimula
 	return options, nil
 }
 
+func parseTransactionBroadcastOptions(c *gin.Context, isBroadcastEnabled bool) (common.TransactionBroadcastOptions, error) {
+	broadcast, err := parseBoolUrlParamWithDefault(c, common.UrlParameterBroadcast, isBroadcastEnabled)
+	if err != nil {
+		return common.TransactionBroadcastOptions{}, err
+	}
+
+	numObservers, err := parseUint32UrlParam(c, common.UrlParameterBroadcastObservers)
+	if err != nil {
+		return common.TransactionBroadcastOptions{}, err
+	}
+
+	quorum, err := parseUint32UrlParam(c, common.UrlParameterBroadcastQuorum)
+	if err != nil {
+		return common.TransactionBroadcastOptions{}, err
+	}
+
+	return common.TransactionBroadcastOptions{
+		Broadcast:    broadcast,
+		NumObservers: numObservers,
+		Quorum:       quorum,
+	}, nil
+}
+
+// parseHyperblockStreamOptions also accepts the Last-Event-ID header, sent by the reconnecting server-sent events
+// clients, in which case the stream resumes from the nonce following the last received one
+func parseHyperblockStreamOptions(c *gin.Context) (common.HyperblockStreamOptions, error) {
+	withLogs, err := parseBoolUrlParam(c, common.UrlParameterWithLogs)
+	if err != nil {
+		return common.HyperblockStreamOptions{}, err
+	}
+
+	withAlteredAccounts, err := parseBoolUrlParam(c, common.UrlParameterWithAlteredAccounts)
+	if err != nil {
+		return common.HyperblockStreamOptions{}, err
+	}
+
+	fromNonce, err := parseResumeNonce(c)
+	if err != nil {
+		return common.HyperblockStreamOptions{}, err
+	}
+
+	return common.HyperblockStreamOptions{
+		FromNonce:           fromNonce,
+		WithLogs:            withLogs,
+		WithAlteredAccounts: withAlteredAccounts,
+	}, nil
+}
+
+func parseAddressActivityOptions(c *gin.Context) (common.AddressActivityOptions, error) {
+	fromNonce, err := parseResumeNonce(c)
+	if err != nil {
+		return common.AddressActivityOptions{}, err
+	}
+
+	return common.AddressActivityOptions{
+		FromNonce: fromNonce,
+		Addresses: parseStringListUrlParam(c, common.UrlParameterAddresses),
+		Tokens:    parseStringListUrlParam(c, common.UrlParameterTokens),
+		Events:    parseStringListUrlParam(c, common.UrlParameterEvents),
+	}, nil
+}
+
+func parseResumeNonce(c *gin.Context) (core.OptionalUint64, error) {
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return core.OptionalUint64{}, err
+	}
+
+	lastEventID := c.GetHeader(lastEventIDHeader)
+	if fromNonce.HasValue || len(lastEventID) == 0 {
+		return fromNonce, nil
+	}
+
+	lastNonce, err := strconv.ParseUint(lastEventID, 10, 64)
+	if err != nil {
+		return core.OptionalUint64{}, err
+	}
+
+	return core.OptionalUint64{Value: lastNonce + 1, HasValue: true}, nil
+}
+
 func parseBoolUrlParam(c *gin.Context, name string) (bool, error) {
 	return parseBoolUrlParamWithDefault(c, name, false)
 }
@@ -160,6 +310,21 @@ func parseStringUrlParam(c *gin.Context, name string) string {
 	return c.Request.URL.Query().Get(name)
 }
 
+// parseStringListUrlParam accepts both the repeated parameters and the comma separated values
+func parseStringListUrlParam(c *gin.Context, name string) []string {
+	var values []string
+	for _, param := range c.QueryArray(name) {
+		for _, value := range strings.Split(param, ",") {
+			value = strings.TrimSpace(value)
+			if len(value) > 0 {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values
+}
+
 func parseUint32UrlParam(c *gin.Context, name string) (core.OptionalUint32, error) {
 	param := c.Request.URL.Query().Get(name)
 	if param == "" {
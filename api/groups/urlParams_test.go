@@ -140,6 +140,36 @@ func TestParseAccountQueryOptions(t *testing.T) {
 	require.Empty(t, options)
 }
 
+func TestParseAccountQueryOptionsWithHistoricalCoordinates(t *testing.T) {
+	options, err := parseAccountQueryOptions(createDummyGinContextWithQuery("timestamp=1700000000"), "")
+	require.Nil(t, err)
+	require.Equal(t, common.AccountQueryOptions{
+		Historical: common.HistoricalCoordinates{Timestamp: core.OptionalUint64{Value: 1700000000, HasValue: true}},
+	}, options)
+
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("epoch=37&atEpochStart=true"), "")
+	require.Nil(t, err)
+	require.Equal(t, common.AccountQueryOptions{
+		Historical: common.HistoricalCoordinates{Epoch: core.OptionalUint32{Value: 37, HasValue: true}, AtEpochStart: true},
+	}, options)
+
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("timestamp=1700000000&epoch=37"), "")
+	require.ErrorIs(t, err, ErrInvalidHistoricalCoordinates)
+	require.Empty(t, options)
+
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("atEpochStart=true"), "")
+	require.ErrorIs(t, err, ErrInvalidHistoricalCoordinates)
+	require.Empty(t, options)
+
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("epoch=37&blockNonce=100"), "")
+	require.ErrorIs(t, err, ErrInvalidHistoricalCoordinates)
+	require.Empty(t, options)
+
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("timestamp=foobar"), "")
+	require.NotNil(t, err)
+	require.Empty(t, options)
+}
+
 func TestParseTransactionQueryOptions(t *testing.T) {
 	options, err := parseTransactionQueryOptions(createDummyGinContextWithQuery("withResults=true"))
 	require.Nil(t, err)
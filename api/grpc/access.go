@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	grpcGo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// accessInterceptor applies to each call the route settings of the REST endpoint it mirrors: the route access, the
+// authentication and the rate limiting. The metadata of the call is seen as the headers of the request
+type accessInterceptor struct {
+	accessChecker AccessChecker
+}
+
+func (ai *accessInterceptor) unary(ctx context.Context, req interface{}, info *grpcGo.UnaryServerInfo, handler grpcGo.UnaryHandler) (interface{}, error) {
+	err := ai.checkAccess(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (ai *accessInterceptor) stream(srv interface{}, stream grpcGo.ServerStream, info *grpcGo.StreamServerInfo, handler grpcGo.StreamHandler) error {
+	err := ai.checkAccess(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+func (ai *accessInterceptor) checkAccess(ctx context.Context, fullMethod string) error {
+	route, found := methodRoutes[fullMethod]
+	if !found {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", fullMethod)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, route.Method, route.requestPath(), nil)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		// the pseudo-headers of HTTP/2 are not headers of the REST request
+		if strings.HasPrefix(key, ":") {
+			continue
+		}
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if ok && p.Addr != nil {
+		request.RemoteAddr = p.Addr.String()
+	}
+
+	httpStatus, message := ai.accessChecker.CheckAccess(request)
+	if httpStatus == http.StatusOK {
+		return nil
+	}
+
+	return status.Error(accessStatusCode(httpStatus), message)
+}
+
+func accessStatusCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		// the REST endpoint is not opened
+		return codes.Unimplemented
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,376 @@
+package grpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpc/proto"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// esdtToken is an ESDT, an SFT or an NFT held by an account, as returned by the observers. The byte fields are base64
+// encoded in the JSON responses
+type esdtToken struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Balance         string   `json:"balance"`
+	Nonce           uint64   `json:"nonce"`
+	Name            string   `json:"name"`
+	Creator         string   `json:"creator"`
+	Royalties       string   `json:"royalties"`
+	Hash            []byte   `json:"hash"`
+	Attributes      []byte   `json:"attributes"`
+	URIs            [][]byte `json:"uris"`
+	Properties      string   `json:"properties"`
+}
+
+type guardianData struct {
+	Guarded         bool          `json:"guarded"`
+	ActiveGuardian  *api.Guardian `json:"activeGuardian"`
+	PendingGuardian *api.Guardian `json:"pendingGuardian"`
+}
+
+type networkConfigMetrics struct {
+	ChainID                string `json:"erd_chain_id"`
+	Denomination           uint64 `json:"erd_denomination"`
+	GasPerDataByte         uint64 `json:"erd_gas_per_data_byte"`
+	GasPriceModifier       string `json:"erd_gas_price_modifier"`
+	MinGasLimit            uint64 `json:"erd_min_gas_limit"`
+	MinGasPrice            uint64 `json:"erd_min_gas_price"`
+	MinTransactionVersion  uint32 `json:"erd_min_transaction_version"`
+	MaxGasPerTransaction   uint64 `json:"erd_max_gas_per_transaction"`
+	ExtraGasLimitGuardedTx uint64 `json:"erd_extra_gas_limit_guarded_tx"`
+	NumShardsWithoutMeta   uint32 `json:"erd_num_shards_without_meta"`
+	RoundDuration          uint64 `json:"erd_round_duration"`
+	RoundsPerEpoch         uint64 `json:"erd_rounds_per_epoch"`
+	StartTime              uint64 `json:"erd_start_time"`
+}
+
+type networkStatusMetrics struct {
+	CurrentRound               uint64 `json:"erd_current_round"`
+	EpochNumber                uint32 `json:"erd_epoch_number"`
+	HighestFinalNonce          uint64 `json:"erd_highest_final_nonce"`
+	Nonce                      uint64 `json:"erd_nonce"`
+	NonceAtEpochStart          uint64 `json:"erd_nonce_at_epoch_start"`
+	NoncesPassedInCurrentEpoch uint64 `json:"erd_nonces_passed_in_current_epoch"`
+	RoundAtEpochStart          uint64 `json:"erd_round_at_epoch_start"`
+	RoundsPassedInCurrentEpoch uint64 `json:"erd_rounds_passed_in_current_epoch"`
+	RoundsPerEpoch             uint64 `json:"erd_rounds_per_epoch"`
+}
+
+type economicsMetrics struct {
+	TotalSupply           string `json:"erd_total_supply"`
+	CirculatingSupply     string `json:"erd_circulating_supply"`
+	StakedValue           string `json:"erd_total_staked_value"`
+	DevRewards            string `json:"erd_dev_rewards"`
+	EpochForEconomicsData uint32 `json:"erd_epoch_for_economics_data"`
+	Inflation             string `json:"erd_inflation"`
+	TotalFees             string `json:"erd_total_fees"`
+}
+
+func (metrics *networkConfigMetrics) toProto() *proto.NetworkConfig {
+	return &proto.NetworkConfig{
+		ChainId:                metrics.ChainID,
+		Denomination:           metrics.Denomination,
+		GasPerDataByte:         metrics.GasPerDataByte,
+		GasPriceModifier:       metrics.GasPriceModifier,
+		MinGasLimit:            metrics.MinGasLimit,
+		MinGasPrice:            metrics.MinGasPrice,
+		MinTransactionVersion:  metrics.MinTransactionVersion,
+		MaxGasPerTransaction:   metrics.MaxGasPerTransaction,
+		ExtraGasLimitGuardedTx: metrics.ExtraGasLimitGuardedTx,
+		NumShardsWithoutMeta:   metrics.NumShardsWithoutMeta,
+		RoundDuration:          metrics.RoundDuration,
+		RoundsPerEpoch:         metrics.RoundsPerEpoch,
+		StartTime:              metrics.StartTime,
+	}
+}
+
+func (metrics *networkStatusMetrics) toProto() *proto.NetworkStatus {
+	return &proto.NetworkStatus{
+		CurrentRound:               metrics.CurrentRound,
+		EpochNumber:                metrics.EpochNumber,
+		HighestFinalNonce:          metrics.HighestFinalNonce,
+		Nonce:                      metrics.Nonce,
+		NonceAtEpochStart:          metrics.NonceAtEpochStart,
+		NoncesPassedInCurrentEpoch: metrics.NoncesPassedInCurrentEpoch,
+		RoundAtEpochStart:          metrics.RoundAtEpochStart,
+		RoundsPassedInCurrentEpoch: metrics.RoundsPassedInCurrentEpoch,
+		RoundsPerEpoch:             metrics.RoundsPerEpoch,
+	}
+}
+
+func (metrics *economicsMetrics) toProto() *proto.Economics {
+	return &proto.Economics{
+		TotalSupply:           metrics.TotalSupply,
+		CirculatingSupply:     metrics.CirculatingSupply,
+		StakedValue:           metrics.StakedValue,
+		DevRewards:            metrics.DevRewards,
+		EpochForEconomicsData: metrics.EpochForEconomicsData,
+		Inflation:             metrics.Inflation,
+		TotalFees:             metrics.TotalFees,
+	}
+}
+
+func newAccount(account *data.Account) *proto.Account {
+	return &proto.Account{
+		Address:         account.Address,
+		Nonce:           account.Nonce,
+		Balance:         account.Balance,
+		Username:        account.Username,
+		Code:            account.Code,
+		CodeHash:        account.CodeHash,
+		RootHash:        account.RootHash,
+		CodeMetadata:    account.CodeMetadata,
+		DeveloperReward: account.DeveloperReward,
+		OwnerAddress:    account.OwnerAddress,
+	}
+}
+
+// newESDTTokens returns the tokens sorted by their identifier and nonce
+func newESDTTokens(tokens map[string]*esdtToken) []*proto.ESDTToken {
+	protoTokens := make([]*proto.ESDTToken, 0, len(tokens))
+	for _, token := range tokens {
+		if token == nil {
+			continue
+		}
+
+		protoTokens = append(protoTokens, &proto.ESDTToken{
+			TokenIdentifier: token.TokenIdentifier,
+			Balance:         token.Balance,
+			Nonce:           token.Nonce,
+			Name:            token.Name,
+			Creator:         token.Creator,
+			Royalties:       token.Royalties,
+			Hash:            token.Hash,
+			Attributes:      token.Attributes,
+			Uris:            token.URIs,
+			Properties:      token.Properties,
+		})
+	}
+	sort.Slice(protoTokens, func(i, j int) bool {
+		if protoTokens[i].TokenIdentifier != protoTokens[j].TokenIdentifier {
+			return protoTokens[i].TokenIdentifier < protoTokens[j].TokenIdentifier
+		}
+		return protoTokens[i].Nonce < protoTokens[j].Nonce
+	})
+
+	return protoTokens
+}
+
+func newGuardian(guardian *api.Guardian) *proto.Guardian {
+	if guardian == nil {
+		return nil
+	}
+
+	return &proto.Guardian{
+		Address:         guardian.Address,
+		ActivationEpoch: guardian.ActivationEpoch,
+		ServiceUid:      guardian.ServiceUID,
+	}
+}
+
+func newDataTransaction(req *proto.SendTransactionRequest) *data.Transaction {
+	return &data.Transaction{
+		Nonce:             req.Nonce,
+		Value:             req.Value,
+		Receiver:          req.Receiver,
+		Sender:            req.Sender,
+		SenderUsername:    req.SenderUsername,
+		ReceiverUsername:  req.ReceiverUsername,
+		GasPrice:          req.GasPrice,
+		GasLimit:          req.GasLimit,
+		Data:              req.Data,
+		Signature:         req.Signature,
+		ChainID:           req.ChainId,
+		Version:           req.Version,
+		Options:           req.Options,
+		GuardianAddr:      req.Guardian,
+		GuardianSignature: req.GuardianSignature,
+		RelayerAddr:       req.Relayer,
+		RelayerSignature:  req.RelayerSignature,
+	}
+}
+
+func newTransaction(tx *transaction.ApiTransactionResult) *proto.Transaction {
+	scrs := make([]*proto.SmartContractResult, 0, len(tx.SmartContractResults))
+	for _, scr := range tx.SmartContractResults {
+		if scr == nil {
+			continue
+		}
+
+		scrs = append(scrs, &proto.SmartContractResult{
+			Hash:           scr.Hash,
+			Nonce:          scr.Nonce,
+			Value:          bigIntToString(scr.Value),
+			Sender:         scr.SndAddr,
+			Receiver:       scr.RcvAddr,
+			Data:           scr.Data,
+			PrevTxHash:     scr.PrevTxHash,
+			OriginalTxHash: scr.OriginalTxHash,
+			GasLimit:       scr.GasLimit,
+			GasPrice:       scr.GasPrice,
+			CallType:       int32(scr.CallType),
+			ReturnMessage:  scr.ReturnMessage,
+			Logs:           newLogs(scr.Logs),
+		})
+	}
+
+	return &proto.Transaction{
+		Hash:                 tx.Hash,
+		Type:                 tx.Type,
+		Status:               string(tx.Status),
+		Nonce:                tx.Nonce,
+		Round:                tx.Round,
+		Epoch:                tx.Epoch,
+		Value:                tx.Value,
+		Sender:               tx.Sender,
+		Receiver:             tx.Receiver,
+		GasPrice:             tx.GasPrice,
+		GasLimit:             tx.GasLimit,
+		GasUsed:              tx.GasUsed,
+		Data:                 tx.Data,
+		Signature:            tx.Signature,
+		SourceShard:          tx.SourceShard,
+		DestinationShard:     tx.DestinationShard,
+		BlockNonce:           tx.BlockNonce,
+		BlockHash:            tx.BlockHash,
+		MiniblockHash:        tx.MiniBlockHash,
+		HyperblockNonce:      tx.HyperblockNonce,
+		HyperblockHash:       tx.HyperblockHash,
+		Timestamp:            tx.Timestamp,
+		Function:             tx.Function,
+		Operation:            tx.Operation,
+		Fee:                  tx.Fee,
+		InitiallyPaidFee:     tx.InitiallyPaidFee,
+		SmartContractResults: scrs,
+		Logs:                 newLogs(tx.Logs),
+	}
+}
+
+func newTransactions(txs []*transaction.ApiTransactionResult) []*proto.Transaction {
+	protoTxs := make([]*proto.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if tx != nil {
+			protoTxs = append(protoTxs, newTransaction(tx))
+		}
+	}
+
+	return protoTxs
+}
+
+func newLogs(logs *transaction.ApiLogs) *proto.Logs {
+	if logs == nil {
+		return nil
+	}
+
+	events := make([]*proto.Event, 0, len(logs.Events))
+	for _, event := range logs.Events {
+		if event == nil {
+			continue
+		}
+
+		events = append(events, &proto.Event{
+			Address:        event.Address,
+			Identifier:     event.Identifier,
+			Topics:         event.Topics,
+			Data:           event.Data,
+			AdditionalData: event.AdditionalData,
+		})
+	}
+
+	return &proto.Logs{
+		Address: logs.Address,
+		Events:  events,
+	}
+}
+
+func newBlock(block *api.Block) *proto.Block {
+	miniBlocks := make([]*proto.MiniBlock, 0, len(block.MiniBlocks))
+	for _, miniBlock := range block.MiniBlocks {
+		if miniBlock == nil {
+			continue
+		}
+
+		miniBlocks = append(miniBlocks, &proto.MiniBlock{
+			Hash:             miniBlock.Hash,
+			Type:             miniBlock.Type,
+			SourceShard:      miniBlock.SourceShard,
+			DestinationShard: miniBlock.DestinationShard,
+			Transactions:     newTransactions(miniBlock.Transactions),
+		})
+	}
+
+	return &proto.Block{
+		Hash:            block.Hash,
+		Nonce:           block.Nonce,
+		Round:           block.Round,
+		Epoch:           block.Epoch,
+		Shard:           block.Shard,
+		NumTxs:          block.NumTxs,
+		PrevBlockHash:   block.PrevBlockHash,
+		StateRootHash:   block.StateRootHash,
+		AccumulatedFees: block.AccumulatedFees,
+		DeveloperFees:   block.DeveloperFees,
+		Status:          block.Status,
+		Timestamp:       int64(block.Timestamp),
+		MiniBlocks:      miniBlocks,
+	}
+}
+
+func newHyperblock(hyperblock *api.Hyperblock) *proto.Hyperblock {
+	shardBlocks := make([]*proto.ShardBlock, 0, len(hyperblock.ShardBlocks))
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		if shardBlock == nil {
+			continue
+		}
+
+		shardBlocks = append(shardBlocks, &proto.ShardBlock{
+			Hash:     shardBlock.Hash,
+			Nonce:    shardBlock.Nonce,
+			Round:    shardBlock.Round,
+			Shard:    shardBlock.Shard,
+			RootHash: shardBlock.RootHash,
+		})
+	}
+
+	return &proto.Hyperblock{
+		Hash:            hyperblock.Hash,
+		Nonce:           hyperblock.Nonce,
+		Round:           hyperblock.Round,
+		Epoch:           hyperblock.Epoch,
+		NumTxs:          hyperblock.NumTxs,
+		PrevBlockHash:   hyperblock.PrevBlockHash,
+		StateRootHash:   hyperblock.StateRootHash,
+		AccumulatedFees: hyperblock.AccumulatedFees,
+		DeveloperFees:   hyperblock.DeveloperFees,
+		Status:          hyperblock.Status,
+		Timestamp:       int64(hyperblock.Timestamp),
+		ShardBlocks:     shardBlocks,
+		Transactions:    newTransactions(hyperblock.Transactions),
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// decodeResponseData decodes the payload of a generic response, which holds the observer response as decoded JSON
+func decodeResponseData(response *data.GenericAPIResponse, payload interface{}) error {
+	if response == nil {
+		return ErrNilResponse
+	}
+
+	buff, err := json.Marshal(response.Data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, payload)
+}
@@ -0,0 +1,12 @@
+package grpc
+
+import "errors"
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrNilAccessChecker signals that a nil access checker has been provided
+var ErrNilAccessChecker = errors.New("nil access checker")
+
+// ErrNilResponse signals that the facade returned a nil response without an error
+var ErrNilResponse = errors.New("nil response")
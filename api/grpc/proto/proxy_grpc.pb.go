@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proxy.proto
+
+// The gRPC API of the proxy mirrors the v1.0 REST endpoints. Each call passes through the route access, the
+// authentication and the rate limiting of its REST endpoint, noted in the comment of the call. The credentials are
+// sent as metadata, under the same keys as the HTTP headers.
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Proxy_GetAccount_FullMethodName             = "/multiversx.proxy.v1.Proxy/GetAccount"
+	Proxy_GetAccounts_FullMethodName            = "/multiversx.proxy.v1.Proxy/GetAccounts"
+	Proxy_GetESDTTokens_FullMethodName          = "/multiversx.proxy.v1.Proxy/GetESDTTokens"
+	Proxy_GetKeyValuePairs_FullMethodName       = "/multiversx.proxy.v1.Proxy/GetKeyValuePairs"
+	Proxy_GetGuardianData_FullMethodName        = "/multiversx.proxy.v1.Proxy/GetGuardianData"
+	Proxy_GetTransaction_FullMethodName         = "/multiversx.proxy.v1.Proxy/GetTransaction"
+	Proxy_GetTransactionStatus_FullMethodName   = "/multiversx.proxy.v1.Proxy/GetTransactionStatus"
+	Proxy_SendTransaction_FullMethodName        = "/multiversx.proxy.v1.Proxy/SendTransaction"
+	Proxy_GetBlockByNonce_FullMethodName        = "/multiversx.proxy.v1.Proxy/GetBlockByNonce"
+	Proxy_GetBlockByHash_FullMethodName         = "/multiversx.proxy.v1.Proxy/GetBlockByHash"
+	Proxy_GetHyperblockByNonce_FullMethodName   = "/multiversx.proxy.v1.Proxy/GetHyperblockByNonce"
+	Proxy_GetHyperblockByHash_FullMethodName    = "/multiversx.proxy.v1.Proxy/GetHyperblockByHash"
+	Proxy_SubscribeToHyperblocks_FullMethodName = "/multiversx.proxy.v1.Proxy/SubscribeToHyperblocks"
+	Proxy_GetNetworkConfig_FullMethodName       = "/multiversx.proxy.v1.Proxy/GetNetworkConfig"
+	Proxy_GetNetworkStatus_FullMethodName       = "/multiversx.proxy.v1.Proxy/GetNetworkStatus"
+	Proxy_GetEconomics_FullMethodName           = "/multiversx.proxy.v1.Proxy/GetEconomics"
+	Proxy_QueryVM_FullMethodName                = "/multiversx.proxy.v1.Proxy/QueryVM"
+)
+
+// ProxyClient is the client API for Proxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProxyClient interface {
+	// GET /address/:address
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// POST /address/bulk
+	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
+	// GET /address/:address/esdt
+	GetESDTTokens(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetESDTTokensResponse, error)
+	// GET /address/:address/keys
+	GetKeyValuePairs(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetKeyValuePairsResponse, error)
+	// GET /address/:address/guardian-data
+	GetGuardianData(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GuardianData, error)
+	// GET /transaction/:txhash
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// GET /transaction/:txhash/status
+	GetTransactionStatus(ctx context.Context, in *GetTransactionStatusRequest, opts ...grpc.CallOption) (*GetTransactionStatusResponse, error)
+	// POST /transaction/send
+	SendTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// GET /block/:shard/by-nonce/:nonce
+	GetBlockByNonce(ctx context.Context, in *GetBlockByNonceRequest, opts ...grpc.CallOption) (*Block, error)
+	// GET /block/:shard/by-hash/:hash
+	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*Block, error)
+	// GET /hyperblock/by-nonce/:nonce
+	GetHyperblockByNonce(ctx context.Context, in *GetHyperblockByNonceRequest, opts ...grpc.CallOption) (*Hyperblock, error)
+	// GET /hyperblock/by-hash/:hash
+	GetHyperblockByHash(ctx context.Context, in *GetHyperblockByHashRequest, opts ...grpc.CallOption) (*Hyperblock, error)
+	// GET /hyperblock/stream. The new hyperblocks are streamed until the client cancels the call or the subscription
+	// ends, the status of the call telling why it ended
+	SubscribeToHyperblocks(ctx context.Context, in *SubscribeToHyperblocksRequest, opts ...grpc.CallOption) (Proxy_SubscribeToHyperblocksClient, error)
+	// GET /network/config
+	GetNetworkConfig(ctx context.Context, in *GetNetworkConfigRequest, opts ...grpc.CallOption) (*NetworkConfig, error)
+	// GET /network/status/:shard
+	GetNetworkStatus(ctx context.Context, in *GetNetworkStatusRequest, opts ...grpc.CallOption) (*NetworkStatus, error)
+	// GET /network/economics
+	GetEconomics(ctx context.Context, in *GetEconomicsRequest, opts ...grpc.CallOption) (*Economics, error)
+	// POST /vm-values/query
+	QueryVM(ctx context.Context, in *QueryVMRequest, opts ...grpc.CallOption) (*QueryVMResponse, error)
+}
+
+type proxyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProxyClient(cc grpc.ClientConnInterface) ProxyClient {
+	return &proxyClient{cc}
+}
+
+func (c *proxyClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, Proxy_GetAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error) {
+	out := new(GetAccountsResponse)
+	err := c.cc.Invoke(ctx, Proxy_GetAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetESDTTokens(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetESDTTokensResponse, error) {
+	out := new(GetESDTTokensResponse)
+	err := c.cc.Invoke(ctx, Proxy_GetESDTTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetKeyValuePairs(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetKeyValuePairsResponse, error) {
+	out := new(GetKeyValuePairsResponse)
+	err := c.cc.Invoke(ctx, Proxy_GetKeyValuePairs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetGuardianData(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GuardianData, error) {
+	out := new(GuardianData)
+	err := c.cc.Invoke(ctx, Proxy_GetGuardianData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Proxy_GetTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetTransactionStatus(ctx context.Context, in *GetTransactionStatusRequest, opts ...grpc.CallOption) (*GetTransactionStatusResponse, error) {
+	out := new(GetTransactionStatusResponse)
+	err := c.cc.Invoke(ctx, Proxy_GetTransactionStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SendTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := c.cc.Invoke(ctx, Proxy_SendTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetBlockByNonce(ctx context.Context, in *GetBlockByNonceRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, Proxy_GetBlockByNonce_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, Proxy_GetBlockByHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetHyperblockByNonce(ctx context.Context, in *GetHyperblockByNonceRequest, opts ...grpc.CallOption) (*Hyperblock, error) {
+	out := new(Hyperblock)
+	err := c.cc.Invoke(ctx, Proxy_GetHyperblockByNonce_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetHyperblockByHash(ctx context.Context, in *GetHyperblockByHashRequest, opts ...grpc.CallOption) (*Hyperblock, error) {
+	out := new(Hyperblock)
+	err := c.cc.Invoke(ctx, Proxy_GetHyperblockByHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SubscribeToHyperblocks(ctx context.Context, in *SubscribeToHyperblocksRequest, opts ...grpc.CallOption) (Proxy_SubscribeToHyperblocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Proxy_ServiceDesc.Streams[0], Proxy_SubscribeToHyperblocks_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &proxySubscribeToHyperblocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Proxy_SubscribeToHyperblocksClient interface {
+	Recv() (*Hyperblock, error)
+	grpc.ClientStream
+}
+
+type proxySubscribeToHyperblocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *proxySubscribeToHyperblocksClient) Recv() (*Hyperblock, error) {
+	m := new(Hyperblock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *proxyClient) GetNetworkConfig(ctx context.Context, in *GetNetworkConfigRequest, opts ...grpc.CallOption) (*NetworkConfig, error) {
+	out := new(NetworkConfig)
+	err := c.cc.Invoke(ctx, Proxy_GetNetworkConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetNetworkStatus(ctx context.Context, in *GetNetworkStatusRequest, opts ...grpc.CallOption) (*NetworkStatus, error) {
+	out := new(NetworkStatus)
+	err := c.cc.Invoke(ctx, Proxy_GetNetworkStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetEconomics(ctx context.Context, in *GetEconomicsRequest, opts ...grpc.CallOption) (*Economics, error) {
+	out := new(Economics)
+	err := c.cc.Invoke(ctx, Proxy_GetEconomics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) QueryVM(ctx context.Context, in *QueryVMRequest, opts ...grpc.CallOption) (*QueryVMResponse, error) {
+	out := new(QueryVMResponse)
+	err := c.cc.Invoke(ctx, Proxy_QueryVM_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProxyServer is the server API for Proxy service.
+// All implementations must embed UnimplementedProxyServer
+// for forward compatibility
+type ProxyServer interface {
+	// GET /address/:address
+	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
+	// POST /address/bulk
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
+	// GET /address/:address/esdt
+	GetESDTTokens(context.Context, *GetAccountRequest) (*GetESDTTokensResponse, error)
+	// GET /address/:address/keys
+	GetKeyValuePairs(context.Context, *GetAccountRequest) (*GetKeyValuePairsResponse, error)
+	// GET /address/:address/guardian-data
+	GetGuardianData(context.Context, *GetAccountRequest) (*GuardianData, error)
+	// GET /transaction/:txhash
+	GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error)
+	// GET /transaction/:txhash/status
+	GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error)
+	// POST /transaction/send
+	SendTransaction(context.Context, *SendTransactionRequest) (*SendTransactionResponse, error)
+	// GET /block/:shard/by-nonce/:nonce
+	GetBlockByNonce(context.Context, *GetBlockByNonceRequest) (*Block, error)
+	// GET /block/:shard/by-hash/:hash
+	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*Block, error)
+	// GET /hyperblock/by-nonce/:nonce
+	GetHyperblockByNonce(context.Context, *GetHyperblockByNonceRequest) (*Hyperblock, error)
+	// GET /hyperblock/by-hash/:hash
+	GetHyperblockByHash(context.Context, *GetHyperblockByHashRequest) (*Hyperblock, error)
+	// GET /hyperblock/stream. The new hyperblocks are streamed until the client cancels the call or the subscription
+	// ends, the status of the call telling why it ended
+	SubscribeToHyperblocks(*SubscribeToHyperblocksRequest, Proxy_SubscribeToHyperblocksServer) error
+	// GET /network/config
+	GetNetworkConfig(context.Context, *GetNetworkConfigRequest) (*NetworkConfig, error)
+	// GET /network/status/:shard
+	GetNetworkStatus(context.Context, *GetNetworkStatusRequest) (*NetworkStatus, error)
+	// GET /network/economics
+	GetEconomics(context.Context, *GetEconomicsRequest) (*Economics, error)
+	// POST /vm-values/query
+	QueryVM(context.Context, *QueryVMRequest) (*QueryVMResponse, error)
+	mustEmbedUnimplementedProxyServer()
+}
+
+// UnimplementedProxyServer must be embedded to have forward compatible implementations.
+type UnimplementedProxyServer struct {
+}
+
+func (UnimplementedProxyServer) GetAccount(context.Context, *GetAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedProxyServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedProxyServer) GetESDTTokens(context.Context, *GetAccountRequest) (*GetESDTTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetESDTTokens not implemented")
+}
+func (UnimplementedProxyServer) GetKeyValuePairs(context.Context, *GetAccountRequest) (*GetKeyValuePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyValuePairs not implemented")
+}
+func (UnimplementedProxyServer) GetGuardianData(context.Context, *GetAccountRequest) (*GuardianData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardianData not implemented")
+}
+func (UnimplementedProxyServer) GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedProxyServer) GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionStatus not implemented")
+}
+func (UnimplementedProxyServer) SendTransaction(context.Context, *SendTransactionRequest) (*SendTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransaction not implemented")
+}
+func (UnimplementedProxyServer) GetBlockByNonce(context.Context, *GetBlockByNonceRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByNonce not implemented")
+}
+func (UnimplementedProxyServer) GetBlockByHash(context.Context, *GetBlockByHashRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
+}
+func (UnimplementedProxyServer) GetHyperblockByNonce(context.Context, *GetHyperblockByNonceRequest) (*Hyperblock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHyperblockByNonce not implemented")
+}
+func (UnimplementedProxyServer) GetHyperblockByHash(context.Context, *GetHyperblockByHashRequest) (*Hyperblock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHyperblockByHash not implemented")
+}
+func (UnimplementedProxyServer) SubscribeToHyperblocks(*SubscribeToHyperblocksRequest, Proxy_SubscribeToHyperblocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToHyperblocks not implemented")
+}
+func (UnimplementedProxyServer) GetNetworkConfig(context.Context, *GetNetworkConfigRequest) (*NetworkConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkConfig not implemented")
+}
+func (UnimplementedProxyServer) GetNetworkStatus(context.Context, *GetNetworkStatusRequest) (*NetworkStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkStatus not implemented")
+}
+func (UnimplementedProxyServer) GetEconomics(context.Context, *GetEconomicsRequest) (*Economics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEconomics not implemented")
+}
+func (UnimplementedProxyServer) QueryVM(context.Context, *QueryVMRequest) (*QueryVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVM not implemented")
+}
+func (UnimplementedProxyServer) mustEmbedUnimplementedProxyServer() {}
+
+// UnsafeProxyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProxyServer will
+// result in compilation errors.
+type UnsafeProxyServer interface {
+	mustEmbedUnimplementedProxyServer()
+}
+
+func RegisterProxyServer(s grpc.ServiceRegistrar, srv ProxyServer) {
+	s.RegisterService(&Proxy_ServiceDesc, srv)
+}
+
+func _Proxy_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetESDTTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetESDTTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetESDTTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetESDTTokens(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetKeyValuePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetKeyValuePairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetKeyValuePairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetKeyValuePairs(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetGuardianData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetGuardianData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetGuardianData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetGuardianData(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetTransactionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetTransactionStatus(ctx, req.(*GetTransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).SendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_SendTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).SendTransaction(ctx, req.(*SendTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetBlockByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetBlockByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetBlockByNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetBlockByNonce(ctx, req.(*GetBlockByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetBlockByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetHyperblockByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHyperblockByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetHyperblockByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetHyperblockByNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetHyperblockByNonce(ctx, req.(*GetHyperblockByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetHyperblockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHyperblockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetHyperblockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetHyperblockByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetHyperblockByHash(ctx, req.(*GetHyperblockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SubscribeToHyperblocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeToHyperblocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProxyServer).SubscribeToHyperblocks(m, &proxySubscribeToHyperblocksServer{stream})
+}
+
+type Proxy_SubscribeToHyperblocksServer interface {
+	Send(*Hyperblock) error
+	grpc.ServerStream
+}
+
+type proxySubscribeToHyperblocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *proxySubscribeToHyperblocksServer) Send(m *Hyperblock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Proxy_GetNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetNetworkConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetNetworkConfig(ctx, req.(*GetNetworkConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetNetworkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetNetworkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetNetworkStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetNetworkStatus(ctx, req.(*GetNetworkStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetEconomics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEconomicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetEconomics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetEconomics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetEconomics(ctx, req.(*GetEconomicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_QueryVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).QueryVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_QueryVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).QueryVM(ctx, req.(*QueryVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Proxy_ServiceDesc is the grpc.ServiceDesc for Proxy service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Proxy_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "multiversx.proxy.v1.Proxy",
+	HandlerType: (*ProxyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _Proxy_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _Proxy_GetAccounts_Handler,
+		},
+		{
+			MethodName: "GetESDTTokens",
+			Handler:    _Proxy_GetESDTTokens_Handler,
+		},
+		{
+			MethodName: "GetKeyValuePairs",
+			Handler:    _Proxy_GetKeyValuePairs_Handler,
+		},
+		{
+			MethodName: "GetGuardianData",
+			Handler:    _Proxy_GetGuardianData_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _Proxy_GetTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransactionStatus",
+			Handler:    _Proxy_GetTransactionStatus_Handler,
+		},
+		{
+			MethodName: "SendTransaction",
+			Handler:    _Proxy_SendTransaction_Handler,
+		},
+		{
+			MethodName: "GetBlockByNonce",
+			Handler:    _Proxy_GetBlockByNonce_Handler,
+		},
+		{
+			MethodName: "GetBlockByHash",
+			Handler:    _Proxy_GetBlockByHash_Handler,
+		},
+		{
+			MethodName: "GetHyperblockByNonce",
+			Handler:    _Proxy_GetHyperblockByNonce_Handler,
+		},
+		{
+			MethodName: "GetHyperblockByHash",
+			Handler:    _Proxy_GetHyperblockByHash_Handler,
+		},
+		{
+			MethodName: "GetNetworkConfig",
+			Handler:    _Proxy_GetNetworkConfig_Handler,
+		},
+		{
+			MethodName: "GetNetworkStatus",
+			Handler:    _Proxy_GetNetworkStatus_Handler,
+		},
+		{
+			MethodName: "GetEconomics",
+			Handler:    _Proxy_GetEconomics_Handler,
+		},
+		{
+			MethodName: "QueryVM",
+			Handler:    _Proxy_QueryVM_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeToHyperblocks",
+			Handler:       _Proxy_SubscribeToHyperblocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proxy.proto",
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/grpc/proto"
+)
+
+// restVersionPrefix is the prefix of the REST endpoints mirrored by the gRPC API
+const restVersionPrefix = "/v1.0"
+
+// Route is the REST endpoint mirrored by a gRPC call, whose route settings apply to the call
+type Route struct {
+	Method string
+	Path   string
+}
+
+var methodRoutes = map[string]Route{
+	proto.Proxy_GetAccount_FullMethodName:             {Method: http.MethodGet, Path: "/address/:address"},
+	proto.Proxy_GetAccounts_FullMethodName:            {Method: http.MethodPost, Path: "/address/bulk"},
+	proto.Proxy_GetESDTTokens_FullMethodName:          {Method: http.MethodGet, Path: "/address/:address/esdt"},
+	proto.Proxy_GetKeyValuePairs_FullMethodName:       {Method: http.MethodGet, Path: "/address/:address/keys"},
+	proto.Proxy_GetGuardianData_FullMethodName:        {Method: http.MethodGet, Path: "/address/:address/guardian-data"},
+	proto.Proxy_GetTransaction_FullMethodName:         {Method: http.MethodGet, Path: "/transaction/:txhash"},
+	proto.Proxy_GetTransactionStatus_FullMethodName:   {Method: http.MethodGet, Path: "/transaction/:txhash/status"},
+	proto.Proxy_SendTransaction_FullMethodName:        {Method: http.MethodPost, Path: "/transaction/send"},
+	proto.Proxy_GetBlockByNonce_FullMethodName:        {Method: http.MethodGet, Path: "/block/:shard/by-nonce/:nonce"},
+	proto.Proxy_GetBlockByHash_FullMethodName:         {Method: http.MethodGet, Path: "/block/:shard/by-hash/:hash"},
+	proto.Proxy_GetHyperblockByNonce_FullMethodName:   {Method: http.MethodGet, Path: "/hyperblock/by-nonce/:nonce"},
+	proto.Proxy_GetHyperblockByHash_FullMethodName:    {Method: http.MethodGet, Path: "/hyperblock/by-hash/:hash"},
+	proto.Proxy_SubscribeToHyperblocks_FullMethodName: {Method: http.MethodGet, Path: "/hyperblock/stream"},
+	proto.Proxy_GetNetworkConfig_FullMethodName:       {Method: http.MethodGet, Path: "/network/config"},
+	proto.Proxy_GetNetworkStatus_FullMethodName:       {Method: http.MethodGet, Path: "/network/status/:shard"},
+	proto.Proxy_GetEconomics_FullMethodName:           {Method: http.MethodGet, Path: "/network/economics"},
+	proto.Proxy_QueryVM_FullMethodName:                {Method: http.MethodPost, Path: "/vm-values/query"},
+}
+
+// Routes returns the REST endpoints mirrored by the gRPC calls, their paths being prefixed by the REST version
+func Routes() []Route {
+	routes := make([]Route, 0, len(methodRoutes))
+	for _, route := range methodRoutes {
+		routes = append(routes, Route{
+			Method: route.Method,
+			Path:   restVersionPrefix + route.Path,
+		})
+	}
+
+	return routes
+}
+
+// requestPath returns a path matched by the route, the parameters being replaced by a placeholder since the route
+// settings do not depend on them
+func (route Route) requestPath() string {
+	segments := strings.Split(route.Path, "/")
+	for idx, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[idx] = "_"
+		}
+	}
+
+	return restVersionPrefix + strings.Join(segments, "/")
+}
@@ -0,0 +1,484 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpc/proto"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	grpcGo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logger.GetOrCreate("api/grpc")
+
+// grpcServer serves the gRPC API, which mirrors the v1.0 REST endpoints of the facade
+type grpcServer struct {
+	proto.UnimplementedProxyServer
+	facade FacadeHandler
+	server *grpcGo.Server
+}
+
+// NewGRPCServer returns a new instance of grpcServer. Each call is checked by the access checker before being handled
+func NewGRPCServer(facade FacadeHandler, accessChecker AccessChecker) (*grpcServer, error) {
+	if facade == nil {
+		return nil, ErrNilFacade
+	}
+	if check.IfNil(accessChecker) {
+		return nil, ErrNilAccessChecker
+	}
+
+	interceptor := &accessInterceptor{
+		accessChecker: accessChecker,
+	}
+	gs := &grpcServer{
+		facade: facade,
+		server: grpcGo.NewServer(
+			grpcGo.UnaryInterceptor(interceptor.unary),
+			grpcGo.StreamInterceptor(interceptor.stream),
+		),
+	}
+	proto.RegisterProxyServer(gs.server, gs)
+
+	return gs, nil
+}
+
+// Serve accepts the connections on the listener until the server is closed
+func (gs *grpcServer) Serve(listener net.Listener) error {
+	err := gs.server.Serve(listener)
+	if errors.Is(err, grpcGo.ErrServerStopped) {
+		return nil
+	}
+
+	return err
+}
+
+// GetAccount returns an account
+func (gs *grpcServer) GetAccount(ctx context.Context, req *proto.GetAccountRequest) (*proto.Account, error) {
+	if len(req.Address) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrEmptyAddress.Error())
+	}
+
+	accountModel, err := gs.facade.GetAccount(ctx, req.Address, accountQueryOptions(req.Options))
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if accountModel == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	account := newAccount(&accountModel.Account)
+	account.BlockInfo = &proto.BlockInfo{
+		Nonce:    accountModel.BlockInfo.Nonce,
+		Hash:     accountModel.BlockInfo.Hash,
+		RootHash: accountModel.BlockInfo.RootHash,
+	}
+
+	return account, nil
+}
+
+// GetAccounts returns many accounts, fetched with one request to each shard
+func (gs *grpcServer) GetAccounts(ctx context.Context, req *proto.GetAccountsRequest) (*proto.GetAccountsResponse, error) {
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrEmptyAddress.Error())
+	}
+
+	accountsModel, err := gs.facade.GetAccounts(ctx, req.Addresses, accountQueryOptions(req.Options))
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if accountsModel == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	accounts := make(map[string]*proto.Account, len(accountsModel.Accounts))
+	for address, account := range accountsModel.Accounts {
+		if account != nil {
+			accounts[address] = newAccount(account)
+		}
+	}
+
+	return &proto.GetAccountsResponse{Accounts: accounts}, nil
+}
+
+// GetESDTTokens returns all the tokens held by an account
+func (gs *grpcServer) GetESDTTokens(ctx context.Context, req *proto.GetAccountRequest) (*proto.GetESDTTokensResponse, error) {
+	if len(req.Address) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrEmptyAddress.Error())
+	}
+
+	response, err := gs.facade.GetAllESDTTokens(ctx, req.Address, accountQueryOptions(req.Options))
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	payload := struct {
+		Esdts map[string]*esdtToken `json:"esdts"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	return &proto.GetESDTTokensResponse{Tokens: newESDTTokens(payload.Esdts)}, nil
+}
+
+// GetKeyValuePairs returns the storage of an account
+func (gs *grpcServer) GetKeyValuePairs(ctx context.Context, req *proto.GetAccountRequest) (*proto.GetKeyValuePairsResponse, error) {
+	if len(req.Address) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrEmptyAddress.Error())
+	}
+
+	response, err := gs.facade.GetKeyValuePairs(ctx, req.Address, accountQueryOptions(req.Options))
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	payload := struct {
+		Pairs map[string]string `json:"pairs"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	return &proto.GetKeyValuePairsResponse{Pairs: payload.Pairs}, nil
+}
+
+// GetGuardianData returns the guardians of an account
+func (gs *grpcServer) GetGuardianData(ctx context.Context, req *proto.GetAccountRequest) (*proto.GuardianData, error) {
+	if len(req.Address) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrEmptyAddress.Error())
+	}
+
+	response, err := gs.facade.GetGuardianData(ctx, req.Address, accountQueryOptions(req.Options))
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	payload := struct {
+		GuardianData *guardianData `json:"guardianData"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if payload.GuardianData == nil {
+		return &proto.GuardianData{}, nil
+	}
+
+	return &proto.GuardianData{
+		Guarded:         payload.GuardianData.Guarded,
+		ActiveGuardian:  newGuardian(payload.GuardianData.ActiveGuardian),
+		PendingGuardian: newGuardian(payload.GuardianData.PendingGuardian),
+	}, nil
+}
+
+// GetTransaction returns a transaction, optionally along with its smart contract results and logs
+func (gs *grpcServer) GetTransaction(ctx context.Context, req *proto.GetTransactionRequest) (*proto.Transaction, error) {
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrTransactionHashMissing.Error())
+	}
+
+	tx, err := gs.facade.GetTransaction(ctx, req.Hash, req.WithResults)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if tx == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	return newTransaction(tx), nil
+}
+
+// GetTransactionStatus returns the status of a transaction
+func (gs *grpcServer) GetTransactionStatus(ctx context.Context, req *proto.GetTransactionStatusRequest) (*proto.GetTransactionStatusResponse, error) {
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrTransactionHashMissing.Error())
+	}
+
+	txStatus, err := gs.facade.GetTransactionStatus(ctx, req.Hash, req.Sender)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	return &proto.GetTransactionStatusResponse{Status: txStatus}, nil
+}
+
+// SendTransaction sends a transaction to the observers of the sender's shard
+func (gs *grpcServer) SendTransaction(ctx context.Context, req *proto.SendTransactionRequest) (*proto.SendTransactionResponse, error) {
+	httpStatus, txHash, err := gs.facade.SendTransaction(ctx, newDataTransaction(req))
+	if err != nil {
+		if httpStatus == http.StatusBadRequest {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, facadeError(err)
+	}
+
+	return &proto.SendTransactionResponse{TxHash: txHash}, nil
+}
+
+// GetBlockByNonce returns a block of a shard by its nonce
+func (gs *grpcServer) GetBlockByNonce(ctx context.Context, req *proto.GetBlockByNonceRequest) (*proto.Block, error) {
+	options := common.BlockQueryOptions{
+		WithTransactions: req.WithTransactions,
+		WithLogs:         req.WithLogs,
+	}
+	response, err := gs.facade.GetBlockByNonce(ctx, req.Shard, req.Nonce, options)
+
+	return blockFromResponse(response, err)
+}
+
+// GetBlockByHash returns a block of a shard by its hash
+func (gs *grpcServer) GetBlockByHash(ctx context.Context, req *proto.GetBlockByHashRequest) (*proto.Block, error) {
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrInvalidBlockHashParam.Error())
+	}
+
+	options := common.BlockQueryOptions{
+		WithTransactions: req.WithTransactions,
+		WithLogs:         req.WithLogs,
+	}
+	response, err := gs.facade.GetBlockByHash(ctx, req.Shard, req.Hash, options)
+
+	return blockFromResponse(response, err)
+}
+
+func blockFromResponse(response *data.BlockApiResponse, err error) (*proto.Block, error) {
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if response == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	return newBlock(&response.Data.Block), nil
+}
+
+// GetHyperblockByNonce returns a hyperblock by its nonce
+func (gs *grpcServer) GetHyperblockByNonce(ctx context.Context, req *proto.GetHyperblockByNonceRequest) (*proto.Hyperblock, error) {
+	options := common.HyperblockQueryOptions{
+		WithLogs: req.WithLogs,
+	}
+	response, err := gs.facade.GetHyperBlockByNonce(ctx, req.Nonce, options)
+
+	return hyperblockFromResponse(response, err)
+}
+
+// GetHyperblockByHash returns a hyperblock by its hash
+func (gs *grpcServer) GetHyperblockByHash(ctx context.Context, req *proto.GetHyperblockByHashRequest) (*proto.Hyperblock, error) {
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, apiErrors.ErrInvalidBlockHashParam.Error())
+	}
+
+	options := common.HyperblockQueryOptions{
+		WithLogs: req.WithLogs,
+	}
+	response, err := gs.facade.GetHyperBlockByHash(ctx, req.Hash, options)
+
+	return hyperblockFromResponse(response, err)
+}
+
+func hyperblockFromResponse(response *data.HyperblockApiResponse, err error) (*proto.Hyperblock, error) {
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if response == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	return newHyperblock(&response.Data.Hyperblock), nil
+}
+
+// SubscribeToHyperblocks streams the new hyperblocks until the client cancels the call or the subscription ends
+func (gs *grpcServer) SubscribeToHyperblocks(req *proto.SubscribeToHyperblocksRequest, stream proto.Proxy_SubscribeToHyperblocksServer) error {
+	if !gs.facade.IsHyperblockStreamEnabled() {
+		return status.Error(codes.FailedPrecondition, apiErrors.ErrHyperblockStreamNotEnabled.Error())
+	}
+
+	options := common.HyperblockStreamOptions{
+		WithLogs: req.WithLogs,
+	}
+	if req.FromNonce != nil {
+		options.FromNonce = core.OptionalUint64{Value: *req.FromNonce, HasValue: true}
+	}
+
+	subscription, err := gs.facade.SubscribeToHyperblocks(stream.Context(), options)
+	if err != nil {
+		return subscriptionError(err)
+	}
+	defer subscription.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case hyperblock, ok := <-subscription.Hyperblocks():
+			if !ok {
+				return subscriptionEndError(subscription.Err())
+			}
+
+			err = stream.Send(newHyperblock(hyperblock))
+			if err != nil {
+				log.Debug("cannot send hyperblock to gRPC subscriber", "nonce", hyperblock.Nonce, "error", err)
+				return err
+			}
+		}
+	}
+}
+
+func subscriptionError(err error) error {
+	switch {
+	case errors.Is(err, apiErrors.ErrTooManyHyperblockSubscribers):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, apiErrors.ErrHyperblockResumeNonceTooOld):
+		return status.Error(codes.OutOfRange, err.Error())
+	default:
+		return facadeError(err)
+	}
+}
+
+// subscriptionEndError tells the client why the stream ended. The subscriptions dropped for being too slow, as well
+// as the ones ended by the proxy shutting down, can be resumed from the nonce following the last received hyperblock
+func subscriptionEndError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// GetNetworkConfig returns the configuration metrics of the network
+func (gs *grpcServer) GetNetworkConfig(ctx context.Context, _ *proto.GetNetworkConfigRequest) (*proto.NetworkConfig, error) {
+	response, err := gs.facade.GetNetworkConfigMetrics(ctx)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	payload := struct {
+		Config *networkConfigMetrics `json:"config"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if payload.Config == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	return payload.Config.toProto(), nil
+}
+
+// GetNetworkStatus returns the status metrics of a shard
+func (gs *grpcServer) GetNetworkStatus(ctx context.Context, req *proto.GetNetworkStatusRequest) (*proto.NetworkStatus, error) {
+	response, err := gs.facade.GetNetworkStatusMetrics(ctx, req.Shard)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	payload := struct {
+		Status *networkStatusMetrics `json:"status"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if payload.Status == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	return payload.Status.toProto(), nil
+}
+
+// GetEconomics returns the economics metrics of the network
+func (gs *grpcServer) GetEconomics(ctx context.Context, _ *proto.GetEconomicsRequest) (*proto.Economics, error) {
+	response, err := gs.facade.GetEconomicsDataMetrics(ctx)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+
+	payload := struct {
+		Metrics *economicsMetrics `json:"metrics"`
+	}{}
+	err = decodeResponseData(response, &payload)
+	if err != nil {
+		return nil, facadeError(err)
+	}
+	if payload.Metrics == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	return payload.Metrics.toProto(), nil
+}
+
+// QueryVM executes a read-only function of a smart contract
+func (gs *grpcServer) QueryVM(ctx context.Context, req *proto.QueryVMRequest) (*proto.QueryVMResponse, error) {
+	query := &data.SCQuery{
+		ScAddress:  req.ScAddress,
+		FuncName:   req.FuncName,
+		CallerAddr: req.Caller,
+		CallValue:  req.Value,
+		Arguments:  req.Args,
+	}
+	if query.Arguments == nil {
+		query.Arguments = make([][]byte, 0)
+	}
+	if req.BlockNonce != nil {
+		query.BlockNonce = core.OptionalUint64{Value: *req.BlockNonce, HasValue: true}
+	}
+
+	vmOutput, _, err := gs.facade.ExecuteSCQuery(ctx, query)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if vmOutput == nil {
+		return nil, facadeError(ErrNilResponse)
+	}
+
+	return &proto.QueryVMResponse{
+		ReturnData:    vmOutput.ReturnData,
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  vmOutput.GasRemaining,
+	}, nil
+}
+
+func accountQueryOptions(options *proto.AccountQueryOptions) common.AccountQueryOptions {
+	if options == nil {
+		return common.AccountQueryOptions{}
+	}
+
+	queryOptions := common.AccountQueryOptions{
+		OnFinalBlock: options.OnFinalBlock,
+	}
+	if options.BlockNonce != nil {
+		queryOptions.BlockNonce = core.OptionalUint64{Value: *options.BlockNonce, HasValue: true}
+	}
+
+	return queryOptions
+}
+
+// facadeError wraps the errors of the facade, the same way the REST endpoints respond with an internal error
+func facadeError(err error) error {
+	return status.Error(codes.Internal, err.Error())
+}
+
+// Close stops the server, closing the connections and ending the streams
+func (gs *grpcServer) Close() error {
+	gs.server.Stop()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gs *grpcServer) IsInterfaceNil() bool {
+	return gs == nil
+}
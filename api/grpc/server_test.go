@@ -0,0 +1,510 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpc"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpc/proto"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpcGo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type accessCheckerStub struct {
+	mut      sync.Mutex
+	requests []*http.Request
+	status   int
+	message  string
+}
+
+func (stub *accessCheckerStub) CheckAccess(request *http.Request) (int, string) {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	stub.requests = append(stub.requests, request)
+	if stub.status == 0 {
+		return http.StatusOK, ""
+	}
+
+	return stub.status, stub.message
+}
+
+func (stub *accessCheckerStub) lastRequest() *http.Request {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	return stub.requests[len(stub.requests)-1]
+}
+
+func (stub *accessCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+type hyperblockSubscriptionStub struct {
+	ch  chan *api.Hyperblock
+	err error
+}
+
+func (stub *hyperblockSubscriptionStub) Hyperblocks() <-chan *api.Hyperblock {
+	return stub.ch
+}
+
+func (stub *hyperblockSubscriptionStub) Err() error {
+	return stub.err
+}
+
+func (stub *hyperblockSubscriptionStub) Close() {
+}
+
+func startTestServer(t *testing.T, facade grpc.FacadeHandler, accessChecker grpc.AccessChecker) proto.ProxyClient {
+	server, err := grpc.NewGRPCServer(facade, accessChecker)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpcGo.DialContext(
+		context.Background(),
+		"bufnet",
+		grpcGo.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpcGo.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = server.Close()
+	})
+
+	return proto.NewProxyClient(conn)
+}
+
+func TestNewGRPCServer(t *testing.T) {
+	t.Parallel()
+
+	server, err := grpc.NewGRPCServer(nil, &accessCheckerStub{})
+	require.Nil(t, server)
+	require.Equal(t, grpc.ErrNilFacade, err)
+
+	server, err = grpc.NewGRPCServer(&mock.FacadeStub{}, nil)
+	require.Nil(t, server)
+	require.Equal(t, grpc.ErrNilAccessChecker, err)
+
+	server, err = grpc.NewGRPCServer(&mock.FacadeStub{}, &accessCheckerStub{})
+	require.NoError(t, err)
+	require.False(t, server.IsInterfaceNil())
+	require.NoError(t, server.Close())
+}
+
+func TestRoutes(t *testing.T) {
+	t.Parallel()
+
+	routes := grpc.Routes()
+	assert.Len(t, routes, 17)
+	assert.Contains(t, routes, grpc.Route{Method: http.MethodGet, Path: "/v1.0/address/:address"})
+	assert.Contains(t, routes, grpc.Route{Method: http.MethodPost, Path: "/v1.0/transaction/send"})
+	assert.Contains(t, routes, grpc.Route{Method: http.MethodGet, Path: "/v1.0/hyperblock/stream"})
+}
+
+func TestGRPCServer_AccessShouldBeChecked(t *testing.T) {
+	t.Parallel()
+
+	t.Run("allowed call should send the metadata as headers", func(t *testing.T) {
+		t.Parallel()
+
+		accessChecker := &accessCheckerStub{}
+		client := startTestServer(t, &mock.FacadeStub{
+			GetAccountHandler: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Address: address}}, nil
+			},
+		}, accessChecker)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token", "x-api-key", "key")
+		_, err := client.GetAccount(ctx, &proto.GetAccountRequest{Address: "erd1alice"})
+		require.NoError(t, err)
+
+		request := accessChecker.lastRequest()
+		assert.Equal(t, http.MethodGet, request.Method)
+		assert.Equal(t, "/v1.0/address/_", request.URL.Path)
+		assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		assert.Equal(t, "key", request.Header.Get("X-Api-Key"))
+		assert.NotEmpty(t, request.RemoteAddr)
+	})
+
+	denials := map[int]codes.Code{
+		http.StatusUnauthorized:    codes.Unauthenticated,
+		http.StatusForbidden:       codes.PermissionDenied,
+		http.StatusNotFound:        codes.Unimplemented,
+		http.StatusTooManyRequests: codes.ResourceExhausted,
+	}
+	for httpStatus, code := range denials {
+		httpStatus, code := httpStatus, code
+		t.Run(http.StatusText(httpStatus)+" should deny the call", func(t *testing.T) {
+			t.Parallel()
+
+			accessChecker := &accessCheckerStub{status: httpStatus, message: "denied"}
+			client := startTestServer(t, &mock.FacadeStub{}, accessChecker)
+
+			_, err := client.GetNetworkConfig(context.Background(), &proto.GetNetworkConfigRequest{})
+			assert.Equal(t, code, status.Code(err))
+			assert.Equal(t, "denied", status.Convert(err).Message())
+			assert.Equal(t, "/v1.0/network/config", accessChecker.lastRequest().URL.Path)
+		})
+	}
+	t.Run("denied stream should not subscribe", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestServer(t, &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				require.Fail(t, "should not have been called")
+				return true
+			},
+		}, &accessCheckerStub{status: http.StatusNotFound, message: "endpoint is not opened"})
+
+		stream, err := client.SubscribeToHyperblocks(context.Background(), &proto.SubscribeToHyperblocksRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestGRPCServer_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestServer(t, &mock.FacadeStub{}, &accessCheckerStub{})
+		_, err := client.GetAccount(context.Background(), &proto.GetAccountRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestServer(t, &mock.FacadeStub{
+			GetAccountHandler: func(_ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return nil, errors.New("expected error")
+			},
+		}, &accessCheckerStub{})
+		_, err := client.GetAccount(context.Background(), &proto.GetAccountRequest{Address: "erd1alice"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, "expected error", status.Convert(err).Message())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockNonce := uint64(37)
+		client := startTestServer(t, &mock.FacadeStub{
+			GetAccountHandler: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.True(t, options.OnFinalBlock)
+				assert.Equal(t, blockNonce, options.BlockNonce.Value)
+				assert.True(t, options.BlockNonce.HasValue)
+
+				return &data.AccountModel{
+					Account: data.Account{
+						Address:  address,
+						Nonce:    7,
+						Balance:  "1000",
+						CodeHash: []byte("code hash"),
+					},
+					BlockInfo: data.BlockInfo{Nonce: blockNonce, Hash: "aa", RootHash: "bb"},
+				}, nil
+			},
+		}, &accessCheckerStub{})
+
+		account, err := client.GetAccount(context.Background(), &proto.GetAccountRequest{
+			Address: "erd1alice",
+			Options: &proto.AccountQueryOptions{OnFinalBlock: true, BlockNonce: &blockNonce},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "erd1alice", account.Address)
+		assert.Equal(t, uint64(7), account.Nonce)
+		assert.Equal(t, "1000", account.Balance)
+		assert.Equal(t, []byte("code hash"), account.CodeHash)
+		assert.Equal(t, blockNonce, account.BlockInfo.Nonce)
+		assert.Equal(t, "bb", account.BlockInfo.RootHash)
+	})
+}
+
+func TestGRPCServer_GetESDTTokens(t *testing.T) {
+	t.Parallel()
+
+	client := startTestServer(t, &mock.FacadeStub{
+		GetAllESDTTokensCalled: func(_ string, _ common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"esdts": map[string]interface{}{
+						"NFT-abcdef-02": map[string]interface{}{"tokenIdentifier": "NFT-abcdef", "nonce": 2, "uris": []string{"dXJp"}},
+						"AAA-abcdef":    map[string]interface{}{"tokenIdentifier": "AAA-abcdef", "balance": "10"},
+						"NFT-abcdef-01": map[string]interface{}{"tokenIdentifier": "NFT-abcdef", "nonce": 1, "attributes": "YXR0cg=="},
+					},
+				},
+			}, nil
+		},
+	}, &accessCheckerStub{})
+
+	response, err := client.GetESDTTokens(context.Background(), &proto.GetAccountRequest{Address: "erd1alice"})
+	require.NoError(t, err)
+	require.Len(t, response.Tokens, 3)
+	assert.Equal(t, "10", response.Tokens[0].Balance)
+	assert.Equal(t, []byte("attr"), response.Tokens[1].Attributes)
+	assert.Equal(t, [][]byte{[]byte("uri")}, response.Tokens[2].Uris)
+}
+
+func TestGRPCServer_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bad request should error with invalid argument", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestServer(t, &mock.FacadeStub{
+			SendTransactionHandler: func(_ *data.Transaction) (int, string, error) {
+				return http.StatusBadRequest, "", errors.New("invalid transaction")
+			},
+		}, &accessCheckerStub{})
+		_, err := client.SendTransaction(context.Background(), &proto.SendTransactionRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestServer(t, &mock.FacadeStub{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				assert.Equal(t, &data.Transaction{
+					Nonce:        3,
+					Value:        "1",
+					Receiver:     "erd1bob",
+					Sender:       "erd1alice",
+					GasPrice:     1000000000,
+					GasLimit:     50000,
+					Data:         []byte("data"),
+					Signature:    "aabb",
+					ChainID:      "T",
+					Version:      2,
+					GuardianAddr: "erd1guardian",
+				}, tx)
+
+				return http.StatusOK, "hash", nil
+			},
+		}, &accessCheckerStub{})
+		response, err := client.SendTransaction(context.Background(), &proto.SendTransactionRequest{
+			Nonce:     3,
+			Value:     "1",
+			Receiver:  "erd1bob",
+			Sender:    "erd1alice",
+			GasPrice:  1000000000,
+			GasLimit:  50000,
+			Data:      []byte("data"),
+			Signature: "aabb",
+			ChainId:   "T",
+			Version:   2,
+			Guardian:  "erd1guardian",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "hash", response.TxHash)
+	})
+}
+
+func TestGRPCServer_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	client := startTestServer(t, &mock.FacadeStub{
+		GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			assert.True(t, withResults)
+
+			return &transaction.ApiTransactionResult{
+				Hash:   txHash,
+				Status: transaction.TxStatusSuccess,
+				Data:   []byte("data"),
+				Logs: &transaction.ApiLogs{
+					Address: "erd1alice",
+					Events:  []*transaction.Events{{Identifier: "transfer", Topics: [][]byte{[]byte("topic")}}},
+				},
+			}, nil
+		},
+	}, &accessCheckerStub{})
+
+	tx, err := client.GetTransaction(context.Background(), &proto.GetTransactionRequest{Hash: "aa", WithResults: true})
+	require.NoError(t, err)
+	assert.Equal(t, "aa", tx.Hash)
+	assert.Equal(t, "success", tx.Status)
+	assert.Equal(t, []byte("data"), tx.Data)
+	assert.Equal(t, [][]byte{[]byte("topic")}, tx.Logs.Events[0].Topics)
+}
+
+func TestGRPCServer_NetworkMetrics(t *testing.T) {
+	t.Parallel()
+
+	client := startTestServer(t, &mock.FacadeStub{
+		GetConfigMetricsHandler: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id":           "T",
+						"erd_min_gas_price":      float64(1000000000),
+						"erd_gas_price_modifier": "0.01",
+					},
+				},
+			}, nil
+		},
+		GetNetworkMetricsHandler: func(shardID uint32) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"status": map[string]interface{}{
+						"erd_nonce":        float64(shardID + 100),
+						"erd_epoch_number": float64(4),
+					},
+				},
+			}, nil
+		},
+	}, &accessCheckerStub{})
+
+	networkConfig, err := client.GetNetworkConfig(context.Background(), &proto.GetNetworkConfigRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "T", networkConfig.ChainId)
+	assert.Equal(t, uint64(1000000000), networkConfig.MinGasPrice)
+	assert.Equal(t, "0.01", networkConfig.GasPriceModifier)
+
+	networkStatus, err := client.GetNetworkStatus(context.Background(), &proto.GetNetworkStatusRequest{Shard: 1})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(101), networkStatus.Nonce)
+	assert.Equal(t, uint32(4), networkStatus.EpochNumber)
+}
+
+func TestGRPCServer_QueryVM(t *testing.T) {
+	t.Parallel()
+
+	client := startTestServer(t, &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			assert.Equal(t, "erd1contract", query.ScAddress)
+			assert.Equal(t, "getSum", query.FuncName)
+			assert.Equal(t, [][]byte{{1}, {2}}, query.Arguments)
+
+			return &vm.VMOutputApi{ReturnData: [][]byte{{3}}, ReturnCode: "ok"}, data.BlockInfo{}, nil
+		},
+	}, &accessCheckerStub{})
+
+	response, err := client.QueryVM(context.Background(), &proto.QueryVMRequest{
+		ScAddress: "erd1contract",
+		FuncName:  "getSum",
+		Args:      [][]byte{{1}, {2}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{3}}, response.ReturnData)
+	assert.Equal(t, "ok", response.ReturnCode)
+}
+
+func TestGRPCServer_SubscribeToHyperblocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestServer(t, &mock.FacadeStub{}, &accessCheckerStub{})
+		stream, err := client.SubscribeToHyperblocks(context.Background(), &proto.SubscribeToHyperblocksRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("subscription error should error", func(t *testing.T) {
+		t.Parallel()
+
+		client := startTestServer(t, &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				return true
+			},
+			SubscribeToHyperblocksHandler: func(_ common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+				return nil, apiErrors.ErrTooManyHyperblockSubscribers
+			},
+		}, &accessCheckerStub{})
+		stream, err := client.SubscribeToHyperblocks(context.Background(), &proto.SubscribeToHyperblocksRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+	t.Run("should stream the hyperblocks until the subscription ends", func(t *testing.T) {
+		t.Parallel()
+
+		fromNonce := uint64(10)
+		subscription := &hyperblockSubscriptionStub{
+			ch: make(chan *api.Hyperblock, 2),
+		}
+		subscription.ch <- &api.Hyperblock{Nonce: 10, Hash: "aa"}
+		subscription.ch <- &api.Hyperblock{Nonce: 11, Hash: "bb"}
+		close(subscription.ch)
+
+		client := startTestServer(t, &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				return true
+			},
+			SubscribeToHyperblocksHandler: func(options common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+				assert.Equal(t, fromNonce, options.FromNonce.Value)
+				assert.True(t, options.FromNonce.HasValue)
+				assert.True(t, options.WithLogs)
+
+				return subscription, nil
+			},
+		}, &accessCheckerStub{})
+
+		stream, err := client.SubscribeToHyperblocks(context.Background(), &proto.SubscribeToHyperblocksRequest{
+			FromNonce: &fromNonce,
+			WithLogs:  true,
+		})
+		require.NoError(t, err)
+
+		hyperblock, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "aa", hyperblock.Hash)
+		hyperblock, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(11), hyperblock.Nonce)
+		_, err = stream.Recv()
+		assert.Equal(t, io.EOF, err)
+	})
+	t.Run("dropped subscription should end the stream with unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		subscription := &hyperblockSubscriptionStub{
+			ch:  make(chan *api.Hyperblock),
+			err: errors.New("too slow"),
+		}
+		close(subscription.ch)
+
+		client := startTestServer(t, &mock.FacadeStub{
+			IsHyperblockStreamEnabledHandler: func() bool {
+				return true
+			},
+			SubscribeToHyperblocksHandler: func(_ common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+				return subscription, nil
+			},
+		}, &accessCheckerStub{})
+
+		stream, err := client.SubscribeToHyperblocks(context.Background(), &proto.SubscribeToHyperblocksRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, "too slow", status.Convert(err).Message())
+	})
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpc"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// grpcVersion is the API version whose facade serves the gRPC calls and whose route settings apply to them
+const grpcVersion = "v1.0"
+
+// GRPCServerHandler defines the actions of a gRPC server
+type GRPCServerHandler interface {
+	Serve(listener net.Listener) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// CreateGRPCServer creates the gRPC server mirroring the v1.0 REST API. The calls go through the route access and
+// the rate limiter of the web server, so the settings applied through the settings handler apply to them as well
+func CreateGRPCServer(versionsRegistry data.VersionsRegistryHandler, serverSettings ServerSettingsHandler) (GRPCServerHandler, error) {
+	settingsHandler, ok := serverSettings.(*serverSettingsHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	versionsMap, err := versionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+	versionData, found := versionsMap[grpcVersion]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrVersionNotRegistered, grpcVersion)
+	}
+
+	facade, ok := versionData.Facade.(grpc.FacadeHandler)
+	if !ok {
+		return nil, ErrFacadeWithoutGRPCSupport
+	}
+
+	accessChecker := newGRPCAccessChecker(settingsHandler.routeAccess, settingsHandler.rateLimiter)
+
+	return grpc.NewGRPCServer(facade, accessChecker)
+}
+
+// grpcAccessChecker runs the route access and the rate limiter of the web server on the requests standing for the
+// gRPC calls. Its routes are the REST endpoints mirrored by the calls, each one ending in a handler allowing the call
+type grpcAccessChecker struct {
+	engine *gin.Engine
+}
+
+func newGRPCAccessChecker(routeAccess middleware.MiddlewareProcessor, rateLimiter middleware.MiddlewareProcessor) *grpcAccessChecker {
+	engine := gin.New()
+	allowCall := func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	}
+	for _, route := range grpc.Routes() {
+		engine.Handle(route.Method, route.Path, routeAccess.MiddlewareHandlerFunc(), rateLimiter.MiddlewareHandlerFunc(), allowCall)
+	}
+
+	return &grpcAccessChecker{
+		engine: engine,
+	}
+}
+
+// CheckAccess returns the status the web server responds with to the request, along with its error message
+func (checker *grpcAccessChecker) CheckAccess(request *http.Request) (int, string) {
+	recorder := newSubRequestRecorder()
+	checker.engine.ServeHTTP(recorder, request)
+	if recorder.status == http.StatusOK {
+		return http.StatusOK, ""
+	}
+
+	response := &data.GenericAPIResponse{}
+	err := json.Unmarshal(recorder.body.Bytes(), response)
+	if err != nil {
+		return recorder.status, http.StatusText(recorder.status)
+	}
+
+	return recorder.status, response.Error
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *grpcAccessChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// authenticatorStub lets through the requests having an authorization header
+type authenticatorStub struct{}
+
+func (stub *authenticatorStub) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(c.GetHeader("Authorization")) == 0 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{Error: "unauthorized"})
+		}
+	}
+}
+
+func (stub *authenticatorStub) IdentificationHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {}
+}
+
+func (stub *authenticatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createTestGRPCAccessChecker(t *testing.T) *grpcAccessChecker {
+	routeAccess, err := middleware.NewRouteAccess(map[string]data.RouteConfig{
+		"/v1.0/address/:address":         {Open: true},
+		"/v1.0/network/config":           {Open: true, RateLimit: 1},
+		"/v1.0/transaction/send":         {Open: true, Secured: true},
+		"/v1.0/hyperblock/by-hash/:hash": {Open: false},
+	}, &authenticatorStub{})
+	require.NoError(t, err)
+
+	rateLimiter, err := middleware.NewRateLimiter(map[string]uint64{"/v1.0/network/config": 1}, time.Minute, config.RateLimitsConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = rateLimiter.Close()
+	})
+
+	return newGRPCAccessChecker(routeAccess, rateLimiter)
+}
+
+func createGRPCCheckRequest(method string, path string) *http.Request {
+	request, _ := http.NewRequest(method, path, nil)
+	request.RemoteAddr = "10.0.0.1:1234"
+
+	return request
+}
+
+func TestGRPCAccessChecker_CheckAccess(t *testing.T) {
+	t.Parallel()
+
+	checker := createTestGRPCAccessChecker(t)
+	require.False(t, checker.IsInterfaceNil())
+
+	t.Run("open route should allow", func(t *testing.T) {
+		t.Parallel()
+
+		httpStatus, message := checker.CheckAccess(createGRPCCheckRequest(http.MethodGet, "/v1.0/address/_"))
+		assert.Equal(t, http.StatusOK, httpStatus)
+		assert.Empty(t, message)
+	})
+	t.Run("closed or missing route should deny", func(t *testing.T) {
+		t.Parallel()
+
+		httpStatus, message := checker.CheckAccess(createGRPCCheckRequest(http.MethodGet, "/v1.0/hyperblock/by-hash/_"))
+		assert.Equal(t, http.StatusNotFound, httpStatus)
+		assert.Equal(t, "endpoint is not opened", message)
+
+		httpStatus, _ = checker.CheckAccess(createGRPCCheckRequest(http.MethodGet, "/v1.0/network/economics"))
+		assert.Equal(t, http.StatusNotFound, httpStatus)
+	})
+	t.Run("secured route should authenticate", func(t *testing.T) {
+		t.Parallel()
+
+		httpStatus, message := checker.CheckAccess(createGRPCCheckRequest(http.MethodPost, "/v1.0/transaction/send"))
+		assert.Equal(t, http.StatusUnauthorized, httpStatus)
+		assert.Equal(t, "unauthorized", message)
+
+		request := createGRPCCheckRequest(http.MethodPost, "/v1.0/transaction/send")
+		request.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+		httpStatus, _ = checker.CheckAccess(request)
+		assert.Equal(t, http.StatusOK, httpStatus)
+	})
+	t.Run("rate limited route should count the calls", func(t *testing.T) {
+		t.Parallel()
+
+		httpStatus, _ := checker.CheckAccess(createGRPCCheckRequest(http.MethodGet, "/v1.0/network/config"))
+		assert.Equal(t, http.StatusOK, httpStatus)
+
+		httpStatus, message := checker.CheckAccess(createGRPCCheckRequest(http.MethodGet, "/v1.0/network/config"))
+		assert.Equal(t, http.StatusTooManyRequests, httpStatus)
+		assert.NotEmpty(t, message)
+	})
+}
@@ -0,0 +1,107 @@
+package jsonrpc
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+const (
+	hexPrefix = "0x"
+
+	blockTagLatest    = "latest"
+	blockTagPending   = "pending"
+	blockTagSafe      = "safe"
+	blockTagFinalized = "finalized"
+	blockTagEarliest  = "earliest"
+)
+
+// encodeQuantity encodes a number the Ethereum way: hex, with the 0x prefix and without leading zeros
+func encodeQuantity(value uint64) string {
+	return hexPrefix + strconv.FormatUint(value, 16)
+}
+
+// encodeBigQuantity encodes a base 10 big integer as an Ethereum quantity
+func encodeBigQuantity(value string) (string, bool) {
+	if len(value) == 0 {
+		return encodeQuantity(0), true
+	}
+
+	bigValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || bigValue.Sign() < 0 {
+		return "", false
+	}
+
+	return hexPrefix + bigValue.Text(16), true
+}
+
+func decodeQuantity(value string) (uint64, bool) {
+	if !strings.HasPrefix(value, hexPrefix) || len(value) == len(hexPrefix) {
+		return 0, false
+	}
+
+	number, err := strconv.ParseUint(value[len(hexPrefix):], 16, 64)
+
+	return number, err == nil
+}
+
+// decodeBigQuantity decodes an Ethereum quantity into a base 10 big integer
+func decodeBigQuantity(value string) (string, bool) {
+	if !strings.HasPrefix(value, hexPrefix) || len(value) == len(hexPrefix) {
+		return "", false
+	}
+
+	bigValue, ok := big.NewInt(0).SetString(value[len(hexPrefix):], 16)
+	if !ok {
+		return "", false
+	}
+
+	return bigValue.String(), true
+}
+
+func encodeData(value []byte) string {
+	return hexPrefix + hex.EncodeToString(value)
+}
+
+func decodeData(value string) ([]byte, bool) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(value, hexPrefix))
+
+	return decoded, err == nil
+}
+
+// encodeHash prefixes the hex encoded hashes of the proxy with 0x
+func encodeHash(hash string) string {
+	if len(hash) == 0 {
+		return ""
+	}
+
+	return hexPrefix + hash
+}
+
+// decodeHash accepts the hashes with or without the 0x prefix
+func decodeHash(hash string) (string, bool) {
+	hash = strings.TrimPrefix(hash, hexPrefix)
+	_, err := hex.DecodeString(hash)
+
+	return hash, err == nil && len(hash) > 0
+}
+
+// decodeAddress accepts the bech32 addresses as they are, as well as the 0x prefixed hex encoded public keys, which
+// are converted to bech32
+func decodeAddress(converter core.PubkeyConverter, address string) (string, bool) {
+	if !strings.HasPrefix(address, hexPrefix) {
+		return address, len(address) > 0
+	}
+
+	publicKey, err := hex.DecodeString(address[len(hexPrefix):])
+	if err != nil || len(publicKey) != converter.Len() {
+		return "", false
+	}
+
+	bech32Address, err := converter.Encode(publicKey)
+
+	return bech32Address, err == nil
+}
@@ -0,0 +1,12 @@
+package jsonrpc
+
+import "errors"
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrNilAddressConverter signals that the facade provided a nil address converter
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrInvalidJSONRPCConfig signals that the JSON-RPC config is invalid
+var ErrInvalidJSONRPCConfig = errors.New("invalid JSON-RPC config")
@@ -0,0 +1,168 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+type methodHandler func(ctx context.Context, params json.RawMessage) (interface{}, *rpcError)
+
+// jsonRPCHandler executes the Ethereum-style JSON-RPC requests against the facade. The requests of a batch are
+// executed in order and the whole batch counts as a single request for the rate limiting, so the hyperblocks scanned
+// by all the eth_getLogs calls of an HTTP request are bounded by a shared budget
+type jsonRPCHandler struct {
+	facade                  FacadeHandler
+	addressConverter        core.PubkeyConverter
+	maxBatchSize            int
+	maxLogsBlockRange       uint64
+	maxLogsBlocksPerRequest uint64
+	methods                 map[string]methodHandler
+}
+
+// NewJSONRPCHandler returns a new instance of jsonRPCHandler
+func NewJSONRPCHandler(facade FacadeHandler, jsonRPCConfig config.JSONRPCConfig) (*jsonRPCHandler, error) {
+	if facade == nil {
+		return nil, ErrNilFacade
+	}
+	if jsonRPCConfig.MaxBatchSize <= 0 {
+		return nil, fmt.Errorf("%w: MaxBatchSize must be greater than zero", ErrInvalidJSONRPCConfig)
+	}
+	if jsonRPCConfig.MaxLogsBlockRange <= 0 {
+		return nil, fmt.Errorf("%w: MaxLogsBlockRange must be greater than zero", ErrInvalidJSONRPCConfig)
+	}
+	if jsonRPCConfig.MaxLogsBlocksPerRequest <= 0 {
+		return nil, fmt.Errorf("%w: MaxLogsBlocksPerRequest must be greater than zero", ErrInvalidJSONRPCConfig)
+	}
+
+	addressConverter, err := facade.GetAddressConverter()
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(addressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+
+	jh := &jsonRPCHandler{
+		facade:                  facade,
+		addressConverter:        addressConverter,
+		maxBatchSize:            jsonRPCConfig.MaxBatchSize,
+		maxLogsBlockRange:       uint64(jsonRPCConfig.MaxLogsBlockRange),
+		maxLogsBlocksPerRequest: uint64(jsonRPCConfig.MaxLogsBlocksPerRequest),
+	}
+	jh.methods = map[string]methodHandler{
+		"eth_getBalance":            jh.getBalance,
+		"eth_getTransactionCount":   jh.getTransactionCount,
+		"eth_sendRawTransaction":    jh.sendRawTransaction,
+		"eth_getTransactionReceipt": jh.getTransactionReceipt,
+		"eth_call":                  jh.call,
+		"eth_blockNumber":           jh.blockNumber,
+		"eth_getLogs":               jh.getLogs,
+	}
+
+	return jh, nil
+}
+
+// Handle executes the request or the batch of requests of the body. The errors are reported in the JSON-RPC
+// responses, so the HTTP status is 200 unless only notifications were sent
+func (jh *jsonRPCHandler) Handle(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusOK, newErrorResponse(nil, newRPCError(codeParseError, "cannot read the request: %s", err.Error())))
+		return
+	}
+
+	ctx := withLogsBudget(c.Request.Context(), newLogsBudget(jh.maxLogsBlocksPerRequest))
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] != '[' {
+		response := jh.execute(ctx, body)
+		if response == nil {
+			c.Status(http.StatusNoContent)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	var batch []json.RawMessage
+	err = json.Unmarshal(body, &batch)
+	if err != nil {
+		c.JSON(http.StatusOK, newErrorResponse(nil, newRPCError(codeParseError, "invalid JSON: %s", err.Error())))
+		return
+	}
+	if len(batch) == 0 {
+		c.JSON(http.StatusOK, newErrorResponse(nil, newRPCError(codeInvalidRequest, "empty batch")))
+		return
+	}
+	if len(batch) > jh.maxBatchSize {
+		c.JSON(http.StatusOK, newErrorResponse(nil, newRPCError(codeInvalidRequest, "batch too large, the limit is %d requests", jh.maxBatchSize)))
+		return
+	}
+
+	responses := make([]*rpcResponse, 0, len(batch))
+	for _, rawRequest := range batch {
+		response := jh.execute(ctx, rawRequest)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// execute executes a single request, returning nil for the notifications
+func (jh *jsonRPCHandler) execute(ctx context.Context, rawRequest json.RawMessage) *rpcResponse {
+	request := rpcRequest{}
+	err := json.Unmarshal(rawRequest, &request)
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		if len(rawRequest) == 0 || errors.As(err, &syntaxErr) {
+			return newErrorResponse(nil, newRPCError(codeParseError, "invalid JSON: %s", err.Error()))
+		}
+		return newErrorResponse(nil, newRPCError(codeInvalidRequest, "invalid request: %s", err.Error()))
+	}
+	if request.JSONRPC != jsonRPCVersion || len(request.Method) == 0 {
+		return newErrorResponse(request.ID, newRPCError(codeInvalidRequest, "invalid request: the jsonrpc version must be 2.0 and the method must be set"))
+	}
+
+	isNotification := len(request.ID) == 0
+	method, found := jh.methods[request.Method]
+	if !found {
+		if isNotification {
+			return nil
+		}
+		return newErrorResponse(request.ID, newRPCError(codeMethodNotFound, "the method %s does not exist/is not available", request.Method))
+	}
+
+	result, rpcErr := method(ctx, request.Params)
+	if isNotification {
+		return nil
+	}
+	if rpcErr != nil {
+		return newErrorResponse(request.ID, rpcErr)
+	}
+
+	resultBytes, err := json.Marshal(result)
+	if err != nil {
+		return newErrorResponse(request.ID, newRPCError(codeInternalError, "cannot encode the result: %s", err.Error()))
+	}
+
+	return &rpcResponse{
+		JSONRPC: jsonRPCVersion,
+		ID:      request.ID,
+		Result:  resultBytes,
+	}
+}
@@ -0,0 +1,621 @@
+package jsonrpc_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/jsonrpc"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+
+var testAddressConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *struct {
+		Code    int         `json:"code"`
+		Message string      `json:"message"`
+		Data    interface{} `json:"data"`
+	} `json:"error"`
+}
+
+func createTestJSONRPCConfig() config.JSONRPCConfig {
+	return config.JSONRPCConfig{
+		Enabled:                 true,
+		MaxBatchSize:            3,
+		MaxLogsBlockRange:       10,
+		MaxLogsBlocksPerRequest: 12,
+	}
+}
+
+func createFacade() *mock.FacadeStub {
+	return &mock.FacadeStub{
+		GetAddressConverterCalled: func() (core.PubkeyConverter, error) {
+			return testAddressConverter, nil
+		},
+	}
+}
+
+func sendRequest(t *testing.T, facade jsonrpc.FacadeHandler, body string) *httptest.ResponseRecorder {
+	handler, err := jsonrpc.NewJSONRPCHandler(facade, createTestJSONRPCConfig())
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.POST("/jsonrpc", handler.Handle)
+
+	req, _ := http.NewRequest(http.MethodPost, "/jsonrpc", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func call(t *testing.T, facade jsonrpc.FacadeHandler, method string, params ...interface{}) *rpcResponse {
+	body, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	resp := sendRequest(t, facade, string(body))
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	response := &rpcResponse{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), response))
+	require.Equal(t, "2.0", response.JSONRPC)
+	require.Equal(t, "1", string(response.ID))
+
+	return response
+}
+
+func requireResult(t *testing.T, response *rpcResponse, expected interface{}) {
+	require.Nil(t, response.Error)
+
+	expectedBytes, _ := json.Marshal(expected)
+	require.JSONEq(t, string(expectedBytes), string(response.Result))
+}
+
+func requireErrorCode(t *testing.T, response *rpcResponse, code int) {
+	require.NotNil(t, response.Error)
+	require.Equal(t, code, response.Error.Code)
+}
+
+func TestNewJSONRPCHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := jsonrpc.NewJSONRPCHandler(nil, createTestJSONRPCConfig())
+		require.Nil(t, handler)
+		require.Equal(t, jsonrpc.ErrNilFacade, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestJSONRPCConfig()
+		cfg.MaxBatchSize = 0
+		handler, err := jsonrpc.NewJSONRPCHandler(createFacade(), cfg)
+		require.Nil(t, handler)
+		require.True(t, errors.Is(err, jsonrpc.ErrInvalidJSONRPCConfig))
+
+		cfg = createTestJSONRPCConfig()
+		cfg.MaxLogsBlockRange = 0
+		handler, err = jsonrpc.NewJSONRPCHandler(createFacade(), cfg)
+		require.Nil(t, handler)
+		require.True(t, errors.Is(err, jsonrpc.ErrInvalidJSONRPCConfig))
+
+		cfg = createTestJSONRPCConfig()
+		cfg.MaxLogsBlocksPerRequest = 0
+		handler, err = jsonrpc.NewJSONRPCHandler(createFacade(), cfg)
+		require.Nil(t, handler)
+		require.True(t, errors.Is(err, jsonrpc.ErrInvalidJSONRPCConfig))
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := jsonrpc.NewJSONRPCHandler(&mock.FacadeStub{}, createTestJSONRPCConfig())
+		require.Nil(t, handler)
+		require.Equal(t, jsonrpc.ErrNilAddressConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := jsonrpc.NewJSONRPCHandler(createFacade(), createTestJSONRPCConfig())
+		require.NoError(t, err)
+		require.NotNil(t, handler)
+	})
+}
+
+func TestJSONRPCHandler_Protocol(t *testing.T) {
+	t.Parallel()
+
+	createNonceFacade := func() *mock.FacadeStub {
+		facade := createFacade()
+		facade.GetLatestHyperblockNonceCalled = func() (uint64, error) {
+			return 26, nil
+		}
+
+		return facade
+	}
+
+	t.Run("invalid JSON should return a parse error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRequest(t, createNonceFacade(), `{"jsonrpc": "2.0", "method": `)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		response := &rpcResponse{}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), response))
+		requireErrorCode(t, response, -32700)
+		assert.Equal(t, "null", string(response.ID))
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRequest(t, createNonceFacade(), `{"jsonrpc": "1.0", "id": 7, "method": "eth_blockNumber"}`)
+
+		response := &rpcResponse{}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), response))
+		requireErrorCode(t, response, -32600)
+		assert.Equal(t, "7", string(response.ID))
+	})
+	t.Run("unknown method should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := call(t, createNonceFacade(), "eth_chainId")
+		requireErrorCode(t, response, -32601)
+	})
+	t.Run("invalid params should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := call(t, createNonceFacade(), "eth_blockNumber", "unexpected")
+		requireErrorCode(t, response, -32602)
+	})
+	t.Run("notification should not have a response", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRequest(t, createNonceFacade(), `{"jsonrpc": "2.0", "method": "eth_blockNumber"}`)
+		require.Equal(t, http.StatusNoContent, resp.Code)
+		require.Empty(t, resp.Body.Bytes())
+	})
+	t.Run("batch should respond to each request", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRequest(t, createNonceFacade(), `[
+			{"jsonrpc": "2.0", "id": 1, "method": "eth_blockNumber"},
+			{"jsonrpc": "2.0", "method": "eth_blockNumber"},
+			{"jsonrpc": "2.0", "id": "second", "method": "eth_unknown"}
+		]`)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		var responses []*rpcResponse
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &responses))
+		require.Len(t, responses, 2)
+		assert.Equal(t, "1", string(responses[0].ID))
+		requireResult(t, responses[0], "0x1a")
+		assert.Equal(t, `"second"`, string(responses[1].ID))
+		requireErrorCode(t, responses[1], -32601)
+	})
+	t.Run("invalid batch element should have an invalid request response", func(t *testing.T) {
+		t.Parallel()
+
+		resp := sendRequest(t, createNonceFacade(), `[1, {"jsonrpc": "2.0", "id": 2, "method": "eth_blockNumber"}]`)
+
+		var responses []*rpcResponse
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &responses))
+		require.Len(t, responses, 2)
+		requireErrorCode(t, responses[0], -32600)
+		requireResult(t, responses[1], "0x1a")
+	})
+	t.Run("empty or too large batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, body := range []string{
+			`[]`,
+			`[{"jsonrpc": "2.0", "id": 1, "method": "eth_blockNumber"},
+			  {"jsonrpc": "2.0", "id": 2, "method": "eth_blockNumber"},
+			  {"jsonrpc": "2.0", "id": 3, "method": "eth_blockNumber"},
+			  {"jsonrpc": "2.0", "id": 4, "method": "eth_blockNumber"}]`,
+		} {
+			resp := sendRequest(t, createNonceFacade(), body)
+
+			response := &rpcResponse{}
+			require.NoError(t, json.Unmarshal(resp.Body.Bytes(), response))
+			requireErrorCode(t, response, -32600)
+		}
+	})
+}
+
+func TestJSONRPCHandler_Accounts(t *testing.T) {
+	t.Parallel()
+
+	publicKey, _ := testAddressConverter.Decode(testAddress)
+
+	createAccountFacade := func(providedOptions *common.AccountQueryOptions) *mock.FacadeStub {
+		facade := createFacade()
+		facade.GetAccountHandler = func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+			if address != testAddress {
+				return nil, errors.New("unexpected address")
+			}
+			if providedOptions != nil {
+				*providedOptions = options
+			}
+
+			return &data.AccountModel{
+				Account: data.Account{
+					Address: address,
+					Nonce:   37,
+					Balance: "1000000000000000000",
+				},
+			}, nil
+		}
+
+		return facade
+	}
+
+	t.Run("getBalance should work", func(t *testing.T) {
+		t.Parallel()
+
+		response := call(t, createAccountFacade(nil), "eth_getBalance", testAddress, "latest")
+		requireResult(t, response, "0xde0b6b3a7640000")
+	})
+	t.Run("getTransactionCount of a hex address should work", func(t *testing.T) {
+		t.Parallel()
+
+		options := common.AccountQueryOptions{}
+		response := call(t, createAccountFacade(&options), "eth_getTransactionCount", "0x"+hex.EncodeToString(publicKey), "finalized")
+		requireResult(t, response, "0x25")
+		assert.True(t, options.OnFinalBlock)
+	})
+	t.Run("block number should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := call(t, createAccountFacade(nil), "eth_getBalance", testAddress, "0x10")
+		requireErrorCode(t, response, -32602)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := call(t, createAccountFacade(nil), "eth_getBalance", "0x0102", "latest")
+		requireErrorCode(t, response, -32602)
+	})
+	t.Run("facade error should be a server error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade()
+		facade.GetAccountHandler = func(_ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return nil, errors.New("observers offline")
+		}
+
+		response := call(t, facade, "eth_getBalance", testAddress)
+		requireErrorCode(t, response, -32000)
+		assert.Equal(t, "observers offline", response.Error.Message)
+	})
+}
+
+func TestJSONRPCHandler_Transactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sendRawTransaction should work", func(t *testing.T) {
+		t.Parallel()
+
+		var sentTx *data.Transaction
+		facade := createFacade()
+		facade.SendTransactionHandler = func(tx *data.Transaction) (int, string, error) {
+			sentTx = tx
+			return http.StatusOK, "aabb", nil
+		}
+
+		tx := &data.Transaction{Nonce: 5, Value: "10", Sender: testAddress, Receiver: testAddress, ChainID: "T", Version: 1}
+		txBytes, _ := json.Marshal(tx)
+		response := call(t, facade, "eth_sendRawTransaction", "0x"+hex.EncodeToString(txBytes))
+		requireResult(t, response, "0xaabb")
+		assert.Equal(t, tx, sentTx)
+	})
+	t.Run("sendRawTransaction of invalid data should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := call(t, createFacade(), "eth_sendRawTransaction", "0x"+hex.EncodeToString([]byte("not a transaction")))
+		requireErrorCode(t, response, -32602)
+	})
+	t.Run("getTransactionReceipt should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade()
+		facade.GetTransactionHandler = func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			assert.Equal(t, "aabb", txHash)
+			assert.True(t, withResults)
+
+			return &transaction.ApiTransactionResult{
+				Hash:            "aabb",
+				Sender:          testAddress,
+				Receiver:        testAddress,
+				Status:          transaction.TxStatusFail,
+				GasUsed:         50000,
+				GasPrice:        1000000000,
+				HyperblockNonce: 26,
+				HyperblockHash:  "ccdd",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{{Address: testAddress, Identifier: "signalError", Topics: [][]byte{[]byte("t")}, Data: []byte("d")}},
+				},
+				SmartContractResults: []*transaction.ApiSmartContractResult{
+					{Logs: &transaction.ApiLogs{Events: []*transaction.Events{{Address: testAddress, Identifier: "completedTxEvent"}}}},
+				},
+			}, nil
+		}
+
+		response := call(t, facade, "eth_getTransactionReceipt", "0xaabb")
+		requireResult(t, response, map[string]interface{}{
+			"transactionHash":   "0xaabb",
+			"blockHash":         "0xccdd",
+			"blockNumber":       "0x1a",
+			"from":              testAddress,
+			"to":                testAddress,
+			"status":            "0x0",
+			"gasUsed":           "0xc350",
+			"effectiveGasPrice": "0x3b9aca00",
+			"logs": []interface{}{
+				map[string]interface{}{
+					"address":         testAddress,
+					"topics":          []string{"0x" + hex.EncodeToString([]byte("signalError")), "0x74"},
+					"data":            "0x64",
+					"blockNumber":     "0x1a",
+					"blockHash":       "0xccdd",
+					"transactionHash": "0xaabb",
+					"logIndex":        "0x0",
+					"removed":         false,
+				},
+				map[string]interface{}{
+					"address":         testAddress,
+					"topics":          []string{"0x" + hex.EncodeToString([]byte("completedTxEvent"))},
+					"data":            "0x",
+					"blockNumber":     "0x1a",
+					"blockHash":       "0xccdd",
+					"transactionHash": "0xaabb",
+					"logIndex":        "0x1",
+					"removed":         false,
+				},
+			},
+		})
+	})
+	t.Run("getTransactionReceipt of an unknown or pending transaction should be null", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade()
+		facade.GetTransactionHandler = func(txHash string, _ bool) (*transaction.ApiTransactionResult, error) {
+			if txHash == "aa" {
+				return nil, apiErrors.ErrTransactionNotFound
+			}
+
+			return &transaction.ApiTransactionResult{Hash: txHash, Status: transaction.TxStatusPending}, nil
+		}
+
+		requireResult(t, call(t, facade, "eth_getTransactionReceipt", "0xaa"), nil)
+		requireResult(t, call(t, facade, "eth_getTransactionReceipt", "0xbb"), nil)
+	})
+}
+
+func TestJSONRPCHandler_Call(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should execute the query", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade()
+		facade.ExecuteSCQueryHandler = func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			assert.Equal(t, &data.SCQuery{
+				ScAddress:  testAddress,
+				FuncName:   "getSum",
+				CallerAddr: testAddress,
+				CallValue:  "16",
+				Arguments:  [][]byte{{0x01}, {0x02, 0x03}},
+			}, query)
+
+			return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{{0x06}, {}}}, data.BlockInfo{}, nil
+		}
+
+		callObj := map[string]string{
+			"from":  testAddress,
+			"to":    testAddress,
+			"value": "0x10",
+			"data":  "0x" + hex.EncodeToString([]byte("getSum@01@0203")),
+		}
+		response := call(t, facade, "eth_call", callObj, "latest")
+		requireResult(t, response, "0x"+hex.EncodeToString([]byte("06@")))
+	})
+	t.Run("failed execution should be reverted", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade()
+		facade.ExecuteSCQueryHandler = func(_ *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			return &vm.VMOutputApi{ReturnCode: "user error", ReturnMessage: "not allowed"}, data.BlockInfo{}, nil
+		}
+
+		callObj := map[string]string{
+			"to":    testAddress,
+			"input": "0x" + hex.EncodeToString([]byte("withdraw")),
+		}
+		response := call(t, facade, "eth_call", callObj)
+		requireErrorCode(t, response, 3)
+		assert.Equal(t, "execution reverted: not allowed", response.Error.Message)
+		assert.Equal(t, "user error", response.Error.Data)
+	})
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		callObj := map[string]string{
+			"to":   testAddress,
+			"data": "0x" + hex.EncodeToString([]byte("getSum@zz")),
+		}
+		response := call(t, createFacade(), "eth_call", callObj)
+		requireErrorCode(t, response, -32602)
+
+		callObj["data"] = "0x" + hex.EncodeToString([]byte("getSum"))
+		response = call(t, createFacade(), "eth_call", callObj, "0x05")
+		requireErrorCode(t, response, -32602)
+	})
+}
+
+func TestJSONRPCHandler_GetLogs(t *testing.T) {
+	t.Parallel()
+
+	otherAddress := "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	createHyperblock := func(nonce uint64) *data.HyperblockApiResponse {
+		return data.NewHyperblockApiResponse(api.Hyperblock{
+			Nonce: nonce,
+			Hash:  "ee",
+			Transactions: []*transaction.ApiTransactionResult{
+				{
+					Hash: "aa",
+					Logs: &transaction.ApiLogs{Events: []*transaction.Events{
+						{Address: testAddress, Identifier: "transfer", Topics: [][]byte{{0x01}}},
+						{Address: otherAddress, Identifier: "transfer", Topics: [][]byte{{0x02}}},
+					}},
+				},
+				{
+					Hash: "bb",
+					Logs: &transaction.ApiLogs{Events: []*transaction.Events{
+						{Address: testAddress, Identifier: "mint", Topics: [][]byte{{0x01}}},
+					}},
+				},
+			},
+		})
+	}
+	createLogsFacade := func(requestedNonces *[]uint64) *mock.FacadeStub {
+		facade := createFacade()
+		facade.GetLatestHyperblockNonceCalled = func() (uint64, error) {
+			return 20, nil
+		}
+		facade.GetHyperBlockByNonceCalled = func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			assert.True(t, options.WithLogs)
+			if requestedNonces != nil {
+				*requestedNonces = append(*requestedNonces, nonce)
+			}
+
+			return createHyperblock(nonce), nil
+		}
+		facade.GetHyperBlockByHashCalled = func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			assert.Equal(t, "ee", hash)
+			assert.True(t, options.WithLogs)
+
+			return createHyperblock(20), nil
+		}
+
+		return facade
+	}
+	transferTopic := "0x" + hex.EncodeToString([]byte("transfer"))
+
+	t.Run("should filter by address and topics", func(t *testing.T) {
+		t.Parallel()
+
+		var requestedNonces []uint64
+		filter := map[string]interface{}{
+			"fromBlock": "0x12",
+			"toBlock":   "0x14",
+			"address":   []string{testAddress},
+			"topics":    []interface{}{nil, []string{"0x01", "0x02"}},
+		}
+		response := call(t, createLogsFacade(&requestedNonces), "eth_getLogs", filter)
+		require.Nil(t, response.Error)
+		assert.Equal(t, []uint64{18, 19, 20}, requestedNonces)
+
+		var logs []map[string]interface{}
+		require.NoError(t, json.Unmarshal(response.Result, &logs))
+		require.Len(t, logs, 6)
+		assert.Equal(t, "0x12", logs[0]["blockNumber"])
+		assert.Equal(t, "0x0", logs[0]["logIndex"])
+		assert.Equal(t, "0x0", logs[0]["transactionIndex"])
+		assert.Equal(t, "0x2", logs[1]["logIndex"])
+		assert.Equal(t, "0x1", logs[1]["transactionIndex"])
+		assert.Equal(t, "0xbb", logs[1]["transactionHash"])
+	})
+	t.Run("should filter the block with the hash", func(t *testing.T) {
+		t.Parallel()
+
+		filter := map[string]interface{}{
+			"blockHash": "0xee",
+			"topics":    []interface{}{transferTopic},
+		}
+		response := call(t, createLogsFacade(nil), "eth_getLogs", filter)
+
+		var logs []map[string]interface{}
+		require.NoError(t, json.Unmarshal(response.Result, &logs))
+		require.Len(t, logs, 2)
+		assert.Equal(t, testAddress, logs[0]["address"])
+		assert.Equal(t, otherAddress, logs[1]["address"])
+		assert.Equal(t, "0x1", logs[1]["logIndex"])
+	})
+	t.Run("no filter should return the logs of the latest block", func(t *testing.T) {
+		t.Parallel()
+
+		var requestedNonces []uint64
+		response := call(t, createLogsFacade(&requestedNonces), "eth_getLogs", map[string]interface{}{})
+
+		var logs []map[string]interface{}
+		require.NoError(t, json.Unmarshal(response.Result, &logs))
+		assert.Len(t, logs, 3)
+		assert.Equal(t, []uint64{20}, requestedNonces)
+	})
+	t.Run("range exceeding the limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := call(t, createLogsFacade(nil), "eth_getLogs", map[string]interface{}{"fromBlock": "earliest"})
+		requireErrorCode(t, response, -32005)
+	})
+	t.Run("batch exceeding the blocks budget should error", func(t *testing.T) {
+		t.Parallel()
+
+		var requestedNonces []uint64
+		resp := sendRequest(t, createLogsFacade(&requestedNonces), `[
+			{"jsonrpc": "2.0", "id": 1, "method": "eth_getLogs", "params": [{"fromBlock": "0xb", "toBlock": "0x14"}]},
+			{"jsonrpc": "2.0", "id": 2, "method": "eth_getLogs", "params": [{"fromBlock": "0x12", "toBlock": "0x14"}]},
+			{"jsonrpc": "2.0", "id": 3, "method": "eth_getLogs", "params": [{"blockHash": "0xee"}]}
+		]`)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		var responses []*rpcResponse
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &responses))
+		require.Len(t, responses, 3)
+		require.Nil(t, responses[0].Error)
+		// the second call would scan 3 more blocks, exceeding the budget of 12, while the third one still fits
+		requireErrorCode(t, responses[1], -32005)
+		require.Nil(t, responses[2].Error)
+		assert.Len(t, requestedNonces, 10)
+	})
+	t.Run("invalid filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, filter := range []map[string]interface{}{
+			{"fromBlock": "0x10", "toBlock": "0x05"},
+			{"fromBlock": "0x10", "blockHash": "0xee"},
+			{"fromBlock": "16"},
+			{"address": 5},
+			{"topics": []interface{}{5}},
+		} {
+			response := call(t, createLogsFacade(nil), "eth_getLogs", filter)
+			requireErrorCode(t, response, -32602)
+		}
+	})
+}
@@ -0,0 +1,308 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+// rpcLog is a log event. Its first topic is the hex encoded identifier of the event, followed by the topics of the
+// event. The block fields refer to the hyperblock notarizing the transaction
+type rpcLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex,omitempty"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+// logFilter is the param of eth_getLogs. The address is either an address or a list of addresses, while each topic
+// is either null, matching any topic, a topic or a list of alternative topics
+type logFilter struct {
+	FromBlock string            `json:"fromBlock"`
+	ToBlock   string            `json:"toBlock"`
+	BlockHash string            `json:"blockHash"`
+	Address   json.RawMessage   `json:"address"`
+	Topics    []json.RawMessage `json:"topics"`
+}
+
+// logMatcher selects the logs matching a filter. An empty set of addresses or of alternative topics matches anything
+type logMatcher struct {
+	addresses map[string]struct{}
+	topics    [][]string
+}
+
+type logsBudgetKey struct{}
+
+// logsBudget holds the number of hyperblocks that the eth_getLogs calls of an HTTP request can still scan. The calls
+// of a batch are executed in order, so the budget is not concurrently accessed
+type logsBudget struct {
+	remainingBlocks uint64
+}
+
+func newLogsBudget(maxBlocks uint64) *logsBudget {
+	return &logsBudget{
+		remainingBlocks: maxBlocks,
+	}
+}
+
+func withLogsBudget(ctx context.Context, budget *logsBudget) context.Context {
+	return context.WithValue(ctx, logsBudgetKey{}, budget)
+}
+
+// spendLogsBudget consumes the hyperblocks from the budget of the request, if there are enough of them left
+func spendLogsBudget(ctx context.Context, numBlocks uint64) bool {
+	budget, ok := ctx.Value(logsBudgetKey{}).(*logsBudget)
+	if !ok {
+		return true
+	}
+	if numBlocks > budget.remainingBlocks {
+		return false
+	}
+
+	budget.remainingBlocks -= numBlocks
+
+	return true
+}
+
+// getLogs handles eth_getLogs: [filter]. The logs are read from the hyperblocks in the range of the filter, which
+// cannot span more hyperblocks than configured, or from the hyperblock with the hash of the filter. The scanned
+// hyperblocks are taken from the budget of the HTTP request
+func (jh *jsonRPCHandler) getLogs(ctx context.Context, params json.RawMessage) (interface{}, *rpcError) {
+	filter := logFilter{}
+	rpcErr := parseParams(params, 1, &filter)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	matcher, rpcErr := jh.newLogMatcher(filter)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	logs := make([]*rpcLog, 0)
+	if len(filter.BlockHash) > 0 {
+		if len(filter.FromBlock) > 0 || len(filter.ToBlock) > 0 {
+			return nil, invalidParams("blockHash cannot be used along with fromBlock or toBlock")
+		}
+
+		hash, ok := decodeHash(filter.BlockHash)
+		if !ok {
+			return nil, invalidParams("invalid block hash %q", filter.BlockHash)
+		}
+		if !spendLogsBudget(ctx, 1) {
+			return nil, jh.newLogsBudgetExceededError()
+		}
+		response, err := jh.facade.GetHyperBlockByHash(ctx, hash, common.HyperblockQueryOptions{WithLogs: true})
+		if err != nil {
+			return nil, serverError(err)
+		}
+		if response == nil {
+			return nil, newRPCError(codeInternalError, "nil hyperblock")
+		}
+
+		return matcher.filter(logs, &response.Data.Hyperblock), nil
+	}
+
+	latestNonce, err := jh.facade.GetLatestFullySynchronizedHyperblockNonce(ctx)
+	if err != nil {
+		return nil, serverError(err)
+	}
+	fromNonce, rpcErr := resolveBlockNumber(filter.FromBlock, latestNonce)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	toNonce, rpcErr := resolveBlockNumber(filter.ToBlock, latestNonce)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if fromNonce > toNonce {
+		return nil, invalidParams("fromBlock must not be greater than toBlock")
+	}
+	if toNonce-fromNonce >= jh.maxLogsBlockRange {
+		return nil, newRPCError(codeLimitExceeded, "the range of the filter exceeds %d blocks", jh.maxLogsBlockRange)
+	}
+
+	// the hyperblocks following the latest fully synchronized one are not available yet
+	if toNonce > latestNonce {
+		toNonce = latestNonce
+	}
+	if fromNonce <= toNonce && !spendLogsBudget(ctx, toNonce-fromNonce+1) {
+		return nil, jh.newLogsBudgetExceededError()
+	}
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		response, errGet := jh.facade.GetHyperBlockByNonce(ctx, nonce, common.HyperblockQueryOptions{WithLogs: true})
+		if errGet != nil {
+			return nil, serverError(errGet)
+		}
+		if response == nil {
+			return nil, newRPCError(codeInternalError, "nil hyperblock")
+		}
+
+		logs = matcher.filter(logs, &response.Data.Hyperblock)
+	}
+
+	return logs, nil
+}
+
+func (jh *jsonRPCHandler) newLogsBudgetExceededError() *rpcError {
+	return newRPCError(codeLimitExceeded, "the eth_getLogs calls of the request exceed %d blocks", jh.maxLogsBlocksPerRequest)
+}
+
+// resolveBlockNumber returns the hyperblock nonce of a block number or tag. All the tags except earliest stand for
+// the latest fully synchronized hyperblock, which is final
+func resolveBlockNumber(blockNumber string, latestNonce uint64) (uint64, *rpcError) {
+	switch blockNumber {
+	case "", blockTagLatest, blockTagPending, blockTagSafe, blockTagFinalized:
+		return latestNonce, nil
+	case blockTagEarliest:
+		return 0, nil
+	}
+
+	nonce, ok := decodeQuantity(blockNumber)
+	if !ok {
+		return 0, invalidParams("invalid block number %q", blockNumber)
+	}
+
+	return nonce, nil
+}
+
+func (jh *jsonRPCHandler) newLogMatcher(filter logFilter) (*logMatcher, *rpcError) {
+	addresses, err := decodeOneOrMany(filter.Address)
+	if err != nil {
+		return nil, invalidParams("the address must be an address or a list of addresses")
+	}
+
+	matcher := &logMatcher{
+		addresses: make(map[string]struct{}, len(addresses)),
+		topics:    make([][]string, 0, len(filter.Topics)),
+	}
+	for _, address := range addresses {
+		bech32Address, ok := decodeAddress(jh.addressConverter, address)
+		if !ok {
+			return nil, invalidParams("invalid address %q", address)
+		}
+
+		matcher.addresses[bech32Address] = struct{}{}
+	}
+	for idx, topic := range filter.Topics {
+		alternatives, errDecode := decodeOneOrMany(topic)
+		if errDecode != nil {
+			return nil, invalidParams("the topic at index %d must be null, a topic or a list of topics", idx)
+		}
+		for altIdx := range alternatives {
+			alternatives[altIdx] = strings.ToLower(alternatives[altIdx])
+		}
+
+		matcher.topics = append(matcher.topics, alternatives)
+	}
+
+	return matcher, nil
+}
+
+// decodeOneOrMany decodes a value which is either null, a string or a list of strings
+func decodeOneOrMany(value json.RawMessage) ([]string, error) {
+	if len(value) == 0 || string(value) == "null" {
+		return nil, nil
+	}
+
+	var single string
+	err := json.Unmarshal(value, &single)
+	if err == nil {
+		return []string{single}, nil
+	}
+
+	var many []string
+	err = json.Unmarshal(value, &many)
+
+	return many, err
+}
+
+// filter appends to the logs the ones of the hyperblock matching the filter. The log indexes are the positions of the
+// logs among all the logs of the hyperblock
+func (lm *logMatcher) filter(logs []*rpcLog, hyperblock *api.Hyperblock) []*rpcLog {
+	blockLogs := make([]*rpcLog, 0)
+	for txIndex, tx := range hyperblock.Transactions {
+		if tx != nil {
+			blockLogs = appendLogs(blockLogs, tx.Logs, tx.Hash, hyperblock.Nonce, hyperblock.Hash, encodeQuantity(uint64(txIndex)))
+		}
+	}
+
+	for _, blockLog := range blockLogs {
+		if lm.matches(blockLog) {
+			logs = append(logs, blockLog)
+		}
+	}
+
+	return logs
+}
+
+func (lm *logMatcher) matches(blockLog *rpcLog) bool {
+	if len(lm.addresses) > 0 {
+		_, found := lm.addresses[blockLog.Address]
+		if !found {
+			return false
+		}
+	}
+
+	for idx, alternatives := range lm.topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if idx >= len(blockLog.Topics) || !containsString(alternatives, blockLog.Topics[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendLogs converts the log events of a transaction, their log indexes being their positions in the logs list
+func appendLogs(logs []*rpcLog, apiLogs *transaction.ApiLogs, txHash string, blockNonce uint64, blockHash string, txIndex string) []*rpcLog {
+	if apiLogs == nil {
+		return logs
+	}
+
+	for _, event := range apiLogs.Events {
+		if event == nil {
+			continue
+		}
+
+		topics := make([]string, 0, len(event.Topics)+1)
+		topics = append(topics, encodeData([]byte(event.Identifier)))
+		for _, topic := range event.Topics {
+			topics = append(topics, encodeData(topic))
+		}
+
+		logs = append(logs, &rpcLog{
+			Address:          event.Address,
+			Topics:           topics,
+			Data:             encodeData(event.Data),
+			BlockNumber:      encodeQuantity(blockNonce),
+			BlockHash:        encodeHash(blockHash),
+			TransactionHash:  encodeHash(txHash),
+			TransactionIndex: txIndex,
+			LogIndex:         encodeQuantity(uint64(len(logs))),
+		})
+	}
+
+	return logs
+}
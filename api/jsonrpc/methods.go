@@ -0,0 +1,305 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	vmReturnCodeOK      = "ok"
+	callDataSeparator   = "@"
+	txStatusSuccessCode = "0x1"
+	txStatusFailureCode = "0x0"
+)
+
+// callObject is the first param of eth_call. Its data holds the function and the hex encoded arguments in the format
+// of the data field of the MultiversX transactions, "function@arg1@arg2", itself hex encoded
+type callObject struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+	Input string `json:"input"`
+}
+
+// rpcReceipt is the receipt of an executed transaction. The block fields refer to the hyperblock notarizing it
+type rpcReceipt struct {
+	TransactionHash   string    `json:"transactionHash"`
+	BlockHash         string    `json:"blockHash"`
+	BlockNumber       string    `json:"blockNumber"`
+	From              string    `json:"from"`
+	To                string    `json:"to"`
+	Status            string    `json:"status"`
+	GasUsed           string    `json:"gasUsed"`
+	EffectiveGasPrice string    `json:"effectiveGasPrice"`
+	Logs              []*rpcLog `json:"logs"`
+}
+
+// getBalance handles eth_getBalance: [address, blockTag]
+func (jh *jsonRPCHandler) getBalance(ctx context.Context, params json.RawMessage) (interface{}, *rpcError) {
+	account, rpcErr := jh.getAccount(ctx, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	balance, ok := encodeBigQuantity(account.Balance)
+	if !ok {
+		return nil, newRPCError(codeInternalError, "invalid balance %q", account.Balance)
+	}
+
+	return balance, nil
+}
+
+// getTransactionCount handles eth_getTransactionCount: [address, blockTag]. The count is the nonce of the account
+func (jh *jsonRPCHandler) getTransactionCount(ctx context.Context, params json.RawMessage) (interface{}, *rpcError) {
+	account, rpcErr := jh.getAccount(ctx, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	return encodeQuantity(account.Nonce), nil
+}
+
+func (jh *jsonRPCHandler) getAccount(ctx context.Context, params json.RawMessage) (*data.Account, *rpcError) {
+	var address, blockTag string
+	rpcErr := parseParams(params, 1, &address, &blockTag)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	bech32Address, ok := decodeAddress(jh.addressConverter, address)
+	if !ok {
+		return nil, invalidParams("invalid address %q", address)
+	}
+	options, rpcErr := accountQueryOptions(blockTag)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	accountModel, err := jh.facade.GetAccount(ctx, bech32Address, options)
+	if err != nil {
+		return nil, serverError(err)
+	}
+	if accountModel == nil {
+		return nil, newRPCError(codeInternalError, "nil account")
+	}
+
+	return &accountModel.Account, nil
+}
+
+// accountQueryOptions maps the block tag onto the account query options. The accounts can only be queried in their
+// latest or final state, since a block number is a hyperblock nonce while the account state is kept per shard block
+func accountQueryOptions(blockTag string) (common.AccountQueryOptions, *rpcError) {
+	switch blockTag {
+	case "", blockTagLatest, blockTagPending:
+		return common.AccountQueryOptions{}, nil
+	case blockTagSafe, blockTagFinalized:
+		return common.AccountQueryOptions{OnFinalBlock: true}, nil
+	default:
+		return common.AccountQueryOptions{}, invalidParams("unsupported block %q, only the latest, pending, safe and finalized tags are supported", blockTag)
+	}
+}
+
+// sendRawTransaction handles eth_sendRawTransaction: [data]. The data is the hex encoded JSON of the transaction, as
+// sent to /transaction/send. The hash of the transaction is returned
+func (jh *jsonRPCHandler) sendRawTransaction(ctx context.Context, params json.RawMessage) (interface{}, *rpcError) {
+	var rawTx string
+	rpcErr := parseParams(params, 1, &rawTx)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	txBytes, ok := decodeData(rawTx)
+	if !ok {
+		return nil, invalidParams("the transaction must be hex encoded")
+	}
+	tx := &data.Transaction{}
+	err := json.Unmarshal(txBytes, tx)
+	if err != nil {
+		return nil, invalidParams("the transaction must be the JSON of a MultiversX transaction: %s", err.Error())
+	}
+
+	_, txHash, err := jh.facade.SendTransaction(ctx, tx)
+	if err != nil {
+		return nil, serverError(err)
+	}
+
+	return encodeHash(txHash), nil
+}
+
+// getTransactionReceipt handles eth_getTransactionReceipt: [hash]. The receipt is null for the unknown transactions
+// and for the ones not executed yet
+func (jh *jsonRPCHandler) getTransactionReceipt(ctx context.Context, params json.RawMessage) (interface{}, *rpcError) {
+	var hash string
+	rpcErr := parseParams(params, 1, &hash)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	txHash, ok := decodeHash(hash)
+	if !ok {
+		return nil, invalidParams("invalid transaction hash %q", hash)
+	}
+
+	tx, err := jh.facade.GetTransaction(ctx, txHash, true)
+	if errors.Is(err, apiErrors.ErrTransactionNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, serverError(err)
+	}
+	if tx == nil {
+		return nil, nil
+	}
+
+	status, isExecuted := receiptStatus(tx)
+	if !isExecuted {
+		return nil, nil
+	}
+
+	logs := make([]*rpcLog, 0)
+	logs = appendLogs(logs, tx.Logs, tx.Hash, tx.HyperblockNonce, tx.HyperblockHash, "")
+	for _, scr := range tx.SmartContractResults {
+		if scr != nil {
+			logs = appendLogs(logs, scr.Logs, tx.Hash, tx.HyperblockNonce, tx.HyperblockHash, "")
+		}
+	}
+
+	return &rpcReceipt{
+		TransactionHash:   encodeHash(tx.Hash),
+		BlockHash:         encodeHash(tx.HyperblockHash),
+		BlockNumber:       encodeQuantity(tx.HyperblockNonce),
+		From:              tx.Sender,
+		To:                tx.Receiver,
+		Status:            status,
+		GasUsed:           encodeQuantity(tx.GasUsed),
+		EffectiveGasPrice: encodeQuantity(tx.GasPrice),
+		Logs:              logs,
+	}, nil
+}
+
+// receiptStatus returns the status of the receipt, 0x1 for the successful transactions and 0x0 for the failed ones,
+// along with whether the transaction was executed
+func receiptStatus(tx *transaction.ApiTransactionResult) (string, bool) {
+	switch tx.Status {
+	case transaction.TxStatusSuccess:
+		return txStatusSuccessCode, true
+	case transaction.TxStatusFail, transaction.TxStatusInvalid, transaction.TxStatusRewardReverted:
+		return txStatusFailureCode, true
+	default:
+		return "", false
+	}
+}
+
+// call handles eth_call: [callObject, blockTag]. The result holds the hex encoded return data, joined by @ the same
+// way as the call data, the whole being hex encoded. A return code other than ok is reported as a reverted execution
+func (jh *jsonRPCHandler) call(ctx context.Context, params json.RawMessage) (interface{}, *rpcError) {
+	callObj := callObject{}
+	var blockTag string
+	rpcErr := parseParams(params, 1, &callObj, &blockTag)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if blockTag != "" && blockTag != blockTagLatest && blockTag != blockTagPending {
+		return nil, invalidParams("unsupported block %q, only the latest and pending tags are supported", blockTag)
+	}
+
+	query, rpcErr := jh.createSCQuery(callObj)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	vmOutput, _, err := jh.facade.ExecuteSCQuery(ctx, query)
+	if err != nil {
+		return nil, serverError(err)
+	}
+	if vmOutput == nil {
+		return nil, newRPCError(codeInternalError, "nil vm output")
+	}
+	if vmOutput.ReturnCode != vmReturnCodeOK {
+		return nil, &rpcError{
+			Code:    codeExecutionReverted,
+			Message: "execution reverted: " + vmOutput.ReturnMessage,
+			Data:    vmOutput.ReturnCode,
+		}
+	}
+
+	returnData := make([]string, 0, len(vmOutput.ReturnData))
+	for _, item := range vmOutput.ReturnData {
+		returnData = append(returnData, hex.EncodeToString(item))
+	}
+
+	return encodeData([]byte(strings.Join(returnData, callDataSeparator))), nil
+}
+
+func (jh *jsonRPCHandler) createSCQuery(callObj callObject) (*data.SCQuery, *rpcError) {
+	scAddress, ok := decodeAddress(jh.addressConverter, callObj.To)
+	if !ok {
+		return nil, invalidParams("invalid to address %q", callObj.To)
+	}
+
+	callDataHex := callObj.Data
+	if len(callDataHex) == 0 {
+		callDataHex = callObj.Input
+	}
+	callData, ok := decodeData(callDataHex)
+	if !ok {
+		return nil, invalidParams("the call data must be hex encoded")
+	}
+	callParts := strings.Split(string(callData), callDataSeparator)
+	if len(callParts[0]) == 0 {
+		return nil, invalidParams("the call data must start with the function name")
+	}
+
+	query := &data.SCQuery{
+		ScAddress: scAddress,
+		FuncName:  callParts[0],
+		Arguments: make([][]byte, 0, len(callParts)-1),
+	}
+	for _, arg := range callParts[1:] {
+		argBytes, err := hex.DecodeString(arg)
+		if err != nil {
+			return nil, invalidParams("'%s' is not a valid hex argument", arg)
+		}
+
+		query.Arguments = append(query.Arguments, argBytes)
+	}
+
+	if len(callObj.From) > 0 {
+		query.CallerAddr, ok = decodeAddress(jh.addressConverter, callObj.From)
+		if !ok {
+			return nil, invalidParams("invalid from address %q", callObj.From)
+		}
+	}
+	if len(callObj.Value) > 0 {
+		query.CallValue, ok = decodeBigQuantity(callObj.Value)
+		if !ok {
+			return nil, invalidParams("invalid value %q", callObj.Value)
+		}
+	}
+
+	return query, nil
+}
+
+// blockNumber handles eth_blockNumber: []. The block number is the nonce of the latest fully synchronized hyperblock
+func (jh *jsonRPCHandler) blockNumber(ctx context.Context, params json.RawMessage) (interface{}, *rpcError) {
+	rpcErr := parseParams(params, 0)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	nonce, err := jh.facade.GetLatestFullySynchronizedHyperblockNonce(ctx)
+	if err != nil {
+		return nil, serverError(err)
+	}
+
+	return encodeQuantity(nonce), nil
+}
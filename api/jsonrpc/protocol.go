@@ -0,0 +1,97 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const jsonRPCVersion = "2.0"
+
+// the error codes of the JSON-RPC 2.0 specification, along with the ones used by the Ethereum nodes
+const (
+	codeParseError        = -32700
+	codeInvalidRequest    = -32600
+	codeMethodNotFound    = -32601
+	codeInvalidParams     = -32602
+	codeInternalError     = -32603
+	codeServerError       = -32000
+	codeLimitExceeded     = -32005
+	codeExecutionReverted = 3
+)
+
+var nullID = json.RawMessage("null")
+
+// rpcRequest is a JSON-RPC request. A request without an id is a notification, which gets no response
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// rpcResponse is a JSON-RPC response, holding either the result or the error of the request
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is the error of a JSON-RPC request
+type rpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func newRPCError(code int, format string, args ...interface{}) *rpcError {
+	return &rpcError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func invalidParams(format string, args ...interface{}) *rpcError {
+	return newRPCError(codeInvalidParams, format, args...)
+}
+
+// serverError wraps the errors of the facade, which fetches the data from the observers
+func serverError(err error) *rpcError {
+	return newRPCError(codeServerError, err.Error())
+}
+
+func newErrorResponse(id json.RawMessage, err *rpcError) *rpcResponse {
+	if len(id) == 0 {
+		id = nullID
+	}
+
+	return &rpcResponse{
+		JSONRPC: jsonRPCVersion,
+		ID:      id,
+		Error:   err,
+	}
+}
+
+// parseParams reads the positional parameters of a request into the targets, the ones after the first
+// numMandatory being optional
+func parseParams(params json.RawMessage, numMandatory int, targets ...interface{}) *rpcError {
+	var values []json.RawMessage
+	if len(params) > 0 && string(params) != "null" {
+		err := json.Unmarshal(params, &values)
+		if err != nil {
+			return invalidParams("the params must be an array")
+		}
+	}
+	if len(values) < numMandatory || len(values) > len(targets) {
+		return invalidParams("expected between %d and %d params, got %d", numMandatory, len(targets), len(values))
+	}
+
+	for idx, value := range values {
+		err := json.Unmarshal(value, targets[idx])
+		if err != nil {
+			return invalidParams("invalid param at index %d: %s", idx, err.Error())
+		}
+	}
+
+	return nil
+}
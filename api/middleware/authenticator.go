@@ -0,0 +1,279 @@
+package middleware
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// AuthenticatedConsumerKey is the gin context key that holds the name of the authenticated consumer
+const AuthenticatedConsumerKey = "authenticatedConsumer"
+
+const (
+	bearerPrefix = "Bearer "
+	allScopes    = "*"
+)
+
+type authenticatedConsumer struct {
+	name   string
+	scopes map[string]struct{}
+}
+
+func newAuthenticatedConsumer(name string, scopes []string) *authenticatedConsumer {
+	consumer := &authenticatedConsumer{
+		name:   name,
+		scopes: make(map[string]struct{}),
+	}
+	for _, scope := range scopes {
+		consumer.scopes[scope] = struct{}{}
+	}
+
+	return consumer
+}
+
+func (consumer *authenticatedConsumer) missingScopes(requiredScopes []string) []string {
+	_, hasAllScopes := consumer.scopes[allScopes]
+	if hasAllScopes {
+		return nil
+	}
+
+	missingScopes := make([]string, 0)
+	for _, scope := range requiredScopes {
+		_, found := consumer.scopes[scope]
+		if !found {
+			missingScopes = append(missingScopes, scope)
+		}
+	}
+
+	return missingScopes
+}
+
+type apiKeyCredential struct {
+	name   string
+	scopes []string
+}
+
+// authenticationData holds the credentials accepted by the authenticator and the scopes required by each endpoint
+type authenticationData struct {
+	accounts    map[string]string
+	apiKeys     map[string]*apiKeyCredential
+	jwtVerifier *jwtVerifier
+	routeScopes map[string][]string
+}
+
+// authenticator authenticates the requests made to the secured endpoints. A request can be authenticated using Basic
+// Authentication, which grants all the scopes, or using a bearer token, which can either be a JWT or an opaque API
+// key. The bearer tokens have to grant all the scopes required by the endpoint
+type authenticator struct {
+	hasher         hashing.Hasher
+	getTimeHandler func() time.Time
+
+	mutAuthData sync.RWMutex
+	authData    *authenticationData
+}
+
+// NewAuthenticator returns a new instance of authenticator. The route scopes map holds the scopes required by each
+// endpoint, keyed by its full path
+func NewAuthenticator(credentialsConfig config.CredentialsConfig, hasher hashing.Hasher, routeScopes map[string][]string) (*authenticator, error) {
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	authData, err := newAuthenticationData(credentialsConfig, routeScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticator{
+		hasher:         hasher,
+		getTimeHandler: time.Now,
+		authData:       authData,
+	}, nil
+}
+
+func newAuthenticationData(credentialsConfig config.CredentialsConfig, routeScopes map[string][]string) (*authenticationData, error) {
+	if routeScopes == nil {
+		return nil, ErrNilRouteScopesMap
+	}
+
+	accounts := make(map[string]string)
+	for _, pair := range credentialsConfig.Credentials {
+		accounts[pair.Username] = pair.Password
+	}
+
+	apiKeys := make(map[string]*apiKeyCredential)
+	for _, apiKey := range credentialsConfig.APIKeys {
+		keyHash := strings.ToLower(apiKey.KeyHash)
+		_, exists := apiKeys[keyHash]
+		if len(apiKey.Name) == 0 || len(keyHash) == 0 || exists {
+			return nil, fmt.Errorf("%w: name %q", ErrInvalidAPIKeyConfig, apiKey.Name)
+		}
+
+		apiKeys[keyHash] = &apiKeyCredential{
+			name:   apiKey.Name,
+			scopes: apiKey.Scopes,
+		}
+	}
+
+	verifier, err := newJWTVerifier(credentialsConfig.JWT)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationData{
+		accounts:    accounts,
+		apiKeys:     apiKeys,
+		jwtVerifier: verifier,
+		routeScopes: routeScopes,
+	}, nil
+}
+
+// SetCredentials replaces the accepted credentials and the scopes required by each endpoint. If the provided config is
+// not valid, the current credentials are kept
+func (a *authenticator) SetCredentials(credentialsConfig config.CredentialsConfig, routeScopes map[string][]string) error {
+	authData, err := newAuthenticationData(credentialsConfig, routeScopes)
+	if err != nil {
+		return err
+	}
+
+	a.mutAuthData.Lock()
+	a.authData = authData
+	a.mutAuthData.Unlock()
+
+	return nil
+}
+
+func (a *authenticator) getAuthenticationData() *authenticationData {
+	a.mutAuthData.RLock()
+	defer a.mutAuthData.RUnlock()
+
+	return a.authData
+}
+
+// MiddlewareHandlerFunc returns the gin middleware for authenticating the requests
+func (a *authenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authData := a.getAuthenticationData()
+		if !authData.hasCredentials() {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				data.GenericAPIResponse{
+					Data:  nil,
+					Error: "no credentials found on server",
+					Code:  data.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		consumer, errMessage := a.authenticate(authData, c.Request)
+		if consumer == nil {
+			log.Debug("request authentication failed", "path", c.FullPath(), "client", c.ClientIP(), "error", errMessage)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
+				Data:  nil,
+				Error: errMessage,
+				Code:  data.ReturnCodeRequestError,
+			})
+			return
+		}
+
+		missingScopes := consumer.missingScopes(authData.routeScopes[c.FullPath()])
+		if len(missingScopes) > 0 {
+			log.Debug("request authorization failed", "path", c.FullPath(), "consumer", consumer.name,
+				"missing scopes", strings.Join(missingScopes, " "))
+			c.AbortWithStatusJSON(http.StatusForbidden, data.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("missing required scopes: %s", strings.Join(missingScopes, " ")),
+				Code:  data.ReturnCodeRequestError,
+			})
+			return
+		}
+
+		log.Info("authenticated request", "path", c.FullPath(), "consumer", consumer.name, "client", c.ClientIP())
+		c.Set(AuthenticatedConsumerKey, consumer.name)
+	}
+}
+
+// IdentificationHandlerFunc returns the gin middleware for identifying the consumers making requests to the open
+// endpoints. The requests without valid credentials are not rejected, being treated as anonymous
+func (a *authenticator) IdentificationHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(c.GetHeader("Authorization")) == 0 {
+			return
+		}
+
+		consumer, errMessage := a.authenticate(a.getAuthenticationData(), c.Request)
+		if consumer == nil {
+			log.Trace("request identification failed", "path", c.FullPath(), "client", c.ClientIP(), "error", errMessage)
+			return
+		}
+
+		c.Set(AuthenticatedConsumerKey, consumer.name)
+	}
+}
+
+func (authData *authenticationData) hasCredentials() bool {
+	return len(authData.accounts)+len(authData.apiKeys) > 0 || authData.jwtVerifier.hasKeys()
+}
+
+// authenticate returns the consumer that made the request or, if the request could not be authenticated, the reason
+func (a *authenticator) authenticate(authData *authenticationData, request *http.Request) (*authenticatedConsumer, string) {
+	authorization := request.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, bearerPrefix) {
+		token := strings.TrimSpace(strings.TrimPrefix(authorization, bearerPrefix))
+		if strings.Count(token, ".") == jwtNumParts-1 {
+			return a.authenticateJWT(authData, token)
+		}
+
+		return a.authenticateAPIKey(authData, token)
+	}
+
+	user, pass, ok := request.BasicAuth()
+	if !ok {
+		return nil, "this endpoint requires Basic Authentication or a bearer token"
+	}
+
+	userPassword, ok := authData.accounts[user]
+	if !ok {
+		return nil, "username does not exist"
+	}
+
+	if userPassword != hex.EncodeToString(a.hasher.Compute(pass)) {
+		return nil, "invalid password"
+	}
+
+	return newAuthenticatedConsumer("user:"+user, []string{allScopes}), ""
+}
+
+func (a *authenticator) authenticateJWT(authData *authenticationData, token string) (*authenticatedConsumer, string) {
+	claims, err := authData.jwtVerifier.verify(token, a.getTimeHandler())
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	return newAuthenticatedConsumer("jwt:"+claims.Subject, claims.scopes()), ""
+}
+
+func (a *authenticator) authenticateAPIKey(authData *authenticationData, apiKey string) (*authenticatedConsumer, string) {
+	keyHash := hex.EncodeToString(a.hasher.Compute(apiKey))
+	credential, found := authData.apiKeys[keyHash]
+	if !found {
+		return nil, "invalid API key"
+	}
+
+	return newAuthenticatedConsumer("apikey:"+credential.name, credential.scopes), ""
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *authenticator) IsInterfaceNil() bool {
+	return a == nil
+}
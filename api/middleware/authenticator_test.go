@@ -0,0 +1,391 @@
+package middleware
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreSha256 "github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const securedPath = "/actions/reload-observers"
+
+var testHMACKey = []byte("0123456789abcdef0123456789abcdef")
+
+func hashHex(value string) string {
+	return hex.EncodeToString(coreSha256.NewSha256().Compute(value))
+}
+
+func createJWT(header map[string]interface{}, claims map[string]interface{}, sign func(content []byte) []byte) string {
+	headerBytes, _ := json.Marshal(header)
+	claimsBytes, _ := json.Marshal(claims)
+	content := base64.RawURLEncoding.EncodeToString(headerBytes) + "." + base64.RawURLEncoding.EncodeToString(claimsBytes)
+
+	return content + "." + base64.RawURLEncoding.EncodeToString(sign([]byte(content)))
+}
+
+func signHS256(key []byte) func(content []byte) []byte {
+	return func(content []byte) []byte {
+		mac := hmac.New(sha256.New, key)
+		_, _ = mac.Write(content)
+		return mac.Sum(nil)
+	}
+}
+
+func validClaims(scope string) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":   "consumer",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": scope,
+	}
+}
+
+func createTestCredentialsConfig(edPublicKey ed25519.PublicKey) config.CredentialsConfig {
+	return config.CredentialsConfig{
+		Credentials: []data.Credential{
+			{Username: "admin", Password: hashHex("admin-password")},
+		},
+		APIKeys: []config.APIKeyConfig{
+			{Name: "operations", KeyHash: hashHex("operations-key"), Scopes: []string{"actions"}},
+			{Name: "monitoring", KeyHash: strings.ToUpper(hashHex("monitoring-key")), Scopes: []string{"staking-info"}},
+		},
+		JWT: config.JWTConfig{
+			Issuer:   "issuer",
+			Audience: "proxy",
+			HMACKeys: []config.JWTKeyConfig{
+				{KeyID: "old", Key: hex.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))},
+				{KeyID: "new", Key: hex.EncodeToString(testHMACKey)},
+			},
+			Ed25519Keys: []config.JWTKeyConfig{
+				{KeyID: "ed", Key: hex.EncodeToString(edPublicKey)},
+			},
+			RevokedTokenIDs: []string{"revoked-id"},
+		},
+	}
+}
+
+func startAuthenticatedServer(t *testing.T, credentialsConfig config.CredentialsConfig) (*gin.Engine, *authenticator) {
+	auth, err := NewAuthenticator(credentialsConfig, coreSha256.NewSha256(), map[string][]string{
+		securedPath: {"actions"},
+	})
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.GET(securedPath, auth.MiddlewareHandlerFunc(), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(AuthenticatedConsumerKey))
+	})
+
+	return ws, auth
+}
+
+func doAuthenticatedRequest(ws *gin.Engine, setAuthorization func(req *http.Request)) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", securedPath, nil)
+	setAuthorization(req)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func withBearer(token string) func(req *http.Request) {
+	return func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := NewAuthenticator(config.CredentialsConfig{}, nil, map[string][]string{})
+		require.Equal(t, ErrNilHasher, err)
+		require.True(t, check.IfNil(auth))
+	})
+	t.Run("nil route scopes map should error", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := NewAuthenticator(config.CredentialsConfig{}, coreSha256.NewSha256(), nil)
+		require.Equal(t, ErrNilRouteScopesMap, err)
+		require.True(t, check.IfNil(auth))
+	})
+	t.Run("invalid API key should error", func(t *testing.T) {
+		t.Parallel()
+
+		credentialsConfig := config.CredentialsConfig{
+			APIKeys: []config.APIKeyConfig{{Name: "", KeyHash: hashHex("key")}},
+		}
+		auth, err := NewAuthenticator(credentialsConfig, coreSha256.NewSha256(), map[string][]string{})
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyConfig))
+		require.True(t, check.IfNil(auth))
+
+		credentialsConfig.APIKeys = []config.APIKeyConfig{
+			{Name: "first", KeyHash: hashHex("key")},
+			{Name: "second", KeyHash: hashHex("key")},
+		}
+		auth, err = NewAuthenticator(credentialsConfig, coreSha256.NewSha256(), map[string][]string{})
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyConfig))
+		require.True(t, check.IfNil(auth))
+	})
+	t.Run("invalid JWT keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		credentialsConfig := config.CredentialsConfig{
+			JWT: config.JWTConfig{HMACKeys: []config.JWTKeyConfig{{KeyID: "short", Key: "abcd"}}},
+		}
+		auth, err := NewAuthenticator(credentialsConfig, coreSha256.NewSha256(), map[string][]string{})
+		require.True(t, errors.Is(err, ErrInvalidJWTKey))
+		require.True(t, check.IfNil(auth))
+
+		credentialsConfig.JWT = config.JWTConfig{Ed25519Keys: []config.JWTKeyConfig{{KeyID: "ed", Key: "not hex"}}}
+		auth, err = NewAuthenticator(credentialsConfig, coreSha256.NewSha256(), map[string][]string{})
+		require.True(t, errors.Is(err, ErrInvalidJWTKey))
+		require.True(t, check.IfNil(auth))
+
+		key := config.JWTKeyConfig{KeyID: "key", Key: hex.EncodeToString(testHMACKey)}
+		credentialsConfig.JWT = config.JWTConfig{HMACKeys: []config.JWTKeyConfig{key, key}}
+		auth, err = NewAuthenticator(credentialsConfig, coreSha256.NewSha256(), map[string][]string{})
+		require.True(t, errors.Is(err, ErrDuplicatedJWTKeyID))
+		require.True(t, check.IfNil(auth))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := NewAuthenticator(createTestCredentialsConfig(make([]byte, ed25519.PublicKeySize)), coreSha256.NewSha256(), map[string][]string{})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(auth))
+	})
+}
+
+func TestAuthenticator_NoCredentialsShouldReturnInternalError(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startAuthenticatedServer(t, config.CredentialsConfig{})
+
+	resp := doAuthenticatedRequest(ws, func(req *http.Request) {
+		req.SetBasicAuth("admin", "admin-password")
+	})
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, resp.Body.String(), "no credentials found on server")
+}
+
+func TestAuthenticator_BasicAuthentication(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startAuthenticatedServer(t, createTestCredentialsConfig(make([]byte, ed25519.PublicKeySize)))
+
+	resp := doAuthenticatedRequest(ws, func(req *http.Request) {})
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), "this endpoint requires Basic Authentication or a bearer token")
+
+	resp = doAuthenticatedRequest(ws, func(req *http.Request) {
+		req.SetBasicAuth("unknown", "admin-password")
+	})
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), "username does not exist")
+
+	resp = doAuthenticatedRequest(ws, func(req *http.Request) {
+		req.SetBasicAuth("admin", "wrong")
+	})
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), "invalid password")
+
+	// the credential pairs grant all the scopes
+	resp = doAuthenticatedRequest(ws, func(req *http.Request) {
+		req.SetBasicAuth("admin", "admin-password")
+	})
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "user:admin", resp.Body.String())
+}
+
+func TestAuthenticator_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startAuthenticatedServer(t, createTestCredentialsConfig(make([]byte, ed25519.PublicKeySize)))
+
+	resp := doAuthenticatedRequest(ws, withBearer("unknown-key"))
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), "invalid API key")
+
+	resp = doAuthenticatedRequest(ws, withBearer("monitoring-key"))
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	assert.Contains(t, resp.Body.String(), "missing required scopes: actions")
+
+	resp = doAuthenticatedRequest(ws, withBearer("operations-key"))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "apikey:operations", resp.Body.String())
+}
+
+func TestAuthenticator_IdentificationHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	auth, err := NewAuthenticator(createTestCredentialsConfig(make([]byte, ed25519.PublicKeySize)), coreSha256.NewSha256(), map[string][]string{})
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.GET("/open", auth.IdentificationHandlerFunc(), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(AuthenticatedConsumerKey))
+	})
+	doGet := func(setAuthorization func(req *http.Request)) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", "/open", nil)
+		setAuthorization(req)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	// the requests without valid credentials are not rejected
+	resp := doGet(func(req *http.Request) {})
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resp.Body.String())
+
+	resp = doGet(withBearer("unknown-key"))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resp.Body.String())
+
+	// the scopes are not required on the open endpoints
+	resp = doGet(withBearer("monitoring-key"))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "apikey:monitoring", resp.Body.String())
+
+	resp = doGet(func(req *http.Request) {
+		req.SetBasicAuth("admin", "admin-password")
+	})
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "user:admin", resp.Body.String())
+}
+
+func TestAuthenticator_JWT(t *testing.T) {
+	t.Parallel()
+
+	edPublicKey, edPrivateKey, err := ed25519.GenerateKey(nil)
+	require.Nil(t, err)
+	ws, auth := startAuthenticatedServer(t, createTestCredentialsConfig(edPublicKey))
+
+	hs256Header := map[string]interface{}{"alg": jwtAlgorithmHS256, "kid": "new"}
+	withIssuerAndAudience := func(claims map[string]interface{}) map[string]interface{} {
+		claims["iss"] = "issuer"
+		claims["aud"] = []string{"other", "proxy"}
+		return claims
+	}
+
+	t.Run("valid HS256 token should work", func(t *testing.T) {
+		t.Parallel()
+
+		token := createJWT(hs256Header, withIssuerAndAudience(validClaims("staking-info actions")), signHS256(testHMACKey))
+		resp := doAuthenticatedRequest(ws, withBearer(token))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "jwt:consumer", resp.Body.String())
+	})
+	t.Run("valid EdDSA token should work", func(t *testing.T) {
+		t.Parallel()
+
+		header := map[string]interface{}{"alg": jwtAlgorithmEdDSA}
+		claims := withIssuerAndAudience(validClaims("*"))
+		claims["aud"] = "proxy"
+		token := createJWT(header, claims, func(content []byte) []byte {
+			return ed25519.Sign(edPrivateKey, content)
+		})
+		resp := doAuthenticatedRequest(ws, withBearer(token))
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("missing scope should be forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		token := createJWT(hs256Header, withIssuerAndAudience(validClaims("staking-info")), signHS256(testHMACKey))
+		resp := doAuthenticatedRequest(ws, withBearer(token))
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+	})
+	t.Run("invalid tokens should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		expiredClaims := withIssuerAndAudience(validClaims("actions"))
+		expiredClaims["exp"] = time.Now().Add(-time.Minute).Unix()
+		revokedClaims := withIssuerAndAudience(validClaims("actions"))
+		revokedClaims["jti"] = "revoked-id"
+		noExpirationClaims := withIssuerAndAudience(validClaims("actions"))
+		delete(noExpirationClaims, "exp")
+		wrongIssuerClaims := withIssuerAndAudience(validClaims("actions"))
+		wrongIssuerClaims["iss"] = "other"
+		wrongAudienceClaims := withIssuerAndAudience(validClaims("actions"))
+		wrongAudienceClaims["aud"] = "other"
+
+		tokens := map[string]string{
+			"invalid token signature":             createJWT(hs256Header, withIssuerAndAudience(validClaims("actions")), signHS256([]byte("wrong key, but long enough 1234"))),
+			"unknown token signing key":           createJWT(map[string]interface{}{"alg": jwtAlgorithmHS256}, withIssuerAndAudience(validClaims("actions")), signHS256(testHMACKey)),
+			"unsupported token signing algorithm": createJWT(map[string]interface{}{"alg": "none"}, withIssuerAndAudience(validClaims("actions")), func([]byte) []byte { return nil }),
+			"token has expired":                   createJWT(hs256Header, expiredClaims, signHS256(testHMACKey)),
+			"token has been revoked":              createJWT(hs256Header, revokedClaims, signHS256(testHMACKey)),
+			"missing expiration time":             createJWT(hs256Header, noExpirationClaims, signHS256(testHMACKey)),
+			"unexpected issuer":                   createJWT(hs256Header, wrongIssuerClaims, signHS256(testHMACKey)),
+			"unexpected audience":                 createJWT(hs256Header, wrongAudienceClaims, signHS256(testHMACKey)),
+			"malformed token":                     "a.b.c",
+		}
+		for expectedError, token := range tokens {
+			resp := doAuthenticatedRequest(ws, withBearer(token))
+			assert.Equal(t, http.StatusUnauthorized, resp.Code, expectedError)
+			assert.Contains(t, resp.Body.String(), expectedError)
+		}
+	})
+	t.Run("token should expire", func(t *testing.T) {
+		t.Parallel()
+
+		token := createJWT(hs256Header, withIssuerAndAudience(validClaims("actions")), signHS256(testHMACKey))
+		_, err := auth.getAuthenticationData().jwtVerifier.verify(token, time.Now().Add(2*time.Hour))
+		assert.Equal(t, ErrExpiredJWT, err)
+	})
+}
+
+func TestAuthenticator_SetCredentials(t *testing.T) {
+	t.Parallel()
+
+	ws, auth := startAuthenticatedServer(t, createTestCredentialsConfig(make([]byte, ed25519.PublicKeySize)))
+
+	resp := doAuthenticatedRequest(ws, withBearer("operations-key"))
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	invalidConfig := config.CredentialsConfig{
+		APIKeys: []config.APIKeyConfig{{Name: "", KeyHash: hashHex("key")}},
+	}
+	err := auth.SetCredentials(invalidConfig, map[string][]string{})
+	assert.True(t, errors.Is(err, ErrInvalidAPIKeyConfig))
+	err = auth.SetCredentials(config.CredentialsConfig{}, nil)
+	assert.Equal(t, ErrNilRouteScopesMap, err)
+
+	// the invalid configs should not change the accepted credentials
+	resp = doAuthenticatedRequest(ws, withBearer("operations-key"))
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// the operations key is revoked and the monitoring key is granted the scope required by the route
+	newConfig := config.CredentialsConfig{
+		APIKeys: []config.APIKeyConfig{
+			{Name: "monitoring", KeyHash: hashHex("monitoring-key"), Scopes: []string{"actions"}},
+		},
+	}
+	err = auth.SetCredentials(newConfig, map[string][]string{securedPath: {"actions"}})
+	require.Nil(t, err)
+
+	resp = doAuthenticatedRequest(ws, withBearer("operations-key"))
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = doAuthenticatedRequest(ws, withBearer("monitoring-key"))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "apikey:monitoring", resp.Body.String())
+}
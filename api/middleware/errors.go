@@ -7,3 +7,63 @@ var ErrNilLimitsMapForEndpoints = errors.New("nil limits map")
 
 // ErrNilStatusMetricsExtractor signals that a nil status metrics extractor has been provided
 var ErrNilStatusMetricsExtractor = errors.New("nil status metrics extractor")
+
+// ErrInvalidRateLimitWindow signals that an invalid rate limit window has been provided
+var ErrInvalidRateLimitWindow = errors.New("invalid rate limit window")
+
+// ErrInvalidRateLimitTier signals that an invalid rate limit tier has been provided
+var ErrInvalidRateLimitTier = errors.New("invalid rate limit tier")
+
+// ErrDuplicatedRateLimitTier signals that a rate limit tier was defined more than once
+var ErrDuplicatedRateLimitTier = errors.New("duplicated rate limit tier")
+
+// ErrUnknownRateLimitTier signals that a consumer was assigned to a rate limit tier that is not defined
+var ErrUnknownRateLimitTier = errors.New("unknown rate limit tier")
+
+// ErrInvalidRateLimitConsumer signals that an invalid rate limit consumer has been provided
+var ErrInvalidRateLimitConsumer = errors.New("invalid rate limit consumer")
+
+// ErrDuplicatedRateLimitConsumer signals that a consumer was assigned to rate limit tiers more than once
+var ErrDuplicatedRateLimitConsumer = errors.New("duplicated rate limit consumer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilRouteScopesMap signals that a nil route scopes map has been provided
+var ErrNilRouteScopesMap = errors.New("nil route scopes map")
+
+// ErrInvalidAPIKeyConfig signals that an invalid API key configuration has been provided
+var ErrInvalidAPIKeyConfig = errors.New("invalid API key configuration")
+
+// ErrInvalidJWTKey signals that an invalid JWT verification key has been provided
+var ErrInvalidJWTKey = errors.New("invalid JWT key")
+
+// ErrDuplicatedJWTKeyID signals that a JWT key ID was defined more than once
+var ErrDuplicatedJWTKeyID = errors.New("duplicated JWT key ID")
+
+// ErrMalformedJWT signals that a malformed JWT has been provided
+var ErrMalformedJWT = errors.New("malformed token")
+
+// ErrUnsupportedJWTAlgorithm signals that the JWT is signed with an algorithm that is not supported
+var ErrUnsupportedJWTAlgorithm = errors.New("unsupported token signing algorithm")
+
+// ErrUnknownJWTKey signals that the JWT is signed with a key that is not known
+var ErrUnknownJWTKey = errors.New("unknown token signing key")
+
+// ErrInvalidJWTSignature signals that the JWT signature is not valid
+var ErrInvalidJWTSignature = errors.New("invalid token signature")
+
+// ErrInvalidJWTClaims signals that the JWT claims are not valid
+var ErrInvalidJWTClaims = errors.New("invalid token claims")
+
+// ErrExpiredJWT signals that the JWT has expired
+var ErrExpiredJWT = errors.New("token has expired")
+
+// ErrRevokedJWT signals that the JWT has been revoked
+var ErrRevokedJWT = errors.New("token has been revoked")
+
+// ErrNilRoutesMap signals that a nil routes map has been provided
+var ErrNilRoutesMap = errors.New("nil routes map")
+
+// ErrNilAuthenticator signals that a nil authenticator has been provided
+var ErrNilAuthenticator = errors.New("nil authenticator")
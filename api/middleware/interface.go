@@ -4,12 +4,39 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // RateLimiterHandler defines the actions that an implementation of rate limiter handler should do
 type RateLimiterHandler interface {
 	MiddlewareProcessor
-	ResetMap(version string)
+	SetLimits(limits map[string]uint64, window time.Duration, rateLimitsConfig config.RateLimitsConfig) error
+	Close() error
+}
+
+// ConsumerAuthenticator defines an authenticator that can also identify the consumers without rejecting the requests
+type ConsumerAuthenticator interface {
+	MiddlewareProcessor
+	IdentificationHandlerFunc() gin.HandlerFunc
+}
+
+// AuthenticatorHandler defines the actions that an implementation of authenticator handler should do
+type AuthenticatorHandler interface {
+	ConsumerAuthenticator
+	SetCredentials(credentialsConfig config.CredentialsConfig, routeScopes map[string][]string) error
+}
+
+// RouteAccessHandler defines the actions that an implementation of route access handler should do
+type RouteAccessHandler interface {
+	MiddlewareProcessor
+	SetRoutes(routes map[string]data.RouteConfig) error
+}
+
+// ResponseLoggerHandler defines the actions that an implementation of response logger handler should do
+type ResponseLoggerHandler interface {
+	MiddlewareProcessor
+	SetSettings(isEnabled bool, thresholdDurationForLoggingRequest time.Duration)
 }
 
 // StatusMetricsExtractor defines what a status metrics extractor should do
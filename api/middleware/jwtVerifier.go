@@ -0,0 +1,265 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const (
+	jwtAlgorithmHS256 = "HS256"
+	jwtAlgorithmEdDSA = "EdDSA"
+	jwtNumParts       = 3
+	minHMACKeyLength  = 32
+)
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Subject   string      `json:"sub"`
+	Issuer    string      `json:"iss"`
+	Audience  jwtAudience `json:"aud"`
+	ExpiresAt int64       `json:"exp"`
+	NotBefore int64       `json:"nbf"`
+	ID        string      `json:"jti"`
+	Scope     string      `json:"scope"`
+}
+
+// jwtAudience holds the aud claim, which can either be a string or an array of strings
+type jwtAudience []string
+
+// UnmarshalJSON unmarshals the aud claim
+func (aud *jwtAudience) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		var audiences []string
+		err := json.Unmarshal(data, &audiences)
+		*aud = audiences
+		return err
+	}
+
+	var audience string
+	err := json.Unmarshal(data, &audience)
+	*aud = jwtAudience{audience}
+	return err
+}
+
+func (aud jwtAudience) contains(audience string) bool {
+	for _, value := range aud {
+		if value == audience {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jwtVerifier verifies compact serialized JWTs signed with HMAC-SHA256 or Ed25519 keys
+type jwtVerifier struct {
+	issuer          string
+	audience        string
+	hmacKeys        map[string][]byte
+	ed25519Keys     map[string][]byte
+	revokedTokenIDs map[string]struct{}
+}
+
+func newJWTVerifier(jwtConfig config.JWTConfig) (*jwtVerifier, error) {
+	verifier := &jwtVerifier{
+		issuer:          jwtConfig.Issuer,
+		audience:        jwtConfig.Audience,
+		hmacKeys:        make(map[string][]byte),
+		ed25519Keys:     make(map[string][]byte),
+		revokedTokenIDs: make(map[string]struct{}),
+	}
+
+	for _, keyConfig := range jwtConfig.HMACKeys {
+		key, err := decodeJWTKey(keyConfig, verifier.hmacKeys)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) < minHMACKeyLength {
+			return nil, fmt.Errorf("%w: HMAC key %q should have at least %d bytes",
+				ErrInvalidJWTKey, keyConfig.KeyID, minHMACKeyLength)
+		}
+
+		verifier.hmacKeys[keyConfig.KeyID] = key
+	}
+
+	for _, keyConfig := range jwtConfig.Ed25519Keys {
+		key, err := decodeJWTKey(keyConfig, verifier.ed25519Keys)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w: Ed25519 key %q should have %d bytes",
+				ErrInvalidJWTKey, keyConfig.KeyID, ed25519.PublicKeySize)
+		}
+
+		verifier.ed25519Keys[keyConfig.KeyID] = key
+	}
+
+	for _, tokenID := range jwtConfig.RevokedTokenIDs {
+		verifier.revokedTokenIDs[tokenID] = struct{}{}
+	}
+
+	return verifier, nil
+}
+
+func decodeJWTKey(keyConfig config.JWTKeyConfig, existingKeys map[string][]byte) ([]byte, error) {
+	_, exists := existingKeys[keyConfig.KeyID]
+	if exists {
+		return nil, fmt.Errorf("%w: %q", ErrDuplicatedJWTKeyID, keyConfig.KeyID)
+	}
+
+	key, err := hex.DecodeString(keyConfig.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: key %q is not hex encoded", ErrInvalidJWTKey, keyConfig.KeyID)
+	}
+
+	return key, nil
+}
+
+func (verifier *jwtVerifier) hasKeys() bool {
+	return len(verifier.hmacKeys)+len(verifier.ed25519Keys) > 0
+}
+
+// verify checks the signature and the registered claims of the provided token and returns its claims
+func (verifier *jwtVerifier) verify(token string, now time.Time) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != jwtNumParts {
+		return nil, ErrMalformedJWT
+	}
+
+	header := &jwtHeader{}
+	err := decodeJWTPart(parts[0], header)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedJWT
+	}
+
+	signedContent := []byte(parts[0] + "." + parts[1])
+	err = verifier.verifySignature(header, signedContent, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &jwtClaims{}
+	err = decodeJWTPart(parts[1], claims)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifier.verifyClaims(claims, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+func decodeJWTPart(part string, value interface{}) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return ErrMalformedJWT
+	}
+
+	err = json.Unmarshal(decoded, value)
+	if err != nil {
+		return ErrMalformedJWT
+	}
+
+	return nil
+}
+
+func (verifier *jwtVerifier) verifySignature(header *jwtHeader, signedContent []byte, signature []byte) error {
+	switch header.Algorithm {
+	case jwtAlgorithmHS256:
+		key, found := findJWTKey(verifier.hmacKeys, header.KeyID)
+		if !found {
+			return ErrUnknownJWTKey
+		}
+
+		mac := hmac.New(sha256.New, key)
+		_, _ = mac.Write(signedContent)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return ErrInvalidJWTSignature
+		}
+
+		return nil
+	case jwtAlgorithmEdDSA:
+		key, found := findJWTKey(verifier.ed25519Keys, header.KeyID)
+		if !found {
+			return ErrUnknownJWTKey
+		}
+
+		if !ed25519.Verify(ed25519.PublicKey(key), signedContent, signature) {
+			return ErrInvalidJWTSignature
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedJWTAlgorithm, header.Algorithm)
+	}
+}
+
+// findJWTKey returns the key with the provided ID. The key ID can be omitted from the token if there is only one key
+// of its type, so that the key can be rotated by adding a new key with an ID before removing the old one
+func findJWTKey(keys map[string][]byte, keyID string) ([]byte, bool) {
+	key, found := keys[keyID]
+	if found || len(keyID) > 0 || len(keys) != 1 {
+		return key, found
+	}
+
+	for _, onlyKey := range keys {
+		return onlyKey, true
+	}
+
+	return nil, false
+}
+
+func (verifier *jwtVerifier) verifyClaims(claims *jwtClaims, now time.Time) error {
+	if len(claims.Subject) == 0 {
+		return fmt.Errorf("%w: missing subject", ErrInvalidJWTClaims)
+	}
+	if claims.ExpiresAt == 0 {
+		return fmt.Errorf("%w: missing expiration time", ErrInvalidJWTClaims)
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return ErrExpiredJWT
+	}
+	if claims.NotBefore > 0 && now.Unix() < claims.NotBefore {
+		return fmt.Errorf("%w: token is not valid yet", ErrInvalidJWTClaims)
+	}
+	if len(verifier.issuer) > 0 && claims.Issuer != verifier.issuer {
+		return fmt.Errorf("%w: unexpected issuer", ErrInvalidJWTClaims)
+	}
+	if len(verifier.audience) > 0 && !claims.Audience.contains(verifier.audience) {
+		return fmt.Errorf("%w: unexpected audience", ErrInvalidJWTClaims)
+	}
+
+	_, isRevoked := verifier.revokedTokenIDs[claims.ID]
+	if isRevoked && len(claims.ID) > 0 {
+		return ErrRevokedJWT
+	}
+
+	return nil
+}
+
+func (claims *jwtClaims) scopes() []string {
+	return strings.Fields(claims.Scope)
+}
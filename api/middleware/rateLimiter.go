@@ -1,85 +1,367 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // ReturnCodeRequestError defines a request which hasn't been executed successfully due to a bad request received
 const ReturnCodeRequestError string = "bad_request"
 
+const (
+	anonymousTierName = "anonymous"
+	globalBudgetName  = "*"
+
+	rateLimitLimitHeader     = "X-RateLimit-Limit"
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+	retryAfterHeader         = "Retry-After"
+)
+
+type rateLimitTier struct {
+	name                    string
+	endpointLimitMultiplier float64
+	disableEndpointLimits   bool
+	globalLimit             uint64
+}
+
+// rateLimitBudget is a token bucket that a request has to consume from
+type rateLimitBudget struct {
+	bucketKey   string
+	limit       uint64
+	description string
+}
+
+type rateLimitResult struct {
+	isAllowed  bool
+	limit      uint64
+	remaining  uint64
+	reset      time.Duration
+	retryAfter time.Duration
+	exceeded   *rateLimitBudget
+}
+
+// rateLimitSettings holds the limits applied by the rate limiter
+type rateLimitSettings struct {
+	limits          map[string]uint64
+	window          time.Duration
+	anonymousTier   *rateLimitTier
+	tiersByConsumer map[string]*rateLimitTier
+}
+
+// rateLimiter limits the requests using token buckets. Each client, identified by the consumer name set by the
+// authenticator if the consumer is assigned to a tier or by its IP otherwise, has a bucket for each limited endpoint
+// and, optionally, a global bucket shared by all the endpoints. The limits depend on the tier of the client
 type rateLimiter struct {
-	requestsMap    map[string]uint64
-	mutRequestsMap sync.RWMutex
-	limits         map[string]uint64
-	countDuration  time.Duration
+	getTimeHandler func() time.Time
+
+	mutSettings sync.RWMutex
+	settings    *rateLimitSettings
+
+	mutBuckets sync.Mutex
+	buckets    map[string]*tokenBucket
+	cancelFunc func()
+}
+
+// NewRateLimiter returns a new instance of rateLimiter. The limits map holds the number of requests allowed for each
+// endpoint in the provided window, for a client of a tier with an endpoint limit multiplier of 1
+func NewRateLimiter(limits map[string]uint64, window time.Duration, rateLimitsConfig config.RateLimitsConfig) (*rateLimiter, error) {
+	settings, err := newRateLimitSettings(limits, window, rateLimitsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	rl := &rateLimiter{
+		getTimeHandler: time.Now,
+		settings:       settings,
+		buckets:        make(map[string]*tokenBucket),
+	}
+
+	var ctx context.Context
+	ctx, rl.cancelFunc = context.WithCancel(context.Background())
+	go rl.cleanupIdleBuckets(ctx)
+
+	return rl, nil
 }
 
-// NewRateLimiter returns a new instance of rateLimiter
-func NewRateLimiter(limits map[string]uint64, countDuration time.Duration) (*rateLimiter, error) {
+func newRateLimitSettings(limits map[string]uint64, window time.Duration, rateLimitsConfig config.RateLimitsConfig) (*rateLimitSettings, error) {
 	if limits == nil {
 		return nil, ErrNilLimitsMapForEndpoints
 	}
-	return &rateLimiter{
-		requestsMap:   make(map[string]uint64),
-		limits:        limits,
-		countDuration: countDuration,
+	if window <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRateLimitWindow, window)
+	}
+
+	anonymousTierConfig := rateLimitsConfig.Anonymous
+	anonymousTierConfig.Name = anonymousTierName
+	anonymousTier, err := newRateLimitTier(anonymousTierConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	tiersByConsumer, err := createTiersByConsumer(rateLimitsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rateLimitSettings{
+		limits:          limits,
+		window:          window,
+		anonymousTier:   anonymousTier,
+		tiersByConsumer: tiersByConsumer,
 	}, nil
 }
 
-// MiddlewareHandlerFunc returns the gin middleware for limiting the number of requests for a given endpoint
+// SetLimits replaces the endpoint limits, the window and the tiers of the rate limiter. The buckets of all the clients
+// are reset, as they were filled using the old limits. If the provided settings are not valid, the current ones are kept
+func (rl *rateLimiter) SetLimits(limits map[string]uint64, window time.Duration, rateLimitsConfig config.RateLimitsConfig) error {
+	settings, err := newRateLimitSettings(limits, window, rateLimitsConfig)
+	if err != nil {
+		return err
+	}
+
+	rl.mutBuckets.Lock()
+	rl.buckets = make(map[string]*tokenBucket)
+	rl.mutSettings.Lock()
+	rl.settings = settings
+	rl.mutSettings.Unlock()
+	rl.mutBuckets.Unlock()
+
+	return nil
+}
+
+func (rl *rateLimiter) getSettings() *rateLimitSettings {
+	rl.mutSettings.RLock()
+	defer rl.mutSettings.RUnlock()
+
+	return rl.settings
+}
+
+func newRateLimitTier(tierConfig config.RateLimitTierConfig) (*rateLimitTier, error) {
+	if len(tierConfig.Name) == 0 || tierConfig.EndpointLimitMultiplier < 0 {
+		return nil, fmt.Errorf("%w: name %q, endpoint limit multiplier %v",
+			ErrInvalidRateLimitTier, tierConfig.Name, tierConfig.EndpointLimitMultiplier)
+	}
+
+	endpointLimitMultiplier := tierConfig.EndpointLimitMultiplier
+	if endpointLimitMultiplier == 0 {
+		endpointLimitMultiplier = 1
+	}
+
+	return &rateLimitTier{
+		name:                    tierConfig.Name,
+		endpointLimitMultiplier: endpointLimitMultiplier,
+		disableEndpointLimits:   tierConfig.DisableEndpointLimits,
+		globalLimit:             tierConfig.GlobalLimit,
+	}, nil
+}
+
+func createTiersByConsumer(rateLimitsConfig config.RateLimitsConfig) (map[string]*rateLimitTier, error) {
+	tiersByName := make(map[string]*rateLimitTier)
+	for _, tierConfig := range rateLimitsConfig.Tiers {
+		tier, err := newRateLimitTier(tierConfig)
+		if err != nil {
+			return nil, err
+		}
+		if tier.name == anonymousTierName {
+			return nil, fmt.Errorf("%w: %s is reserved", ErrInvalidRateLimitTier, anonymousTierName)
+		}
+		_, exists := tiersByName[tier.name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedRateLimitTier, tier.name)
+		}
+
+		tiersByName[tier.name] = tier
+	}
+
+	tiersByConsumer := make(map[string]*rateLimitTier)
+	for _, consumer := range rateLimitsConfig.Consumers {
+		if len(consumer.Name) == 0 {
+			return nil, fmt.Errorf("%w: empty name", ErrInvalidRateLimitConsumer)
+		}
+		_, exists := tiersByConsumer[consumer.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedRateLimitConsumer, consumer.Name)
+		}
+		tier, found := tiersByName[consumer.Tier]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownRateLimitTier, consumer.Tier)
+		}
+
+		tiersByConsumer[consumer.Name] = tier
+	}
+
+	return tiersByConsumer, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware for limiting the number of requests
 func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		endpoint := c.FullPath()
+		settings := rl.getSettings()
+		tier, clientID, clientDescription := settings.identifyClient(c)
+
+		budgets := settings.getBudgets(c.FullPath(), tier, clientID)
+		if len(budgets) == 0 {
+			return
+		}
+
+		result := rl.consume(settings, budgets)
 
-		limitForEndpoint, isEndpointLimited := rl.limits[endpoint]
-		if !isEndpointLimited {
+		c.Header(rateLimitLimitHeader, strconv.FormatUint(result.limit, 10))
+		c.Header(rateLimitRemainingHeader, strconv.FormatUint(result.remaining, 10))
+		c.Header(rateLimitResetHeader, formatSeconds(result.reset))
+		if result.isAllowed {
 			return
 		}
 
-		clientIP := c.ClientIP()
-		key := fmt.Sprintf("%s_%s", endpoint, clientIP)
-
-		numRequests := rl.addInRequestsMap(key)
-		if numRequests >= limitForEndpoint {
-			printMessage := fmt.Sprintf("your IP exceeded the limit of %d requests in %v for this endpoint", limitForEndpoint, rl.countDuration)
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, data.GenericAPIResponse{
-				Data:  nil,
-				Error: printMessage,
-				Code:  data.ReturnCode(ReturnCodeRequestError),
-			})
+		c.Header(retryAfterHeader, formatSeconds(result.retryAfter))
+		printMessage := fmt.Sprintf("%s exceeded the limit of %d requests in %v %s",
+			clientDescription, result.exceeded.limit, settings.window, result.exceeded.description)
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, data.GenericAPIResponse{
+			Data:  nil,
+			Error: printMessage,
+			Code:  data.ReturnCode(ReturnCodeRequestError),
+		})
+	}
+}
+
+// identifyClient returns the tier of the client and its identifier. The consumers are authenticated, and set in the
+// context, by the authenticator, so the rate limiter does not keep credentials of its own. The clients that are not
+// authenticated, or whose consumer is not assigned to a tier, are identified by their IP
+func (settings *rateLimitSettings) identifyClient(c *gin.Context) (*rateLimitTier, string, string) {
+	consumer := c.GetString(AuthenticatedConsumerKey)
+	if len(consumer) > 0 {
+		tier, found := settings.tiersByConsumer[consumer]
+		if found {
+			return tier, "consumer:" + consumer, "your credentials"
+		}
+	}
+
+	return settings.anonymousTier, "ip:" + c.ClientIP(), "your IP"
+}
+
+func (settings *rateLimitSettings) getBudgets(endpoint string, tier *rateLimitTier, clientID string) []*rateLimitBudget {
+	budgets := make([]*rateLimitBudget, 0, 2)
+
+	limitForEndpoint, isEndpointLimited := settings.limits[endpoint]
+	if isEndpointLimited && limitForEndpoint > 0 && !tier.disableEndpointLimits {
+		limit := uint64(math.Max(1, math.Round(float64(limitForEndpoint)*tier.endpointLimitMultiplier)))
+		budgets = append(budgets, &rateLimitBudget{
+			bucketKey:   fmt.Sprintf("%s_%s", endpoint, clientID),
+			limit:       limit,
+			description: "for this endpoint",
+		})
+	}
+
+	if tier.globalLimit > 0 {
+		budgets = append(budgets, &rateLimitBudget{
+			bucketKey:   fmt.Sprintf("%s_%s", globalBudgetName, clientID),
+			limit:       tier.globalLimit,
+			description: "across all endpoints",
+		})
+	}
+
+	return budgets
+}
+
+// consume takes a token from each of the provided budgets, only if all of them have one. The returned limit,
+// remaining and reset values are the ones of the most restrictive budget
+func (rl *rateLimiter) consume(settings *rateLimitSettings, budgets []*rateLimitBudget) *rateLimitResult {
+	rl.mutBuckets.Lock()
+	defer rl.mutBuckets.Unlock()
+
+	now := rl.getTimeHandler()
+	buckets := make([]*tokenBucket, 0, len(budgets))
+	result := &rateLimitResult{
+		isAllowed: true,
+	}
+	for idx, budget := range budgets {
+		bucket, found := rl.buckets[budget.bucketKey]
+		if !found {
+			bucket = newTokenBucket(budget.limit, settings.window, now)
+			rl.buckets[budget.bucketKey] = bucket
+		}
+		bucket.refill(now)
+		buckets = append(buckets, bucket)
+
+		if !bucket.hasToken() {
+			result.isAllowed = false
+			timeUntilToken := bucket.timeUntilToken()
+			if timeUntilToken > result.retryAfter {
+				result.retryAfter = timeUntilToken
+				result.exceeded = budgets[idx]
+			}
 		}
 	}
+
+	for idx, bucket := range buckets {
+		if result.isAllowed {
+			bucket.take()
+		}
+
+		isMostRestrictive := idx == 0 || bucket.remaining() < result.remaining
+		if isMostRestrictive {
+			result.limit = budgets[idx].limit
+			result.remaining = bucket.remaining()
+			result.reset = bucket.timeUntilFull()
+		}
+	}
+
+	return result
 }
 
-func (rl *rateLimiter) addInRequestsMap(key string) uint64 {
-	rl.mutRequestsMap.Lock()
-	defer rl.mutRequestsMap.Unlock()
+func (rl *rateLimiter) cleanupIdleBuckets(ctx context.Context) {
+	timer := time.NewTimer(rl.getSettings().window)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(rl.getSettings().window)
 
-	_, ok := rl.requestsMap[key]
-	if !ok {
-		rl.requestsMap[key] = 1
-		return 1
+		select {
+		case <-timer.C:
+			rl.removeFullBuckets()
+		case <-ctx.Done():
+			log.Debug("finishing rate limiter buckets cleanup...")
+			return
+		}
 	}
+}
 
-	rl.requestsMap[key]++
+// removeFullBuckets removes the buckets of the clients that have not made requests for a while. A new full bucket
+// will be created on their next request, so this does not change the limits
+func (rl *rateLimiter) removeFullBuckets() {
+	rl.mutBuckets.Lock()
+	defer rl.mutBuckets.Unlock()
+
+	now := rl.getTimeHandler()
+	for key, bucket := range rl.buckets {
+		bucket.refill(now)
+		if bucket.isFull() {
+			delete(rl.buckets, key)
+		}
+	}
+
+	log.Debug("rate limiter idle buckets removed", "remaining buckets", len(rl.buckets))
+}
 
-	return rl.requestsMap[key]
+func formatSeconds(duration time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(duration.Seconds())), 10)
 }
 
-// ResetMap has to be called from outside at a given interval so the requests map will be cleaned and older restrictions
-// would be erased
-func (rl *rateLimiter) ResetMap(version string) {
-	rl.mutRequestsMap.Lock()
-	rl.requestsMap = make(map[string]uint64)
-	rl.mutRequestsMap.Unlock()
+// Close stops the cleanup of the idle buckets
+func (rl *rateLimiter) Close() error {
+	rl.cancelFunc()
 
-	log.Info("rate limiter map has been reset", "version", version, "time", time.Now())
+	return nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
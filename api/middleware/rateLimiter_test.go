@@ -1,103 +1,322 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreSha256 "github.com/multiversx/mx-chain-core-go/hashing/sha256"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestNewRateLimiter_NilLimitsMapShouldErr(t *testing.T) {
+func TestNewRateLimiter(t *testing.T) {
 	t.Parallel()
 
-	rl, err := NewRateLimiter(nil, time.Millisecond)
-	require.Equal(t, ErrNilLimitsMapForEndpoints, err)
-	require.True(t, check.IfNil(rl))
+	t.Run("nil limits map should error", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(nil, time.Millisecond, config.RateLimitsConfig{})
+		require.Equal(t, ErrNilLimitsMapForEndpoints, err)
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("invalid window should error", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(map[string]uint64{}, 0, config.RateLimitsConfig{})
+		require.True(t, errors.Is(err, ErrInvalidRateLimitWindow))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("invalid tier should error", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(map[string]uint64{}, time.Second, config.RateLimitsConfig{
+			Anonymous: config.RateLimitTierConfig{EndpointLimitMultiplier: -1},
+		})
+		require.True(t, errors.Is(err, ErrInvalidRateLimitTier))
+		require.True(t, check.IfNil(rl))
+
+		rl, err = NewRateLimiter(map[string]uint64{}, time.Second, config.RateLimitsConfig{
+			Tiers: []config.RateLimitTierConfig{{Name: ""}},
+		})
+		require.True(t, errors.Is(err, ErrInvalidRateLimitTier))
+		require.True(t, check.IfNil(rl))
+
+		rl, err = NewRateLimiter(map[string]uint64{}, time.Second, config.RateLimitsConfig{
+			Tiers: []config.RateLimitTierConfig{{Name: anonymousTierName}},
+		})
+		require.True(t, errors.Is(err, ErrInvalidRateLimitTier))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("duplicated tier should error", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(map[string]uint64{}, time.Second, config.RateLimitsConfig{
+			Tiers: []config.RateLimitTierConfig{{Name: "basic"}, {Name: "basic"}},
+		})
+		require.True(t, errors.Is(err, ErrDuplicatedRateLimitTier))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("unknown tier should error", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(map[string]uint64{}, time.Second, config.RateLimitsConfig{
+			Consumers: []config.RateLimitConsumerConfig{{Name: "apikey:operations", Tier: "basic"}},
+		})
+		require.True(t, errors.Is(err, ErrUnknownRateLimitTier))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("empty consumer name should error", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(map[string]uint64{}, time.Second, config.RateLimitsConfig{
+			Tiers:     []config.RateLimitTierConfig{{Name: "basic"}},
+			Consumers: []config.RateLimitConsumerConfig{{Name: "", Tier: "basic"}},
+		})
+		require.True(t, errors.Is(err, ErrInvalidRateLimitConsumer))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("duplicated consumer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(map[string]uint64{}, time.Second, config.RateLimitsConfig{
+			Tiers: []config.RateLimitTierConfig{{Name: "basic"}},
+			Consumers: []config.RateLimitConsumerConfig{
+				{Name: "apikey:operations", Tier: "basic"},
+				{Name: "apikey:operations", Tier: "basic"},
+			},
+		})
+		require.True(t, errors.Is(err, ErrDuplicatedRateLimitConsumer))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(map[string]uint64{"abc": 5}, time.Millisecond, config.RateLimitsConfig{})
+		require.NoError(t, err)
+		require.False(t, check.IfNil(rl))
+		require.Nil(t, rl.Close())
+	})
 }
 
-func TestNewRateLimiter_ShouldWork(t *testing.T) {
+func TestRateLimiter_IpRestrictionRaisedAndRefilled(t *testing.T) {
 	t.Parallel()
 
-	rl, err := NewRateLimiter(map[string]uint64{"abc": 5}, time.Millisecond)
+	rl, err := NewRateLimiter(map[string]uint64{"/address/:address": 2}, 10*time.Second, config.RateLimitsConfig{})
 	require.NoError(t, err)
-	require.False(t, check.IfNil(rl))
+	defer func() {
+		_ = rl.Close()
+	}()
+	currentTime := time.Now()
+	rl.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	ws := startProxyServer(createAccountsGroup(t), rl, 2, "/address")
+
+	resp := doRequest(ws)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "2", resp.Header().Get(rateLimitLimitHeader))
+	assert.Equal(t, "1", resp.Header().Get(rateLimitRemainingHeader))
+	assert.Equal(t, "5", resp.Header().Get(rateLimitResetHeader))
+
+	resp = doRequest(ws)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "0", resp.Header().Get(rateLimitRemainingHeader))
+	assert.Equal(t, "10", resp.Header().Get(rateLimitResetHeader))
+
+	resp = doRequest(ws)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "0", resp.Header().Get(rateLimitRemainingHeader))
+	assert.Equal(t, "5", resp.Header().Get(retryAfterHeader))
+	assert.Contains(t, resp.Body.String(), "your IP exceeded the limit of 2 requests in 10s for this endpoint")
+
+	// half of the window restores one token
+	currentTime = currentTime.Add(5 * time.Second)
+	resp = doRequest(ws)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resp.Header().Get(retryAfterHeader))
+
+	resp = doRequest(ws)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
 }
 
-func TestRateLimiter_IpRestrictionRaisedAndErased(t *testing.T) {
+func TestRateLimiter_ConsumerTiers(t *testing.T) {
 	t.Parallel()
 
-	rl, err := NewRateLimiter(map[string]uint64{"/address/:address": 2}, time.Millisecond)
+	rateLimitsConfig := config.RateLimitsConfig{
+		Tiers: []config.RateLimitTierConfig{
+			{Name: "premium", EndpointLimitMultiplier: 3},
+		},
+		Consumers: []config.RateLimitConsumerConfig{
+			{Name: "apikey:operations", Tier: "premium"},
+		},
+	}
+	rl, err := NewRateLimiter(map[string]uint64{"/address/:address": 1}, time.Minute, rateLimitsConfig)
 	require.NoError(t, err)
+	defer func() {
+		_ = rl.Close()
+	}()
 
-	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
-			return &data.AccountModel{
-				Account: data.Account{
-					Address: address,
-					Nonce:   1,
-					Balance: "100",
-				},
-			}, nil
+	auth, err := NewAuthenticator(config.CredentialsConfig{
+		APIKeys: []config.APIKeyConfig{
+			{Name: "operations", KeyHash: hashHex("operations-key")},
+			{Name: "monitoring", KeyHash: hashHex("monitoring-key")},
 		},
-	}
-	addressGroup, err := groups.NewAccountsGroup(facade)
+	}, coreSha256.NewSha256(), map[string][]string{})
+	require.NoError(t, err)
+	routeAccess, err := NewRouteAccess(map[string]data.RouteConfig{
+		"/address/:address": {Name: "/:address", Open: true, RateLimit: 1},
+	}, auth)
 	require.NoError(t, err)
-	ws := startProxyServer(addressGroup, rl, 2, "/address")
 
-	resp := httptest.NewRecorder()
-	context, _ := gin.CreateTestContext(resp)
-	req, _ := http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	ws.ServeHTTP(resp, req)
+	ws := gin.New()
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"address": {Routes: []data.RouteConfig{{Name: "/:address", Open: true, RateLimit: 1}}},
+		},
+	}
+	createAccountsGroup(t).RegisterRoutes(ws.Group("/address"), apiConfig, routeAccess.MiddlewareHandlerFunc(), rl.MiddlewareHandlerFunc(), emptyGinHandler)
+
+	doRequestWithKey := func(apiKey string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", "/address/test", nil)
+		if len(apiKey) > 0 {
+			req.Header.Set("Authorization", "Bearer "+apiKey)
+		}
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	for i := 0; i < 3; i++ {
+		resp := doRequestWithKey("operations-key")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "3", resp.Header().Get(rateLimitLimitHeader))
+	}
+	resp := doRequestWithKey("operations-key")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Contains(t, resp.Body.String(), "your credentials exceeded the limit of 3 requests")
+
+	// the unknown keys and the consumers without a tier are treated as anonymous and do not share the budget of the
+	// consumer with a tier
+	resp = doRequestWithKey("unknown-key")
 	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "1", resp.Header().Get(rateLimitLimitHeader))
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+	resp = doRequestWithKey("monitoring-key")
 	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Contains(t, resp.Body.String(), "your IP exceeded the limit of 1 requests")
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+	resp = doRequestWithKey("")
 	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
 
-	rl.ResetMap("")
+func TestRateLimiter_GlobalBudget(t *testing.T) {
+	t.Parallel()
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
+	rateLimitsConfig := config.RateLimitsConfig{
+		Anonymous: config.RateLimitTierConfig{
+			GlobalLimit: 3,
+		},
+	}
+	rl, err := NewRateLimiter(map[string]uint64{"/address/:address": 5}, time.Minute, rateLimitsConfig)
+	require.NoError(t, err)
+	defer func() {
+		_ = rl.Close()
+	}()
+
+	accountsGroup := createAccountsGroup(t)
+	ws := gin.New()
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"address": {Routes: []data.RouteConfig{
+				{Name: "/:address", Open: true, RateLimit: 5},
+				{Name: "/:address/balance", Open: true},
+			}},
+		},
+	}
+	accountsGroup.RegisterRoutes(ws.Group("/address"), apiConfig, emptyGinHandler, rl.MiddlewareHandlerFunc(), emptyGinHandler)
+
+	paths := []string{"/address/test", "/address/test/balance", "/address/test"}
+	for idx, path := range paths {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		// the global budget is the most restrictive one
+		assert.Equal(t, "3", resp.Header().Get(rateLimitLimitHeader))
+		assert.Equal(t, strconv.Itoa(2-idx), resp.Header().Get(rateLimitRemainingHeader))
+	}
+
+	req, _ := http.NewRequest("GET", "/address/test/balance", nil)
+	resp := httptest.NewRecorder()
 	ws.ServeHTTP(resp, req)
-	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "20", resp.Header().Get(retryAfterHeader))
+	assert.Contains(t, resp.Body.String(), "your IP exceeded the limit of 3 requests in 1m0s across all endpoints")
+
+	// the rejected request did not consume from the endpoint budget
+	rl.mutBuckets.Lock()
+	endpointBucket := rl.buckets["/address/:address_ip:"]
+	rl.mutBuckets.Unlock()
+	require.NotNil(t, endpointBucket)
+	assert.Equal(t, uint64(3), endpointBucket.remaining())
 }
 
-func TestRateLimiter_EndpointNotLimitedShouldNotRaiseRestrictions(t *testing.T) {
+func TestRateLimiter_RemoveFullBuckets(t *testing.T) {
 	t.Parallel()
 
-	rl, err := NewRateLimiter(map[string]uint64{"/address/:address/nonce": 1}, time.Millisecond)
-	require.NoError(t, err)
-
-	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
-			return &data.AccountModel{
-				Account: data.Account{
-					Address: address,
-					Nonce:   1,
-					Balance: "100",
-				},
-			}, nil
+	rateLimitsConfig := config.RateLimitsConfig{
+		Anonymous: config.RateLimitTierConfig{
+			GlobalLimit: 1,
 		},
 	}
-	addressGroup, err := groups.NewAccountsGroup(facade)
+	rl, err := NewRateLimiter(map[string]uint64{"/address/:address": 2}, 10*time.Second, rateLimitsConfig)
 	require.NoError(t, err)
-	ws := startProxyServer(addressGroup, rl, 1, "/address")
+	defer func() {
+		_ = rl.Close()
+	}()
+	currentTime := time.Now()
+	rl.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	ws := startProxyServer(createAccountsGroup(t), rl, 2, "/address")
+	_ = doRequest(ws)
+	require.Len(t, rl.buckets, 2)
+
+	// the endpoint bucket is full again, but the global one is not
+	currentTime = currentTime.Add(5 * time.Second)
+	rl.removeFullBuckets()
+	require.Len(t, rl.buckets, 1)
+
+	currentTime = currentTime.Add(5 * time.Second)
+	rl.removeFullBuckets()
+	require.Len(t, rl.buckets, 0)
+}
+
+func TestRateLimiter_EndpointNotLimitedShouldNotRaiseRestrictions(t *testing.T) {
+	t.Parallel()
+
+	rl, err := NewRateLimiter(map[string]uint64{"/address/:address/nonce": 1}, time.Millisecond, config.RateLimitsConfig{})
+	require.NoError(t, err)
+	defer func() {
+		_ = rl.Close()
+	}()
+
+	ws := startProxyServer(createAccountsGroup(t), rl, 1, "/address")
 
 	resp := httptest.NewRecorder()
 	context, _ := gin.CreateTestContext(resp)
@@ -116,6 +335,40 @@ func TestRateLimiter_EndpointNotLimitedShouldNotRaiseRestrictions(t *testing.T)
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
 
+func TestRateLimiter_SetLimits(t *testing.T) {
+	t.Parallel()
+
+	rl, err := NewRateLimiter(map[string]uint64{"/address/:address": 1}, 10*time.Second, config.RateLimitsConfig{})
+	require.NoError(t, err)
+	defer func() {
+		_ = rl.Close()
+	}()
+
+	ws := startProxyServer(createAccountsGroup(t), rl, 1, "/address")
+
+	resp := doRequest(ws)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = doRequest(ws)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	err = rl.SetLimits(nil, time.Second, config.RateLimitsConfig{})
+	assert.Equal(t, ErrNilLimitsMapForEndpoints, err)
+	err = rl.SetLimits(map[string]uint64{}, 0, config.RateLimitsConfig{})
+	assert.True(t, errors.Is(err, ErrInvalidRateLimitWindow))
+
+	// the invalid settings should not reset the buckets
+	resp = doRequest(ws)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	err = rl.SetLimits(map[string]uint64{"/address/:address": 3}, time.Minute, config.RateLimitsConfig{})
+	require.NoError(t, err)
+
+	resp = doRequest(ws)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "3", resp.Header().Get(rateLimitLimitHeader))
+	assert.Equal(t, "2", resp.Header().Get(rateLimitRemainingHeader))
+}
+
 func startProxyServer(group data.GroupHandler, rateLimiter RateLimiterHandler, rateLimit uint64, path string) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -136,3 +389,29 @@ func startProxyServer(group data.GroupHandler, rateLimiter RateLimiterHandler, r
 	group.RegisterRoutes(routes, apiConfig, emptyGinHandler, rateLimiter.MiddlewareHandlerFunc(), emptyGinHandler)
 	return ws
 }
+
+func createAccountsGroup(t *testing.T) data.GroupHandler {
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{
+				Account: data.Account{
+					Address: address,
+					Nonce:   1,
+					Balance: "100",
+				},
+			}, nil
+		},
+	}
+	accountsGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+
+	return accountsGroup
+}
+
+func doRequest(ws *gin.Engine) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -20,11 +21,16 @@ const (
 	prefixBadRequest           = "[bad request]"
 	prefixInternalError        = "[internal error]"
 	maxLengthRequestOrResponse = 400
+	// maxBufferedResponseLength bounds the response bytes kept for logging, so the long-lived streams are not
+	// buffered in memory
+	maxBufferedResponseLength = 4096
 )
 
 // TODO: remove this file and use the same middleware from mx-chain-go after it is merged
 
 type responseLoggerMiddleware struct {
+	mutSettings                        sync.RWMutex
+	isEnabled                          bool
 	thresholdDurationForLoggingRequest time.Duration
 	printRequestFunc                   func(title string, path string, duration time.Duration, status int, clientIP string, request string, response string)
 }
@@ -32,6 +38,7 @@ type responseLoggerMiddleware struct {
 // NewResponseLoggerMiddleware returns a new instance of responseLoggerMiddleware
 func NewResponseLoggerMiddleware(thresholdDurationForLoggingRequest time.Duration) *responseLoggerMiddleware {
 	rlm := &responseLoggerMiddleware{
+		isEnabled:                          true,
 		thresholdDurationForLoggingRequest: thresholdDurationForLoggingRequest,
 	}
 
@@ -40,9 +47,29 @@ func NewResponseLoggerMiddleware(thresholdDurationForLoggingRequest time.Duratio
 	return rlm
 }
 
+// SetSettings enables or disables the logging of the requests and changes the duration threshold above which they are logged
+func (rlm *responseLoggerMiddleware) SetSettings(isEnabled bool, thresholdDurationForLoggingRequest time.Duration) {
+	rlm.mutSettings.Lock()
+	rlm.isEnabled = isEnabled
+	rlm.thresholdDurationForLoggingRequest = thresholdDurationForLoggingRequest
+	rlm.mutSettings.Unlock()
+}
+
+func (rlm *responseLoggerMiddleware) getSettings() (bool, time.Duration) {
+	rlm.mutSettings.RLock()
+	defer rlm.mutSettings.RUnlock()
+
+	return rlm.isEnabled, rlm.thresholdDurationForLoggingRequest
+}
+
 // MiddlewareHandlerFunc logs detail about a request if it is not successful or it's duration is higher than a threshold
 func (rlm *responseLoggerMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		isEnabled, thresholdDurationForLoggingRequest := rlm.getSettings()
+		if !isEnabled {
+			return
+		}
+
 		t := time.Now()
 
 		// read the body for logging purposes and restore it into the context
@@ -61,7 +88,7 @@ func (rlm *responseLoggerMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
 		latency := time.Since(t)
 		status := c.Writer.Status()
 
-		shouldLogRequest := latency > rlm.thresholdDurationForLoggingRequest || c.Writer.Status() != http.StatusOK
+		shouldLogRequest := latency > thresholdDurationForLoggingRequest || c.Writer.Status() != http.StatusOK
 		if shouldLogRequest {
 			requestBodyString = prepareLog(requestBodyString)
 			responseBodyString := prepareLog(bw.body.String())
@@ -127,6 +154,13 @@ type bodyWriter struct {
 }
 
 func (w bodyWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+	remaining := maxBufferedResponseLength - w.body.Len()
+	if remaining > len(b) {
+		remaining = len(b)
+	}
+	if remaining > 0 {
+		w.body.Write(b[:remaining])
+	}
+
 	return w.ResponseWriter.Write(b)
 }
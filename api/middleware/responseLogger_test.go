@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
@@ -163,3 +164,57 @@ func TestResponseLoggerMiddleware_ShouldNotCallHandler(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.False(t, handlerWasCalled)
 }
+
+func TestResponseLoggerMiddleware_SetSettings(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetAccountHandler: func(_ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return nil, errors.New("internal err")
+		},
+	}
+
+	numCalls := 0
+	printHandler := func(title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
+		numCalls++
+	}
+
+	rlm := NewResponseLoggerMiddleware(10000 * time.Millisecond)
+	rlm.printRequestFunc = printHandler
+
+	ws := startApiServerResponseLogger(&facade, rlm)
+	doBalanceRequest := func() {
+		req, _ := http.NewRequest("GET", "/address/addr/balance", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+	}
+
+	doBalanceRequest()
+	assert.Equal(t, 1, numCalls)
+
+	rlm.SetSettings(false, time.Millisecond)
+	doBalanceRequest()
+	assert.Equal(t, 1, numCalls)
+
+	rlm.SetSettings(true, time.Millisecond)
+	doBalanceRequest()
+	assert.Equal(t, 2, numCalls)
+}
+
+func TestBodyWriter_ShouldBoundTheBufferedResponse(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	bw := &bodyWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
+
+	chunk := []byte(strings.Repeat("a", 1000))
+	for i := 0; i < 10; i++ {
+		n, err := bw.Write(chunk)
+		assert.Nil(t, err)
+		assert.Equal(t, len(chunk), n)
+	}
+
+	assert.Equal(t, maxBufferedResponseLength, bw.body.Len())
+	assert.Equal(t, 10*len(chunk), recorder.Body.Len())
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// routeAccess applies the Open and Secured flags of the routes config on each request, so that they can be changed
+// without registering the endpoints again. The requests made to the secured endpoints are passed to the authenticator,
+// while the consumers making requests to the open ones are only identified, so they get the rate limits of their tiers
+type routeAccess struct {
+	authenticationFunc gin.HandlerFunc
+	identificationFunc gin.HandlerFunc
+
+	mutRoutes sync.RWMutex
+	routes    map[string]data.RouteConfig
+}
+
+// NewRouteAccess returns a new instance of routeAccess. The routes map holds the config of each endpoint, keyed by its
+// full path
+func NewRouteAccess(routes map[string]data.RouteConfig, authenticator ConsumerAuthenticator) (*routeAccess, error) {
+	if routes == nil {
+		return nil, ErrNilRoutesMap
+	}
+	if check.IfNil(authenticator) {
+		return nil, ErrNilAuthenticator
+	}
+
+	return &routeAccess{
+		authenticationFunc: authenticator.MiddlewareHandlerFunc(),
+		identificationFunc: authenticator.IdentificationHandlerFunc(),
+		routes:             routes,
+	}, nil
+}
+
+// SetRoutes replaces the routes config
+func (ra *routeAccess) SetRoutes(routes map[string]data.RouteConfig) error {
+	if routes == nil {
+		return ErrNilRoutesMap
+	}
+
+	ra.mutRoutes.Lock()
+	ra.routes = routes
+	ra.mutRoutes.Unlock()
+
+	return nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects the requests made to the closed endpoints and
+// authenticates the requests made to the secured ones
+func (ra *routeAccess) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ra.mutRoutes.RLock()
+		route, found := ra.routes[c.FullPath()]
+		ra.mutRoutes.RUnlock()
+
+		if !found || !route.Open {
+			c.AbortWithStatusJSON(http.StatusNotFound, data.GenericAPIResponse{
+				Data:  nil,
+				Error: "endpoint is not opened",
+				Code:  data.ReturnCodeRequestError,
+			})
+			return
+		}
+
+		isSecured := route.Secured || len(route.Scopes) > 0
+		if isSecured {
+			ra.authenticationFunc(c)
+			return
+		}
+
+		ra.identificationFunc(c)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ra *routeAccess) IsInterfaceNil() bool {
+	return ra == nil
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type authenticatorStub struct {
+	numCalls               int
+	numIdentificationCalls int
+}
+
+func (stub *authenticatorStub) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		stub.numCalls++
+		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+}
+
+func (stub *authenticatorStub) IdentificationHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		stub.numIdentificationCalls++
+	}
+}
+
+func (stub *authenticatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewRouteAccess(t *testing.T) {
+	t.Parallel()
+
+	ra, err := NewRouteAccess(nil, &authenticatorStub{})
+	assert.Equal(t, ErrNilRoutesMap, err)
+	assert.True(t, check.IfNil(ra))
+
+	ra, err = NewRouteAccess(map[string]data.RouteConfig{}, nil)
+	assert.Equal(t, ErrNilAuthenticator, err)
+	assert.True(t, check.IfNil(ra))
+
+	ra, err = NewRouteAccess(map[string]data.RouteConfig{}, &authenticatorStub{})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(ra))
+}
+
+func TestRouteAccess_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	authenticator := &authenticatorStub{}
+	ra, err := NewRouteAccess(map[string]data.RouteConfig{
+		"/open":   {Name: "/open", Open: true},
+		"/closed": {Name: "/closed", Open: false},
+	}, authenticator)
+	require.Nil(t, err)
+
+	ws := gin.New()
+	for _, path := range []string{"/open", "/closed", "/unknown"} {
+		ws.GET(path, ra.MiddlewareHandlerFunc(), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	}
+	doGet := func(path string) int {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	assert.Equal(t, http.StatusOK, doGet("/open"))
+	assert.Equal(t, http.StatusNotFound, doGet("/closed"))
+	assert.Equal(t, http.StatusNotFound, doGet("/unknown"))
+	assert.Zero(t, authenticator.numCalls)
+	assert.Equal(t, 1, authenticator.numIdentificationCalls)
+
+	err = ra.SetRoutes(nil)
+	assert.Equal(t, ErrNilRoutesMap, err)
+
+	err = ra.SetRoutes(map[string]data.RouteConfig{
+		"/open":   {Name: "/open", Open: true, Scopes: []string{"actions"}},
+		"/closed": {Name: "/closed", Open: true},
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, doGet("/open"))
+	assert.Equal(t, 1, authenticator.numCalls)
+	assert.Equal(t, 1, authenticator.numIdentificationCalls)
+	assert.Equal(t, http.StatusOK, doGet("/closed"))
+	assert.Equal(t, 2, authenticator.numIdentificationCalls)
+}
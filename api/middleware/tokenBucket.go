@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"math"
+	"time"
+)
+
+// tokenBucket holds up to capacity tokens and is refilled continuously, so that capacity tokens are added over a
+// window. Each request consumes a token
+type tokenBucket struct {
+	capacity        float64
+	tokens          float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+
+func newTokenBucket(capacity uint64, window time.Duration, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity:        float64(capacity),
+		tokens:          float64(capacity),
+		refillPerSecond: float64(capacity) / window.Seconds(),
+		lastRefill:      now,
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(tb.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	tb.tokens = math.Min(tb.capacity, tb.tokens+elapsed*tb.refillPerSecond)
+	tb.lastRefill = now
+}
+
+func (tb *tokenBucket) hasToken() bool {
+	return tb.tokens >= 1
+}
+
+func (tb *tokenBucket) take() {
+	tb.tokens--
+}
+
+func (tb *tokenBucket) remaining() uint64 {
+	return uint64(math.Max(0, math.Floor(tb.tokens)))
+}
+
+func (tb *tokenBucket) isFull() bool {
+	return tb.tokens >= tb.capacity
+}
+
+func (tb *tokenBucket) timeUntilToken() time.Duration {
+	return tb.timeUntil(1)
+}
+
+func (tb *tokenBucket) timeUntilFull() time.Duration {
+	return tb.timeUntil(tb.capacity)
+}
+
+func (tb *tokenBucket) timeUntil(tokens float64) time.Duration {
+	missing := tokens - tb.tokens
+	if missing <= 0 {
+		return 0
+	}
+
+	return time.Duration(missing / tb.refillPerSecond * float64(time.Second))
+}
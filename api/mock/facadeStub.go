@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -31,14 +32,28 @@ type FacadeStub struct {
 	GetLastPoolNonceForSenderHandler             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderHandler func(sender string) (*data.TransactionsPoolNonceGaps, error)
 	SendTransactionHandler                       func(tx *data.Transaction) (int, string, error)
+	BroadcastTransactionHandler                  func(tx *data.Transaction, options common.TransactionBroadcastOptions) (int, *data.TransactionBroadcastResponse, error)
+	IsTransactionBroadcastEnabledHandler         func() bool
+	IsTransactionTrackingEnabledHandler          func() bool
+	CheckTransactionTrackingHandler              func(callbackURL string) error
+	TrackTransactionHandler                      func(txHash string, callbackURL string) error
+	IsNonceReservationEnabledHandler             func() bool
+	ReserveNextNonceHandler                      func(sender string) (*data.NonceReservation, error)
+	DiagnoseSenderNoncesHandler                  func(sender string) (*data.SenderNonceDiagnosis, error)
+	RepairSenderNonceGapsHandler                 func(sender string, txs []*data.Transaction) (*data.NonceGapsRepairResponse, error)
+	IsHyperblockStreamEnabledHandler             func() bool
+	SubscribeToHyperblocksHandler                func(options common.HyperblockStreamOptions) (data.HyperblockSubscription, error)
+	SubscribeToAddressActivityHandler            func(options common.AddressActivityOptions) (data.AddressActivitySubscription, error)
 	SendMultipleTransactionsHandler              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionHandler                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	ValidateTransactionHandler                   func(tx *data.Transaction) (*data.TransactionValidationResult, error)
 	SendUserFundsCalled                          func(receiver string, value *big.Int) error
 	ExecuteSCQueryHandler                        func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
 	GetHeartbeatDataHandler                      func() (*data.HeartbeatResponse, error)
 	ValidatorStatisticsHandler                   func() (map[string]*data.ValidatorApiResponse, error)
 	AuctionListHandler                           func() ([]*data.AuctionListValidatorAPIResponse, error)
 	TransactionCostRequestHandler                func(tx *data.Transaction) (*data.TxCostResponseData, error)
+	EstimateTransactionFeeHandler                func(tx *data.Transaction) (*data.TransactionFeeEstimation, error)
 	GetTransactionStatusHandler                  func(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatusHandler         func(txHash string) (*data.ProcessStatusResponse, error)
 	GetConfigMetricsHandler                      func() (*data.GenericAPIResponse, error)
@@ -70,6 +85,7 @@ type FacadeStub struct {
 	GetESDTSupplyCalled                          func(token string) (*data.ESDTSupplyResponse, error)
 	GetMetricsCalled                             func() map[string]*data.EndpointMetrics
 	GetPrometheusMetricsCalled                   func() string
+	GetObserversHealthCalled                     func() []*data.NodeHealth
 	GetGenesisNodesPubKeysCalled                 func() (*data.GenericAPIResponse, error)
 	GetGasConfigsCalled                          func() (*data.GenericAPIResponse, error)
 	IsOldStorageForTokenCalled                   func(tokenID string, nonce uint64) (bool, error)
@@ -82,11 +98,14 @@ type FacadeStub struct {
 	GetCodeHashCalled                            func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetGuardianDataCalled                        func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigratedCalled                     func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAccountDiffCalled                         func(address string, options common.AccountDiffOptions) (*data.AccountDiff, error)
 	GetWaitingEpochsLeftForPublicKeyCalled       func(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
+	GetAddressConverterCalled                    func() (core.PubkeyConverter, error)
+	GetLatestHyperblockNonceCalled               func() (uint64, error)
 }
 
 // GetProof -
-func (f *FacadeStub) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	if f.GetProofCalled != nil {
 		return f.GetProofCalled(rootHash, address)
 	}
@@ -95,7 +114,7 @@ func (f *FacadeStub) GetProof(rootHash string, address string) (*data.GenericAPI
 }
 
 // GetProofDataTrie -
-func (f *FacadeStub) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
 	if f.GetProofDataTrieCalled != nil {
 		return f.GetProofDataTrieCalled(rootHash, address, key)
 	}
@@ -104,7 +123,7 @@ func (f *FacadeStub) GetProofDataTrie(rootHash string, address string, key strin
 }
 
 // GetProofCurrentRootHash -
-func (f *FacadeStub) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
 	if f.GetProofCurrentRootHashCalled != nil {
 		return f.GetProofCurrentRootHashCalled(address)
 	}
@@ -113,7 +132,7 @@ func (f *FacadeStub) GetProofCurrentRootHash(address string) (*data.GenericAPIRe
 }
 
 // VerifyProof -
-func (f *FacadeStub) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	if f.VerifyProofCalled != nil {
 		return f.VerifyProofCalled(rootHash, address, proof)
 	}
@@ -149,7 +168,7 @@ func (f *FacadeStub) ReloadFullHistoryObservers() data.NodesReloadResponse {
 }
 
 // GetNetworkStatusMetrics -
-func (f *FacadeStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	if f.GetNetworkMetricsHandler != nil {
 		return f.GetNetworkMetricsHandler(shardID)
 	}
@@ -158,7 +177,7 @@ func (f *FacadeStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIRe
 }
 
 // GetNetworkConfigMetrics -
-func (f *FacadeStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetConfigMetricsHandler != nil {
 		return f.GetConfigMetricsHandler()
 	}
@@ -167,7 +186,7 @@ func (f *FacadeStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
 }
 
 // GetEconomicsDataMetrics -
-func (f *FacadeStub) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetEconomicsDataMetricsHandler != nil {
 		return f.GetEconomicsDataMetricsHandler()
 	}
@@ -176,7 +195,7 @@ func (f *FacadeStub) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
 }
 
 // GetAllIssuedESDTs -
-func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if f.GetAllIssuedESDTsHandler != nil {
 		return f.GetAllIssuedESDTsHandler(tokenType)
 	}
@@ -185,7 +204,7 @@ func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIRespon
 }
 
 // GetESDTsWithRole -
-func (f *FacadeStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTsWithRoleCalled != nil {
 		return f.GetESDTsWithRoleCalled(address, role, options)
 	}
@@ -194,7 +213,7 @@ func (f *FacadeStub) GetESDTsWithRole(address string, role string, options commo
 }
 
 // GetESDTsRoles -
-func (f *FacadeStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTsRolesCalled != nil {
 		return f.GetESDTsRolesCalled(address, options)
 	}
@@ -203,7 +222,7 @@ func (f *FacadeStub) GetESDTsRoles(address string, options common.AccountQueryOp
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetNFTTokenIDsRegisteredByAddressCalled != nil {
 		return f.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 	}
@@ -212,7 +231,7 @@ func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options c
 }
 
 // GetDirectStakedInfo -
-func (f *FacadeStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetDirectStakedInfoCalled != nil {
 		return f.GetDirectStakedInfoCalled()
 	}
@@ -221,7 +240,7 @@ func (f *FacadeStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetDelegatedInfo -
-func (f *FacadeStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetDelegatedInfoCalled != nil {
 		return f.GetDelegatedInfoCalled()
 	}
@@ -230,17 +249,17 @@ func (f *FacadeStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetEnableEpochsMetrics -
-func (f *FacadeStub) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetEnableEpochsMetricsHandler()
 }
 
 // GetRatingsConfig -
-func (f *FacadeStub) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetRatingsConfigCalled()
 }
 
 // GetESDTSupply -
-func (f *FacadeStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
+func (f *FacadeStub) GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error) {
 	if f.GetESDTSupplyCalled != nil {
 		return f.GetESDTSupplyCalled(token)
 	}
@@ -249,7 +268,7 @@ func (f *FacadeStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, erro
 }
 
 // ValidatorStatistics -
-func (f *FacadeStub) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (f *FacadeStub) ValidatorStatistics(ctx context.Context) (map[string]*data.ValidatorApiResponse, error) {
 	if f.ValidatorStatisticsHandler != nil {
 		return f.ValidatorStatisticsHandler()
 	}
@@ -258,7 +277,7 @@ func (f *FacadeStub) ValidatorStatistics() (map[string]*data.ValidatorApiRespons
 }
 
 // AuctionList -
-func (f *FacadeStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
+func (f *FacadeStub) AuctionList(ctx context.Context) ([]*data.AuctionListValidatorAPIResponse, error) {
 	if f.AuctionListHandler != nil {
 		return f.AuctionListHandler()
 	}
@@ -267,27 +286,27 @@ func (f *FacadeStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, err
 }
 
 // GetAccount -
-func (f *FacadeStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+func (f *FacadeStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
 	return f.GetAccountHandler(address, options)
 }
 
 // GetAccounts -
-func (f *FacadeStub) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+func (f *FacadeStub) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	return f.GetAccountsHandler(addresses, options)
 }
 
 // GetKeyValuePairs -
-func (f *FacadeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetKeyValuePairsHandler(address, options)
 }
 
 // GetValueForKey -
-func (f *FacadeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (f *FacadeStub) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	return f.GetValueForKeyHandler(address, key, options)
 }
 
 // GetGuardianData -
-func (f *FacadeStub) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetGuardianDataCalled(address, options)
 }
 
@@ -297,7 +316,7 @@ func (f *FacadeStub) GetShardIDForAddress(address string) (uint32, error) {
 }
 
 // GetESDTTokenData -
-func (f *FacadeStub) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTTokenDataCalled != nil {
 		return f.GetESDTTokenDataCalled(address, key, options)
 	}
@@ -306,7 +325,7 @@ func (f *FacadeStub) GetESDTTokenData(address string, key string, options common
 }
 
 // GetAllESDTTokens -
-func (f *FacadeStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetAllESDTTokensCalled != nil {
 		return f.GetAllESDTTokensCalled(address, options)
 	}
@@ -315,7 +334,7 @@ func (f *FacadeStub) GetAllESDTTokens(address string, options common.AccountQuer
 }
 
 // GetESDTNftTokenData -
-func (f *FacadeStub) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTNftTokenDataCalled != nil {
 		return f.GetESDTNftTokenDataCalled(address, key, nonce, options)
 	}
@@ -324,7 +343,7 @@ func (f *FacadeStub) GetESDTNftTokenData(address string, key string, nonce uint6
 }
 
 // IsOldStorageForToken -
-func (f *FacadeStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (f *FacadeStub) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
 	if f.IsOldStorageForTokenCalled != nil {
 		return f.IsOldStorageForTokenCalled(tokenID, nonce)
 	}
@@ -338,17 +357,17 @@ func (f *FacadeStub) GetTransactions(address string) ([]data.DatabaseTransaction
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (f *FacadeStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+func (f *FacadeStub) GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
 	return f.GetTransactionByHashAndSenderAddressHandler(txHash, sndAddr, withEvents)
 }
 
 // GetTransaction -
-func (f *FacadeStub) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (f *FacadeStub) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return f.GetTransactionHandler(txHash, withResults)
 }
 
 // GetTransactionsPool -
-func (f *FacadeStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (f *FacadeStub) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolHandler != nil {
 		return f.GetTransactionsPoolHandler(fields)
 	}
@@ -357,7 +376,7 @@ func (f *FacadeStub) GetTransactionsPool(fields string) (*data.TransactionsPool,
 }
 
 // GetTransactionsPoolForShard -
-func (f *FacadeStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (f *FacadeStub) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolForShardHandler != nil {
 		return f.GetTransactionsPoolForShardHandler(shardID, fields)
 	}
@@ -366,7 +385,7 @@ func (f *FacadeStub) GetTransactionsPoolForShard(shardID uint32, fields string)
 }
 
 // GetTransactionsPoolForSender -
-func (f *FacadeStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (f *FacadeStub) GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	if f.GetTransactionsPoolForSenderHandler != nil {
 		return f.GetTransactionsPoolForSenderHandler(sender, fields)
 	}
@@ -375,7 +394,7 @@ func (f *FacadeStub) GetTransactionsPoolForSender(sender, fields string) (*data.
 }
 
 // GetLastPoolNonceForSender -
-func (f *FacadeStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
+func (f *FacadeStub) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
 	if f.GetLastPoolNonceForSenderHandler != nil {
 		return f.GetLastPoolNonceForSenderHandler(sender)
 	}
@@ -384,7 +403,7 @@ func (f *FacadeStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
 }
 
 // GetTransactionsPoolNonceGapsForSender -
-func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	if f.GetTransactionsPoolNonceGapsForSenderHandler != nil {
 		return f.GetTransactionsPoolNonceGapsForSenderHandler(sender)
 	}
@@ -393,67 +412,202 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data
 }
 
 // SendTransaction -
-func (f *FacadeStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (f *FacadeStub) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
 	return f.SendTransactionHandler(tx)
 }
 
+// BroadcastTransaction -
+func (f *FacadeStub) BroadcastTransaction(ctx context.Context, tx *data.Transaction, options common.TransactionBroadcastOptions) (int, *data.TransactionBroadcastResponse, error) {
+	return f.BroadcastTransactionHandler(tx, options)
+}
+
+// IsTransactionTrackingEnabled -
+func (f *FacadeStub) IsTransactionTrackingEnabled() bool {
+	if f.IsTransactionTrackingEnabledHandler != nil {
+		return f.IsTransactionTrackingEnabledHandler()
+	}
+
+	return false
+}
+
+// CheckTransactionTracking -
+func (f *FacadeStub) CheckTransactionTracking(callbackURL string) error {
+	if f.CheckTransactionTrackingHandler != nil {
+		return f.CheckTransactionTrackingHandler(callbackURL)
+	}
+
+	return nil
+}
+
+// TrackTransaction -
+func (f *FacadeStub) TrackTransaction(txHash string, callbackURL string) error {
+	if f.TrackTransactionHandler != nil {
+		return f.TrackTransactionHandler(txHash, callbackURL)
+	}
+
+	return nil
+}
+
+// IsHyperblockStreamEnabled -
+func (f *FacadeStub) IsHyperblockStreamEnabled() bool {
+	if f.IsHyperblockStreamEnabledHandler != nil {
+		return f.IsHyperblockStreamEnabledHandler()
+	}
+
+	return false
+}
+
+// SubscribeToHyperblocks -
+func (f *FacadeStub) SubscribeToHyperblocks(_ context.Context, options common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+	if f.SubscribeToHyperblocksHandler != nil {
+		return f.SubscribeToHyperblocksHandler(options)
+	}
+
+	return nil, nil
+}
+
+// SubscribeToAddressActivity -
+func (f *FacadeStub) SubscribeToAddressActivity(_ context.Context, options common.AddressActivityOptions) (data.AddressActivitySubscription, error) {
+	if f.SubscribeToAddressActivityHandler != nil {
+		return f.SubscribeToAddressActivityHandler(options)
+	}
+
+	return nil, nil
+}
+
+// IsNonceReservationEnabled -
+func (f *FacadeStub) IsNonceReservationEnabled() bool {
+	if f.IsNonceReservationEnabledHandler != nil {
+		return f.IsNonceReservationEnabledHandler()
+	}
+
+	return false
+}
+
+// ReserveNextNonce -
+func (f *FacadeStub) ReserveNextNonce(_ context.Context, sender string) (*data.NonceReservation, error) {
+	if f.ReserveNextNonceHandler != nil {
+		return f.ReserveNextNonceHandler(sender)
+	}
+
+	return nil, nil
+}
+
+// DiagnoseSenderNonces -
+func (f *FacadeStub) DiagnoseSenderNonces(_ context.Context, sender string) (*data.SenderNonceDiagnosis, error) {
+	if f.DiagnoseSenderNoncesHandler != nil {
+		return f.DiagnoseSenderNoncesHandler(sender)
+	}
+
+	return nil, nil
+}
+
+// RepairSenderNonceGaps -
+func (f *FacadeStub) RepairSenderNonceGaps(_ context.Context, sender string, txs []*data.Transaction) (*data.NonceGapsRepairResponse, error) {
+	if f.RepairSenderNonceGapsHandler != nil {
+		return f.RepairSenderNonceGapsHandler(sender, txs)
+	}
+
+	return nil, nil
+}
+
+// IsTransactionBroadcastEnabled -
+func (f *FacadeStub) IsTransactionBroadcastEnabled() bool {
+	if f.IsTransactionBroadcastEnabledHandler != nil {
+		return f.IsTransactionBroadcastEnabledHandler()
+	}
+
+	return false
+}
+
+// ValidateTransaction -
+func (f *FacadeStub) ValidateTransaction(_ context.Context, tx *data.Transaction) (*data.TransactionValidationResult, error) {
+	if f.ValidateTransactionHandler != nil {
+		return f.ValidateTransactionHandler(tx)
+	}
+
+	return nil, nil
+}
+
 // SimulateTransaction -
-func (f *FacadeStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	return f.SimulateTransactionHandler(tx, checkSignature)
 }
 
 // GetAddressConverter -
 func (f *FacadeStub) GetAddressConverter() (core.PubkeyConverter, error) {
+	if f.GetAddressConverterCalled != nil {
+		return f.GetAddressConverterCalled()
+	}
+
 	return nil, nil
 }
 
+// GetLatestFullySynchronizedHyperblockNonce -
+func (f *FacadeStub) GetLatestFullySynchronizedHyperblockNonce(_ context.Context) (uint64, error) {
+	if f.GetLatestHyperblockNonceCalled != nil {
+		return f.GetLatestHyperblockNonceCalled()
+	}
+
+	return 0, nil
+}
+
 // SendMultipleTransactions -
-func (f *FacadeStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (f *FacadeStub) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	return f.SendMultipleTransactionsHandler(txs)
 }
 
 // TransactionCostRequest -
-func (f *FacadeStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (f *FacadeStub) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	return f.TransactionCostRequestHandler(tx)
 }
 
+// EstimateTransactionFee -
+func (f *FacadeStub) EstimateTransactionFee(_ context.Context, tx *data.Transaction) (*data.TransactionFeeEstimation, error) {
+	if f.EstimateTransactionFeeHandler != nil {
+		return f.EstimateTransactionFeeHandler(tx)
+	}
+
+	return &data.TransactionFeeEstimation{}, nil
+}
+
 // GetTransactionStatus -
-func (f *FacadeStub) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (f *FacadeStub) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
 	return f.GetTransactionStatusHandler(txHash, sender)
 }
 
 // GetProcessedTransactionStatus -
-func (f *FacadeStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+func (f *FacadeStub) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 	return f.GetProcessedTransactionStatusHandler(txHash)
 }
 
 // SendUserFunds -
-func (f *FacadeStub) SendUserFunds(receiver string, value *big.Int) error {
+func (f *FacadeStub) SendUserFunds(ctx context.Context, receiver string, value *big.Int) error {
 	return f.SendUserFundsCalled(receiver, value)
 }
 
 // ExecuteSCQuery -
-func (f *FacadeStub) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+func (f *FacadeStub) ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	return f.ExecuteSCQueryHandler(query)
 }
 
 // GetHeartbeatData -
-func (f *FacadeStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (f *FacadeStub) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
 	return f.GetHeartbeatDataHandler()
 }
 
 // GetBlockByHash -
-func (f *FacadeStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (f *FacadeStub) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return f.GetBlockByHashCalled(shardID, hash, options)
 }
 
 // GetBlockByNonce -
-func (f *FacadeStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (f *FacadeStub) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return f.GetBlockByNonceCalled(shardID, nonce, options)
 }
 
 // GetBlocksByRound -
-func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (f *FacadeStub) GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
 	if f.GetBlocksByRoundCalled != nil {
 		return f.GetBlocksByRoundCalled(round, options)
 	}
@@ -461,32 +615,32 @@ func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOpt
 }
 
 // GetInternalBlockByHash -
-func (f *FacadeStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByHashCalled(shardID, hash, format)
 }
 
 // GetInternalBlockByNonce -
-func (f *FacadeStub) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByNonceCalled(shardID, nonce, format)
 }
 
 // GetInternalMiniBlockByHash -
-func (f *FacadeStub) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return f.GetInternalMiniBlockByHashCalled(shardID, hash, epoch, format)
 }
 
 // GetInternalStartOfEpochMetaBlock -
-func (f *FacadeStub) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalStartOfEpochMetaBlockCalled(epoch, format)
 }
 
 // GetHyperBlockByHash -
-func (f *FacadeStub) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (f *FacadeStub) GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByHashCalled(hash, options)
 }
 
 // GetHyperBlockByNonce -
-func (f *FacadeStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (f *FacadeStub) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByNonceCalled(nonce, options)
 }
 
@@ -500,13 +654,18 @@ func (f *FacadeStub) GetMetricsForPrometheus() string {
 	return f.GetPrometheusMetricsCalled()
 }
 
+// GetObserversHealth -
+func (f *FacadeStub) GetObserversHealth() []*data.NodeHealth {
+	return f.GetObserversHealthCalled()
+}
+
 // GetGenesisNodesPubKeys -
-func (f *FacadeStub) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetGenesisNodesPubKeysCalled()
 }
 
 // GetGasConfigs -
-func (f *FacadeStub) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetGasConfigsCalled()
 }
 
@@ -516,12 +675,12 @@ func (f *FacadeStub) GetAboutInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetNodesVersions -
-func (f *FacadeStub) GetNodesVersions() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNodesVersions(ctx context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetNodesVersionsCalled()
 }
 
 // GetAlteredAccountsByNonce -
-func (f *FacadeStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (f *FacadeStub) GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	if f.GetAlteredAccountsByNonceCalled != nil {
 		return f.GetAlteredAccountsByNonceCalled(shardID, nonce, options)
 	}
@@ -529,7 +688,7 @@ func (f *FacadeStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, opt
 }
 
 // GetAlteredAccountsByHash -
-func (f *FacadeStub) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (f *FacadeStub) GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	if f.GetAlteredAccountsByHashCalled != nil {
 		return f.GetAlteredAccountsByHashCalled(shardID, hash, options)
 	}
@@ -538,7 +697,7 @@ func (f *FacadeStub) GetAlteredAccountsByHash(shardID uint32, hash string, optio
 }
 
 // GetTriesStatistics -
-func (f *FacadeStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+func (f *FacadeStub) GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
 	if f.GetTriesStatisticsCalled != nil {
 		return f.GetTriesStatisticsCalled(shardID)
 	}
@@ -546,22 +705,22 @@ func (f *FacadeStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPI
 }
 
 // GetEpochStartData -
-func (f *FacadeStub) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	return f.GetEpochStartDataCalled(epoch, shardID)
 }
 
 // GetInternalStartOfEpochValidatorsInfo -
-func (f *FacadeStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+func (f *FacadeStub) GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	return f.GetInternalStartOfEpochValidatorsInfoCalled(epoch)
 }
 
 // GetCodeHash -
-func (f *FacadeStub) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetCodeHashCalled(address, options)
 }
 
 // IsDataTrieMigrated -
-func (f *FacadeStub) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.IsDataTrieMigratedCalled != nil {
 		return f.IsDataTrieMigratedCalled(address, options)
 	}
@@ -569,8 +728,17 @@ func (f *FacadeStub) IsDataTrieMigrated(address string, options common.AccountQu
 	return &data.GenericAPIResponse{}, nil
 }
 
+// GetAccountDiff -
+func (f *FacadeStub) GetAccountDiff(_ context.Context, address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+	if f.GetAccountDiffCalled != nil {
+		return f.GetAccountDiffCalled(address, options)
+	}
+
+	return &data.AccountDiff{}, nil
+}
+
 // GetWaitingEpochsLeftForPublicKey -
-func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if f.GetWaitingEpochsLeftForPublicKeyCalled != nil {
 		return f.GetWaitingEpochsLeftForPublicKeyCalled(publicKey)
 	}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ServerSettings holds the settings of the web server that can be changed while it is running
+type ServerSettings struct {
+	ApiConfigs      map[string]data.ApiRoutesConfig
+	ApiLogging      config.ApiLoggingConfig
+	Credentials     config.CredentialsConfig
+	RateLimitWindow time.Duration
+	RateLimits      config.RateLimitsConfig
+}
+
+// ServerSettingsHandler defines the actions that a web server settings handler should do
+type ServerSettingsHandler interface {
+	ApplySettings(settings ServerSettings) error
+	IsInterfaceNil() bool
+}
+
+// serverSettingsHandler swaps new settings into the middlewares of a running web server
+type serverSettingsHandler struct {
+	versionsRegistry data.VersionsRegistryHandler
+	authenticator    middleware.AuthenticatorHandler
+	routeAccess      middleware.RouteAccessHandler
+	rateLimiter      middleware.RateLimiterHandler
+	responseLogger   middleware.ResponseLoggerHandler
+
+	mutSettings     sync.Mutex
+	currentSettings ServerSettings
+}
+
+// ApplySettings applies the provided settings to the running web server. The settings are applied as a whole: if any
+// of them is not valid, an error is returned and the current settings are kept. The api routes configs can only
+// change the flags and the limits of the routes, the endpoints themselves being registered when the server starts
+func (handler *serverSettingsHandler) ApplySettings(settings ServerSettings) error {
+	handler.mutSettings.Lock()
+	defer handler.mutSettings.Unlock()
+
+	if settings.Credentials.Hasher.Type != handler.currentSettings.Credentials.Hasher.Type {
+		return fmt.Errorf("%w: current type %q, new type %q", ErrHasherChangeNotSupported,
+			handler.currentSettings.Credentials.Hasher.Type, settings.Credentials.Hasher.Type)
+	}
+
+	if len(settings.ApiConfigs) != len(handler.currentSettings.ApiConfigs) {
+		return fmt.Errorf("%w: expected api configs for %d versions, got %d", ErrVersionNotRegistered,
+			len(handler.currentSettings.ApiConfigs), len(settings.ApiConfigs))
+	}
+	for version := range settings.ApiConfigs {
+		_, found := handler.currentSettings.ApiConfigs[version]
+		if !found {
+			return fmt.Errorf("%w: api config provided for unknown version %q", ErrVersionNotRegistered, version)
+		}
+	}
+
+	err := handler.authenticator.SetCredentials(settings.Credentials, getRouteScopesMap(settings.ApiConfigs))
+	if err != nil {
+		return err
+	}
+
+	err = handler.rateLimiter.SetLimits(getLimitsMap(settings.ApiConfigs), settings.RateLimitWindow, settings.RateLimits)
+	if err != nil {
+		// the current credentials were already valid, so restoring them cannot fail
+		_ = handler.authenticator.SetCredentials(handler.currentSettings.Credentials, getRouteScopesMap(handler.currentSettings.ApiConfigs))
+		return err
+	}
+
+	err = handler.routeAccess.SetRoutes(getRoutesMap(settings.ApiConfigs))
+	if err != nil {
+		return err
+	}
+
+	handler.responseLogger.SetSettings(settings.ApiLogging.LoggingEnabled, getLoggingThreshold(settings.ApiLogging))
+
+	for version, apiConfig := range settings.ApiConfigs {
+		err = handler.versionsRegistry.UpdateApiConfig(version, apiConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	handler.currentSettings = settings
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *serverSettingsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/config/watcher"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	versionsFactory "github.com/multiversx/mx-chain-proxy-go/versions/factory"
+	"github.com/urfave/cli"
+)
+
+// cacheValidityHandler defines a component that periodically updates a cache and whose update period can be changed
+type cacheValidityHandler interface {
+	SetCacheValidityDuration(cacheValidityDuration time.Duration) error
+}
+
+// reloadableComponents holds the components whose settings are changed when the config files are reloaded
+type reloadableComponents struct {
+	heartbeatCacheUpdater       cacheValidityHandler
+	valStatsCacheUpdater        cacheValidityHandler
+	economicMetricsCacheUpdater cacheValidityHandler
+	serverSettings              api.ServerSettingsHandler
+}
+
+type argsConfigReload struct {
+	configurationFilePath  string
+	credentialsFilePath    string
+	rateLimitsFilePath     string
+	apiConfigDirectoryPath string
+	versionsRegistry       data.VersionsRegistryHandler
+	components             *reloadableComponents
+}
+
+func startConfigWatcher(
+	ctx *cli.Context,
+	cfg config.ConfigReloadConfig,
+	versionsRegistry data.VersionsRegistryHandler,
+	reloadableComps *reloadableComponents,
+	closableComponents *data.ClosableComponentsHandler,
+) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if ctx.GlobalBool(testHttpServerEn.Name) {
+		log.Warn("the config files hot reload is not available in test mode")
+		return nil
+	}
+
+	args := argsConfigReload{
+		configurationFilePath:  ctx.GlobalString(configurationFile.Name),
+		credentialsFilePath:    ctx.GlobalString(credentialsConfigFile.Name),
+		rateLimitsFilePath:     ctx.GlobalString(rateLimitsConfigFile.Name),
+		apiConfigDirectoryPath: ctx.GlobalString(apiConfigDirectory.Name),
+		versionsRegistry:       versionsRegistry,
+		components:             reloadableComps,
+	}
+
+	configWatcher, err := watcher.NewConfigWatcher(watcher.ArgsConfigWatcher{
+		Paths: []string{
+			args.configurationFilePath,
+			args.credentialsFilePath,
+			args.rateLimitsFilePath,
+			args.apiConfigDirectoryPath,
+		},
+		PollInterval: time.Duration(cfg.PollIntervalSec) * time.Second,
+		ReloadHandler: func() error {
+			return reloadConfigs(args)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	configWatcher.Start()
+	closableComponents.Add(configWatcher)
+	log.Info("config files hot reload enabled", "poll interval in seconds", cfg.PollIntervalSec)
+
+	return nil
+}
+
+// reloadConfigs loads all the config files again and applies the reloadable settings. The configs are validated
+// before applying any of them, so that an invalid config does not leave the proxy with a mix of old and new settings
+func reloadConfigs(args argsConfigReload) error {
+	generalConfig, err := loadMainConfig(args.configurationFilePath)
+	if err != nil {
+		return fmt.Errorf("%w while loading %s", err, args.configurationFilePath)
+	}
+
+	credentialsConfig, err := loadCredentialsConfig(args.credentialsFilePath)
+	if err != nil {
+		return fmt.Errorf("%w while loading %s", err, args.credentialsFilePath)
+	}
+
+	rateLimitsConfig, err := loadRateLimitsConfig(args.rateLimitsFilePath)
+	if err != nil {
+		return fmt.Errorf("%w while loading %s", err, args.rateLimitsFilePath)
+	}
+
+	apiConfigParser, err := versionsFactory.NewApiConfigParser(args.apiConfigDirectoryPath)
+	if err != nil {
+		return err
+	}
+
+	apiConfigs, err := versionsFactory.LoadApiConfigs(args.versionsRegistry, apiConfigParser)
+	if err != nil {
+		return err
+	}
+
+	generalSettings := generalConfig.GeneralSettings
+	err = checkReloadableGeneralSettings(generalSettings)
+	if err != nil {
+		return err
+	}
+
+	err = args.components.serverSettings.ApplySettings(api.ServerSettings{
+		ApiConfigs:      apiConfigs,
+		ApiLogging:      generalConfig.ApiLogging,
+		Credentials:     *credentialsConfig,
+		RateLimitWindow: time.Duration(generalSettings.RateLimitWindowDurationSeconds) * time.Second,
+		RateLimits:      *rateLimitsConfig,
+	})
+	if err != nil {
+		return err
+	}
+
+	// the durations were checked above, so setting them cannot fail
+	_ = args.components.heartbeatCacheUpdater.SetCacheValidityDuration(
+		time.Duration(generalSettings.HeartbeatCacheValidityDurationSec) * time.Second)
+	_ = args.components.valStatsCacheUpdater.SetCacheValidityDuration(
+		time.Duration(generalSettings.ValStatsCacheValidityDurationSec) * time.Second)
+	_ = args.components.economicMetricsCacheUpdater.SetCacheValidityDuration(
+		time.Duration(generalSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second)
+
+	return nil
+}
+
+func checkReloadableGeneralSettings(generalSettings config.GeneralSettingsConfig) error {
+	positiveSettings := []struct {
+		name  string
+		value int
+	}{
+		{name: "RateLimitWindowDurationSeconds", value: generalSettings.RateLimitWindowDurationSeconds},
+		{name: "HeartbeatCacheValidityDurationSec", value: generalSettings.HeartbeatCacheValidityDurationSec},
+		{name: "ValStatsCacheValidityDurationSec", value: generalSettings.ValStatsCacheValidityDurationSec},
+		{name: "EconomicsMetricsCacheValidityDurationSec", value: generalSettings.EconomicsMetricsCacheValidityDurationSec},
+	}
+
+	for _, setting := range positiveSettings {
+		if setting.value <= 0 {
+			return fmt.Errorf("invalid value %d for %s. It must be greater than zero", setting.value, setting.name)
+		}
+	}
+
+	return nil
+}
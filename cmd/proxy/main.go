@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math/big"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -25,9 +28,14 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/metrics"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
+	"github.com/multiversx/mx-chain-proxy-go/observer/circuitBreaker"
+	"github.com/multiversx/mx-chain-proxy-go/observer/latencyTracker"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	processFactory "github.com/multiversx/mx-chain-proxy-go/process/factory"
+	"github.com/multiversx/mx-chain-proxy-go/process/historical"
+	"github.com/multiversx/mx-chain-proxy-go/process/nonces"
 	"github.com/multiversx/mx-chain-proxy-go/testing"
 	versionsFactory "github.com/multiversx/mx-chain-proxy-go/versions/factory"
 	"github.com/urfave/cli"
@@ -39,6 +47,12 @@ const (
 	logFileLifeSpanInSec = 86400
 	logFileMaxSizeInMB   = 1024
 	addressHRP           = "erd"
+
+	heartbeatsSharedCacheKey       = "heartbeats"
+	validatorStatsSharedCacheKey   = "validator-statistics"
+	economicMetricsSharedCacheKey  = "economic-metrics"
+	cacheUpdateLeaseKeySuffix      = ":update-lease"
+	cacheUpdateLeaseValidityFactor = 3
 )
 
 // commitID and appVersion should be populated at build time using ldflags
@@ -113,6 +127,14 @@ VERSION:
 		Value: "./config/apiConfig/credentials.toml",
 	}
 
+	// rateLimitsConfigFile defines a flag for the path to the rate limits toml configuration file
+	rateLimitsConfigFile = cli.StringFlag{
+		Name: "config-rate-limits",
+		Usage: "The path for the rate limits configuration file. This TOML file contains" +
+			" the rate limiting tiers and the API keys assigned to them.",
+		Value: "./config/apiConfig/rateLimits.toml",
+	}
+
 	// apiConfigDirectory defines a flag for the path to the api configuration directory
 	apiConfigDirectory = cli.StringFlag{
 		Name: "api-config-directory",
@@ -181,6 +203,7 @@ func main() {
 	app.Flags = []cli.Flag{
 		configurationFile,
 		credentialsConfigFile,
+		rateLimitsConfigFile,
 		apiConfigDirectory,
 		profileMode,
 		walletKeyPemFile,
@@ -277,16 +300,34 @@ func startProxy(ctx *cli.Context) error {
 		return err
 	}
 
+	rateLimitsConfigurationFileName := ctx.GlobalString(rateLimitsConfigFile.Name)
+	rateLimitsConfig, err := loadRateLimitsConfig(rateLimitsConfigurationFileName)
+	if err != nil {
+		return err
+	}
+
 	statusMetricsProvider := metrics.NewStatusMetrics()
 
 	shouldStartSwaggerUI := ctx.GlobalBool(startSwaggerUI.Name)
 	skipStatusCheck := ctx.GlobalBool(noStatusCheck.Name)
-	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, statusMetricsProvider, closableComponents, skipStatusCheck)
+	reloadableComps := &reloadableComponents{}
+	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, statusMetricsProvider, closableComponents, reloadableComps, skipStatusCheck)
+	if err != nil {
+		return err
+	}
+
+	httpServer, serverSettings, err := startWebServer(versionsRegistry, generalConfig, *credentialsConfig, *rateLimitsConfig, statusMetricsProvider, isProfileModeActivated, shouldStartSwaggerUI)
+	if err != nil {
+		return err
+	}
+	reloadableComps.serverSettings = serverSettings
+
+	err = startGRPCServer(generalConfig.GRPC, versionsRegistry, serverSettings, closableComponents)
 	if err != nil {
 		return err
 	}
 
-	httpServer, err := startWebServer(versionsRegistry, generalConfig, *credentialsConfig, statusMetricsProvider, isProfileModeActivated, shouldStartSwaggerUI)
+	err = startConfigWatcher(ctx, generalConfig.ConfigReload, versionsRegistry, reloadableComps, closableComponents)
 	if err != nil {
 		return err
 	}
@@ -317,6 +358,7 @@ func createVersionsRegistryTestOrProduction(
 	configurationFilePath string,
 	statusMetricsHandler data.StatusMetricsProvider,
 	closableComponents *data.ClosableComponentsHandler,
+	reloadableComps *reloadableComponents,
 	skipStatusCheck bool,
 ) (data.VersionsRegistryHandler, error) {
 
@@ -382,6 +424,7 @@ func createVersionsRegistryTestOrProduction(
 			ctx.GlobalString(walletKeyPemFile.Name),
 			ctx.GlobalString(apiConfigDirectory.Name),
 			closableComponents,
+			reloadableComps,
 			skipStatusCheck,
 		)
 	}
@@ -393,6 +436,7 @@ func createVersionsRegistryTestOrProduction(
 		ctx.GlobalString(walletKeyPemFile.Name),
 		ctx.GlobalString(apiConfigDirectory.Name),
 		closableComponents,
+		reloadableComps,
 		skipStatusCheck,
 	)
 }
@@ -404,6 +448,7 @@ func createVersionsRegistry(
 	pemFileLocation string,
 	apiConfigDirectoryPath string,
 	closableComponents *data.ClosableComponentsHandler,
+	reloadableComps *reloadableComponents,
 	skipStatusCheck bool,
 ) (data.VersionsRegistryHandler, error) {
 	pubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(cfg.AddressPubkeyConverter.Length, addressHRP)
@@ -425,7 +470,17 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	nodesProviderFactory, err := observer.NewNodesProviderFactory(*cfg, configurationFilePath, numShards)
+	nodesHealth, err := createNodesHealthHandler(cfg.CircuitBreaker)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesLatency, err := createNodesLatencyHandler(cfg.NodesSelection)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesProviderFactory, err := observer.NewNodesProviderFactory(*cfg, configurationFilePath, numShards, nodesHealth, nodesLatency)
 	if err != nil {
 		return nil, err
 	}
@@ -454,81 +509,168 @@ func createVersionsRegistry(
 		fullHistoryNodesProvider,
 		pubKeyConverter,
 		skipStatusCheck,
+		cfg.ObserverTransport,
+		nodesHealth,
+		nodesLatency,
+		cfg.RequestHedging,
 	)
 	if err != nil {
 		return nil, err
 	}
 	bp.StartNodesSyncStateChecks()
 
-	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter)
+	faucetValue := big.NewInt(0)
+	faucetValue.SetString(cfg.GeneralSettings.FaucetValue, 10)
+	faucetProc, err := processFactory.CreateFaucetProcessor(bp, shardCoord, faucetValue, pubKeyConverter, pemFileLocation)
 	if err != nil {
 		return nil, err
 	}
 
-	faucetValue := big.NewInt(0)
-	faucetValue.SetString(cfg.GeneralSettings.FaucetValue, 10)
-	faucetProc, err := processFactory.CreateFaucetProcessor(bp, shardCoord, faucetValue, pubKeyConverter, pemFileLocation)
+	sharedCache, err := createSharedCacheComponents(cfg.SharedCache, closableComponents)
 	if err != nil {
 		return nil, err
 	}
 
-	txProc, err := processFactory.CreateTransactionProcessor(
+	htbCacher, err := createHeartbeatCacher(sharedCache)
+	if err != nil {
+		return nil, err
+	}
+	cacheValidity := time.Duration(cfg.GeneralSettings.HeartbeatCacheValidityDurationSec) * time.Second
+	htbCacheUpdateLease, err := createCacheUpdateLease(sharedCache, heartbeatsSharedCacheKey, cacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeGroupProc, err := process.NewNodeGroupProcessor(bp, htbCacher, cacheValidity, htbCacheUpdateLease)
+	if err != nil {
+		return nil, err
+	}
+
+	valStatsCacher, err := createValidatorStatsCacher(sharedCache)
+	if err != nil {
+		return nil, err
+	}
+	cacheValidity = time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
+	valStatsCacheUpdateLease, err := createCacheUpdateLease(sharedCache, validatorStatsSharedCacheKey, cacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity, valStatsCacheUpdateLease)
+	if err != nil {
+		return nil, err
+	}
+
+	economicMetricsCacher, err := createEconomicMetricsCacher(sharedCache)
+	if err != nil {
+		return nil, err
+	}
+	cacheValidity = time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
+	economicMetricsCacheUpdateLease, err := createCacheUpdateLease(sharedCache, economicMetricsSharedCacheKey, cacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	responseCache, err := createResponseCache(cfg.ResponseCache)
+	if err != nil {
+		return nil, err
+	}
+
+	staticDataTTL := time.Duration(cfg.ResponseCache.StaticDataTTLSec) * time.Second
+	nodeStatusProc, err := process.NewNodeStatusProcessor(
 		bp,
-		pubKeyConverter,
-		hasher,
-		marshalizer,
-		cfg.GeneralSettings.AllowEntireTxPoolFetch,
+		economicMetricsCacher,
+		cacheValidity,
+		responseCache,
+		staticDataTTL,
+		economicMetricsCacheUpdateLease,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
+	responseTTL, err := createResponseTTLHandler(cfg.ResponseCache, nodeStatusProc)
 	if err != nil {
 		return nil, err
 	}
 
-	htbCacher := cache.NewHeartbeatMemoryCacher()
-	cacheValidity := time.Duration(cfg.GeneralSettings.HeartbeatCacheValidityDurationSec) * time.Second
+	blockProc, err := process.NewBlockProcessor(bp, responseCache, responseTTL)
+	if err != nil {
+		return nil, err
+	}
 
-	nodeGroupProc, err := process.NewNodeGroupProcessor(bp, htbCacher, cacheValidity)
+	historyResolver, err := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+		BlockProvider:         blockProc,
+		NetworkStatusProvider: nodeStatusProc,
+		IndexedBlocksPerShard: cfg.HistoricalQueries.IndexedBlocksPerShard,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	valStatsCacher := cache.NewValidatorsStatsMemoryCacher()
-	cacheValidity = time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
+	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter, historyResolver)
+	if err != nil {
+		return nil, err
+	}
 
-	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity)
+	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter, historyResolver)
 	if err != nil {
 		return nil, err
 	}
 
-	economicMetricsCacher := cache.NewGenericApiResponseMemoryCacher()
-	cacheValidity = time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
+	txProc, err := processFactory.CreateTransactionProcessor(
+		bp,
+		pubKeyConverter,
+		hasher,
+		marshalizer,
+		cfg.GeneralSettings.AllowEntireTxPoolFetch,
+		responseCache,
+		responseTTL,
+		cfg.TransactionBroadcast,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, cacheValidity)
+	txTracker, err := processFactory.CreateTransactionTracker(cfg.TransactionTracking, txProc)
 	if err != nil {
 		return nil, err
 	}
 
-	closableComponents.Add(nodeGroupProc, valStatsProc, nodeStatusProc, bp)
+	nonceReserver, err := processFactory.CreateNonceReserver(cfg.NonceReservation, accntProc, txProc)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceDiagnoser, err := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+		AccountProvider: accntProc,
+		PoolProvider:    txProc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	closableComponents.Add(nodeGroupProc, valStatsProc, nodeStatusProc, bp, txTracker)
+	reloadableComps.heartbeatCacheUpdater = nodeGroupProc
+	reloadableComps.valStatsCacheUpdater = valStatsProc
+	reloadableComps.economicMetricsCacheUpdater = nodeStatusProc
 
 	nodeGroupProc.StartCacheUpdate()
 	valStatsProc.StartCacheUpdate()
 	nodeStatusProc.StartCacheUpdate()
 
-	blockProc, err := process.NewBlockProcessor(bp)
+	hyperStreamer, err := processFactory.CreateHyperblockStreamer(cfg.HyperblockStream, blockProc, nodeStatusProc, pubKeyConverter)
 	if err != nil {
 		return nil, err
 	}
+	closableComponents.Add(hyperStreamer)
 
 	blocksPrc, err := process.NewBlocksProcessor(bp)
 	if err != nil {
 		return nil, err
 	}
 
-	proofProc, err := process.NewProofProcessor(bp, pubKeyConverter)
+	proofProc, err := process.NewProofProcessor(bp, pubKeyConverter, responseCache)
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +680,7 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	statusProc, err := process.NewStatusProcessor(bp, statusMetricsHandler)
+	statusProc, err := process.NewStatusProcessor(bp, statusMetricsHandler, nodesHealth)
 	if err != nil {
 		return nil, err
 	}
@@ -564,6 +706,10 @@ func createVersionsRegistry(
 		ESDTSuppliesProcessor:        esdtSuppliesProc,
 		StatusProcessor:              statusProc,
 		AboutInfoProcessor:           aboutInfoProc,
+		TransactionTracker:           txTracker,
+		HyperblockStreamer:           hyperStreamer,
+		NonceReserver:                nonceReserver,
+		NonceGapDiagnoser:            nonceDiagnoser,
 	}
 
 	apiConfigParser, err := versionsFactory.NewApiConfigParser(apiConfigDirectoryPath)
@@ -578,32 +724,38 @@ func startWebServer(
 	versionsRegistry data.VersionsRegistryHandler,
 	generalConfig *config.Config,
 	credentialsConfig config.CredentialsConfig,
+	rateLimitsConfig config.RateLimitsConfig,
 	statusMetricsProvider data.StatusMetricsProvider,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
-) (*http.Server, error) {
+) (*http.Server, api.ServerSettingsHandler, error) {
 	var err error
 	var httpServer *http.Server
+	var serverSettings api.ServerSettingsHandler
 
 	port := generalConfig.GeneralSettings.ServerPort
 
 	if generalConfig.GeneralSettings.RateLimitWindowDurationSeconds <= 0 {
-		return nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
+		return nil, nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
 			"than zero", generalConfig.GeneralSettings.RateLimitWindowDurationSeconds)
 	}
-	httpServer, err = api.CreateServer(
+	httpServer, serverSettings, err = api.CreateServer(
 		versionsRegistry,
 		port,
 		generalConfig.ApiLogging,
 		credentialsConfig,
 		statusMetricsProvider,
 		generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
+		rateLimitsConfig,
+		generalConfig.BatchRequests,
+		generalConfig.GraphQL,
+		generalConfig.JSONRPC,
 		isProfileModeActivated,
 		shouldStartSwaggerUI,
 	)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	go func() {
 		err = httpServer.ListenAndServe()
@@ -613,7 +765,45 @@ func startWebServer(
 		}
 	}()
 
-	return httpServer, nil
+	return httpServer, serverSettings, nil
+}
+
+// startGRPCServer starts the gRPC server, if enabled. It shares the route settings and the rate limits of the web
+// server, so it has to be started after it
+func startGRPCServer(
+	grpcConfig config.GRPCConfig,
+	versionsRegistry data.VersionsRegistryHandler,
+	serverSettings api.ServerSettingsHandler,
+	closableComponents *data.ClosableComponentsHandler,
+) error {
+	if !grpcConfig.Enabled {
+		return nil
+	}
+	if grpcConfig.Port <= 0 {
+		return fmt.Errorf("invalid value %d for GRPC.Port. It must be greater than zero", grpcConfig.Port)
+	}
+
+	grpcServer, err := api.CreateGRPCServer(versionsRegistry, serverSettings)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcConfig.Port))
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(grpcServer)
+
+	go func() {
+		errServe := grpcServer.Serve(listener)
+		if errServe != nil {
+			log.Error("cannot serve the gRPC API", "err", errServe)
+			os.Exit(1)
+		}
+	}()
+	log.Info("started the gRPC server", "port", grpcConfig.Port)
+
+	return nil
 }
 
 func waitForServerShutdown(httpServer *http.Server, closableComponents *data.ClosableComponentsHandler) {
@@ -655,6 +845,145 @@ func getNumOfShards(cfg *config.Config) (uint32, error) {
 	return numShardsProcessor.GetNetworkNumShards(ctx)
 }
 
+func createNodesHealthHandler(cfg config.CircuitBreakerConfig) (observer.NodesHealthHandler, error) {
+	if !cfg.Enabled {
+		return circuitBreaker.NewDisabledNodesCircuitBreaker(), nil
+	}
+
+	return circuitBreaker.NewNodesCircuitBreaker(cfg)
+}
+
+func createNodesLatencyHandler(cfg config.NodesSelectionConfig) (observer.NodesLatencyHandler, error) {
+	isLatencyAwareSelectionUsed := cfg.ObserversStrategy == observer.LatencyAwareSelectionStrategy ||
+		cfg.FullHistoryNodesStrategy == observer.LatencyAwareSelectionStrategy
+	if !isLatencyAwareSelectionUsed {
+		return latencyTracker.NewDisabledNodesLatencyTracker(), nil
+	}
+
+	return latencyTracker.NewNodesLatencyTracker(cfg.LatencyEWMAAlpha)
+}
+
+// sharedCacheComponents holds the components used when the heartbeat, validator statistics and economics caches are
+// shared between several proxy instances
+type sharedCacheComponents struct {
+	store      cache.SharedStoreHandler
+	keyPrefix  string
+	leaseOwner string
+}
+
+func createSharedCacheComponents(
+	cfg config.SharedCacheConfig,
+	closableComponents *data.ClosableComponentsHandler,
+) (*sharedCacheComponents, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	respClient, err := cache.NewRespClient(cache.ArgsRespClient{
+		Address:          cfg.Address,
+		Password:         cfg.Password,
+		DB:               cfg.DB,
+		DialTimeout:      time.Duration(cfg.DialTimeoutMs) * time.Millisecond,
+		OperationTimeout: time.Duration(cfg.OperationTimeoutMs) * time.Millisecond,
+		MaxIdleConns:     cfg.MaxIdleConnections,
+	})
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(respClient)
+
+	leaseOwner, err := createCacheUpdateLeaseOwner()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("using shared caches", "address", cfg.Address, "key prefix", cfg.KeyPrefix, "lease owner", leaseOwner)
+
+	return &sharedCacheComponents{
+		store:      respClient,
+		keyPrefix:  cfg.KeyPrefix,
+		leaseOwner: leaseOwner,
+	}, nil
+}
+
+func createCacheUpdateLeaseOwner() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+
+	randomBytes := make([]byte, 8)
+	_, err = rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), hex.EncodeToString(randomBytes)), nil
+}
+
+func createHeartbeatCacher(sharedCache *sharedCacheComponents) (process.HeartbeatCacheHandler, error) {
+	if sharedCache == nil {
+		return cache.NewHeartbeatMemoryCacher(), nil
+	}
+
+	return cache.NewSharedStoreHeartbeatCacher(sharedCache.store, sharedCache.keyPrefix+heartbeatsSharedCacheKey)
+}
+
+func createValidatorStatsCacher(sharedCache *sharedCacheComponents) (process.ValidatorStatisticsCacheHandler, error) {
+	if sharedCache == nil {
+		return cache.NewValidatorsStatsMemoryCacher(), nil
+	}
+
+	return cache.NewSharedStoreValidatorsStatsCacher(sharedCache.store, sharedCache.keyPrefix+validatorStatsSharedCacheKey)
+}
+
+func createEconomicMetricsCacher(sharedCache *sharedCacheComponents) (process.GenericApiResponseCacheHandler, error) {
+	if sharedCache == nil {
+		return cache.NewGenericApiResponseMemoryCacher(), nil
+	}
+
+	return cache.NewSharedStoreGenericApiResponseCacher(sharedCache.store, sharedCache.keyPrefix+economicMetricsSharedCacheKey)
+}
+
+// createCacheUpdateLease creates the lease deciding which proxy refreshes a shared cache. The lease outlives a few
+// refresh periods, so another proxy takes over only if the current one stopped refreshing
+func createCacheUpdateLease(
+	sharedCache *sharedCacheComponents,
+	cacheKey string,
+	cacheValidity time.Duration,
+) (process.CacheUpdateLeaseHandler, error) {
+	if sharedCache == nil {
+		return &disabled.CacheUpdateLease{}, nil
+	}
+
+	return cache.NewSharedStoreLease(
+		sharedCache.store,
+		sharedCache.keyPrefix+cacheKey+cacheUpdateLeaseKeySuffix,
+		sharedCache.leaseOwner,
+		cacheUpdateLeaseValidityFactor*cacheValidity,
+	)
+}
+
+func createResponseCache(cfg config.ResponseCacheConfig) (process.ResponseCacheHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.ResponseCache{}, nil
+	}
+
+	return cache.NewResponseCache(cfg.Capacity)
+}
+
+func createResponseTTLHandler(
+	cfg config.ResponseCacheConfig,
+	finalNonceHandler process.LatestFinalNonceHandler,
+) (process.ResponseTTLHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.ResponseTTLProvider{}, nil
+	}
+
+	nonFinalTTL := time.Duration(cfg.NonFinalTTLMs) * time.Millisecond
+	return process.NewResponseTTLProvider(finalNonceHandler, nonFinalTTL)
+}
+
 func removeLogColors() {
 	err := logger.RemoveLogObserver(os.Stdout)
 	if err != nil {
@@ -692,3 +1021,12 @@ func loadCredentialsConfig(filepath string) (*config.CredentialsConfig, error) {
 	}
 	return cfg, nil
 }
+
+func loadRateLimitsConfig(filepath string) (*config.RateLimitsConfig, error) {
+	cfg := &config.RateLimitsConfig{}
+	err := core.LoadTomlFile(cfg, filepath)
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
@@ -51,6 +51,30 @@ const (
 	UrlParameterWithAlteredAccounts = "withAlteredAccounts"
 	// UrlParameterWithKeys represents the name of an URL parameter
 	UrlParameterWithKeys = "withKeys"
+	// UrlParameterBroadcast represents the name of an URL parameter
+	UrlParameterBroadcast = "broadcast"
+	// UrlParameterBroadcastObservers represents the name of an URL parameter
+	UrlParameterBroadcastObservers = "broadcastObservers"
+	// UrlParameterBroadcastQuorum represents the name of an URL parameter
+	UrlParameterBroadcastQuorum = "broadcastQuorum"
+	// UrlParameterCallbackURL represents the name of an URL parameter
+	UrlParameterCallbackURL = "callbackUrl"
+	// UrlParameterFromNonce represents the name of an URL parameter
+	UrlParameterFromNonce = "fromNonce"
+	// UrlParameterAddresses represents the name of an URL parameter
+	UrlParameterAddresses = "addresses"
+	// UrlParameterTokens represents the name of an URL parameter
+	UrlParameterTokens = "tokens"
+	// UrlParameterEvents represents the name of an URL parameter
+	UrlParameterEvents = "events"
+	// UrlParameterTimestamp represents the name of an URL parameter
+	UrlParameterTimestamp = "timestamp"
+	// UrlParameterEpoch represents the name of an URL parameter
+	UrlParameterEpoch = "epoch"
+	// UrlParameterAtEpochStart represents the name of an URL parameter
+	UrlParameterAtEpochStart = "atEpochStart"
+	// UrlParameterToNonce represents the name of an URL parameter
+	UrlParameterToNonce = "toNonce"
 )
 
 // BlockQueryOptions holds options for block queries
@@ -68,6 +92,21 @@ type HyperblockQueryOptions struct {
 	AlteredAccountsOptions GetAlteredAccountsForBlockOptions
 }
 
+// HyperblockStreamOptions holds options for the subscriptions to the stream of the new hyperblocks
+type HyperblockStreamOptions struct {
+	FromNonce           core.OptionalUint64
+	WithLogs            bool
+	WithAlteredAccounts bool
+}
+
+// AddressActivityOptions holds options for the subscriptions to the activity of a set of addresses, tokens and events
+type AddressActivityOptions struct {
+	FromNonce core.OptionalUint64
+	Addresses []string
+	Tokens    []string
+	Events    []string
+}
+
 // TransactionQueryOptions holds options for transaction queries
 type TransactionQueryOptions struct {
 	WithResults bool
@@ -78,6 +117,13 @@ type TransactionSimulationOptions struct {
 	CheckSignature bool
 }
 
+// TransactionBroadcastOptions holds options for transaction send requests that are broadcast to multiple observers
+type TransactionBroadcastOptions struct {
+	Broadcast    bool
+	NumObservers core.OptionalUint32
+	Quorum       core.OptionalUint32
+}
+
 // TransactionsPoolOptions holds options for transactions pool requests
 type TransactionsPoolOptions struct {
 	ShardID   string
@@ -111,6 +157,20 @@ func BuildUrlWithBlockQueryOptions(path string, options BlockQueryOptions) strin
 	return u.String()
 }
 
+// HistoricalCoordinates holds the wall-clock or epoch coordinates of a historical query, which the proxy resolves to
+// a block of the queried shard. The timestamp selects the last block produced at or before it, in unix seconds, while
+// the epoch selects its last block, or its first one when AtEpochStart is set
+type HistoricalCoordinates struct {
+	Timestamp    core.OptionalUint64
+	Epoch        core.OptionalUint32
+	AtEpochStart bool
+}
+
+// IsSet returns true if the timestamp or the epoch is set
+func (hc HistoricalCoordinates) IsSet() bool {
+	return hc.Timestamp.HasValue || hc.Epoch.HasValue
+}
+
 // AccountQueryOptions holds options for account queries
 type AccountQueryOptions struct {
 	OnFinalBlock   bool
@@ -121,6 +181,7 @@ type AccountQueryOptions struct {
 	BlockRootHash  []byte
 	HintEpoch      core.OptionalUint32
 	WithKeys       bool
+	Historical     HistoricalCoordinates
 }
 
 // AreHistoricalCoordinatesSet returns true if historical block coordinates are set
@@ -129,7 +190,14 @@ func (a AccountQueryOptions) AreHistoricalCoordinatesSet() bool {
 		a.OnStartOfEpoch.HasValue ||
 		a.HintEpoch.HasValue ||
 		len(a.BlockHash) > 0 ||
-		len(a.BlockRootHash) > 0
+		len(a.BlockRootHash) > 0 ||
+		a.Historical.IsSet()
+}
+
+// AccountDiffOptions holds options for the account state diff queries, between two blocks of the account's shard
+type AccountDiffOptions struct {
+	FromNonce uint64
+	ToNonce   uint64
 }
 
 // BuildUrlWithAccountQueryOptions builds an URL with block query parameters
@@ -27,6 +27,22 @@ type Config struct {
 	Marshalizer            TypeConfig
 	Hasher                 TypeConfig
 	ApiLogging             ApiLoggingConfig
+	ObserverTransport      ObserverTransportConfig
+	CircuitBreaker         CircuitBreakerConfig
+	NodesSelection         NodesSelectionConfig
+	RequestHedging         RequestHedgingConfig
+	ResponseCache          ResponseCacheConfig
+	SharedCache            SharedCacheConfig
+	ConfigReload           ConfigReloadConfig
+	TransactionBroadcast   TransactionBroadcastConfig
+	TransactionTracking    TransactionTrackingConfig
+	HyperblockStream       HyperblockStreamConfig
+	NonceReservation       NonceReservationConfig
+	HistoricalQueries      HistoricalQueriesConfig
+	BatchRequests          BatchRequestsConfig
+	GraphQL                GraphQLConfig
+	GRPC                   GRPCConfig
+	JSONRPC                JSONRPCConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
@@ -49,8 +65,215 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
-// CredentialsConfig holds the credential pairs
+// ObserverTransportConfig holds the configuration of the HTTP transport used when calling the observers
+type ObserverTransportConfig struct {
+	MaxIdleConns           int
+	MaxIdleConnsPerHost    int
+	MaxConnsPerHost        int
+	IdleConnTimeoutSec     int
+	TLSHandshakeTimeoutSec int
+	ForceAttemptHTTP2      bool
+	TLS                    ObserverTLSConfig
+	RouteTimeouts          []RouteTimeoutConfig
+}
+
+// ObserverTLSConfig holds the (mutual) TLS settings used when calling the observers over HTTPS
+type ObserverTLSConfig struct {
+	Enabled            bool
+	CertificateFile    string
+	PrivateKeyFile     string
+	CACertificateFile  string
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// RouteTimeoutConfig overrides the request timeout for the observer paths matching the given path. The path is matched
+// segment by segment against the beginning of the observer path, segments starting with ':' matching any value
+type RouteTimeoutConfig struct {
+	Path       string
+	TimeoutSec int
+}
+
+// CircuitBreakerConfig holds the configuration of the per-node circuit breaker
+type CircuitBreakerConfig struct {
+	Enabled                  bool
+	FailureThreshold         uint32
+	OpenDurationSec          int
+	HalfOpenMaxProbes        uint32
+	HalfOpenSuccessThreshold uint32
+}
+
+// NodesSelectionConfig holds the configuration of the strategies used when selecting the nodes for a request
+type NodesSelectionConfig struct {
+	ObserversStrategy        string
+	FullHistoryNodesStrategy string
+	LatencyEWMAAlpha         float64
+}
+
+// RequestHedgingConfig holds the configuration of the hedged requests sent for the read-only observer calls
+type RequestHedgingConfig struct {
+	Enabled           bool
+	DelayPercentile   float64
+	MinDelayMs        int
+	MaxDelayMs        int
+	WindowSize        int
+	MinSamples        int
+	MaxHedgedRequests int
+}
+
+// ResponseCacheConfig holds the configuration of the cache used for the responses fetched from the observers
+type ResponseCacheConfig struct {
+	Enabled          bool
+	Capacity         int
+	NonFinalTTLMs    int
+	StaticDataTTLSec int
+}
+
+// SharedCacheConfig holds the configuration of the RESP-speaking store (such as Redis) used to share the heartbeat,
+// validator statistics and economics caches between several proxy instances
+type SharedCacheConfig struct {
+	Enabled            bool
+	Address            string
+	Password           string
+	DB                 int
+	KeyPrefix          string
+	DialTimeoutMs      int
+	OperationTimeoutMs int
+	MaxIdleConnections int
+}
+
+// ConfigReloadConfig holds the configuration of the watcher that reloads the config files while the proxy is running
+type ConfigReloadConfig struct {
+	Enabled         bool
+	PollIntervalSec int
+}
+
+// TransactionBroadcastConfig holds the configuration of the broadcast mode used when sending transactions
+type TransactionBroadcastConfig struct {
+	Enabled      bool
+	NumObservers uint32
+	Quorum       uint32
+}
+
+// TransactionTrackingConfig holds the configuration of the tracking of the sent transactions, whose final status is
+// posted to the callback URLs provided by the clients
+type TransactionTrackingConfig struct {
+	Enabled                       bool
+	PollIntervalMs                int
+	DeadlineSec                   int
+	MaxTrackedTransactions        int
+	MaxCallbacksPerTransaction    int
+	AllowedCallbackHosts          []string
+	AllowPrivateCallbackAddresses bool
+	CallbackSigningKey            string
+	CallbackTimeoutMs             int
+	CallbackMaxRetries            int
+	CallbackRetryDelayMs          int
+}
+
+// HyperblockStreamConfig holds the configuration of the stream pushing the new hyperblocks to the subscribers
+type HyperblockStreamConfig struct {
+	Enabled               bool
+	PollIntervalMs        int
+	MaxSubscribers        int
+	SubscriberBufferSize  int
+	MaxResumeDistance     uint64
+	MaxHyperblocksPerPoll int
+	MaxActivityFilters    int
+}
+
+// NonceReservationConfig holds the configuration of the reservation of the senders' nonces
+type NonceReservationConfig struct {
+	Enabled                  bool
+	ReservationTTLSec        int
+	MaxReservationsPerSender int
+	MaxSenders               int
+}
+
+// HistoricalQueriesConfig holds the configuration of the resolution of the timestamps and the epochs of the historical
+// queries to blocks
+type HistoricalQueriesConfig struct {
+	IndexedBlocksPerShard int
+}
+
+// BatchRequestsConfig holds the configuration of the endpoint executing many API calls in one request
+type BatchRequestsConfig struct {
+	Enabled                  bool
+	MaxSubRequests           int
+	MaxConcurrentSubRequests int
+	SubRequestTimeoutSec     int
+}
+
+// GraphQLConfig holds the configuration of the GraphQL endpoint and the limits applied to its queries
+type GraphQLConfig struct {
+	Enabled        bool
+	MaxDepth       int
+	MaxComplexity  int
+	MaxQueryLength int
+}
+
+// GRPCConfig holds the configuration of the gRPC server mirroring the v1.0 REST API
+type GRPCConfig struct {
+	Enabled bool
+	Port    int
+}
+
+// JSONRPCConfig holds the configuration of the Ethereum-style JSON-RPC endpoint
+type JSONRPCConfig struct {
+	Enabled                 bool
+	MaxBatchSize            int
+	MaxLogsBlockRange       int
+	MaxLogsBlocksPerRequest int
+}
+
+// RateLimitsConfig holds the rate limiting tiers and the authenticated consumers assigned to them
+type RateLimitsConfig struct {
+	Anonymous RateLimitTierConfig
+	Tiers     []RateLimitTierConfig
+	Consumers []RateLimitConsumerConfig
+}
+
+// RateLimitTierConfig holds the limits applied to the clients of a rate limiting tier
+type RateLimitTierConfig struct {
+	Name                    string
+	EndpointLimitMultiplier float64
+	DisableEndpointLimits   bool
+	GlobalLimit             uint64
+}
+
+// RateLimitConsumerConfig assigns the authenticated consumer with the given name to a rate limiting tier
+type RateLimitConsumerConfig struct {
+	Name string
+	Tier string
+}
+
+// CredentialsConfig holds the credential pairs, the API keys and the JWT verification keys
 type CredentialsConfig struct {
 	Credentials []data.Credential
 	Hasher      TypeConfig
+	APIKeys     []APIKeyConfig
+	JWT         JWTConfig
+}
+
+// APIKeyConfig holds an opaque API key, identified by its hash, and the scopes it grants
+type APIKeyConfig struct {
+	Name    string
+	KeyHash string
+	Scopes  []string
+}
+
+// JWTConfig holds the keys used for verifying the JWT bearer tokens and the expected claims
+type JWTConfig struct {
+	Issuer          string
+	Audience        string
+	HMACKeys        []JWTKeyConfig
+	Ed25519Keys     []JWTKeyConfig
+	RevokedTokenIDs []string
+}
+
+// JWTKeyConfig holds a hex encoded JWT verification key. The key ID has to match the kid header of the tokens if
+// more keys of the same type are defined
+type JWTKeyConfig struct {
+	KeyID string
+	Key   string
 }
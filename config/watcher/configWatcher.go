@@ -0,0 +1,171 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("config/watcher")
+
+// ArgsConfigWatcher holds the arguments needed for creating a config watcher
+type ArgsConfigWatcher struct {
+	Paths         []string
+	PollInterval  time.Duration
+	ReloadHandler func() error
+}
+
+// configWatcher calls the reload handler when one of the watched files changes or when the process receives SIGHUP.
+// The files are polled, comparing their size and modification time, and the watched directories are checked for
+// changes of the files directly under them
+type configWatcher struct {
+	paths         []string
+	pollInterval  time.Duration
+	reloadHandler func() error
+	signals       chan os.Signal
+	cancelFunc    func()
+}
+
+// NewConfigWatcher returns a new instance of configWatcher
+func NewConfigWatcher(args ArgsConfigWatcher) (*configWatcher, error) {
+	if len(args.Paths) == 0 {
+		return nil, ErrNoWatchedPaths
+	}
+	if args.PollInterval <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPollInterval, args.PollInterval)
+	}
+	if args.ReloadHandler == nil {
+		return nil, ErrNilReloadHandler
+	}
+
+	return &configWatcher{
+		paths:         args.Paths,
+		pollInterval:  args.PollInterval,
+		reloadHandler: args.ReloadHandler,
+		signals:       make(chan os.Signal, 1),
+	}, nil
+}
+
+// Start starts watching the files and listening for SIGHUP
+func (cw *configWatcher) Start() {
+	if cw.cancelFunc != nil {
+		log.Error("configWatcher - already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, cw.cancelFunc = context.WithCancel(context.Background())
+	signal.Notify(cw.signals, syscall.SIGHUP)
+
+	go cw.watch(ctx, cw.computeFingerprint())
+}
+
+func (cw *configWatcher) watch(ctx context.Context, lastFingerprint string) {
+	timer := time.NewTimer(cw.pollInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(cw.pollInterval)
+
+		select {
+		case <-timer.C:
+			fingerprint := cw.computeFingerprint()
+			if fingerprint == lastFingerprint {
+				continue
+			}
+
+			lastFingerprint = fingerprint
+			cw.reload("config files changed")
+		case <-cw.signals:
+			lastFingerprint = cw.computeFingerprint()
+			cw.reload("SIGHUP received")
+		case <-ctx.Done():
+			log.Debug("finishing configWatcher...")
+			return
+		}
+	}
+}
+
+func (cw *configWatcher) reload(reason string) {
+	log.Info("reloading the config files", "reason", reason)
+
+	err := cw.reloadHandler()
+	if err != nil {
+		log.Error("the config files could not be reloaded, the current settings are kept", "error", err.Error())
+		return
+	}
+
+	log.Info("the config files have been reloaded")
+}
+
+// computeFingerprint returns a string that changes whenever one of the watched files is changed, added or removed
+func (cw *configWatcher) computeFingerprint() string {
+	entries := make([]string, 0, len(cw.paths))
+	for _, path := range cw.paths {
+		entries = append(entries, describePath(path)...)
+	}
+
+	sort.Strings(entries)
+
+	return strings.Join(entries, "\n")
+}
+
+func describePath(path string) []string {
+	info, err := os.Stat(path)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: %s", path, err.Error())}
+	}
+	if !info.IsDir() {
+		return []string{describeFile(path, info)}
+	}
+
+	dirEntries, err := os.ReadDir(path)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: %s", path, err.Error())}
+	}
+
+	descriptions := make([]string, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+
+		filePath := filepath.Join(path, dirEntry.Name())
+		fileInfo, errInfo := dirEntry.Info()
+		if errInfo != nil {
+			descriptions = append(descriptions, fmt.Sprintf("%s: %s", filePath, errInfo.Error()))
+			continue
+		}
+
+		descriptions = append(descriptions, describeFile(filePath, fileInfo))
+	}
+
+	return descriptions
+}
+
+func describeFile(path string, info os.FileInfo) string {
+	return fmt.Sprintf("%s: %d %d", path, info.Size(), info.ModTime().UnixNano())
+}
+
+// Close stops watching the files and listening for SIGHUP
+func (cw *configWatcher) Close() error {
+	if cw.cancelFunc != nil {
+		signal.Stop(cw.signals)
+		cw.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cw *configWatcher) IsInterfaceNil() bool {
+	return cw == nil
+}
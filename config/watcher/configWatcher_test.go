@@ -0,0 +1,114 @@
+package watcher
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsConfigWatcher(paths ...string) ArgsConfigWatcher {
+	return ArgsConfigWatcher{
+		Paths:         paths,
+		PollInterval:  10 * time.Millisecond,
+		ReloadHandler: func() error { return nil },
+	}
+}
+
+func TestNewConfigWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no paths should error", func(t *testing.T) {
+		t.Parallel()
+
+		cw, err := NewConfigWatcher(createMockArgsConfigWatcher())
+		assert.Equal(t, ErrNoWatchedPaths, err)
+		assert.True(t, check.IfNil(cw))
+	})
+	t.Run("invalid poll interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConfigWatcher("config.toml")
+		args.PollInterval = 0
+		cw, err := NewConfigWatcher(args)
+		assert.True(t, errors.Is(err, ErrInvalidPollInterval))
+		assert.True(t, check.IfNil(cw))
+	})
+	t.Run("nil reload handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConfigWatcher("config.toml")
+		args.ReloadHandler = nil
+		cw, err := NewConfigWatcher(args)
+		assert.Equal(t, ErrNilReloadHandler, err)
+		assert.True(t, check.IfNil(cw))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cw, err := NewConfigWatcher(createMockArgsConfigWatcher("config.toml"))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(cw))
+	})
+}
+
+func TestConfigWatcher_ShouldReloadOnFileChanges(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	apiConfigDir := filepath.Join(dir, "apiConfig")
+	require.Nil(t, os.Mkdir(apiConfigDir, os.ModePerm))
+	configFile := filepath.Join(dir, "config.toml")
+	require.Nil(t, os.WriteFile(configFile, []byte("a = 1"), os.ModePerm))
+
+	numReloads := int32(0)
+	args := createMockArgsConfigWatcher(configFile, apiConfigDir)
+	args.ReloadHandler = func() error {
+		atomic.AddInt32(&numReloads, 1)
+		return errors.New("invalid config")
+	}
+	cw, _ := NewConfigWatcher(args)
+	cw.Start()
+	defer func() {
+		_ = cw.Close()
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, atomic.LoadInt32(&numReloads))
+
+	require.Nil(t, os.WriteFile(configFile, []byte("a = 22"), os.ModePerm))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numReloads))
+
+	// a failed reload should not be retried until the files change again
+	require.Nil(t, os.WriteFile(filepath.Join(apiConfigDir, "v1_0.toml"), []byte("b = 1"), os.ModePerm))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numReloads))
+}
+
+func TestConfigWatcher_ShouldReloadOnSignal(t *testing.T) {
+	t.Parallel()
+
+	numReloads := int32(0)
+	args := createMockArgsConfigWatcher(filepath.Join(t.TempDir(), "missing.toml"))
+	args.ReloadHandler = func() error {
+		atomic.AddInt32(&numReloads, 1)
+		return nil
+	}
+	cw, _ := NewConfigWatcher(args)
+	cw.Start()
+	defer func() {
+		_ = cw.Close()
+	}()
+
+	cw.signals <- syscall.SIGHUP
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numReloads))
+}
@@ -0,0 +1,12 @@
+package watcher
+
+import "errors"
+
+// ErrNoWatchedPaths signals that no path to be watched has been provided
+var ErrNoWatchedPaths = errors.New("no watched paths")
+
+// ErrInvalidPollInterval signals that an invalid poll interval has been provided
+var ErrInvalidPollInterval = errors.New("invalid poll interval")
+
+// ErrNilReloadHandler signals that a nil reload handler has been provided
+var ErrNilReloadHandler = errors.New("nil reload handler")
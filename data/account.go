@@ -68,3 +68,47 @@ type AccountKeyValueResponse struct {
 	Error string                      `json:"error"`
 	Code  string                      `json:"code"`
 }
+
+// AccountDiff holds the changes of an account between two blocks of its shard. The tokens only hold the ESDT balances
+// which changed, one entry per fungible token and per NFT nonce
+type AccountDiff struct {
+	Address   string              `json:"address"`
+	FromBlock BlockInfo           `json:"fromBlock"`
+	ToBlock   BlockInfo           `json:"toBlock"`
+	Balance   BalanceDiff         `json:"balance"`
+	Nonce     NonceDiff           `json:"nonce"`
+	Username  UsernameDiff        `json:"username"`
+	Tokens    []*TokenBalanceDiff `json:"tokens"`
+}
+
+// BalanceDiff holds a balance at both blocks along with the signed delta between them
+type BalanceDiff struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Delta string `json:"delta"`
+}
+
+// NonceDiff holds the account nonce at both blocks along with the number of transactions sent between them
+type NonceDiff struct {
+	From  uint64 `json:"from"`
+	To    uint64 `json:"to"`
+	Delta int64  `json:"delta"`
+}
+
+// UsernameDiff holds the username at both blocks
+type UsernameDiff struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Changed bool   `json:"changed"`
+}
+
+// TokenBalanceDiff holds the changed balance of an ESDT token. The identifier holds the nonce suffix of the NFTs, while
+// the token identifier is the one of their collection
+type TokenBalanceDiff struct {
+	Identifier      string `json:"identifier"`
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce,omitempty"`
+	From            string `json:"from"`
+	To              string `json:"to"`
+	Delta           string `json:"delta"`
+}
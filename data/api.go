@@ -1,6 +1,7 @@
 package data
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,6 +14,13 @@ type GenericAPIResponse struct {
 	Code  ReturnCode  `json:"code"`
 }
 
+// BatchSubRequest holds an API call executed as part of a batch request
+type BatchSubRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
 // NetworkConfig is a dto that will keep information about the network config
 type NetworkConfig struct {
 	Config struct {
@@ -20,6 +28,11 @@ type NetworkConfig struct {
 		MinGasLimit           uint64 `json:"erd_min_gas_limit"`
 		MinGasPrice           uint64 `json:"erd_min_gas_price"`
 		MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
+		GasPerDataByte        uint64 `json:"erd_gas_per_data_byte"`
+		MaxGasPerTransaction  uint64 `json:"erd_max_gas_per_transaction"`
+		ExtraGasLimitGuarded  uint64 `json:"erd_extra_gas_limit_guarded_tx"`
+		GasPriceModifier      string `json:"erd_gas_price_modifier"`
+		Denomination          uint64 `json:"erd_denomination"`
 	} `json:"config"`
 }
 
@@ -55,7 +68,7 @@ type EndpointHandlerData struct {
 type GroupHandler interface {
 	AddEndpoint(path string, handlerData EndpointHandlerData) error
 	UpdateEndpoint(path string, handlerData EndpointHandlerData) error
-	RegisterRoutes(ws *gin.RouterGroup, apiConfig ApiRoutesConfig, authenticationFunc gin.HandlerFunc, rateLimiter gin.HandlerFunc, statusMetricExtractor gin.HandlerFunc)
+	RegisterRoutes(ws *gin.RouterGroup, apiConfig ApiRoutesConfig, routeAccessFunc gin.HandlerFunc, rateLimiter gin.HandlerFunc, statusMetricExtractor gin.HandlerFunc)
 	RemoveEndpoint(path string) error
 	IsInterfaceNil() bool
 }
@@ -78,6 +91,7 @@ type FacadeHandler interface {
 type VersionsRegistryHandler interface {
 	AddVersion(version string, versionData *VersionData) error
 	GetAllVersions() (map[string]*VersionData, error)
+	UpdateApiConfig(version string, apiConfig ApiRoutesConfig) error
 	IsInterfaceNil() bool
 }
 
@@ -105,6 +119,7 @@ type RouteConfig struct {
 	Open      bool
 	Secured   bool
 	RateLimit uint64
+	Scopes    []string
 }
 
 // Credential holds an username and a password
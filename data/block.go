@@ -1,8 +1,11 @@
 package data
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
 // BlockApiResponse is a response holding a block
@@ -17,6 +20,51 @@ type BlockApiResponsePayload struct {
 	Block api.Block `json:"block"`
 }
 
+// HyperblockSubscription defines a subscription to the stream of the new hyperblocks. The hyperblocks channel is
+// closed when the subscription ends, Err telling why it ended
+type HyperblockSubscription interface {
+	Hyperblocks() <-chan *api.Hyperblock
+	Err() error
+	Close()
+}
+
+// HyperblockStreamMessage represents a message sent to the subscribers of the hyperblocks stream
+type HyperblockStreamMessage struct {
+	Hyperblock *api.Hyperblock `json:"hyperblock,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// AddressActivitySubscription defines a subscription to the activity of a set of addresses, tokens and events. The
+// activities channel is closed when the subscription ends, Err telling why it ended
+type AddressActivitySubscription interface {
+	Activities() <-chan *AddressActivity
+	Err() error
+	Close()
+}
+
+// AddressActivity holds the transactions, smart contract results and log events of a hyperblock matching the filters
+// of a subscription
+type AddressActivity struct {
+	HyperblockNonce uint64                              `json:"hyperblockNonce"`
+	HyperblockHash  string                              `json:"hyperblockHash"`
+	Timestamp       time.Duration                       `json:"timestamp,omitempty"`
+	Transactions    []*transaction.ApiTransactionResult `json:"transactions,omitempty"`
+	Events          []*AddressActivityEvent             `json:"events,omitempty"`
+}
+
+// AddressActivityEvent is a log event matching the filters of a subscription, along with the hash of the transaction
+// which generated it
+type AddressActivityEvent struct {
+	TxHash string              `json:"txHash"`
+	Event  *transaction.Events `json:"event"`
+}
+
+// AddressActivityMessage represents a message sent to the subscribers of the address activity stream
+type AddressActivityMessage struct {
+	Activity *AddressActivity `json:"activity,omitempty"`
+	Error    string           `json:"error,omitempty"`
+}
+
 // HyperblockApiResponse is a response holding a hyperblock
 type HyperblockApiResponse struct {
 	Data  HyperblockApiResponsePayload `json:"data"`
@@ -86,3 +134,10 @@ type AlteredAccountsApiResponse struct {
 type AlteredAccountsPayload struct {
 	Accounts []*alteredAccount.AlteredAccount `json:"accounts"`
 }
+
+// BlockCoordinates identifies the block of a shard which a historical query was resolved to
+type BlockCoordinates struct {
+	Nonce     uint64
+	Epoch     uint32
+	Timestamp uint64
+}
@@ -37,3 +37,28 @@ const (
 	// AvailabilityRecent means that the observer can be used only for recent data
 	AvailabilityRecent ObserverDataAvailabilityType = "recent"
 )
+
+// CircuitBreakerState represents the state of the circuit breaker associated to a node
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed means that the node receives requests normally
+	CircuitBreakerClosed CircuitBreakerState = "closed"
+
+	// CircuitBreakerOpen means that the node failed too many consecutive requests and is deprioritised
+	CircuitBreakerOpen CircuitBreakerState = "open"
+
+	// CircuitBreakerHalfOpen means that the node is probed with a limited number of requests before being closed again
+	CircuitBreakerHalfOpen CircuitBreakerState = "half-open"
+)
+
+// NodeHealth holds the health details of a node, as computed from the outcome of the requests sent to it
+type NodeHealth struct {
+	Address             string              `json:"address"`
+	State               CircuitBreakerState `json:"state"`
+	HealthScore         float64             `json:"healthScore"`
+	ConsecutiveFailures uint32              `json:"consecutiveFailures"`
+	NumSuccesses        uint64              `json:"numSuccesses"`
+	NumFailures         uint64              `json:"numFailures"`
+	NumTimesOpened      uint64              `json:"numTimesOpened"`
+}
@@ -120,6 +120,99 @@ type ResponseTransaction struct {
 	Code  string                  `json:"code"`
 }
 
+// TransactionBroadcastResponse holds the outcome of broadcasting a transaction to multiple observers
+type TransactionBroadcastResponse struct {
+	TxHash   string                           `json:"txHash"`
+	Quorum   uint32                           `json:"quorum"`
+	Accepted []string                         `json:"accepted"`
+	Rejected []*TransactionBroadcastRejection `json:"rejected"`
+}
+
+// TransactionBroadcastRejection holds the answer of an observer that did not accept a broadcast transaction
+type TransactionBroadcastRejection struct {
+	Observer string `json:"observer"`
+	Code     int    `json:"code"`
+	Error    string `json:"error"`
+}
+
+// NonceReservation holds a nonce reserved for a sender until the expiry unix timestamp
+type NonceReservation struct {
+	Address      string `json:"address"`
+	Nonce        uint64 `json:"nonce"`
+	AccountNonce uint64 `json:"accountNonce"`
+	ExpiresAt    int64  `json:"expiresAt"`
+}
+
+// TransactionValidationResult holds all the problems found while validating a transaction without sending it
+type TransactionValidationResult struct {
+	Valid    bool                            `json:"valid"`
+	Problems []*TransactionValidationProblem `json:"problems"`
+}
+
+// TransactionValidationProblem describes a problem of a transaction field
+type TransactionValidationProblem struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// TransactionFeeEstimation holds the gas units and the fee estimated for a transaction, computed with the same formula
+// as the node: the move balance gas is paid at the gas price, while the execution gas is paid at the gas price
+// multiplied by the gas price modifier
+type TransactionFeeEstimation struct {
+	GasUnits            uint64                `json:"txGasUnits"`
+	MoveBalanceGasUnits uint64                `json:"moveBalanceGasUnits"`
+	ExecutionGasUnits   uint64                `json:"executionGasUnits"`
+	GasPrice            uint64                `json:"gasPrice"`
+	GasPriceModifier    float64               `json:"gasPriceModifier"`
+	Fee                 *FeeAmount            `json:"fee"`
+	MoveBalanceFee      *FeeAmount            `json:"moveBalanceFee"`
+	ExecutionFee        *FeeAmount            `json:"executionFee"`
+	IsCrossShard        bool                  `json:"isCrossShard"`
+	GasLimitSuggestions []*GasLimitSuggestion `json:"gasLimitSuggestions"`
+	ReturnMessage       string                `json:"returnMessage,omitempty"`
+}
+
+// GasLimitSuggestion holds a gas limit covering the estimated gas units with a safety margin. The whole gas limit is
+// paid upfront and the unused execution gas is refunded to the sender
+type GasLimitSuggestion struct {
+	SafetyMarginPercent uint64     `json:"safetyMarginPercent"`
+	GasLimit            uint64     `json:"gasLimit"`
+	MaxFee              *FeeAmount `json:"maxFee"`
+	EstimatedRefund     *FeeAmount `json:"estimatedRefund"`
+}
+
+// FeeAmount holds an amount both in the smallest units and in denominated units
+type FeeAmount struct {
+	Value       string `json:"value"`
+	Denominated string `json:"denominated"`
+}
+
+// SenderNonceDiagnosis explains why the transactions of a sender are not executed. The missing nonces block the
+// transactions stuck behind them, while the stale transactions are below the account nonce and will never execute
+type SenderNonceDiagnosis struct {
+	Address                string            `json:"address"`
+	AccountNonce           uint64            `json:"accountNonce"`
+	MissingNonces          []NonceGap        `json:"missingNonces"`
+	ExecutableTransactions []PoolTransaction `json:"executableTransactions"`
+	StuckTransactions      []PoolTransaction `json:"stuckTransactions"`
+	StaleTransactions      []PoolTransaction `json:"staleTransactions"`
+}
+
+// PoolTransaction identifies a transaction from the pool
+type PoolTransaction struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+}
+
+// NonceGapsRepairResponse holds the diagnosis of a sender and the outcome of sending the replacement transactions.
+// The hashes are keyed by the index of the replacement transactions in the request
+type NonceGapsRepairResponse struct {
+	Diagnosis *SenderNonceDiagnosis         `json:"diagnosis"`
+	NumOfTxs  uint64                        `json:"numOfSentTxs"`
+	TxsHashes map[int]string                `json:"txsHashes"`
+	TxsErrors map[int]*TransactionSendError `json:"txsErrors,omitempty"`
+}
+
 // TransactionSimulationResults holds the results of a transaction's simulation
 type TransactionSimulationResults struct {
 	Status     transaction.TxStatus                           `json:"status,omitempty"`
@@ -153,10 +246,35 @@ type ResponseTransactionSimulationCrossShard struct {
 	Code  ReturnCode                                  `json:"code"`
 }
 
-// MultipleTransactionsResponseData holds the data which is returned when sending a bulk of transactions
+// MultipleTransactionsResponseData holds the data which is returned when sending a bulk of transactions. Every
+// transaction of the bulk, keyed by its index, gets either a hash or the reason it was not sent
 type MultipleTransactionsResponseData struct {
-	NumOfTxs  uint64         `json:"txsSent"`
-	TxsHashes map[int]string `json:"txsHashes"`
+	NumOfTxs  uint64                        `json:"txsSent"`
+	TxsHashes map[int]string                `json:"txsHashes"`
+	TxsErrors map[int]*TransactionSendError `json:"txsErrors,omitempty"`
+}
+
+// TransactionSendErrorCode defines the type of the reason codes of the transactions which were not sent
+type TransactionSendErrorCode string
+
+const (
+	// TxSendErrorValidation defines a transaction which did not pass the proxy validation
+	TxSendErrorValidation TransactionSendErrorCode = "validation"
+
+	// TxSendErrorNoObserver defines a transaction of a shard without available observers
+	TxSendErrorNoObserver TransactionSendErrorCode = "no_observer"
+
+	// TxSendErrorRejected defines a transaction which was not accepted by the observers
+	TxSendErrorRejected TransactionSendErrorCode = "rejected"
+
+	// TxSendErrorTimeout defines a transaction whose observers did not answer in time
+	TxSendErrorTimeout TransactionSendErrorCode = "timeout"
+)
+
+// TransactionSendError holds the reason why a transaction of a bulk was not sent
+type TransactionSendError struct {
+	Code   TransactionSendErrorCode `json:"code"`
+	Reason string                   `json:"reason"`
 }
 
 // ResponseMultipleTransactions defines a response from the node holding the number of transactions sent to the chain
@@ -287,3 +405,10 @@ type ProcessStatusResponse struct {
 	Status string `json:"status"`
 	Reason string `json:"reason"`
 }
+
+// TransactionTrackingCallback represents the payload posted to the callback URL of a tracked transaction
+type TransactionTrackingCallback struct {
+	TxHash string `json:"txHash"`
+	ProcessStatusResponse
+	TimedOut bool `json:"timedOut"`
+}
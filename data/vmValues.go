@@ -3,6 +3,7 @@ package data
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 )
 
 // VmValuesResponseData follows the format of the data field in an API response for a VM values query
@@ -40,4 +41,5 @@ type SCQuery struct {
 	Arguments      [][]byte
 	BlockNonce     core.OptionalUint64
 	BlockHash      []byte
+	Historical     common.HistoricalCoordinates
 }
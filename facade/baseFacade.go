@@ -1,10 +1,12 @@
 package facade
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
@@ -43,6 +45,10 @@ type ProxyFacade struct {
 
 	pubKeyConverter core.PubkeyConverter
 	aboutInfoProc   AboutInfoProcessor
+	txTracker       TransactionTracker
+	hyperStreamer   HyperblockStreamer
+	nonceReserver   NonceReserver
+	nonceDiagnoser  NonceGapDiagnoser
 }
 
 // NewProxyFacade creates a new ProxyFacade instance
@@ -62,6 +68,10 @@ func NewProxyFacade(
 	esdtSuppliesProc ESDTSupplyProcessor,
 	statusProc StatusProcessor,
 	aboutInfoProc AboutInfoProcessor,
+	txTracker TransactionTracker,
+	hyperStreamer HyperblockStreamer,
+	nonceReserver NonceReserver,
+	nonceDiagnoser NonceGapDiagnoser,
 ) (*ProxyFacade, error) {
 	if actionsProc == nil {
 		return nil, ErrNilActionsProcessor
@@ -105,6 +115,18 @@ func NewProxyFacade(
 	if aboutInfoProc == nil {
 		return nil, ErrNilAboutInfoProcessor
 	}
+	if check.IfNil(txTracker) {
+		return nil, ErrNilTransactionTracker
+	}
+	if check.IfNil(hyperStreamer) {
+		return nil, ErrNilHyperblockStreamer
+	}
+	if check.IfNil(nonceReserver) {
+		return nil, ErrNilNonceReserver
+	}
+	if check.IfNil(nonceDiagnoser) {
+		return nil, ErrNilNonceGapDiagnoser
+	}
 
 	return &ProxyFacade{
 		actionsProc:      actionsProc,
@@ -122,37 +144,41 @@ func NewProxyFacade(
 		esdtSuppliesProc: esdtSuppliesProc,
 		statusProc:       statusProc,
 		aboutInfoProc:    aboutInfoProc,
+		txTracker:        txTracker,
+		hyperStreamer:    hyperStreamer,
+		nonceReserver:    nonceReserver,
+		nonceDiagnoser:   nonceDiagnoser,
 	}, nil
 }
 
 // GetAccount returns an account based on the input address
-func (pf *ProxyFacade) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	return pf.accountProc.GetAccount(address, options)
+func (pf *ProxyFacade) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	return pf.accountProc.GetAccount(ctx, address, options)
 }
 
 // GetCodeHash returns the code hash for the given address
-func (pf *ProxyFacade) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetCodeHash(address, options)
+func (pf *ProxyFacade) GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetCodeHash(ctx, address, options)
 }
 
 // GetKeyValuePairs returns the key-value pairs for the given address
-func (pf *ProxyFacade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetKeyValuePairs(address, options)
+func (pf *ProxyFacade) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetKeyValuePairs(ctx, address, options)
 }
 
 // GetAccounts returns data about the provided addresses
-func (pf *ProxyFacade) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
-	return pf.accountProc.GetAccounts(addresses, options)
+func (pf *ProxyFacade) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+	return pf.accountProc.GetAccounts(ctx, addresses, options)
 }
 
 // GetValueForKey returns the value for the given address and key
-func (pf *ProxyFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
-	return pf.accountProc.GetValueForKey(address, key, options)
+func (pf *ProxyFacade) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
+	return pf.accountProc.GetValueForKey(ctx, address, key, options)
 }
 
 // GetGuardianData returns the guardian data for the given address
-func (pf *ProxyFacade) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetGuardianData(address, options)
+func (pf *ProxyFacade) GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetGuardianData(ctx, address, options)
 }
 
 // GetShardIDForAddress returns the computed shard ID for the given address based on the current proxy's configuration
@@ -161,68 +187,209 @@ func (pf *ProxyFacade) GetShardIDForAddress(address string) (uint32, error) {
 }
 
 // GetESDTTokenData returns the token data for a given token name
-func (pf *ProxyFacade) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTTokenData(address, key, options)
+func (pf *ProxyFacade) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetESDTTokenData(ctx, address, key, options)
 }
 
 // GetESDTNftTokenData returns the token data for a given token name
-func (pf *ProxyFacade) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTNftTokenData(address, key, nonce, options)
+func (pf *ProxyFacade) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetESDTNftTokenData(ctx, address, key, nonce, options)
 }
 
 // GetESDTsWithRole returns the tokens where the given address has the assigned role
-func (pf *ProxyFacade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTsWithRole(address, role, options)
+func (pf *ProxyFacade) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetESDTsWithRole(ctx, address, role, options)
 }
 
 // GetESDTsRoles returns the tokens and roles for the given address
-func (pf *ProxyFacade) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTsRoles(address, options)
+func (pf *ProxyFacade) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetESDTsRoles(ctx, address, options)
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
-func (pf *ProxyFacade) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetNFTTokenIDsRegisteredByAddress(address, options)
+func (pf *ProxyFacade) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetNFTTokenIDsRegisteredByAddress(ctx, address, options)
 }
 
 // GetAllESDTTokens returns all the ESDT tokens for a given address
-func (pf *ProxyFacade) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetAllESDTTokens(address, options)
+func (pf *ProxyFacade) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetAllESDTTokens(ctx, address, options)
 }
 
 // SendTransaction should send the transaction to the correct observer
-func (pf *ProxyFacade) SendTransaction(tx *data.Transaction) (int, string, error) {
-	return pf.txProc.SendTransaction(tx)
+func (pf *ProxyFacade) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
+	statusCode, txHash, err := pf.txProc.SendTransaction(ctx, tx)
+	if err == nil {
+		pf.nonceReserver.MarkSent(tx.Sender, tx.Nonce)
+	}
+
+	return statusCode, txHash, err
+}
+
+// BroadcastTransaction should send the transaction to multiple observers and check the acknowledgements quorum
+func (pf *ProxyFacade) BroadcastTransaction(
+	ctx context.Context,
+	tx *data.Transaction,
+	options common.TransactionBroadcastOptions,
+) (int, *data.TransactionBroadcastResponse, error) {
+	statusCode, response, err := pf.txProc.BroadcastTransaction(ctx, tx, options)
+	if err == nil {
+		pf.nonceReserver.MarkSent(tx.Sender, tx.Nonce)
+	}
+
+	return statusCode, response, err
+}
+
+// IsNonceReservationEnabled returns true if the senders' nonces can be reserved
+func (pf *ProxyFacade) IsNonceReservationEnabled() bool {
+	return pf.nonceReserver.IsEnabled()
+}
+
+// ReserveNextNonce reserves the next nonce of the sender
+func (pf *ProxyFacade) ReserveNextNonce(ctx context.Context, sender string) (*data.NonceReservation, error) {
+	return pf.nonceReserver.Reserve(ctx, sender)
+}
+
+// DiagnoseSenderNonces returns the missing nonces of the sender and the transactions from the pool which cannot be
+// executed because of them
+func (pf *ProxyFacade) DiagnoseSenderNonces(ctx context.Context, sender string) (*data.SenderNonceDiagnosis, error) {
+	return pf.nonceDiagnoser.Diagnose(ctx, sender)
+}
+
+// RepairSenderNonceGaps sends the pre-signed replacement transactions filling the missing nonces of the sender, in
+// nonce order
+func (pf *ProxyFacade) RepairSenderNonceGaps(ctx context.Context, sender string, txs []*data.Transaction) (*data.NonceGapsRepairResponse, error) {
+	diagnosis, err := pf.nonceDiagnoser.Diagnose(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sortedIndexes, err := pf.nonceDiagnoser.SortReplacementTransactions(diagnosis, txs)
+	if err != nil {
+		return nil, err
+	}
+
+	sortedTxs := make([]*data.Transaction, 0, len(sortedIndexes))
+	for _, idx := range sortedIndexes {
+		sortedTxs = append(sortedTxs, txs[idx])
+	}
+
+	response, err := pf.SendMultipleTransactions(ctx, sortedTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	txsHashes := make(map[int]string, len(response.TxsHashes))
+	for idx, hash := range response.TxsHashes {
+		if idx >= 0 && idx < len(sortedIndexes) {
+			txsHashes[sortedIndexes[idx]] = hash
+		}
+	}
+	txsErrors := make(map[int]*data.TransactionSendError, len(response.TxsErrors))
+	for idx, txErr := range response.TxsErrors {
+		if idx >= 0 && idx < len(sortedIndexes) {
+			txsErrors[sortedIndexes[idx]] = txErr
+		}
+	}
+
+	return &data.NonceGapsRepairResponse{
+		Diagnosis: diagnosis,
+		NumOfTxs:  response.NumOfTxs,
+		TxsHashes: txsHashes,
+		TxsErrors: txsErrors,
+	}, nil
+}
+
+// IsTransactionTrackingEnabled returns true if the sent transactions can be tracked until their final status
+func (pf *ProxyFacade) IsTransactionTrackingEnabled() bool {
+	return pf.txTracker.IsEnabled()
+}
+
+// CheckTransactionTracking returns nil if a transaction can be tracked with the provided callback URL
+func (pf *ProxyFacade) CheckTransactionTracking(callbackURL string) error {
+	return pf.txTracker.CheckTracking(callbackURL)
+}
+
+// TrackTransaction starts tracking the transaction, whose final status will be posted to the callback URL
+func (pf *ProxyFacade) TrackTransaction(txHash string, callbackURL string) error {
+	return pf.txTracker.Track(txHash, callbackURL)
+}
+
+// IsHyperblockStreamEnabled returns true if the new hyperblocks can be streamed to the subscribers
+func (pf *ProxyFacade) IsHyperblockStreamEnabled() bool {
+	return pf.hyperStreamer.IsEnabled()
+}
+
+// SubscribeToHyperblocks registers a new subscriber to the stream of the new hyperblocks
+func (pf *ProxyFacade) SubscribeToHyperblocks(ctx context.Context, options common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+	return pf.hyperStreamer.Subscribe(ctx, options)
+}
+
+// SubscribeToAddressActivity registers a new subscriber to the activity of a set of addresses, tokens and events
+func (pf *ProxyFacade) SubscribeToAddressActivity(ctx context.Context, options common.AddressActivityOptions) (data.AddressActivitySubscription, error) {
+	return pf.hyperStreamer.SubscribeToAddressActivity(ctx, options)
+}
+
+// IsTransactionBroadcastEnabled returns true if the transactions are broadcast to multiple observers by default
+func (pf *ProxyFacade) IsTransactionBroadcastEnabled() bool {
+	return pf.txProc.IsBroadcastEnabled()
 }
 
 // SendMultipleTransactions should send the transactions to the correct observers
-func (pf *ProxyFacade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
-	return pf.txProc.SendMultipleTransactions(txs)
+func (pf *ProxyFacade) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+	response, err := pf.txProc.SendMultipleTransactions(ctx, txs)
+	for idx := range response.TxsHashes {
+		if idx >= 0 && idx < len(txs) {
+			pf.nonceReserver.MarkSent(txs[idx].Sender, txs[idx].Nonce)
+		}
+	}
+
+	return response, err
 }
 
 // SimulateTransaction should send the transaction to the correct observer for simulation
-func (pf *ProxyFacade) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
-	return pf.txProc.SimulateTransaction(tx, checkSignature)
+func (pf *ProxyFacade) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+	return pf.txProc.SimulateTransaction(ctx, tx, checkSignature)
+}
+
+// ValidateTransaction runs on the transaction all the checks which can be done without sending it to the observers
+func (pf *ProxyFacade) ValidateTransaction(ctx context.Context, tx *data.Transaction) (*data.TransactionValidationResult, error) {
+	networkCfg, err := pf.getNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.txProc.ValidateTransaction(tx, networkCfg), nil
+}
+
+// EstimateTransactionFee estimates the gas units and the fee of a transaction, using the economics of the network config
+func (pf *ProxyFacade) EstimateTransactionFee(ctx context.Context, tx *data.Transaction) (*data.TransactionFeeEstimation, error) {
+	networkCfg, err := pf.getNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.txProc.EstimateTransactionFee(ctx, tx, networkCfg)
 }
 
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (pf *ProxyFacade) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
-	return pf.txProc.TransactionCostRequest(tx)
+func (pf *ProxyFacade) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+	return pf.txProc.TransactionCostRequest(ctx, tx)
 }
 
 // GetTransactionStatus should return transaction status
-func (pf *ProxyFacade) GetTransactionStatus(txHash string, sender string) (string, error) {
-	return pf.txProc.GetTransactionStatus(txHash, sender)
+func (pf *ProxyFacade) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	return pf.txProc.GetTransactionStatus(ctx, txHash, sender)
 }
 
 // GetProcessedTransactionStatus should return transaction status after internal processing of the transaction results
-func (pf *ProxyFacade) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
-	return pf.txProc.GetProcessedTransactionStatus(txHash)
+func (pf *ProxyFacade) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+	return pf.txProc.GetProcessedTransactionStatus(ctx, txHash)
 }
 
 // GetTransaction should return a transaction by hash
-func (pf *ProxyFacade) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	return pf.txProc.GetTransaction(txHash, withResults)
+func (pf *ProxyFacade) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	return pf.txProc.GetTransaction(ctx, txHash, withResults)
 }
 
 // ReloadObservers will try to reload the observers
@@ -236,8 +403,8 @@ func (pf *ProxyFacade) ReloadFullHistoryObservers() data.NodesReloadResponse {
 }
 
 // GetTransactionByHashAndSenderAddress should return a transaction by hash and sender address
-func (pf *ProxyFacade) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
-	return pf.txProc.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+func (pf *ProxyFacade) GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+	return pf.txProc.GetTransactionByHashAndSenderAddress(ctx, txHash, sndAddr, withEvents)
 }
 
 // IsFaucetEnabled returns true if the faucet mechanism is enabled or false otherwise
@@ -246,18 +413,18 @@ func (pf *ProxyFacade) IsFaucetEnabled() bool {
 }
 
 // SendUserFunds should send a transaction to load one user's account with extra funds from an account in the pem file
-func (pf *ProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
+func (pf *ProxyFacade) SendUserFunds(ctx context.Context, receiver string, value *big.Int) error {
 	senderSk, senderPk, err := pf.faucetProc.SenderDetailsFromPem(receiver)
 	if err != nil {
 		return err
 	}
 
-	senderAccount, err := pf.accountProc.GetAccount(senderPk, common.AccountQueryOptions{})
+	senderAccount, err := pf.accountProc.GetAccount(ctx, senderPk, common.AccountQueryOptions{})
 	if err != nil {
 		return err
 	}
 
-	networkCfg, err := pf.getNetworkConfig()
+	networkCfg, err := pf.getNetworkConfig(ctx)
 	if err != nil {
 		return err
 	}
@@ -274,12 +441,12 @@ func (pf *ProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
 		return err
 	}
 
-	_, _, err = pf.txProc.SendTransaction(tx)
+	_, _, err = pf.txProc.SendTransaction(ctx, tx)
 	return err
 }
 
-func (pf *ProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
-	genericResponse, err := pf.nodeStatusProc.GetNetworkConfigMetrics()
+func (pf *ProxyFacade) getNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	genericResponse, err := pf.nodeStatusProc.GetNetworkConfigMetrics(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -296,108 +463,108 @@ func (pf *ProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
 }
 
 // ExecuteSCQuery retrieves data from existing SC trie through the use of a VM
-func (pf *ProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
-	return pf.scQueryService.ExecuteQuery(query)
+func (pf *ProxyFacade) ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	return pf.scQueryService.ExecuteQuery(ctx, query)
 }
 
 // GetHeartbeatData retrieves the heartbeat status from one observer
-func (pf *ProxyFacade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
-	return pf.nodeGroupProc.GetHeartbeatData()
+func (pf *ProxyFacade) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
+	return pf.nodeGroupProc.GetHeartbeatData(ctx)
 }
 
 // GetNetworkConfigMetrics retrieves the node's configuration's metrics
-func (pf *ProxyFacade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetNetworkConfigMetrics()
+func (pf *ProxyFacade) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetNetworkConfigMetrics(ctx)
 }
 
 // GetNetworkStatusMetrics retrieves the node's network metrics for a given shard
-func (pf *ProxyFacade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetNetworkStatusMetrics(shardID)
+func (pf *ProxyFacade) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetNetworkStatusMetrics(ctx, shardID)
 }
 
 // GetESDTSupply retrieves the supply for the provided token
-func (pf *ProxyFacade) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
-	return pf.esdtSuppliesProc.GetESDTSupply(token)
+func (pf *ProxyFacade) GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error) {
+	return pf.esdtSuppliesProc.GetESDTSupply(ctx, token)
 }
 
 // GetEconomicsDataMetrics retrieves the node's network metrics for a given shard
-func (pf *ProxyFacade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetEconomicsDataMetrics()
+func (pf *ProxyFacade) GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetEconomicsDataMetrics(ctx)
 }
 
 // GetDelegatedInfo retrieves the node's network delegated info
-func (pf *ProxyFacade) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetDelegatedInfo()
+func (pf *ProxyFacade) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetDelegatedInfo(ctx)
 }
 
 // GetDirectStakedInfo retrieves the node's direct staked values
-func (pf *ProxyFacade) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetDirectStakedInfo()
+func (pf *ProxyFacade) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetDirectStakedInfo(ctx)
 }
 
 // GetAllIssuedESDTs retrieves all the issued ESDTs from the node
-func (pf *ProxyFacade) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetAllIssuedESDTs(tokenType)
+func (pf *ProxyFacade) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetAllIssuedESDTs(ctx, tokenType)
 }
 
 // GetEnableEpochsMetrics retrieves the activation epochs
-func (pf *ProxyFacade) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetEnableEpochsMetrics()
+func (pf *ProxyFacade) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetEnableEpochsMetrics(ctx)
 }
 
 // GetRatingsConfig retrieves the node's configuration's metrics
-func (pf *ProxyFacade) GetRatingsConfig() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetRatingsConfig()
+func (pf *ProxyFacade) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetRatingsConfig(ctx)
 }
 
 // GetBlockByHash retrieves the block by hash for a given shard
-func (pf *ProxyFacade) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return pf.blockProc.GetBlockByHash(shardID, hash, options)
+func (pf *ProxyFacade) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetBlockByHash(ctx, shardID, hash, options)
 }
 
 // GetBlockByNonce retrieves the block by nonce for a given shard
-func (pf *ProxyFacade) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return pf.blockProc.GetBlockByNonce(shardID, nonce, options)
+func (pf *ProxyFacade) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetBlockByNonce(ctx, shardID, nonce, options)
 }
 
 // GetBlocksByRound retrieves the blocks for a given round
-func (pf *ProxyFacade) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
-	return pf.blocksProc.GetBlocksByRound(round, options)
+func (pf *ProxyFacade) GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	return pf.blocksProc.GetBlocksByRound(ctx, round, options)
 }
 
 // GetInternalBlockByHash retrieves the internal block by hash for a given shard
-func (pf *ProxyFacade) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return pf.blockProc.GetInternalBlockByHash(shardID, hash, format)
+func (pf *ProxyFacade) GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return pf.blockProc.GetInternalBlockByHash(ctx, shardID, hash, format)
 }
 
 // GetInternalBlockByNonce retrieves the internal block by nonce for a given shard
-func (pf *ProxyFacade) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return pf.blockProc.GetInternalBlockByNonce(shardID, nonce, format)
+func (pf *ProxyFacade) GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return pf.blockProc.GetInternalBlockByNonce(ctx, shardID, nonce, format)
 }
 
 // GetInternalStartOfEpochMetaBlock retrieves the internal block by nonce for a given shard
-func (pf *ProxyFacade) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return pf.blockProc.GetInternalStartOfEpochMetaBlock(epoch, format)
+func (pf *ProxyFacade) GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return pf.blockProc.GetInternalStartOfEpochMetaBlock(ctx, epoch, format)
 }
 
 // GetInternalMiniBlockByHash retrieves the internal miniblock by hash for a given shard
-func (pf *ProxyFacade) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
-	return pf.blockProc.GetInternalMiniBlockByHash(shardID, hash, epoch, format)
+func (pf *ProxyFacade) GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+	return pf.blockProc.GetInternalMiniBlockByHash(ctx, shardID, hash, epoch, format)
 }
 
 // GetHyperBlockByHash retrieves the hyperblock by hash
-func (pf *ProxyFacade) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	return pf.blockProc.GetHyperBlockByHash(hash, options)
+func (pf *ProxyFacade) GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return pf.blockProc.GetHyperBlockByHash(ctx, hash, options)
 }
 
 // GetHyperBlockByNonce retrieves the block by nonce
-func (pf *ProxyFacade) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	return pf.blockProc.GetHyperBlockByNonce(nonce, options)
+func (pf *ProxyFacade) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return pf.blockProc.GetHyperBlockByNonce(ctx, nonce, options)
 }
 
 // ValidatorStatistics will return the statistics from an observer
-func (pf *ProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
-	valStats, err := pf.valStatsProc.GetValidatorStatistics()
+func (pf *ProxyFacade) ValidatorStatistics(ctx context.Context) (map[string]*data.ValidatorApiResponse, error) {
+	valStats, err := pf.valStatsProc.GetValidatorStatistics(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -406,8 +573,8 @@ func (pf *ProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiRespo
 }
 
 // AuctionList will return the auction list
-func (epf *ProxyFacade) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
-	auctionList, err := epf.valStatsProc.GetAuctionList()
+func (epf *ProxyFacade) AuctionList(ctx context.Context) ([]*data.AuctionListValidatorAPIResponse, error) {
+	auctionList, err := epf.valStatsProc.GetAuctionList(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -421,8 +588,8 @@ func (pf *ProxyFacade) GetAddressConverter() (core.PubkeyConverter, error) {
 }
 
 // GetLatestFullySynchronizedHyperblockNonce returns the latest fully synchronized hyperblock nonce
-func (pf *ProxyFacade) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
-	return pf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+func (pf *ProxyFacade) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
+	return pf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(ctx)
 }
 
 // ComputeTransactionHash will compute hash of a given transaction
@@ -431,53 +598,53 @@ func (pf *ProxyFacade) ComputeTransactionHash(tx *data.Transaction) (string, err
 }
 
 // GetTransactionsPool returns all txs from pool
-func (pf *ProxyFacade) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
-	return pf.txProc.GetTransactionsPool(fields)
+func (pf *ProxyFacade) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
+	return pf.txProc.GetTransactionsPool(ctx, fields)
 }
 
 // GetTransactionsPoolForShard returns all txs from shard's pool
-func (pf *ProxyFacade) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
-	return pf.txProc.GetTransactionsPoolForShard(shardID, fields)
+func (pf *ProxyFacade) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
+	return pf.txProc.GetTransactionsPoolForShard(ctx, shardID, fields)
 }
 
 // GetTransactionsPoolForSender returns tx pool for sender
-func (pf *ProxyFacade) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	return pf.txProc.GetTransactionsPoolForSender(sender, fields)
+func (pf *ProxyFacade) GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
+	return pf.txProc.GetTransactionsPoolForSender(ctx, sender, fields)
 }
 
 // GetLastPoolNonceForSender returns last nonce from tx pool for sender
-func (pf *ProxyFacade) GetLastPoolNonceForSender(sender string) (uint64, error) {
-	return pf.txProc.GetLastPoolNonceForSender(sender)
+func (pf *ProxyFacade) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	return pf.txProc.GetLastPoolNonceForSender(ctx, sender)
 }
 
 // IsOldStorageForToken returns true is the storage for a given token is old
-func (pf *ProxyFacade) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
-	return pf.nodeGroupProc.IsOldStorageForToken(tokenID, nonce)
+func (pf *ProxyFacade) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
+	return pf.nodeGroupProc.IsOldStorageForToken(ctx, tokenID, nonce)
 }
 
 // GetTransactionsPoolNonceGapsForSender returns all nonce gaps from tx pool for sender
-func (pf *ProxyFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	return pf.txProc.GetTransactionsPoolNonceGapsForSender(sender)
+func (pf *ProxyFacade) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	return pf.txProc.GetTransactionsPoolNonceGapsForSender(ctx, sender)
 }
 
 // GetProof returns the Merkle proof for the given address
-func (pf *ProxyFacade) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
-	return pf.proofProc.GetProof(rootHash, address)
+func (pf *ProxyFacade) GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.GetProof(ctx, rootHash, address)
 }
 
 // GetProofDataTrie returns a Merkle proof for the given address and a Merkle proof for the given key
-func (pf *ProxyFacade) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
-	return pf.proofProc.GetProofDataTrie(rootHash, address, key)
+func (pf *ProxyFacade) GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.GetProofDataTrie(ctx, rootHash, address, key)
 }
 
 // GetProofCurrentRootHash returns the Merkle proof for the given address
-func (pf *ProxyFacade) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
-	return pf.proofProc.GetProofCurrentRootHash(address)
+func (pf *ProxyFacade) GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.GetProofCurrentRootHash(ctx, address)
 }
 
 // VerifyProof verifies the given Merkle proof
-func (pf *ProxyFacade) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
-	return pf.proofProc.VerifyProof(rootHash, address, proof)
+func (pf *ProxyFacade) VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.VerifyProof(ctx, rootHash, address, proof)
 }
 
 // GetMetrics will return the status metrics
@@ -490,14 +657,19 @@ func (pf *ProxyFacade) GetMetricsForPrometheus() string {
 	return pf.statusProc.GetMetricsForPrometheus()
 }
 
+// GetObserversHealth will return the health details of the observers
+func (pf *ProxyFacade) GetObserversHealth() []*data.NodeHealth {
+	return pf.statusProc.GetObserversHealth()
+}
+
 // GetGenesisNodesPubKeys retrieves the node's configuration public keys
-func (pf *ProxyFacade) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetGenesisNodesPubKeys()
+func (pf *ProxyFacade) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetGenesisNodesPubKeys(ctx)
 }
 
 // GetGasConfigs retrieves the current gas schedule configs
-func (pf *ProxyFacade) GetGasConfigs() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetGasConfigs()
+func (pf *ProxyFacade) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetGasConfigs(ctx)
 }
 
 // GetAboutInfo will return the app info
@@ -506,41 +678,46 @@ func (pf *ProxyFacade) GetAboutInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetNodesVersions will return the version of the nodes
-func (pf *ProxyFacade) GetNodesVersions() (*data.GenericAPIResponse, error) {
-	return pf.aboutInfoProc.GetNodesVersions()
+func (pf *ProxyFacade) GetNodesVersions(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.aboutInfoProc.GetNodesVersions(ctx)
 }
 
 // GetAlteredAccountsByNonce returns altered accounts by nonce in block
-func (pf *ProxyFacade) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
-	return pf.blockProc.GetAlteredAccountsByNonce(shardID, nonce, options)
+func (pf *ProxyFacade) GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+	return pf.blockProc.GetAlteredAccountsByNonce(ctx, shardID, nonce, options)
 }
 
 // GetAlteredAccountsByHash returns altered accounts by hash in block
-func (pf *ProxyFacade) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
-	return pf.blockProc.GetAlteredAccountsByHash(shardID, hash, options)
+func (pf *ProxyFacade) GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+	return pf.blockProc.GetAlteredAccountsByHash(ctx, shardID, hash, options)
 }
 
 // GetTriesStatistics will return trie statistics
-func (pf *ProxyFacade) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
-	return pf.nodeStatusProc.GetTriesStatistics(shardID)
+func (pf *ProxyFacade) GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+	return pf.nodeStatusProc.GetTriesStatistics(ctx, shardID)
 }
 
 // GetEpochStartData retrieves epoch start data for the provides epoch and shard ID
-func (pf *ProxyFacade) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetEpochStartData(epoch, shardID)
+func (pf *ProxyFacade) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetEpochStartData(ctx, epoch, shardID)
 }
 
 // GetInternalStartOfEpochValidatorsInfo retrieves the validators info by epoch
-func (pf *ProxyFacade) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
-	return pf.blockProc.GetInternalStartOfEpochValidatorsInfo(epoch)
+func (pf *ProxyFacade) GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+	return pf.blockProc.GetInternalStartOfEpochValidatorsInfo(ctx, epoch)
 }
 
 // GetWaitingEpochsLeftForPublicKey returns the number of epochs left for the public key until it becomes eligible
-func (epf *ProxyFacade) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
-	return epf.nodeGroupProc.GetWaitingEpochsLeftForPublicKey(publicKey)
+func (epf *ProxyFacade) GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+	return epf.nodeGroupProc.GetWaitingEpochsLeftForPublicKey(ctx, publicKey)
 }
 
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
-func (pf *ProxyFacade) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.IsDataTrieMigrated(address, options)
+func (pf *ProxyFacade) IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.IsDataTrieMigrated(ctx, address, options)
+}
+
+// GetAccountDiff returns the changes of the account between two blocks of its shard
+func (pf *ProxyFacade) GetAccountDiff(ctx context.Context, address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+	return pf.accountProc.GetAccountDiff(ctx, address, options)
 }
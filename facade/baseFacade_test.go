@@ -1,8 +1,10 @@
 package facade_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
+	"net/http"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
@@ -45,6 +47,10 @@ func TestNewProxyFacade_NilActionsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -70,6 +76,10 @@ func TestNewProxyFacade_NilAccountProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -95,6 +105,10 @@ func TestNewProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -120,6 +134,10 @@ func TestNewProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -145,6 +163,10 @@ func TestNewProxyFacade_NilNodeGroupProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -170,6 +192,10 @@ func TestNewProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -195,6 +221,10 @@ func TestNewProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -220,6 +250,10 @@ func TestNewProxyFacade_NilNodeProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -245,6 +279,10 @@ func TestNewProxyFacade_NilBlocksProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -270,6 +308,10 @@ func TestNewProxyFacade_NilProofProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -295,6 +337,10 @@ func TestNewProxyFacade_NilStatusProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		nil,
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -320,12 +366,132 @@ func TestNewProxyFacade_NilAboutInfoProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		nil,
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.Nil(t, epf)
 	assert.Equal(t, facade.ErrNilAboutInfoProcessor, err)
 }
 
+func TestNewProxyFacade_NilTransactionTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		nil,
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionTracker, err)
+}
+
+func TestNewProxyFacade_NilHyperblockStreamerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		nil,
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilHyperblockStreamer, err)
+}
+
+func TestNewProxyFacade_NilNonceReserverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		nil,
+		&mock.NonceGapDiagnoserStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilNonceReserver, err)
+}
+
+func TestNewProxyFacade_NilNonceGapDiagnoserShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		nil,
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilNonceGapDiagnoser, err)
+}
+
 func TestNewProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -345,6 +511,10 @@ func TestNewProxyFacade_ShouldWork(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	assert.NotNil(t, epf)
@@ -393,14 +563,18 @@ func TestNewProxyFacade_GetBlocksByRound(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 	require.NoError(t, err)
 
-	ret, err := epf.GetBlocksByRound(3, common.BlockQueryOptions{WithTransactions: true})
+	ret, err := epf.GetBlocksByRound(context.Background(), 3, common.BlockQueryOptions{WithTransactions: true})
 	require.Equal(t, errGetBlockByRound, err)
 	require.Nil(t, ret)
 
-	ret, err = epf.GetBlocksByRound(4, common.BlockQueryOptions{WithTransactions: true})
+	ret, err = epf.GetBlocksByRound(context.Background(), 4, common.BlockQueryOptions{WithTransactions: true})
 	require.Nil(t, err)
 	require.Equal(t, expectedResponse, ret)
 }
@@ -430,9 +604,13 @@ func TestProxyFacade_GetAccount(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	_, _ = epf.GetAccount("", common.AccountQueryOptions{})
+	_, _ = epf.GetAccount(context.Background(), "", common.AccountQueryOptions{})
 
 	assert.True(t, wasCalled)
 }
@@ -463,13 +641,361 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	_, _, _ = epf.SendTransaction(&data.Transaction{})
+	_, _, _ = epf.SendTransaction(context.Background(), &data.Transaction{})
 
 	assert.True(t, wasCalled)
 }
 
+func TestProxyFacade_SendTransactionsShouldMarkTheReservedNonces(t *testing.T) {
+	t.Parallel()
+
+	markedNonces := make([]uint64, 0)
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				if tx.Nonce == 2 {
+					return http.StatusBadRequest, "", errors.New("invalid transaction")
+				}
+
+				return http.StatusOK, "hash", nil
+			},
+			SendMultipleTransactionsCalled: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+				return data.MultipleTransactionsResponseData{
+					NumOfTxs:  1,
+					TxsHashes: map[int]string{1: "hash"},
+				}, nil
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{
+			MarkSentCalled: func(sender string, nonce uint64) {
+				assert.Equal(t, "sender", sender)
+				markedNonces = append(markedNonces, nonce)
+			},
+		},
+		&mock.NonceGapDiagnoserStub{},
+	)
+
+	_, _, _ = epf.SendTransaction(context.Background(), &data.Transaction{Sender: "sender", Nonce: 1})
+	_, _, _ = epf.SendTransaction(context.Background(), &data.Transaction{Sender: "sender", Nonce: 2})
+	_, _ = epf.SendMultipleTransactions(context.Background(), []*data.Transaction{
+		{Sender: "sender", Nonce: 3},
+		{Sender: "sender", Nonce: 4},
+	})
+
+	assert.Equal(t, []uint64{1, 4}, markedNonces)
+}
+
+func TestProxyFacade_ValidateTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("network config error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		epf, _ := facade.NewProxyFacade(
+			&mock.ActionsProcessorStub{},
+			&mock.AccountProcessorStub{},
+			&mock.TransactionProcessorStub{
+				ValidateTransactionCalled: func(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult {
+					assert.Fail(t, "should have not been called")
+					return nil
+				},
+			},
+			&mock.SCQueryServiceStub{},
+			&mock.NodeGroupProcessorStub{},
+			&mock.ValidatorStatisticsProcessorStub{},
+			&mock.FaucetProcessorStub{},
+			&mock.NodeStatusProcessorStub{
+				GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+					return nil, expectedErr
+				},
+			},
+			&mock.BlockProcessorStub{},
+			&mock.BlocksProcessorStub{},
+			&mock.ProofProcessorStub{},
+			publicKeyConverter,
+			&mock.ESDTSuppliesProcessorStub{},
+			&mock.StatusProcessorStub{},
+			&mock.AboutInfoProcessorStub{},
+			&mock.TransactionTrackerStub{},
+			&mock.HyperblockStreamerStub{},
+			&mock.NonceReserverStub{},
+			&mock.NonceGapDiagnoserStub{},
+		)
+
+		result, err := epf.ValidateTransaction(context.Background(), &data.Transaction{})
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should validate against the network config", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResult := &data.TransactionValidationResult{Valid: true}
+		epf, _ := facade.NewProxyFacade(
+			&mock.ActionsProcessorStub{},
+			&mock.AccountProcessorStub{},
+			&mock.TransactionProcessorStub{
+				ValidateTransactionCalled: func(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult {
+					assert.Equal(t, "T", networkConfig.Config.ChainID)
+					assert.Equal(t, uint64(1500), networkConfig.Config.GasPerDataByte)
+					return expectedResult
+				},
+			},
+			&mock.SCQueryServiceStub{},
+			&mock.NodeGroupProcessorStub{},
+			&mock.ValidatorStatisticsProcessorStub{},
+			&mock.FaucetProcessorStub{},
+			&mock.NodeStatusProcessorStub{
+				GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+					return &data.GenericAPIResponse{
+						Data: map[string]interface{}{
+							"config": map[string]interface{}{
+								"erd_chain_id":          "T",
+								"erd_gas_per_data_byte": 1500,
+							},
+						},
+					}, nil
+				},
+			},
+			&mock.BlockProcessorStub{},
+			&mock.BlocksProcessorStub{},
+			&mock.ProofProcessorStub{},
+			publicKeyConverter,
+			&mock.ESDTSuppliesProcessorStub{},
+			&mock.StatusProcessorStub{},
+			&mock.AboutInfoProcessorStub{},
+			&mock.TransactionTrackerStub{},
+			&mock.HyperblockStreamerStub{},
+			&mock.NonceReserverStub{},
+			&mock.NonceGapDiagnoserStub{},
+		)
+
+		result, err := epf.ValidateTransaction(context.Background(), &data.Transaction{})
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResult, result)
+	})
+}
+
+func TestProxyFacade_EstimateTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("network config error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		epf, _ := facade.NewProxyFacade(
+			&mock.ActionsProcessorStub{},
+			&mock.AccountProcessorStub{},
+			&mock.TransactionProcessorStub{
+				EstimateTransactionFeeCalled: func(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeEstimation, error) {
+					assert.Fail(t, "should have not been called")
+					return nil, nil
+				},
+			},
+			&mock.SCQueryServiceStub{},
+			&mock.NodeGroupProcessorStub{},
+			&mock.ValidatorStatisticsProcessorStub{},
+			&mock.FaucetProcessorStub{},
+			&mock.NodeStatusProcessorStub{
+				GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+					return nil, expectedErr
+				},
+			},
+			&mock.BlockProcessorStub{},
+			&mock.BlocksProcessorStub{},
+			&mock.ProofProcessorStub{},
+			publicKeyConverter,
+			&mock.ESDTSuppliesProcessorStub{},
+			&mock.StatusProcessorStub{},
+			&mock.AboutInfoProcessorStub{},
+			&mock.TransactionTrackerStub{},
+			&mock.HyperblockStreamerStub{},
+			&mock.NonceReserverStub{},
+			&mock.NonceGapDiagnoserStub{},
+		)
+
+		estimation, err := epf.EstimateTransactionFee(context.Background(), &data.Transaction{})
+		assert.Nil(t, estimation)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should estimate with the network economics", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEstimation := &data.TransactionFeeEstimation{GasUnits: 50000}
+		epf, _ := facade.NewProxyFacade(
+			&mock.ActionsProcessorStub{},
+			&mock.AccountProcessorStub{},
+			&mock.TransactionProcessorStub{
+				EstimateTransactionFeeCalled: func(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeEstimation, error) {
+					assert.Equal(t, "0.01", networkConfig.Config.GasPriceModifier)
+					assert.Equal(t, uint64(18), networkConfig.Config.Denomination)
+					return expectedEstimation, nil
+				},
+			},
+			&mock.SCQueryServiceStub{},
+			&mock.NodeGroupProcessorStub{},
+			&mock.ValidatorStatisticsProcessorStub{},
+			&mock.FaucetProcessorStub{},
+			&mock.NodeStatusProcessorStub{
+				GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+					return &data.GenericAPIResponse{
+						Data: map[string]interface{}{
+							"config": map[string]interface{}{
+								"erd_gas_price_modifier": "0.01",
+								"erd_denomination":       18,
+							},
+						},
+					}, nil
+				},
+			},
+			&mock.BlockProcessorStub{},
+			&mock.BlocksProcessorStub{},
+			&mock.ProofProcessorStub{},
+			publicKeyConverter,
+			&mock.ESDTSuppliesProcessorStub{},
+			&mock.StatusProcessorStub{},
+			&mock.AboutInfoProcessorStub{},
+			&mock.TransactionTrackerStub{},
+			&mock.HyperblockStreamerStub{},
+			&mock.NonceReserverStub{},
+			&mock.NonceGapDiagnoserStub{},
+		)
+
+		estimation, err := epf.EstimateTransactionFee(context.Background(), &data.Transaction{})
+		assert.NoError(t, err)
+		assert.Equal(t, expectedEstimation, estimation)
+	})
+}
+
+func TestProxyFacade_RepairSenderNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid replacement transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		epf, _ := facade.NewProxyFacade(
+			&mock.ActionsProcessorStub{},
+			&mock.AccountProcessorStub{},
+			&mock.TransactionProcessorStub{
+				SendMultipleTransactionsCalled: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+					assert.Fail(t, "should have not been called")
+					return data.MultipleTransactionsResponseData{}, nil
+				},
+			},
+			&mock.SCQueryServiceStub{},
+			&mock.NodeGroupProcessorStub{},
+			&mock.ValidatorStatisticsProcessorStub{},
+			&mock.FaucetProcessorStub{},
+			&mock.NodeStatusProcessorStub{},
+			&mock.BlockProcessorStub{},
+			&mock.BlocksProcessorStub{},
+			&mock.ProofProcessorStub{},
+			publicKeyConverter,
+			&mock.ESDTSuppliesProcessorStub{},
+			&mock.StatusProcessorStub{},
+			&mock.AboutInfoProcessorStub{},
+			&mock.TransactionTrackerStub{},
+			&mock.HyperblockStreamerStub{},
+			&mock.NonceReserverStub{},
+			&mock.NonceGapDiagnoserStub{
+				SortReplacementTransactionsCalled: func(diagnosis *data.SenderNonceDiagnosis, txs []*data.Transaction) ([]int, error) {
+					return nil, expectedErr
+				},
+			},
+		)
+
+		response, err := epf.RepairSenderNonceGaps(context.Background(), "sender", []*data.Transaction{{Sender: "sender"}})
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should send the transactions in nonce order", func(t *testing.T) {
+		t.Parallel()
+
+		providedDiagnosis := &data.SenderNonceDiagnosis{
+			Address:       "sender",
+			AccountNonce:  5,
+			MissingNonces: []data.NonceGap{{From: 6, To: 7}},
+		}
+		markedNonces := make([]uint64, 0)
+		epf, _ := facade.NewProxyFacade(
+			&mock.ActionsProcessorStub{},
+			&mock.AccountProcessorStub{},
+			&mock.TransactionProcessorStub{
+				SendMultipleTransactionsCalled: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+					require.Len(t, txs, 2)
+					assert.Equal(t, uint64(6), txs[0].Nonce)
+					assert.Equal(t, uint64(7), txs[1].Nonce)
+					return data.MultipleTransactionsResponseData{
+						NumOfTxs:  2,
+						TxsHashes: map[int]string{0: "hash6", 1: "hash7"},
+					}, nil
+				},
+			},
+			&mock.SCQueryServiceStub{},
+			&mock.NodeGroupProcessorStub{},
+			&mock.ValidatorStatisticsProcessorStub{},
+			&mock.FaucetProcessorStub{},
+			&mock.NodeStatusProcessorStub{},
+			&mock.BlockProcessorStub{},
+			&mock.BlocksProcessorStub{},
+			&mock.ProofProcessorStub{},
+			publicKeyConverter,
+			&mock.ESDTSuppliesProcessorStub{},
+			&mock.StatusProcessorStub{},
+			&mock.AboutInfoProcessorStub{},
+			&mock.TransactionTrackerStub{},
+			&mock.HyperblockStreamerStub{},
+			&mock.NonceReserverStub{
+				MarkSentCalled: func(sender string, nonce uint64) {
+					markedNonces = append(markedNonces, nonce)
+				},
+			},
+			&mock.NonceGapDiagnoserStub{
+				DiagnoseCalled: func(sender string) (*data.SenderNonceDiagnosis, error) {
+					return providedDiagnosis, nil
+				},
+				SortReplacementTransactionsCalled: func(diagnosis *data.SenderNonceDiagnosis, txs []*data.Transaction) ([]int, error) {
+					assert.Equal(t, providedDiagnosis, diagnosis)
+					return []int{1, 0}, nil
+				},
+			},
+		)
+
+		response, err := epf.RepairSenderNonceGaps(context.Background(), "sender", []*data.Transaction{
+			{Sender: "sender", Nonce: 7},
+			{Sender: "sender", Nonce: 6},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, providedDiagnosis, response.Diagnosis)
+		assert.Equal(t, uint64(2), response.NumOfTxs)
+		assert.Equal(t, map[int]string{0: "hash7", 1: "hash6"}, response.TxsHashes)
+		assert.Equal(t, []uint64{6, 7}, markedNonces)
+	})
+}
+
 func TestProxyFacade_SimulateTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -495,9 +1021,13 @@ func TestProxyFacade_SimulateTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	_, _ = epf.SimulateTransaction(&data.Transaction{}, false)
+	_, _ = epf.SimulateTransaction(context.Background(), &data.Transaction{}, false)
 
 	assert.True(t, wasCalled)
 }
@@ -553,9 +1083,13 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	_ = epf.SendUserFunds("", big.NewInt(0))
+	_ = epf.SendUserFunds(context.Background(), "", big.NewInt(0))
 
 	assert.True(t, wasCalled)
 }
@@ -585,9 +1119,13 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	_, _, _ = epf.ExecuteSCQuery(nil)
+	_, _, _ = epf.ExecuteSCQuery(context.Background(), nil)
 
 	assert.True(t, wasCalled)
 }
@@ -623,9 +1161,13 @@ func TestProxyFacade_GetHeartbeatData(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, _ := epf.GetHeartbeatData()
+	actualResult, _ := epf.GetHeartbeatData(context.Background())
 
 	assert.Equal(t, expectedResults, actualResult)
 }
@@ -658,6 +1200,10 @@ func TestProxyFacade_ReloadObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	actualResult := epf.ReloadObservers()
@@ -693,6 +1239,10 @@ func TestProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
 	actualResult := epf.ReloadFullHistoryObservers()
@@ -732,9 +1282,13 @@ func TestProxyFacade_GetBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, err := epf.GetBlockByHash(0, "aaaa", common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByHash(context.Background(), 0, "aaaa", common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -772,9 +1326,13 @@ func TestProxyFacade_GetBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, err := epf.GetBlockByNonce(0, 10, common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByNonce(context.Background(), 0, 10, common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -814,9 +1372,13 @@ func TestProxyFacade_GetInternalBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, err := epf.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	actualResult, err := epf.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -854,9 +1416,13 @@ func TestProxyFacade_GetInternalBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, err := epf.GetInternalBlockByNonce(0, 10, common.Internal)
+	actualResult, err := epf.GetInternalBlockByNonce(context.Background(), 0, 10, common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -894,9 +1460,13 @@ func TestProxyFacade_GetInternalMiniBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, err := epf.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	actualResult, err := epf.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -932,9 +1502,13 @@ func TestProxyFacade_GetRatingsConfig(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, err := epf.GetRatingsConfig()
+	actualResult, err := epf.GetRatingsConfig(context.Background())
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -997,25 +1571,29 @@ func TestProxyFacade_GetTransactionsPool(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualTxPool, err := epf.GetTransactionsPool("")
+	actualTxPool, err := epf.GetTransactionsPool(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPool, err = epf.GetTransactionsPoolForShard(0, "")
+	actualTxPool, err = epf.GetTransactionsPoolForShard(context.Background(), 0, "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPoolForSender, err := epf.GetTransactionsPoolForSender("", "")
+	actualTxPoolForSender, err := epf.GetTransactionsPoolForSender(context.Background(), "", "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPoolForSender, actualTxPoolForSender)
 
-	actualNonce, err := epf.GetLastPoolNonceForSender("")
+	actualNonce, err := epf.GetLastPoolNonceForSender(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, providedNonce, actualNonce)
 
-	actualNonceGaps, err := epf.GetTransactionsPoolNonceGapsForSender("")
+	actualNonceGaps, err := epf.GetTransactionsPoolNonceGapsForSender(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedNonceGaps, actualNonceGaps)
 }
@@ -1051,9 +1629,13 @@ func TestProxyFacade_GetGasConfigs(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, err := epf.GetGasConfigs()
+	actualResult, err := epf.GetGasConfigs(context.Background())
 	require.Nil(t, err)
 
 	assert.True(t, wasCalled)
@@ -1088,13 +1670,53 @@ func TestProxyFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
 	)
 
-	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey("key")
+	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 
 	assert.Equal(t, expectedResults, actualResult)
 }
 
+func TestProxyFacade_GetAccountDiff(t *testing.T) {
+	t.Parallel()
+
+	expectedDiff := &data.AccountDiff{Address: "address"}
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{
+			GetAccountDiffCalled: func(address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+				assert.Equal(t, common.AccountDiffOptions{FromNonce: 100, ToNonce: 200}, options)
+				return expectedDiff, nil
+			},
+		},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.TransactionTrackerStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.NonceReserverStub{},
+		&mock.NonceGapDiagnoserStub{},
+	)
+
+	diff, err := epf.GetAccountDiff(context.Background(), "address", common.AccountDiffOptions{FromNonce: 100, ToNonce: 200})
+	require.Nil(t, err)
+	assert.Equal(t, expectedDiff, diff)
+}
+
 func getPrivKey() crypto.PrivateKey {
 	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
 	sk, _ := keyGen.GeneratePair()
@@ -43,3 +43,15 @@ var ErrNilStatusProcessor = errors.New("nil status processor")
 
 // ErrNilAboutInfoProcessor signals that a nil about info processor has been provided
 var ErrNilAboutInfoProcessor = errors.New("nil about info processor")
+
+// ErrNilTransactionTracker signals that a nil transaction tracker has been provided
+var ErrNilTransactionTracker = errors.New("nil transaction tracker")
+
+// ErrNilHyperblockStreamer signals that a nil hyperblock streamer has been provided
+var ErrNilHyperblockStreamer = errors.New("nil hyperblock streamer")
+
+// ErrNilNonceReserver signals that a nil nonce reserver has been provided
+var ErrNilNonceReserver = errors.New("nil nonce reserver")
+
+// ErrNilNonceGapDiagnoser signals that a nil nonce gap diagnoser has been provided
+var ErrNilNonceGapDiagnoser = errors.New("nil nonce gap diagnoser")
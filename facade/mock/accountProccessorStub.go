@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -23,35 +25,36 @@ type AccountProcessorStub struct {
 	GetCodeHashCalled                       func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetGuardianDataCalled                   func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigratedCalled                func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAccountDiffCalled                    func(address string, options common.AccountDiffOptions) (*data.AccountDiff, error)
 }
 
 // GetKeyValuePairs -
-func (aps *AccountProcessorStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetKeyValuePairsCalled(address, options)
 }
 
 // GetAllESDTTokens -
-func (aps *AccountProcessorStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetAllESDTTokensCalled(address, options)
 }
 
 // GetESDTTokenData -
-func (aps *AccountProcessorStub) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTTokenDataCalled(address, key, options)
 }
 
 // GetESDTNftTokenData -
-func (aps *AccountProcessorStub) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTNftTokenDataCalled(address, key, nonce, options)
 }
 
 // GetESDTsWithRole -
-func (aps *AccountProcessorStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTsWithRoleCalled(address, role, options)
 }
 
 // GetESDTsRoles -
-func (aps *AccountProcessorStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if aps.GetESDTsRolesCalled != nil {
 		return aps.GetESDTsRolesCalled(address, options)
 	}
@@ -60,27 +63,27 @@ func (aps *AccountProcessorStub) GetESDTsRoles(address string, options common.Ac
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 }
 
 // GetAccount -
-func (aps *AccountProcessorStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+func (aps *AccountProcessorStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
 	return aps.GetAccountCalled(address, options)
 }
 
 // GetAccounts -
-func (aps *AccountProcessorStub) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+func (aps *AccountProcessorStub) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	return aps.GetAccountsCalled(addresses, options)
 }
 
 // GetValueForKey -
-func (aps *AccountProcessorStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (aps *AccountProcessorStub) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	return aps.GetValueForKeyCalled(address, key, options)
 }
 
 // GetGuardianData -
-func (aps *AccountProcessorStub) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetGuardianDataCalled(address, options)
 }
 
@@ -90,17 +93,17 @@ func (aps *AccountProcessorStub) GetShardIDForAddress(address string) (uint32, e
 }
 
 // GetCodeHash -
-func (aps *AccountProcessorStub) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetCodeHashCalled(address, options)
 }
 
 // ValidatorStatistics -
-func (aps *AccountProcessorStub) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (aps *AccountProcessorStub) ValidatorStatistics(ctx context.Context) (map[string]*data.ValidatorApiResponse, error) {
 	return aps.ValidatorStatisticsCalled()
 }
 
 // IsDataTrieMigrated --
-func (aps *AccountProcessorStub) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if aps.IsDataTrieMigratedCalled != nil {
 		return aps.IsDataTrieMigratedCalled(address, options)
 	}
@@ -108,7 +111,16 @@ func (aps *AccountProcessorStub) IsDataTrieMigrated(address string, options comm
 	return &data.GenericAPIResponse{}, nil
 }
 
+// GetAccountDiff -
+func (aps *AccountProcessorStub) GetAccountDiff(_ context.Context, address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+	if aps.GetAccountDiffCalled != nil {
+		return aps.GetAccountDiffCalled(address, options)
+	}
+
+	return &data.AccountDiff{}, nil
+}
+
 // AuctionList -
-func (aps *AccountProcessorStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
+func (aps *AccountProcessorStub) AuctionList(ctx context.Context) ([]*data.AuctionListValidatorAPIResponse, error) {
 	return nil, nil
 }
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -11,7 +13,7 @@ type BlocksProcessorStub struct {
 }
 
 // GetBlocksByRound -
-func (bps *BlocksProcessorStub) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (bps *BlocksProcessorStub) GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
 	if bps.GetBlocksByRoundCalled != nil {
 		return bps.GetBlocksByRoundCalled(round, options)
 	}
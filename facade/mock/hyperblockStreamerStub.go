@@ -0,0 +1,52 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockStreamerStub -
+type HyperblockStreamerStub struct {
+	IsEnabledCalled                  func() bool
+	SubscribeCalled                  func(options common.HyperblockStreamOptions) (data.HyperblockSubscription, error)
+	SubscribeToAddressActivityCalled func(options common.AddressActivityOptions) (data.AddressActivitySubscription, error)
+}
+
+// IsEnabled -
+func (hss *HyperblockStreamerStub) IsEnabled() bool {
+	if hss.IsEnabledCalled != nil {
+		return hss.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// Subscribe -
+func (hss *HyperblockStreamerStub) Subscribe(_ context.Context, options common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+	if hss.SubscribeCalled != nil {
+		return hss.SubscribeCalled(options)
+	}
+
+	return nil, nil
+}
+
+// SubscribeToAddressActivity -
+func (hss *HyperblockStreamerStub) SubscribeToAddressActivity(_ context.Context, options common.AddressActivityOptions) (data.AddressActivitySubscription, error) {
+	if hss.SubscribeToAddressActivityCalled != nil {
+		return hss.SubscribeToAddressActivityCalled(options)
+	}
+
+	return nil, nil
+}
+
+// Close -
+func (hss *HyperblockStreamerStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (hss *HyperblockStreamerStub) IsInterfaceNil() bool {
+	return hss == nil
+}
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // NodeGroupProcessorStub represents a stub implementation of a NodeGroupProcessor
 type NodeGroupProcessorStub struct {
@@ -10,17 +14,17 @@ type NodeGroupProcessorStub struct {
 }
 
 // IsOldStorageForToken -
-func (hbps *NodeGroupProcessorStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (hbps *NodeGroupProcessorStub) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
 	return hbps.IsOldStorageForTokenCalled(tokenID, nonce)
 }
 
 // GetHeartbeatData -
-func (hbps *NodeGroupProcessorStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (hbps *NodeGroupProcessorStub) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
 	return hbps.GetHeartbeatDataCalled()
 }
 
 // GetWaitingEpochsLeftForPublicKey -
-func (hbps *NodeGroupProcessorStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+func (hbps *NodeGroupProcessorStub) GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if hbps.GetWaitingEpochsLeftForPublicKeyCalled != nil {
 		return hbps.GetWaitingEpochsLeftForPublicKeyCalled(publicKey)
 	}
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // NodeStatusProcessorStub --
 type NodeStatusProcessorStub struct {
@@ -20,7 +24,7 @@ type NodeStatusProcessorStub struct {
 }
 
 // GetNetworkConfigMetrics --
-func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetConfigMetricsCalled != nil {
 		return stub.GetConfigMetricsCalled()
 	}
@@ -29,7 +33,7 @@ func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics() (*data.GenericAPI
 }
 
 // GetNetworkStatusMetrics --
-func (stub *NodeStatusProcessorStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	if stub.GetNetworkMetricsCalled != nil {
 		return stub.GetNetworkMetricsCalled(shardID)
 	}
@@ -38,7 +42,7 @@ func (stub *NodeStatusProcessorStub) GetNetworkStatusMetrics(shardID uint32) (*d
 }
 
 // GetEconomicsDataMetrics --
-func (stub *NodeStatusProcessorStub) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetEconomicsDataMetricsCalled != nil {
 		return stub.GetEconomicsDataMetricsCalled()
 	}
@@ -47,7 +51,7 @@ func (stub *NodeStatusProcessorStub) GetEconomicsDataMetrics() (*data.GenericAPI
 }
 
 // GetLatestFullySynchronizedHyperblockNonce -
-func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
 	if stub.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
 		return stub.GetLatestFullySynchronizedHyperblockNonceCalled()
 	}
@@ -56,7 +60,7 @@ func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce()
 }
 
 // GetAllIssuedESDTs -
-func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if stub.GetAllIssuedESDTsCalled != nil {
 		return stub.GetAllIssuedESDTsCalled(tokenType)
 	}
@@ -65,7 +69,7 @@ func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(tokenType string) (*data.
 }
 
 // GetDirectStakedInfo -
-func (stub *NodeStatusProcessorStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetDirectStakedInfoCalled != nil {
 		return stub.GetDirectStakedInfoCalled()
 	}
@@ -74,7 +78,7 @@ func (stub *NodeStatusProcessorStub) GetDirectStakedInfo() (*data.GenericAPIResp
 }
 
 // GetDelegatedInfo -
-func (stub *NodeStatusProcessorStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetDelegatedInfoCalled != nil {
 		return stub.GetDelegatedInfoCalled()
 	}
@@ -83,7 +87,7 @@ func (stub *NodeStatusProcessorStub) GetDelegatedInfo() (*data.GenericAPIRespons
 }
 
 // GetEnableEpochsMetrics -
-func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetEnableEpochsMetricsCalled != nil {
 		return stub.GetEnableEpochsMetricsCalled()
 	}
@@ -92,7 +96,7 @@ func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics() (*data.GenericAPIR
 }
 
 // GetRatingsConfig -
-func (stub *NodeStatusProcessorStub) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetRatingsConfigCalled != nil {
 		return stub.GetRatingsConfigCalled()
 	}
@@ -101,7 +105,7 @@ func (stub *NodeStatusProcessorStub) GetRatingsConfig() (*data.GenericAPIRespons
 }
 
 // GetGenesisNodesPubKeys -
-func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetGenesisNodesPubKeysCalled != nil {
 		return stub.GetGenesisNodesPubKeysCalled()
 	}
@@ -110,7 +114,7 @@ func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys() (*data.GenericAPIR
 }
 
 // GetGasConfigs -
-func (stub *NodeStatusProcessorStub) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetGasConfigsCalled != nil {
 		return stub.GetGasConfigsCalled()
 	}
@@ -119,7 +123,7 @@ func (stub *NodeStatusProcessorStub) GetGasConfigs() (*data.GenericAPIResponse,
 }
 
 // GetEpochStartData -
-func (stub *NodeStatusProcessorStub) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	if stub.GetEpochStartDataCalled != nil {
 		return stub.GetEpochStartDataCalled(epoch, shardID)
 	}
@@ -128,7 +132,7 @@ func (stub *NodeStatusProcessorStub) GetEpochStartData(epoch uint32, shardID uin
 }
 
 // GetTriesStatistics -
-func (stub *NodeStatusProcessorStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
 	if stub.GetTriesStatisticsCalled != nil {
 		return stub.GetTriesStatisticsCalled(shardID)
 	}
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NonceGapDiagnoserStub -
+type NonceGapDiagnoserStub struct {
+	DiagnoseCalled                    func(sender string) (*data.SenderNonceDiagnosis, error)
+	SortReplacementTransactionsCalled func(diagnosis *data.SenderNonceDiagnosis, txs []*data.Transaction) ([]int, error)
+}
+
+// Diagnose -
+func (stub *NonceGapDiagnoserStub) Diagnose(_ context.Context, sender string) (*data.SenderNonceDiagnosis, error) {
+	if stub.DiagnoseCalled != nil {
+		return stub.DiagnoseCalled(sender)
+	}
+
+	return &data.SenderNonceDiagnosis{Address: sender}, nil
+}
+
+// SortReplacementTransactions -
+func (stub *NonceGapDiagnoserStub) SortReplacementTransactions(diagnosis *data.SenderNonceDiagnosis, txs []*data.Transaction) ([]int, error) {
+	if stub.SortReplacementTransactionsCalled != nil {
+		return stub.SortReplacementTransactionsCalled(diagnosis, txs)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *NonceGapDiagnoserStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NonceReserverStub -
+type NonceReserverStub struct {
+	IsEnabledCalled func() bool
+	ReserveCalled   func(sender string) (*data.NonceReservation, error)
+	MarkSentCalled  func(sender string, nonce uint64)
+}
+
+// IsEnabled -
+func (nrs *NonceReserverStub) IsEnabled() bool {
+	if nrs.IsEnabledCalled != nil {
+		return nrs.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// Reserve -
+func (nrs *NonceReserverStub) Reserve(_ context.Context, sender string) (*data.NonceReservation, error) {
+	if nrs.ReserveCalled != nil {
+		return nrs.ReserveCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// MarkSent -
+func (nrs *NonceReserverStub) MarkSent(sender string, nonce uint64) {
+	if nrs.MarkSentCalled != nil {
+		nrs.MarkSentCalled(sender, nonce)
+	}
+}
+
+// IsInterfaceNil -
+func (nrs *NonceReserverStub) IsInterfaceNil() bool {
+	return nrs == nil
+}
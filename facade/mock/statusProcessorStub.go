@@ -8,6 +8,7 @@ import (
 type StatusProcessorStub struct {
 	GetMetricsCalled              func() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheusCalled func() string
+	GetObserversHealthCalled      func() []*data.NodeHealth
 }
 
 // GetMetricsForPrometheus -
@@ -27,3 +28,12 @@ func (s *StatusProcessorStub) GetMetrics() map[string]*data.EndpointMetrics {
 
 	return nil
 }
+
+// GetObserversHealth -
+func (s *StatusProcessorStub) GetObserversHealth() []*data.NodeHealth {
+	if s.GetObserversHealthCalled != nil {
+		return s.GetObserversHealthCalled()
+	}
+
+	return nil
+}
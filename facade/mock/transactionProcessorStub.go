@@ -1,10 +1,12 @@
 package mock
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -13,8 +15,12 @@ var errNotImplemented = errors.New("not implemented")
 // TransactionProcessorStub -
 type TransactionProcessorStub struct {
 	SendTransactionCalled                       func(tx *data.Transaction) (int, string, error)
+	BroadcastTransactionCalled                  func(tx *data.Transaction, options common.TransactionBroadcastOptions) (int, *data.TransactionBroadcastResponse, error)
+	IsBroadcastEnabledCalled                    func() bool
 	SendMultipleTransactionsCalled              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionCalled                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	ValidateTransactionCalled                   func(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult
+	EstimateTransactionFeeCalled                func(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeEstimation, error)
 	SendUserFundsCalled                         func(receiver string, value *big.Int) error
 	TransactionCostRequestCalled                func(tx *data.Transaction) (*data.TxCostResponseData, error)
 	GetTransactionStatusCalled                  func(txHash string, sender string) (string, error)
@@ -30,7 +36,7 @@ type TransactionProcessorStub struct {
 }
 
 // SimulateTransaction -
-func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (tps *TransactionProcessorStub) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	if tps.SimulateTransactionCalled != nil {
 		return tps.SimulateTransactionCalled(tx, checkSignature)
 	}
@@ -38,8 +44,26 @@ func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, c
 	return nil, errNotImplemented
 }
 
+// ValidateTransaction -
+func (tps *TransactionProcessorStub) ValidateTransaction(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult {
+	if tps.ValidateTransactionCalled != nil {
+		return tps.ValidateTransactionCalled(tx, networkConfig)
+	}
+
+	return &data.TransactionValidationResult{Valid: true}
+}
+
+// EstimateTransactionFee -
+func (tps *TransactionProcessorStub) EstimateTransactionFee(_ context.Context, tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeEstimation, error) {
+	if tps.EstimateTransactionFeeCalled != nil {
+		return tps.EstimateTransactionFeeCalled(tx, networkConfig)
+	}
+
+	return &data.TransactionFeeEstimation{}, nil
+}
+
 // SendTransaction -
-func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tps *TransactionProcessorStub) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
 	if tps.SendTransactionCalled != nil {
 		return tps.SendTransactionCalled(tx)
 	}
@@ -47,8 +71,26 @@ func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int,
 	return 0, "", errNotImplemented
 }
 
+// BroadcastTransaction -
+func (tps *TransactionProcessorStub) BroadcastTransaction(ctx context.Context, tx *data.Transaction, options common.TransactionBroadcastOptions) (int, *data.TransactionBroadcastResponse, error) {
+	if tps.BroadcastTransactionCalled != nil {
+		return tps.BroadcastTransactionCalled(tx, options)
+	}
+
+	return 0, nil, errNotImplemented
+}
+
+// IsBroadcastEnabled -
+func (tps *TransactionProcessorStub) IsBroadcastEnabled() bool {
+	if tps.IsBroadcastEnabledCalled != nil {
+		return tps.IsBroadcastEnabledCalled()
+	}
+
+	return false
+}
+
 // SendMultipleTransactions -
-func (tps *TransactionProcessorStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (tps *TransactionProcessorStub) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	if tps.SendMultipleTransactionsCalled != nil {
 		return tps.SendMultipleTransactionsCalled(txs)
 	}
@@ -66,7 +108,7 @@ func (tps *TransactionProcessorStub) ComputeTransactionHash(tx *data.Transaction
 }
 
 // SendUserFunds -
-func (tps *TransactionProcessorStub) SendUserFunds(receiver string, value *big.Int) error {
+func (tps *TransactionProcessorStub) SendUserFunds(ctx context.Context, receiver string, value *big.Int) error {
 	if tps.SendUserFundsCalled != nil {
 		return tps.SendUserFundsCalled(receiver, value)
 	}
@@ -75,7 +117,7 @@ func (tps *TransactionProcessorStub) SendUserFunds(receiver string, value *big.I
 }
 
 // GetTransactionStatus -
-func (tps *TransactionProcessorStub) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (tps *TransactionProcessorStub) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
 	if tps.GetTransactionStatusCalled != nil {
 		return tps.GetTransactionStatusCalled(txHash, sender)
 	}
@@ -84,7 +126,7 @@ func (tps *TransactionProcessorStub) GetTransactionStatus(txHash string, sender
 }
 
 // GetProcessedTransactionStatus -
-func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 	if tps.GetProcessedTransactionStatusCalled != nil {
 		return tps.GetProcessedTransactionStatusCalled(txHash)
 	}
@@ -93,7 +135,7 @@ func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(txHash string
 }
 
 // GetTransaction -
-func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+func (tps *TransactionProcessorStub) GetTransaction(ctx context.Context, txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
 	if tps.GetTransactionCalled != nil {
 		return tps.GetTransactionCalled(txHash, withEvents)
 	}
@@ -102,7 +144,7 @@ func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bo
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
 	if tps.GetTransactionByHashAndSenderAddressCalled != nil {
 		return tps.GetTransactionByHashAndSenderAddressCalled(txHash, sndAddr, withEvents)
 	}
@@ -111,7 +153,7 @@ func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash
 }
 
 // TransactionCostRequest -
-func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tps *TransactionProcessorStub) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	if tps.TransactionCostRequestCalled != nil {
 		return tps.TransactionCostRequestCalled(tx)
 	}
@@ -120,7 +162,7 @@ func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction
 }
 
 // GetTransactionsPool -
-func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolCalled != nil {
 		return tps.GetTransactionsPoolCalled(fields)
 	}
@@ -129,7 +171,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.T
 }
 
 // GetTransactionsPoolForShard -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolForShardCalled != nil {
 		return tps.GetTransactionsPoolForShardCalled(shardID, fields)
 	}
@@ -138,7 +180,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32,
 }
 
 // GetTransactionsPoolForSender -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	if tps.GetTransactionsPoolForSenderCalled != nil {
 		return tps.GetTransactionsPoolForSenderCalled(sender, fields)
 	}
@@ -147,7 +189,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(sender, fields
 }
 
 // GetLastPoolNonceForSender -
-func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
+func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
 	if tps.GetLastPoolNonceForSenderCalled != nil {
 		return tps.GetLastPoolNonceForSenderCalled(sender)
 	}
@@ -156,7 +198,7 @@ func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(sender string) (u
 }
 
 // GetTransactionsPoolNonceGapsForSender -
-func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	if tps.GetTransactionsPoolNonceGapsForSenderCalled != nil {
 		return tps.GetTransactionsPoolNonceGapsForSenderCalled(sender)
 	}
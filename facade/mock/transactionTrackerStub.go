@@ -0,0 +1,45 @@
+package mock
+
+// TransactionTrackerStub -
+type TransactionTrackerStub struct {
+	IsEnabledCalled     func() bool
+	CheckTrackingCalled func(callbackURL string) error
+	TrackCalled         func(txHash string, callbackURL string) error
+}
+
+// IsEnabled -
+func (tts *TransactionTrackerStub) IsEnabled() bool {
+	if tts.IsEnabledCalled != nil {
+		return tts.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// CheckTracking -
+func (tts *TransactionTrackerStub) CheckTracking(callbackURL string) error {
+	if tts.CheckTrackingCalled != nil {
+		return tts.CheckTrackingCalled(callbackURL)
+	}
+
+	return nil
+}
+
+// Track -
+func (tts *TransactionTrackerStub) Track(txHash string, callbackURL string) error {
+	if tts.TrackCalled != nil {
+		return tts.TrackCalled(txHash, callbackURL)
+	}
+
+	return nil
+}
+
+// Close -
+func (tts *TransactionTrackerStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (tts *TransactionTrackerStub) IsInterfaceNil() bool {
+	return tts == nil
+}
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // ValidatorStatisticsProcessorStub -
 type ValidatorStatisticsProcessorStub struct {
@@ -8,11 +12,11 @@ type ValidatorStatisticsProcessorStub struct {
 }
 
 // GetValidatorStatistics -
-func (v *ValidatorStatisticsProcessorStub) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
+func (v *ValidatorStatisticsProcessorStub) GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	return v.GetValidatorStatisticsCalled()
 }
 
 // GetAuctionList -
-func (v *ValidatorStatisticsProcessorStub) GetAuctionList() (*data.AuctionListResponse, error) {
+func (v *ValidatorStatisticsProcessorStub) GetAuctionList(ctx context.Context) (*data.AuctionListResponse, error) {
 	return nil, nil
 }
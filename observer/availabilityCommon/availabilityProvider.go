@@ -21,7 +21,7 @@ func (ap *AvailabilityProvider) AvailabilityForAccountQueryOptions(options commo
 // AvailabilityForVmQuery returns the availability needed for the provided query options
 func (ap *AvailabilityProvider) AvailabilityForVmQuery(query *data.SCQuery) data.ObserverDataAvailabilityType {
 	availability := data.AvailabilityRecent
-	if query.BlockNonce.HasValue || len(query.BlockHash) > 0 {
+	if query.BlockNonce.HasValue || len(query.BlockHash) > 0 || query.Historical.IsSet() {
 		availability = data.AvailabilityAll
 	}
 	return availability
@@ -18,6 +18,7 @@ type baseNodeProvider struct {
 	configurationFilePath string
 	regularNodes          NodesHolder
 	snapshotlessNodes     NodesHolder
+	nodesHealth           NodesHealthHandler
 }
 
 func (bnp *baseNodeProvider) initNodes(nodes []*data.NodeData) error {
@@ -261,6 +262,24 @@ func (bnp *baseNodeProvider) getSyncedNodesUnprotected(dataAvailability data.Obs
 	return syncedNodes, nil
 }
 
+// prioritizeAvailableNodes returns a new slice in which the nodes that are not available (their circuit is open)
+// are moved at the end, keeping the relative order of the nodes. Unavailable nodes are not removed so they can still
+// be used if all the others fail
+func (bnp *baseNodeProvider) prioritizeAvailableNodes(nodes []*data.NodeData) []*data.NodeData {
+	availableNodes := make([]*data.NodeData, 0, len(nodes))
+	unavailableNodes := make([]*data.NodeData, 0)
+	for _, node := range nodes {
+		if bnp.nodesHealth.IsAvailable(node.Address) {
+			availableNodes = append(availableNodes, node)
+			continue
+		}
+
+		unavailableNodes = append(unavailableNodes, node)
+	}
+
+	return append(availableNodes, unavailableNodes...)
+}
+
 func loadMainConfig(filepath string) (*config.Config, error) {
 	cfg := &config.Config{}
 	err := core.LoadTomlFile(cfg, filepath)
@@ -0,0 +1,43 @@
+package circuitBreaker
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+type disabledNodesCircuitBreaker struct {
+}
+
+// NewDisabledNodesCircuitBreaker returns a circuit breaker that considers all the nodes always available
+func NewDisabledNodesCircuitBreaker() *disabledNodesCircuitBreaker {
+	return &disabledNodesCircuitBreaker{}
+}
+
+// IsAvailable returns true as this is a disabled component
+func (d *disabledNodesCircuitBreaker) IsAvailable(_ string) bool {
+	return true
+}
+
+// RecordRequestSent returns false as this is a disabled component
+func (d *disabledNodesCircuitBreaker) RecordRequestSent(_ string) bool {
+	return false
+}
+
+// RecordCancellation does nothing as this is a disabled component
+func (d *disabledNodesCircuitBreaker) RecordCancellation(_ string, _ bool) {
+}
+
+// RecordSuccess does nothing as this is a disabled component
+func (d *disabledNodesCircuitBreaker) RecordSuccess(_ string, _ bool) {
+}
+
+// RecordFailure does nothing as this is a disabled component
+func (d *disabledNodesCircuitBreaker) RecordFailure(_ string) {
+}
+
+// GetNodesHealth returns an empty slice
+func (d *disabledNodesCircuitBreaker) GetNodesHealth() []*data.NodeHealth {
+	return make([]*data.NodeHealth, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledNodesCircuitBreaker) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,25 @@
+package circuitBreaker
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisabledNodesCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	d := NewDisabledNodesCircuitBreaker()
+	require.False(t, check.IfNil(d))
+
+	for i := 0; i < 10; i++ {
+		d.RecordFailure(testAddress)
+	}
+	d.RecordSuccess(testAddress, false)
+	require.False(t, d.RecordRequestSent(testAddress))
+	d.RecordCancellation(testAddress, false)
+
+	require.True(t, d.IsAvailable(testAddress))
+	require.Empty(t, d.GetNodesHealth())
+}
@@ -0,0 +1,310 @@
+package circuitBreaker
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	initialHealthScore   = 1.0
+	healthScoreAlpha     = 0.2
+	successOutcomeWeight = 1.0
+	failureOutcomeWeight = 0.0
+)
+
+type nodeCircuit struct {
+	state               data.CircuitBreakerState
+	consecutiveFailures uint32
+	halfOpenSuccesses   uint32
+	probesInFlight      uint32
+	lastProbeTime       time.Time
+	openedAt            time.Time
+	healthScore         float64
+	numSuccesses        uint64
+	numFailures         uint64
+	numTimesOpened      uint64
+}
+
+// nodesCircuitBreaker keeps a circuit breaker for each node, based on the outcome of the requests sent to it.
+// A node's circuit opens after a number of consecutive failures. After the open duration elapses, the circuit
+// becomes half-open and a limited number of probe requests are allowed. Enough successful probes close the circuit
+// while a failed probe opens it again
+type nodesCircuitBreaker struct {
+	mutCircuits              sync.Mutex
+	circuits                 map[string]*nodeCircuit
+	failureThreshold         uint32
+	openDuration             time.Duration
+	halfOpenMaxProbes        uint32
+	halfOpenSuccessThreshold uint32
+	getTimeHandler           func() time.Time
+}
+
+// NewNodesCircuitBreaker returns a new instance of nodesCircuitBreaker
+func NewNodesCircuitBreaker(cfg config.CircuitBreakerConfig) (*nodesCircuitBreaker, error) {
+	err := checkConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodesCircuitBreaker{
+		circuits:                 make(map[string]*nodeCircuit),
+		failureThreshold:         cfg.FailureThreshold,
+		openDuration:             time.Duration(cfg.OpenDurationSec) * time.Second,
+		halfOpenMaxProbes:        cfg.HalfOpenMaxProbes,
+		halfOpenSuccessThreshold: cfg.HalfOpenSuccessThreshold,
+		getTimeHandler:           time.Now,
+	}, nil
+}
+
+func checkConfig(cfg config.CircuitBreakerConfig) error {
+	if cfg.FailureThreshold == 0 {
+		return fmt.Errorf("%w for FailureThreshold, %d provided", core.ErrInvalidValue, cfg.FailureThreshold)
+	}
+	if cfg.OpenDurationSec <= 0 {
+		return fmt.Errorf("%w for OpenDurationSec, %d provided", core.ErrInvalidValue, cfg.OpenDurationSec)
+	}
+	if cfg.HalfOpenMaxProbes == 0 {
+		return fmt.Errorf("%w for HalfOpenMaxProbes, %d provided", core.ErrInvalidValue, cfg.HalfOpenMaxProbes)
+	}
+	if cfg.HalfOpenSuccessThreshold == 0 {
+		return fmt.Errorf("%w for HalfOpenSuccessThreshold, %d provided", core.ErrInvalidValue, cfg.HalfOpenSuccessThreshold)
+	}
+
+	return nil
+}
+
+// IsAvailable returns true if a request can be sent to the provided node: its circuit is closed, or it is half-open
+// and has free probe slots. It has no side effects, so that the nodes can be ordered without reserving probes for the
+// ones which end up not receiving any request
+func (ncb *nodesCircuitBreaker) IsAvailable(address string) bool {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	circuit, found := ncb.circuits[address]
+	if !found {
+		return true
+	}
+
+	now := ncb.getTimeHandler()
+	switch ncb.currentState(circuit, now) {
+	case data.CircuitBreakerOpen:
+		return false
+	case data.CircuitBreakerHalfOpen:
+		return ncb.numActiveProbes(circuit, now) < ncb.halfOpenMaxProbes
+	default:
+		return true
+	}
+}
+
+// RecordRequestSent records that a request is sent to the provided node and returns true if the request reserved one
+// of the probe slots of a half-open node. The slot is released when the outcome of the probe is recorded or when the
+// open duration elapses. The requests sent once all the slots are reserved are not probes, as IsAvailable and
+// RecordRequestSent are not called atomically
+func (ncb *nodesCircuitBreaker) RecordRequestSent(address string) bool {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	circuit, found := ncb.circuits[address]
+	if !found {
+		return false
+	}
+
+	now := ncb.getTimeHandler()
+	ncb.moveToHalfOpenIfNeeded(circuit, now)
+	if circuit.state != data.CircuitBreakerHalfOpen {
+		return false
+	}
+
+	circuit.probesInFlight = ncb.numActiveProbes(circuit, now)
+	if circuit.probesInFlight >= ncb.halfOpenMaxProbes {
+		return false
+	}
+
+	circuit.probesInFlight++
+	circuit.lastProbeTime = now
+
+	return true
+}
+
+// RecordCancellation records a request towards the provided node cancelled by its caller, without counting it as a
+// success or a failure. A cancelled probe releases its slot
+func (ncb *nodesCircuitBreaker) RecordCancellation(address string, isProbe bool) {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	circuit, found := ncb.circuits[address]
+	if !found {
+		return
+	}
+
+	if isProbe && circuit.state == data.CircuitBreakerHalfOpen {
+		ncb.releaseProbe(circuit)
+	}
+}
+
+// RecordSuccess records a successful request towards the provided node. Only the successful probes count towards
+// closing a half-open circuit, the other responses being either stale or sent past the probes limit
+func (ncb *nodesCircuitBreaker) RecordSuccess(address string, isProbe bool) {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	circuit := ncb.getOrCreateCircuit(address)
+	circuit.numSuccesses++
+	circuit.consecutiveFailures = 0
+	circuit.healthScore = computeHealthScore(circuit.healthScore, successOutcomeWeight)
+
+	switch circuit.state {
+	case data.CircuitBreakerOpen:
+		// the node was used even if deprioritised and it responded, so there is no need to wait for the open duration
+		// before probing it
+		ncb.moveToHalfOpen(circuit)
+	case data.CircuitBreakerHalfOpen:
+		if isProbe {
+			ncb.recordHalfOpenSuccess(circuit)
+		}
+	}
+}
+
+// RecordFailure records a failed request (connection error or timeout) towards the provided node
+func (ncb *nodesCircuitBreaker) RecordFailure(address string) {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	circuit := ncb.getOrCreateCircuit(address)
+	circuit.numFailures++
+	circuit.consecutiveFailures++
+	circuit.healthScore = computeHealthScore(circuit.healthScore, failureOutcomeWeight)
+
+	now := ncb.getTimeHandler()
+	switch circuit.state {
+	case data.CircuitBreakerOpen:
+		circuit.openedAt = now
+	case data.CircuitBreakerHalfOpen:
+		ncb.open(circuit, now)
+	default:
+		if circuit.consecutiveFailures >= ncb.failureThreshold {
+			ncb.open(circuit, now)
+		}
+	}
+}
+
+// GetNodesHealth returns the health details of all the nodes that received at least one request, sorted by address
+func (ncb *nodesCircuitBreaker) GetNodesHealth() []*data.NodeHealth {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	now := ncb.getTimeHandler()
+	nodesHealth := make([]*data.NodeHealth, 0, len(ncb.circuits))
+	for address, circuit := range ncb.circuits {
+		ncb.moveToHalfOpenIfNeeded(circuit, now)
+
+		nodesHealth = append(nodesHealth, &data.NodeHealth{
+			Address:             address,
+			State:               circuit.state,
+			HealthScore:         circuit.healthScore,
+			ConsecutiveFailures: circuit.consecutiveFailures,
+			NumSuccesses:        circuit.numSuccesses,
+			NumFailures:         circuit.numFailures,
+			NumTimesOpened:      circuit.numTimesOpened,
+		})
+	}
+
+	sort.Slice(nodesHealth, func(i, j int) bool {
+		return nodesHealth[i].Address < nodesHealth[j].Address
+	})
+
+	return nodesHealth
+}
+
+func (ncb *nodesCircuitBreaker) getOrCreateCircuit(address string) *nodeCircuit {
+	circuit, found := ncb.circuits[address]
+	if found {
+		return circuit
+	}
+
+	circuit = &nodeCircuit{
+		state:       data.CircuitBreakerClosed,
+		healthScore: initialHealthScore,
+	}
+	ncb.circuits[address] = circuit
+
+	return circuit
+}
+
+func (ncb *nodesCircuitBreaker) open(circuit *nodeCircuit, now time.Time) {
+	circuit.state = data.CircuitBreakerOpen
+	circuit.openedAt = now
+	circuit.probesInFlight = 0
+	circuit.halfOpenSuccesses = 0
+	circuit.numTimesOpened++
+}
+
+// currentState returns the state of the circuit at the provided time, without moving it to half-open
+func (ncb *nodesCircuitBreaker) currentState(circuit *nodeCircuit, now time.Time) data.CircuitBreakerState {
+	if circuit.state == data.CircuitBreakerOpen && now.Sub(circuit.openedAt) >= ncb.openDuration {
+		return data.CircuitBreakerHalfOpen
+	}
+
+	return circuit.state
+}
+
+// numActiveProbes returns the number of reserved probe slots, the stale ones being released after the open duration
+func (ncb *nodesCircuitBreaker) numActiveProbes(circuit *nodeCircuit, now time.Time) uint32 {
+	if circuit.state != data.CircuitBreakerHalfOpen {
+		return 0
+	}
+	if now.Sub(circuit.lastProbeTime) >= ncb.openDuration {
+		return 0
+	}
+
+	return circuit.probesInFlight
+}
+
+func (ncb *nodesCircuitBreaker) moveToHalfOpenIfNeeded(circuit *nodeCircuit, now time.Time) {
+	if circuit.state != data.CircuitBreakerOpen {
+		return
+	}
+	if now.Sub(circuit.openedAt) < ncb.openDuration {
+		return
+	}
+
+	ncb.moveToHalfOpen(circuit)
+}
+
+func (ncb *nodesCircuitBreaker) moveToHalfOpen(circuit *nodeCircuit) {
+	circuit.state = data.CircuitBreakerHalfOpen
+	circuit.probesInFlight = 0
+	circuit.halfOpenSuccesses = 0
+	circuit.lastProbeTime = time.Time{}
+}
+
+func (ncb *nodesCircuitBreaker) releaseProbe(circuit *nodeCircuit) {
+	if circuit.probesInFlight > 0 {
+		circuit.probesInFlight--
+	}
+}
+
+func (ncb *nodesCircuitBreaker) recordHalfOpenSuccess(circuit *nodeCircuit) {
+	ncb.releaseProbe(circuit)
+
+	circuit.halfOpenSuccesses++
+	if circuit.halfOpenSuccesses >= ncb.halfOpenSuccessThreshold {
+		circuit.state = data.CircuitBreakerClosed
+		circuit.halfOpenSuccesses = 0
+	}
+}
+
+func computeHealthScore(currentScore float64, outcomeWeight float64) float64 {
+	return (1-healthScoreAlpha)*currentScore + healthScoreAlpha*outcomeWeight
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncb *nodesCircuitBreaker) IsInterfaceNil() bool {
+	return ncb == nil
+}
@@ -0,0 +1,327 @@
+package circuitBreaker
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "http://observer0"
+
+func createMockCircuitBreakerConfig() config.CircuitBreakerConfig {
+	return config.CircuitBreakerConfig{
+		Enabled:                  true,
+		FailureThreshold:         3,
+		OpenDurationSec:          10,
+		HalfOpenMaxProbes:        1,
+		HalfOpenSuccessThreshold: 2,
+	}
+}
+
+func createCircuitBreakerWithTime(t *testing.T, cfg config.CircuitBreakerConfig, currentTime *time.Time) *nodesCircuitBreaker {
+	ncb, err := NewNodesCircuitBreaker(cfg)
+	require.NoError(t, err)
+
+	ncb.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
+
+	return ncb
+}
+
+func recordFailures(ncb *nodesCircuitBreaker, numFailures int) {
+	for i := 0; i < numFailures; i++ {
+		ncb.RecordFailure(testAddress)
+	}
+}
+
+func TestNewNodesCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid FailureThreshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockCircuitBreakerConfig()
+		cfg.FailureThreshold = 0
+
+		ncb, err := NewNodesCircuitBreaker(cfg)
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "FailureThreshold"))
+		require.Nil(t, ncb)
+	})
+	t.Run("invalid OpenDurationSec should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockCircuitBreakerConfig()
+		cfg.OpenDurationSec = 0
+
+		ncb, err := NewNodesCircuitBreaker(cfg)
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "OpenDurationSec"))
+		require.Nil(t, ncb)
+	})
+	t.Run("invalid HalfOpenMaxProbes should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockCircuitBreakerConfig()
+		cfg.HalfOpenMaxProbes = 0
+
+		ncb, err := NewNodesCircuitBreaker(cfg)
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "HalfOpenMaxProbes"))
+		require.Nil(t, ncb)
+	})
+	t.Run("invalid HalfOpenSuccessThreshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockCircuitBreakerConfig()
+		cfg.HalfOpenSuccessThreshold = 0
+
+		ncb, err := NewNodesCircuitBreaker(cfg)
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "HalfOpenSuccessThreshold"))
+		require.Nil(t, ncb)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ncb, err := NewNodesCircuitBreaker(createMockCircuitBreakerConfig())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(ncb))
+	})
+}
+
+func TestNodesCircuitBreaker_ShouldOpenAfterConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	ncb := createCircuitBreakerWithTime(t, createMockCircuitBreakerConfig(), &currentTime)
+
+	require.True(t, ncb.IsAvailable(testAddress))
+
+	recordFailures(ncb, 2)
+	ncb.RecordSuccess(testAddress, false)
+	recordFailures(ncb, 2)
+	require.True(t, ncb.IsAvailable(testAddress))
+
+	ncb.RecordFailure(testAddress)
+	require.False(t, ncb.IsAvailable(testAddress))
+	require.True(t, ncb.IsAvailable("http://other-observer"))
+
+	nodesHealth := ncb.GetNodesHealth()
+	require.Len(t, nodesHealth, 1)
+	require.Equal(t, testAddress, nodesHealth[0].Address)
+	require.Equal(t, data.CircuitBreakerOpen, nodesHealth[0].State)
+	require.Equal(t, uint32(3), nodesHealth[0].ConsecutiveFailures)
+	require.Equal(t, uint64(1), nodesHealth[0].NumSuccesses)
+	require.Equal(t, uint64(5), nodesHealth[0].NumFailures)
+	require.Equal(t, uint64(1), nodesHealth[0].NumTimesOpened)
+	require.Less(t, nodesHealth[0].HealthScore, initialHealthScore)
+}
+
+func TestNodesCircuitBreaker_HalfOpenShouldAllowLimitedProbes(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	cfg := createMockCircuitBreakerConfig()
+	ncb := createCircuitBreakerWithTime(t, cfg, &currentTime)
+
+	recordFailures(ncb, int(cfg.FailureThreshold))
+	require.False(t, ncb.IsAvailable(testAddress))
+
+	currentTime = currentTime.Add(time.Duration(cfg.OpenDurationSec) * time.Second)
+	require.Equal(t, data.CircuitBreakerHalfOpen, ncb.GetNodesHealth()[0].State)
+	require.True(t, ncb.IsAvailable(testAddress))
+	require.True(t, ncb.RecordRequestSent(testAddress))
+	require.False(t, ncb.IsAvailable(testAddress))
+
+	// the probe succeeded, a new one is allowed
+	ncb.RecordSuccess(testAddress, true)
+	require.Equal(t, data.CircuitBreakerHalfOpen, ncb.GetNodesHealth()[0].State)
+	require.True(t, ncb.IsAvailable(testAddress))
+	require.True(t, ncb.RecordRequestSent(testAddress))
+
+	ncb.RecordSuccess(testAddress, true)
+	require.Equal(t, data.CircuitBreakerClosed, ncb.GetNodesHealth()[0].State)
+	require.True(t, ncb.IsAvailable(testAddress))
+	require.True(t, ncb.IsAvailable(testAddress))
+}
+
+func TestNodesCircuitBreaker_HalfOpenProbeFailureShouldOpenAgain(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	cfg := createMockCircuitBreakerConfig()
+	ncb := createCircuitBreakerWithTime(t, cfg, &currentTime)
+
+	recordFailures(ncb, int(cfg.FailureThreshold))
+	currentTime = currentTime.Add(time.Duration(cfg.OpenDurationSec) * time.Second)
+	require.True(t, ncb.IsAvailable(testAddress))
+	require.True(t, ncb.RecordRequestSent(testAddress))
+
+	ncb.RecordFailure(testAddress)
+	require.False(t, ncb.IsAvailable(testAddress))
+
+	nodesHealth := ncb.GetNodesHealth()
+	require.Equal(t, data.CircuitBreakerOpen, nodesHealth[0].State)
+	require.Equal(t, uint64(2), nodesHealth[0].NumTimesOpened)
+}
+
+func TestNodesCircuitBreaker_IsAvailableShouldNotReserveProbes(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	cfg := createMockCircuitBreakerConfig()
+	ncb := createCircuitBreakerWithTime(t, cfg, &currentTime)
+
+	recordFailures(ncb, int(cfg.FailureThreshold))
+	currentTime = currentTime.Add(time.Duration(cfg.OpenDurationSec) * time.Second)
+
+	// the nodes are listed on every request, most of them without receiving it
+	for i := 0; i < 10; i++ {
+		require.True(t, ncb.IsAvailable(testAddress))
+	}
+
+	require.True(t, ncb.RecordRequestSent(testAddress))
+	require.False(t, ncb.IsAvailable(testAddress))
+
+	// a cancelled probe releases its slot without changing the state
+	ncb.RecordCancellation(testAddress, true)
+	require.True(t, ncb.IsAvailable(testAddress))
+	require.Equal(t, data.CircuitBreakerHalfOpen, ncb.GetNodesHealth()[0].State)
+}
+
+func TestNodesCircuitBreaker_RecordRequestSentOnClosedCircuitShouldNotReserveProbes(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := NewNodesCircuitBreaker(createMockCircuitBreakerConfig())
+	ncb.RecordSuccess(testAddress, false)
+
+	for i := 0; i < 10; i++ {
+		require.False(t, ncb.RecordRequestSent(testAddress))
+	}
+	require.True(t, ncb.IsAvailable(testAddress))
+}
+
+func TestNodesCircuitBreaker_StaleProbeShouldBeReleased(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	cfg := createMockCircuitBreakerConfig()
+	ncb := createCircuitBreakerWithTime(t, cfg, &currentTime)
+
+	recordFailures(ncb, int(cfg.FailureThreshold))
+	currentTime = currentTime.Add(time.Duration(cfg.OpenDurationSec) * time.Second)
+	require.True(t, ncb.RecordRequestSent(testAddress))
+	require.False(t, ncb.IsAvailable(testAddress))
+
+	// the outcome of the probe was never recorded
+	currentTime = currentTime.Add(time.Duration(cfg.OpenDurationSec) * time.Second)
+	require.True(t, ncb.IsAvailable(testAddress))
+}
+
+func TestNodesCircuitBreaker_SuccessWhileOpenShouldMoveToHalfOpen(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	cfg := createMockCircuitBreakerConfig()
+	ncb := createCircuitBreakerWithTime(t, cfg, &currentTime)
+
+	recordFailures(ncb, int(cfg.FailureThreshold))
+	ncb.RecordSuccess(testAddress, false)
+	require.Equal(t, data.CircuitBreakerHalfOpen, ncb.GetNodesHealth()[0].State)
+
+	// the response was not a probe, so the circuit is closed by the probes only
+	ncb.RecordSuccess(testAddress, false)
+	require.Equal(t, data.CircuitBreakerHalfOpen, ncb.GetNodesHealth()[0].State)
+
+	for i := uint32(0); i < cfg.HalfOpenSuccessThreshold; i++ {
+		require.True(t, ncb.RecordRequestSent(testAddress))
+		ncb.RecordSuccess(testAddress, true)
+	}
+	require.Equal(t, data.CircuitBreakerClosed, ncb.GetNodesHealth()[0].State)
+}
+
+func TestNodesCircuitBreaker_OnlyTheProbesShouldReleaseSlotsAndCountTowardsClosing(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	cfg := createMockCircuitBreakerConfig()
+	ncb := createCircuitBreakerWithTime(t, cfg, &currentTime)
+
+	recordFailures(ncb, int(cfg.FailureThreshold))
+	currentTime = currentTime.Add(time.Duration(cfg.OpenDurationSec) * time.Second)
+
+	// both requests were let through by IsAvailable before any of them was sent
+	require.True(t, ncb.RecordRequestSent(testAddress))
+	require.False(t, ncb.RecordRequestSent(testAddress))
+
+	// the request sent past the probes limit neither releases the probe slot nor counts as a probe success
+	ncb.RecordSuccess(testAddress, false)
+	ncb.RecordCancellation(testAddress, false)
+	require.False(t, ncb.IsAvailable(testAddress))
+	require.Equal(t, data.CircuitBreakerHalfOpen, ncb.GetNodesHealth()[0].State)
+
+	ncb.RecordSuccess(testAddress, true)
+	require.True(t, ncb.IsAvailable(testAddress))
+	require.Equal(t, data.CircuitBreakerHalfOpen, ncb.GetNodesHealth()[0].State)
+
+	require.True(t, ncb.RecordRequestSent(testAddress))
+	ncb.RecordSuccess(testAddress, true)
+	require.Equal(t, data.CircuitBreakerClosed, ncb.GetNodesHealth()[0].State)
+}
+
+func TestNodesCircuitBreaker_GetNodesHealthShouldBeSortedByAddress(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := NewNodesCircuitBreaker(createMockCircuitBreakerConfig())
+	ncb.RecordSuccess("http://observer2", false)
+	ncb.RecordFailure("http://observer0")
+	ncb.RecordSuccess("http://observer1", false)
+
+	nodesHealth := ncb.GetNodesHealth()
+	require.Len(t, nodesHealth, 3)
+	require.Equal(t, "http://observer0", nodesHealth[0].Address)
+	require.Equal(t, "http://observer1", nodesHealth[1].Address)
+	require.Equal(t, "http://observer2", nodesHealth[2].Address)
+	require.Equal(t, initialHealthScore, nodesHealth[1].HealthScore)
+}
+
+func TestNodesCircuitBreaker_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := NewNodesCircuitBreaker(createMockCircuitBreakerConfig())
+
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 6 {
+			case 0:
+				ncb.RecordFailure(testAddress)
+			case 1:
+				ncb.RecordSuccess(testAddress, idx%4 == 1)
+			case 2:
+				_ = ncb.IsAvailable(testAddress)
+			case 3:
+				_ = ncb.GetNodesHealth()
+			case 4:
+				_ = ncb.RecordRequestSent(testAddress)
+			case 5:
+				ncb.RecordCancellation(testAddress, idx%4 == 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -10,3 +10,12 @@ var ErrShardNotAvailable = errors.New("the specified shard ID does not exist in
 
 // ErrInvalidShard signals that an invalid shard has been provided
 var ErrInvalidShard = errors.New("invalid shard")
+
+// ErrNilNodesHealthHandler signals that a nil nodes health handler has been provided
+var ErrNilNodesHealthHandler = errors.New("nil nodes health handler")
+
+// ErrNilNodesLatencyHandler signals that a nil nodes latency handler has been provided
+var ErrNilNodesLatencyHandler = errors.New("nil nodes latency handler")
+
+// ErrInvalidSelectionStrategy signals that an invalid nodes selection strategy has been provided
+var ErrInvalidSelectionStrategy = errors.New("invalid nodes selection strategy")
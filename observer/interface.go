@@ -1,6 +1,10 @@
 package observer
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // NodesProviderHandler defines what a nodes provider should be able to do
 type NodesProviderHandler interface {
@@ -31,3 +35,25 @@ type CounterMapsHolder interface {
 	ComputeAllNodesPosition(availability data.ObserverDataAvailabilityType, numNodes uint32) (uint32, error)
 	IsInterfaceNil() bool
 }
+
+// NodesHealthHandler defines the actions of a component that tracks the health of the nodes based on the outcome
+// of the requests sent to them
+type NodesHealthHandler interface {
+	IsAvailable(address string) bool
+	RecordRequestSent(address string) bool
+	RecordCancellation(address string, isProbe bool)
+	RecordSuccess(address string, isProbe bool)
+	RecordFailure(address string)
+	GetNodesHealth() []*data.NodeHealth
+	IsInterfaceNil() bool
+}
+
+// NodesLatencyHandler defines the actions of a component that tracks the latency and the in-flight requests of the nodes
+type NodesLatencyHandler interface {
+	RequestStarted(address string)
+	RequestFinished(address string, duration time.Duration)
+	RequestFailed(address string)
+	RequestCancelled(address string)
+	ComputeCost(address string) float64
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,95 @@
+package observer
+
+import (
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// latencyAwareNodesProvider will handle the providing of observers ordered by their cost, computed from the average
+// response latency and the number of in-flight requests, so faster and less loaded observers are tried first
+type latencyAwareNodesProvider struct {
+	*baseNodeProvider
+	nodesLatency NodesLatencyHandler
+}
+
+// NewLatencyAwareNodesProvider returns a new instance of latencyAwareNodesProvider
+func NewLatencyAwareNodesProvider(
+	observers []*data.NodeData,
+	configurationFilePath string,
+	numberOfShards uint32,
+	nodesHealth NodesHealthHandler,
+	nodesLatency NodesLatencyHandler,
+) (*latencyAwareNodesProvider, error) {
+	if check.IfNil(nodesHealth) {
+		return nil, ErrNilNodesHealthHandler
+	}
+	if check.IfNil(nodesLatency) {
+		return nil, ErrNilNodesLatencyHandler
+	}
+
+	bop := &baseNodeProvider{
+		configurationFilePath: configurationFilePath,
+		numOfShards:           numberOfShards,
+		nodesHealth:           nodesHealth,
+	}
+
+	err := bop.initNodes(observers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &latencyAwareNodesProvider{
+		baseNodeProvider: bop,
+		nodesLatency:     nodesLatency,
+	}, nil
+}
+
+// GetNodesByShardId will return a slice of observers for the given shard, ordered by their cost
+func (lanp *latencyAwareNodesProvider) GetNodesByShardId(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+	lanp.mutNodes.RLock()
+	defer lanp.mutNodes.RUnlock()
+
+	syncedNodesForShard, err := lanp.getSyncedNodesForShardUnprotected(shardId, dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	return lanp.prioritizeAvailableNodes(lanp.sortNodesByCost(syncedNodesForShard)), nil
+}
+
+// GetAllNodes will return a slice containing all observers, ordered by their cost
+func (lanp *latencyAwareNodesProvider) GetAllNodes(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+	lanp.mutNodes.RLock()
+	defer lanp.mutNodes.RUnlock()
+
+	allNodes, err := lanp.getSyncedNodesUnprotected(dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	return lanp.prioritizeAvailableNodes(lanp.sortNodesByCost(allNodes)), nil
+}
+
+// sortNodesByCost returns a new slice with the provided nodes sorted by their cost. Nodes with equal costs keep
+// their configured order
+func (lanp *latencyAwareNodesProvider) sortNodesByCost(nodes []*data.NodeData) []*data.NodeData {
+	costs := make(map[string]float64, len(nodes))
+	for _, node := range nodes {
+		costs[node.Address] = lanp.nodesLatency.ComputeCost(node.Address)
+	}
+
+	sortedNodes := make([]*data.NodeData, len(nodes))
+	copy(sortedNodes, nodes)
+	sort.SliceStable(sortedNodes, func(i, j int) bool {
+		return costs[sortedNodes[i].Address] < costs[sortedNodes[j].Address]
+	})
+
+	return sortedNodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lanp *latencyAwareNodesProvider) IsInterfaceNil() bool {
+	return lanp == nil
+}
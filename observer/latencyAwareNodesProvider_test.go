@@ -0,0 +1,103 @@
+package observer
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createLatencyAwareTestObservers() []*data.NodeData {
+	return []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+		{Address: "addr3", ShardId: 1},
+	}
+}
+
+func TestNewLatencyAwareNodesProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes health handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		lanp, err := NewLatencyAwareNodesProvider(createLatencyAwareTestObservers(), "path", 2, nil, &mock.NodesLatencyHandlerStub{})
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrNilNodesHealthHandler, err)
+	})
+	t.Run("nil nodes latency handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		lanp, err := NewLatencyAwareNodesProvider(createLatencyAwareTestObservers(), "path", 2, &mock.NodesHealthHandlerStub{}, nil)
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrNilNodesLatencyHandler, err)
+	})
+	t.Run("empty observers list should error", func(t *testing.T) {
+		t.Parallel()
+
+		lanp, err := NewLatencyAwareNodesProvider(nil, "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrEmptyObserversList, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lanp, err := NewLatencyAwareNodesProvider(createLatencyAwareTestObservers(), "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(lanp))
+	})
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdShouldOrderByCost(t *testing.T) {
+	t.Parallel()
+
+	costs := map[string]float64{
+		"addr0": 300,
+		"addr1": 100,
+		"addr2": 300,
+	}
+	nodesLatency := &mock.NodesLatencyHandlerStub{
+		ComputeCostCalled: func(address string) float64 {
+			return costs[address]
+		},
+	}
+	lanp, _ := NewLatencyAwareNodesProvider(createLatencyAwareTestObservers(), "path", 2, &mock.NodesHealthHandlerStub{}, nodesLatency)
+
+	nodes, err := lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr0", "addr2"}, getNodesAddresses(nodes))
+
+	costs["addr1"] = 500
+	nodes, err = lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"addr0", "addr2", "addr1"}, getNodesAddresses(nodes))
+}
+
+func TestLatencyAwareNodesProvider_GetAllNodesShouldOrderByCostAndDeprioritiseUnavailableNodes(t *testing.T) {
+	t.Parallel()
+
+	costs := map[string]float64{
+		"addr0": 50,
+		"addr1": 400,
+		"addr2": 200,
+		"addr3": 100,
+	}
+	nodesLatency := &mock.NodesLatencyHandlerStub{
+		ComputeCostCalled: func(address string) float64 {
+			return costs[address]
+		},
+	}
+	nodesHealth := &mock.NodesHealthHandlerStub{
+		IsAvailableCalled: func(address string) bool {
+			return address != "addr0"
+		},
+	}
+	lanp, _ := NewLatencyAwareNodesProvider(createLatencyAwareTestObservers(), "path", 2, nodesHealth, nodesLatency)
+
+	nodes, err := lanp.GetAllNodes(data.AvailabilityAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"addr3", "addr2", "addr1", "addr0"}, getNodesAddresses(nodes))
+}
@@ -0,0 +1,37 @@
+package latencyTracker
+
+import "time"
+
+type disabledNodesLatencyTracker struct {
+}
+
+// NewDisabledNodesLatencyTracker returns a latency tracker that does not track anything
+func NewDisabledNodesLatencyTracker() *disabledNodesLatencyTracker {
+	return &disabledNodesLatencyTracker{}
+}
+
+// RequestStarted does nothing as this is a disabled component
+func (d *disabledNodesLatencyTracker) RequestStarted(_ string) {
+}
+
+// RequestFinished does nothing as this is a disabled component
+func (d *disabledNodesLatencyTracker) RequestFinished(_ string, _ time.Duration) {
+}
+
+// RequestFailed does nothing as this is a disabled component
+func (d *disabledNodesLatencyTracker) RequestFailed(_ string) {
+}
+
+// RequestCancelled does nothing as this is a disabled component
+func (d *disabledNodesLatencyTracker) RequestCancelled(_ string) {
+}
+
+// ComputeCost returns 0 as this is a disabled component
+func (d *disabledNodesLatencyTracker) ComputeCost(_ string) float64 {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledNodesLatencyTracker) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,22 @@
+package latencyTracker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisabledNodesLatencyTracker(t *testing.T) {
+	t.Parallel()
+
+	d := NewDisabledNodesLatencyTracker()
+	require.False(t, check.IfNil(d))
+
+	d.RequestStarted(testAddress)
+	d.RequestFinished(testAddress, time.Second)
+	d.RequestFailed(testAddress)
+	d.RequestCancelled(testAddress)
+	require.Zero(t, d.ComputeCost(testAddress))
+}
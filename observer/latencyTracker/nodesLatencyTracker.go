@@ -0,0 +1,128 @@
+package latencyTracker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+const (
+	minEWMAAlpha = 0.0
+	maxEWMAAlpha = 1.0
+
+	// failedRequestLatency is the latency sample recorded for a failed request, so that the unreachable nodes rank
+	// after the slow ones instead of looking fast because they fail right away
+	failedRequestLatency = 30 * time.Second
+)
+
+type nodeLatency struct {
+	ewmaLatency      float64
+	inFlightRequests uint64
+	hasSamples       bool
+}
+
+// nodesLatencyTracker keeps, for each node, an exponentially weighted moving average of the response latency and
+// the number of in-flight requests
+type nodesLatencyTracker struct {
+	mutNodes  sync.RWMutex
+	nodes     map[string]*nodeLatency
+	ewmaAlpha float64
+}
+
+// NewNodesLatencyTracker returns a new instance of nodesLatencyTracker
+func NewNodesLatencyTracker(ewmaAlpha float64) (*nodesLatencyTracker, error) {
+	if ewmaAlpha <= minEWMAAlpha || ewmaAlpha > maxEWMAAlpha {
+		return nil, fmt.Errorf("%w for EWMA alpha, %f provided, expected a value in (%.1f, %.1f]",
+			core.ErrInvalidValue, ewmaAlpha, minEWMAAlpha, maxEWMAAlpha)
+	}
+
+	return &nodesLatencyTracker{
+		nodes:     make(map[string]*nodeLatency),
+		ewmaAlpha: ewmaAlpha,
+	}, nil
+}
+
+// RequestStarted marks the beginning of a request towards the provided node
+func (nlt *nodesLatencyTracker) RequestStarted(address string) {
+	nlt.mutNodes.Lock()
+	defer nlt.mutNodes.Unlock()
+
+	nlt.getOrCreateNode(address).inFlightRequests++
+}
+
+// RequestFinished marks the end of a successful request towards the provided node and updates its average latency
+func (nlt *nodesLatencyTracker) RequestFinished(address string, duration time.Duration) {
+	nlt.mutNodes.Lock()
+	defer nlt.mutNodes.Unlock()
+
+	node := nlt.finishRequest(address)
+	nlt.addSample(node, duration)
+}
+
+// RequestFailed marks the end of a failed request towards the provided node and penalizes its average latency
+func (nlt *nodesLatencyTracker) RequestFailed(address string) {
+	nlt.mutNodes.Lock()
+	defer nlt.mutNodes.Unlock()
+
+	node := nlt.finishRequest(address)
+	nlt.addSample(node, failedRequestLatency)
+}
+
+// RequestCancelled marks the end of a request cancelled by its caller, which says nothing about the node's latency
+func (nlt *nodesLatencyTracker) RequestCancelled(address string) {
+	nlt.mutNodes.Lock()
+	defer nlt.mutNodes.Unlock()
+
+	nlt.finishRequest(address)
+}
+
+func (nlt *nodesLatencyTracker) finishRequest(address string) *nodeLatency {
+	node := nlt.getOrCreateNode(address)
+	if node.inFlightRequests > 0 {
+		node.inFlightRequests--
+	}
+
+	return node
+}
+
+func (nlt *nodesLatencyTracker) addSample(node *nodeLatency, duration time.Duration) {
+	latency := float64(duration)
+	if !node.hasSamples {
+		node.ewmaLatency = latency
+		node.hasSamples = true
+		return
+	}
+
+	node.ewmaLatency = nlt.ewmaAlpha*latency + (1-nlt.ewmaAlpha)*node.ewmaLatency
+}
+
+// ComputeCost returns the cost of sending a new request to the provided node, as the average latency multiplied by
+// the number of requests the node would be handling. Nodes without samples have a zero cost, so they get probed
+func (nlt *nodesLatencyTracker) ComputeCost(address string) float64 {
+	nlt.mutNodes.RLock()
+	defer nlt.mutNodes.RUnlock()
+
+	node, found := nlt.nodes[address]
+	if !found || !node.hasSamples {
+		return 0
+	}
+
+	return node.ewmaLatency * float64(node.inFlightRequests+1)
+}
+
+func (nlt *nodesLatencyTracker) getOrCreateNode(address string) *nodeLatency {
+	node, found := nlt.nodes[address]
+	if !found {
+		node = &nodeLatency{}
+		nlt.nodes[address] = node
+	}
+
+	return node
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nlt *nodesLatencyTracker) IsInterfaceNil() bool {
+	return nlt == nil
+}
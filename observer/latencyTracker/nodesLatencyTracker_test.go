@@ -0,0 +1,138 @@
+package latencyTracker
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "http://observer0"
+
+func TestNewNodesLatencyTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero alpha should error", func(t *testing.T) {
+		t.Parallel()
+
+		nlt, err := NewNodesLatencyTracker(0)
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.Nil(t, nlt)
+	})
+	t.Run("alpha greater than 1 should error", func(t *testing.T) {
+		t.Parallel()
+
+		nlt, err := NewNodesLatencyTracker(1.1)
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.Nil(t, nlt)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nlt, err := NewNodesLatencyTracker(1)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(nlt))
+	})
+}
+
+func TestNodesLatencyTracker_ComputeCost(t *testing.T) {
+	t.Parallel()
+
+	nlt, _ := NewNodesLatencyTracker(0.5)
+	require.Zero(t, nlt.ComputeCost(testAddress))
+
+	// unknown nodes and nodes without samples have zero cost, so they get probed
+	nlt.RequestStarted(testAddress)
+	require.Zero(t, nlt.ComputeCost(testAddress))
+
+	nlt.RequestFinished(testAddress, 100*time.Millisecond)
+	require.Equal(t, float64(100*time.Millisecond), nlt.ComputeCost(testAddress))
+
+	nlt.RequestStarted(testAddress)
+	nlt.RequestFinished(testAddress, 300*time.Millisecond)
+	require.Equal(t, float64(200*time.Millisecond), nlt.ComputeCost(testAddress))
+
+	// each in-flight request increases the cost
+	nlt.RequestStarted(testAddress)
+	nlt.RequestStarted(testAddress)
+	require.Equal(t, float64(600*time.Millisecond), nlt.ComputeCost(testAddress))
+
+	nlt.RequestFinished(testAddress, 200*time.Millisecond)
+	nlt.RequestFinished(testAddress, 200*time.Millisecond)
+	require.Equal(t, float64(200*time.Millisecond), nlt.ComputeCost(testAddress))
+}
+
+func TestNodesLatencyTracker_FailingNodeShouldRankLast(t *testing.T) {
+	t.Parallel()
+
+	fastNode, slowNode, failingNode := "http://fast", "http://slow", "http://failing"
+	nlt, _ := NewNodesLatencyTracker(0.3)
+
+	for i := 0; i < 10; i++ {
+		nlt.RequestStarted(fastNode)
+		nlt.RequestFinished(fastNode, 10*time.Millisecond)
+
+		nlt.RequestStarted(slowNode)
+		nlt.RequestFinished(slowNode, 2*time.Second)
+
+		// a refused connection fails right away, which must not look like a fast response
+		nlt.RequestStarted(failingNode)
+		nlt.RequestFailed(failingNode)
+	}
+
+	nodes := []string{failingNode, slowNode, fastNode}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nlt.ComputeCost(nodes[i]) < nlt.ComputeCost(nodes[j])
+	})
+	require.Equal(t, []string{fastNode, slowNode, failingNode}, nodes)
+}
+
+func TestNodesLatencyTracker_RequestCancelledShouldNotSample(t *testing.T) {
+	t.Parallel()
+
+	nlt, _ := NewNodesLatencyTracker(0.5)
+
+	nlt.RequestStarted(testAddress)
+	nlt.RequestFinished(testAddress, time.Second)
+	require.Equal(t, float64(time.Second), nlt.ComputeCost(testAddress))
+
+	// a cancelled hedging loser only releases its in-flight slot, without pulling the average down
+	nlt.RequestStarted(testAddress)
+	require.Equal(t, float64(2*time.Second), nlt.ComputeCost(testAddress))
+	nlt.RequestCancelled(testAddress)
+	require.Equal(t, float64(time.Second), nlt.ComputeCost(testAddress))
+}
+
+func TestNodesLatencyTracker_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	nlt, _ := NewNodesLatencyTracker(0.3)
+
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 5 {
+			case 0:
+				nlt.RequestStarted(testAddress)
+			case 1:
+				nlt.RequestFinished(testAddress, time.Millisecond)
+			case 2:
+				nlt.RequestFailed(testAddress)
+			case 3:
+				nlt.RequestCancelled(testAddress)
+			case 4:
+				_ = nlt.ComputeCost(testAddress)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
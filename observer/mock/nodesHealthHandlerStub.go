@@ -0,0 +1,66 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NodesHealthHandlerStub -
+type NodesHealthHandlerStub struct {
+	IsAvailableCalled        func(address string) bool
+	RecordRequestSentCalled  func(address string) bool
+	RecordCancellationCalled func(address string, isProbe bool)
+	RecordSuccessCalled      func(address string, isProbe bool)
+	RecordFailureCalled      func(address string)
+	GetNodesHealthCalled     func() []*data.NodeHealth
+}
+
+// IsAvailable -
+func (stub *NodesHealthHandlerStub) IsAvailable(address string) bool {
+	if stub.IsAvailableCalled != nil {
+		return stub.IsAvailableCalled(address)
+	}
+
+	return true
+}
+
+// RecordRequestSent -
+func (stub *NodesHealthHandlerStub) RecordRequestSent(address string) bool {
+	if stub.RecordRequestSentCalled != nil {
+		return stub.RecordRequestSentCalled(address)
+	}
+
+	return false
+}
+
+// RecordCancellation -
+func (stub *NodesHealthHandlerStub) RecordCancellation(address string, isProbe bool) {
+	if stub.RecordCancellationCalled != nil {
+		stub.RecordCancellationCalled(address, isProbe)
+	}
+}
+
+// RecordSuccess -
+func (stub *NodesHealthHandlerStub) RecordSuccess(address string, isProbe bool) {
+	if stub.RecordSuccessCalled != nil {
+		stub.RecordSuccessCalled(address, isProbe)
+	}
+}
+
+// RecordFailure -
+func (stub *NodesHealthHandlerStub) RecordFailure(address string) {
+	if stub.RecordFailureCalled != nil {
+		stub.RecordFailureCalled(address)
+	}
+}
+
+// GetNodesHealth -
+func (stub *NodesHealthHandlerStub) GetNodesHealth() []*data.NodeHealth {
+	if stub.GetNodesHealthCalled != nil {
+		return stub.GetNodesHealthCalled()
+	}
+
+	return make([]*data.NodeHealth, 0)
+}
+
+// IsInterfaceNil -
+func (stub *NodesHealthHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,54 @@
+package mock
+
+import "time"
+
+// NodesLatencyHandlerStub -
+type NodesLatencyHandlerStub struct {
+	RequestStartedCalled   func(address string)
+	RequestFinishedCalled  func(address string, duration time.Duration)
+	RequestFailedCalled    func(address string)
+	RequestCancelledCalled func(address string)
+	ComputeCostCalled      func(address string) float64
+}
+
+// RequestStarted -
+func (stub *NodesLatencyHandlerStub) RequestStarted(address string) {
+	if stub.RequestStartedCalled != nil {
+		stub.RequestStartedCalled(address)
+	}
+}
+
+// RequestFinished -
+func (stub *NodesLatencyHandlerStub) RequestFinished(address string, duration time.Duration) {
+	if stub.RequestFinishedCalled != nil {
+		stub.RequestFinishedCalled(address, duration)
+	}
+}
+
+// RequestFailed -
+func (stub *NodesLatencyHandlerStub) RequestFailed(address string) {
+	if stub.RequestFailedCalled != nil {
+		stub.RequestFailedCalled(address)
+	}
+}
+
+// RequestCancelled -
+func (stub *NodesLatencyHandlerStub) RequestCancelled(address string) {
+	if stub.RequestCancelledCalled != nil {
+		stub.RequestCancelledCalled(address)
+	}
+}
+
+// ComputeCost -
+func (stub *NodesLatencyHandlerStub) ComputeCost(address string) float64 {
+	if stub.ComputeCostCalled != nil {
+		return stub.ComputeCostCalled(address)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *NodesLatencyHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
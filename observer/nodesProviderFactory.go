@@ -1,61 +1,90 @@
 package observer
 
 import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 var log = logger.GetOrCreate("observer")
 
+const (
+	// DefaultSelectionStrategy selects the simple or the circular queue nodes provider, based on the balancing flags
+	DefaultSelectionStrategy = ""
+
+	// LatencyAwareSelectionStrategy selects the nodes provider that orders the nodes by latency and in-flight requests
+	LatencyAwareSelectionStrategy = "latency-aware"
+)
+
 // nodesProviderFactory handles the creation of an nodes provider based on config
 type nodesProviderFactory struct {
 	cfg                   config.Config
 	configurationFilePath string
 	numberOfShards        uint32
+	nodesHealth           NodesHealthHandler
+	nodesLatency          NodesLatencyHandler
 }
 
 // NewNodesProviderFactory returns a new instance of nodesProviderFactory
-func NewNodesProviderFactory(cfg config.Config, configurationFilePath string, numberOfShards uint32) (*nodesProviderFactory, error) {
+func NewNodesProviderFactory(
+	cfg config.Config,
+	configurationFilePath string,
+	numberOfShards uint32,
+	nodesHealth NodesHealthHandler,
+	nodesLatency NodesLatencyHandler,
+) (*nodesProviderFactory, error) {
+	if check.IfNil(nodesHealth) {
+		return nil, ErrNilNodesHealthHandler
+	}
+	if check.IfNil(nodesLatency) {
+		return nil, ErrNilNodesLatencyHandler
+	}
+	err := checkSelectionStrategy(cfg.NodesSelection.ObserversStrategy)
+	if err != nil {
+		return nil, fmt.Errorf("%w for observers", err)
+	}
+	err = checkSelectionStrategy(cfg.NodesSelection.FullHistoryNodesStrategy)
+	if err != nil {
+		return nil, fmt.Errorf("%w for full history nodes", err)
+	}
+
 	return &nodesProviderFactory{
 		cfg:                   cfg,
 		configurationFilePath: configurationFilePath,
 		numberOfShards:        numberOfShards,
+		nodesHealth:           nodesHealth,
+		nodesLatency:          nodesLatency,
 	}, nil
 }
 
-// CreateObservers will create and return an object of type NodesProviderHandler based on a flag
-func (npf *nodesProviderFactory) CreateObservers() (NodesProviderHandler, error) {
-	if npf.cfg.GeneralSettings.BalancedObservers {
-		return NewCircularQueueNodesProvider(
-			npf.cfg.Observers,
-			npf.configurationFilePath,
-			npf.numberOfShards)
+func checkSelectionStrategy(strategy string) error {
+	switch strategy {
+	case DefaultSelectionStrategy, LatencyAwareSelectionStrategy:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidSelectionStrategy, strategy)
 	}
+}
 
-	return NewSimpleNodesProvider(
+// CreateObservers will create and return an object of type NodesProviderHandler based on the selection strategy
+func (npf *nodesProviderFactory) CreateObservers() (NodesProviderHandler, error) {
+	return npf.createNodesProvider(
 		npf.cfg.Observers,
-		npf.configurationFilePath,
-		npf.numberOfShards)
+		npf.cfg.NodesSelection.ObserversStrategy,
+		npf.cfg.GeneralSettings.BalancedObservers,
+	)
 }
 
-// CreateFullHistoryNodes will create and return an object of type NodesProviderHandler based on a flag
+// CreateFullHistoryNodes will create and return an object of type NodesProviderHandler based on the selection strategy
 func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler, error) {
-	if npf.cfg.GeneralSettings.BalancedFullHistoryNodes {
-		nodesProviderHandler, err := NewCircularQueueNodesProvider(
-			npf.cfg.FullHistoryNodes,
-			npf.configurationFilePath,
-			npf.numberOfShards)
-		if err != nil {
-			return getDisabledFullHistoryNodesProviderIfNeeded(err)
-		}
-
-		return nodesProviderHandler, nil
-	}
-
-	nodesProviderHandler, err := NewSimpleNodesProvider(
+	nodesProviderHandler, err := npf.createNodesProvider(
 		npf.cfg.FullHistoryNodes,
-		npf.configurationFilePath,
-		npf.numberOfShards)
+		npf.cfg.NodesSelection.FullHistoryNodesStrategy,
+		npf.cfg.GeneralSettings.BalancedFullHistoryNodes,
+	)
 	if err != nil {
 		return getDisabledFullHistoryNodesProviderIfNeeded(err)
 	}
@@ -63,6 +92,35 @@ func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler,
 	return nodesProviderHandler, nil
 }
 
+func (npf *nodesProviderFactory) createNodesProvider(
+	nodes []*data.NodeData,
+	selectionStrategy string,
+	isBalanced bool,
+) (NodesProviderHandler, error) {
+	if selectionStrategy == LatencyAwareSelectionStrategy {
+		return NewLatencyAwareNodesProvider(
+			nodes,
+			npf.configurationFilePath,
+			npf.numberOfShards,
+			npf.nodesHealth,
+			npf.nodesLatency)
+	}
+
+	if isBalanced {
+		return NewCircularQueueNodesProvider(
+			nodes,
+			npf.configurationFilePath,
+			npf.numberOfShards,
+			npf.nodesHealth)
+	}
+
+	return NewSimpleNodesProvider(
+		nodes,
+		npf.configurationFilePath,
+		npf.numberOfShards,
+		npf.nodesHealth)
+}
+
 func getDisabledFullHistoryNodesProviderIfNeeded(err error) (NodesProviderHandler, error) {
 	if err == ErrEmptyObserversList {
 		log.Warn("no configuration found for full history nodes. Calls to endpoints specific to full history nodes " +
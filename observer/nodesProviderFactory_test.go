@@ -1,16 +1,26 @@
 package observer
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/observer/mock"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestNewObserversProviderFactory_NilNodesHealthHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	opf, err := NewNodesProviderFactory(config.Config{}, "path", 2, nil, &mock.NodesLatencyHandlerStub{})
+	assert.Nil(t, opf)
+	assert.Equal(t, ErrNilNodesHealthHandler, err)
+}
+
 func TestNewObserversProviderFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	opf, err := NewNodesProviderFactory(config.Config{}, "path", 2)
+	opf, err := NewNodesProviderFactory(config.Config{}, "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
 	assert.Nil(t, err)
 	assert.NotNil(t, opf)
 }
@@ -21,7 +31,7 @@ func TestObserversProviderFactory_CreateShouldReturnSimple(t *testing.T) {
 	cfg := getDummyConfig()
 	cfg.GeneralSettings.BalancedObservers = false
 
-	opf, _ := NewNodesProviderFactory(cfg, "path", 2)
+	opf, _ := NewNodesProviderFactory(cfg, "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
 	op, err := opf.CreateObservers()
 	assert.Nil(t, err)
 	_, ok := op.(*simpleNodesProvider)
@@ -34,9 +44,66 @@ func TestObserversProviderFactory_CreateShouldReturnCircularQueue(t *testing.T)
 	cfg := getDummyConfig()
 	cfg.GeneralSettings.BalancedObservers = true
 
-	opf, _ := NewNodesProviderFactory(cfg, "path", 2)
+	opf, _ := NewNodesProviderFactory(cfg, "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
 	op, err := opf.CreateObservers()
 	assert.Nil(t, err)
 	_, ok := op.(*circularQueueNodesProvider)
 	assert.True(t, ok)
 }
+
+func TestNewObserversProviderFactory_NilNodesLatencyHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	opf, err := NewNodesProviderFactory(config.Config{}, "path", 2, &mock.NodesHealthHandlerStub{}, nil)
+	assert.Nil(t, opf)
+	assert.Equal(t, ErrNilNodesLatencyHandler, err)
+}
+
+func TestNewObserversProviderFactory_InvalidSelectionStrategyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.NodesSelection.ObserversStrategy = "fastest"
+	opf, err := NewNodesProviderFactory(cfg, "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
+	assert.Nil(t, opf)
+	assert.True(t, errors.Is(err, ErrInvalidSelectionStrategy))
+
+	cfg = getDummyConfig()
+	cfg.NodesSelection.FullHistoryNodesStrategy = "fastest"
+	opf, err = NewNodesProviderFactory(cfg, "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
+	assert.Nil(t, opf)
+	assert.True(t, errors.Is(err, ErrInvalidSelectionStrategy))
+}
+
+func TestObserversProviderFactory_CreateShouldReturnLatencyAware(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.BalancedObservers = true
+	cfg.NodesSelection.ObserversStrategy = LatencyAwareSelectionStrategy
+
+	opf, _ := NewNodesProviderFactory(cfg, "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
+	op, err := opf.CreateObservers()
+	assert.Nil(t, err)
+	_, ok := op.(*latencyAwareNodesProvider)
+	assert.True(t, ok)
+}
+
+func TestObserversProviderFactory_CreateFullHistoryNodesShouldUseItsOwnStrategy(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.FullHistoryNodes = cfg.Observers
+	cfg.NodesSelection.FullHistoryNodesStrategy = LatencyAwareSelectionStrategy
+
+	opf, _ := NewNodesProviderFactory(cfg, "path", 2, &mock.NodesHealthHandlerStub{}, &mock.NodesLatencyHandlerStub{})
+	op, err := opf.CreateObservers()
+	assert.Nil(t, err)
+	_, ok := op.(*simpleNodesProvider)
+	assert.True(t, ok)
+
+	fhn, err := opf.CreateFullHistoryNodes()
+	assert.Nil(t, err)
+	_, ok = fhn.(*latencyAwareNodesProvider)
+	assert.True(t, ok)
+}
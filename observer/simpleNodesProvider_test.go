@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer/mock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,16 +17,25 @@ func TestNewSimpleObserversProvider_EmptyObserversListShouldErr(t *testing.T) {
 
 	cfg := getDummyConfig()
 	cfg.Observers = make([]*data.NodeData, 0)
-	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), &mock.NodesHealthHandlerStub{})
 	assert.Nil(t, sop)
 	assert.Equal(t, ErrEmptyObserversList, err)
 }
 
+func TestNewSimpleObserversProvider_NilNodesHealthHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), nil)
+	assert.Nil(t, sop)
+	assert.Equal(t, ErrNilNodesHealthHandler, err)
+}
+
 func TestNewSimpleObserversProvider_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	cfg := getDummyConfig()
-	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), &mock.NodesHealthHandlerStub{})
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(sop))
 }
@@ -35,7 +45,7 @@ func TestSimpleObserversProvider_GetObserversByShardIdShouldErrBecauseInvalidSha
 
 	invalidShardId := uint32(37)
 	cfg := getDummyConfig()
-	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), &mock.NodesHealthHandlerStub{})
 
 	res, err := cqop.GetNodesByShardId(invalidShardId, "")
 	assert.Nil(t, res)
@@ -47,7 +57,7 @@ func TestSimpleObserversProvider_GetObserversByShardIdShouldWork(t *testing.T) {
 
 	shardId := uint32(0)
 	cfg := getDummyConfig()
-	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), &mock.NodesHealthHandlerStub{})
 
 	res, err := cqop.GetNodesByShardId(shardId, "")
 	assert.Nil(t, err)
@@ -58,7 +68,7 @@ func TestSimpleObserversProvider_GetAllObserversShouldWork(t *testing.T) {
 	t.Parallel()
 
 	cfg := getDummyConfig()
-	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), &mock.NodesHealthHandlerStub{})
 
 	res, _ := cqop.GetAllNodes("")
 	assert.Equal(t, 2, len(res))
@@ -105,7 +115,7 @@ func TestSimpleObserversProvider_GetObserversByShardId_ConcurrentSafe(t *testing
 	// will be called
 	expectedNumOfTimesAnObserverIsCalled := numOfTimesToCallForEachRoutine * numOfGoRoutinesToStart
 
-	sop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	sop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), &mock.NodesHealthHandlerStub{})
 
 	for i := 0; i < numOfGoRoutinesToStart; i++ {
 		for j := 0; j < numOfTimesToCallForEachRoutine; j++ {
@@ -168,7 +178,7 @@ func TestSimpleObserversProvider_GetAllObservers_ConcurrentSafe(t *testing.T) {
 	// will be called
 	expectedNumOfTimesAnObserverIsCalled := numOfTimesToCallForEachRoutine * numOfGoRoutinesToStart
 
-	sop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	sop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), &mock.NodesHealthHandlerStub{})
 
 	for i := 0; i < numOfGoRoutinesToStart; i++ {
 		for j := 0; j < numOfTimesToCallForEachRoutine; j++ {
@@ -187,3 +197,36 @@ func TestSimpleObserversProvider_GetAllObservers_ConcurrentSafe(t *testing.T) {
 	}
 	mutMap.RUnlock()
 }
+
+func TestSimpleObserversProvider_GetNodesShouldDeprioritiseUnavailableNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+	}
+	nodesHealth := &mock.NodesHealthHandlerStub{
+		IsAvailableCalled: func(address string) bool {
+			return address != "addr0"
+		},
+	}
+	sop, _ := NewSimpleNodesProvider(observers, "path", 1, nodesHealth)
+
+	nodes, err := sop.GetNodesByShardId(0, data.AvailabilityAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr2", "addr0"}, getNodesAddresses(nodes))
+
+	nodes, err = sop.GetAllNodes(data.AvailabilityAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr2", "addr0"}, getNodesAddresses(nodes))
+}
+
+func getNodesAddresses(nodes []*data.NodeData) []string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return addresses
+}
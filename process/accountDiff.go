@@ -0,0 +1,227 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// accountState holds the account and its ESDT tokens, keyed by their identifiers, at a block
+type accountState struct {
+	model  *data.AccountModel
+	tokens map[string]accountToken
+}
+
+// accountToken follows the format of an entry in the ESDT tokens response of the nodes
+type accountToken struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Balance         string `json:"balance"`
+	Nonce           uint64 `json:"nonce"`
+}
+
+// GetAccountDiff returns the changes of the account between two blocks of its shard, computed from the account and the
+// ESDT tokens fetched from the full history observers at both blocks
+func (ap *AccountProcessor) GetAccountDiff(ctx context.Context, address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+	var fromState, toState *accountState
+	var errFrom, errTo error
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		fromState, errFrom = ap.getAccountState(ctx, address, options.FromNonce)
+		wg.Done()
+	}()
+	go func() {
+		toState, errTo = ap.getAccountState(ctx, address, options.ToNonce)
+		wg.Done()
+	}()
+	wg.Wait()
+
+	if errFrom != nil {
+		return nil, errFrom
+	}
+	if errTo != nil {
+		return nil, errTo
+	}
+
+	return buildAccountDiff(address, fromState, toState)
+}
+
+func (ap *AccountProcessor) getAccountState(ctx context.Context, address string, nonce uint64) (*accountState, error) {
+	options := common.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: nonce, HasValue: true},
+	}
+
+	model, err := ap.GetAccount(ctx, address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensResponse, err := ap.GetAllESDTTokens(ctx, address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := parseAccountTokens(tokensResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountState{
+		model:  model,
+		tokens: tokens,
+	}, nil
+}
+
+func parseAccountTokens(response *data.GenericAPIResponse) (map[string]accountToken, error) {
+	buff, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		ESDTs map[string]accountToken `json:"esdts"`
+	}{}
+	err = json.Unmarshal(buff, &payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload.ESDTs == nil {
+		return make(map[string]accountToken), nil
+	}
+
+	return payload.ESDTs, nil
+}
+
+func buildAccountDiff(address string, fromState *accountState, toState *accountState) (*data.AccountDiff, error) {
+	fromAccount := fromState.model.Account
+	toAccount := toState.model.Account
+
+	balance, err := computeBalanceDiff(fromAccount.Balance, toAccount.Balance)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := computeTokensDiff(fromState.tokens, toState.tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.AccountDiff{
+		Address:   address,
+		FromBlock: fromState.model.BlockInfo,
+		ToBlock:   toState.model.BlockInfo,
+		Balance:   *balance,
+		Nonce: data.NonceDiff{
+			From:  fromAccount.Nonce,
+			To:    toAccount.Nonce,
+			Delta: int64(toAccount.Nonce) - int64(fromAccount.Nonce),
+		},
+		Username: data.UsernameDiff{
+			From:    fromAccount.Username,
+			To:      toAccount.Username,
+			Changed: fromAccount.Username != toAccount.Username,
+		},
+		Tokens: tokens,
+	}, nil
+}
+
+// computeTokensDiff returns the tokens whose balances changed, sorted by their identifiers. A token held at a single
+// block has a zero balance at the other one
+func computeTokensDiff(fromTokens map[string]accountToken, toTokens map[string]accountToken) ([]*data.TokenBalanceDiff, error) {
+	identifiers := make([]string, 0, len(fromTokens)+len(toTokens))
+	for identifier := range fromTokens {
+		identifiers = append(identifiers, identifier)
+	}
+	for identifier := range toTokens {
+		_, existed := fromTokens[identifier]
+		if !existed {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	sort.Strings(identifiers)
+
+	tokens := make([]*data.TokenBalanceDiff, 0)
+	for _, identifier := range identifiers {
+		fromToken, hadToken := fromTokens[identifier]
+		toToken, hasToken := toTokens[identifier]
+
+		balance, err := computeBalanceDiff(fromToken.Balance, toToken.Balance)
+		if err != nil {
+			return nil, fmt.Errorf("%w for token %s", err, identifier)
+		}
+		if balance.Delta == "0" {
+			continue
+		}
+
+		token := toToken
+		if !hasToken && hadToken {
+			token = fromToken
+		}
+
+		tokens = append(tokens, &data.TokenBalanceDiff{
+			Identifier:      identifier,
+			TokenIdentifier: collectionIdentifier(identifier, token.Nonce),
+			Nonce:           token.Nonce,
+			From:            balance.From,
+			To:              balance.To,
+			Delta:           balance.Delta,
+		})
+	}
+
+	return tokens, nil
+}
+
+// collectionIdentifier strips the hex nonce suffix from the identifier of an NFT
+func collectionIdentifier(identifier string, nonce uint64) string {
+	if nonce == 0 {
+		return identifier
+	}
+
+	suffixIdx := strings.LastIndex(identifier, "-")
+	if suffixIdx <= 0 {
+		return identifier
+	}
+
+	return identifier[:suffixIdx]
+}
+
+func computeBalanceDiff(from string, to string) (*data.BalanceDiff, error) {
+	fromValue, err := parseBalance(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toValue, err := parseBalance(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.BalanceDiff{
+		From:  fromValue.String(),
+		To:    toValue.String(),
+		Delta: big.NewInt(0).Sub(toValue, fromValue).String(),
+	}, nil
+}
+
+// parseBalance returns the value of the balance, the missing balances being zero
+func parseBalance(balance string) (*big.Int, error) {
+	if len(balance) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	value, ok := big.NewInt(0).SetString(balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAccountBalance, balance)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,138 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testAccountState struct {
+	account data.Account
+	tokens  map[string]interface{}
+}
+
+func createAccountDiffProcessor(t *testing.T, states map[string]testAccountState) *process.AccountProcessor {
+	ap, err := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(_ uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				require.Equal(t, data.AvailabilityAll, dataAvailability)
+				return []*data.NodeData{{Address: "observer", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(_ string, path string, value interface{}) (int, error) {
+				blockNonce := path[strings.Index(path, "blockNonce=")+len("blockNonce="):]
+				state, ok := states[blockNonce]
+				if !ok {
+					return 0, errors.New("block not found")
+				}
+
+				switch response := value.(type) {
+				case *data.AccountApiResponse:
+					response.Data.Account = state.account
+					nonce, _ := strconv.ParseUint(blockNonce, 10, 64)
+					response.Data.BlockInfo = data.BlockInfo{Nonce: nonce}
+				case *data.GenericAPIResponse:
+					response.Data = map[string]interface{}{"esdts": state.tokens}
+				}
+
+				return 0, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
+	)
+	require.Nil(t, err)
+
+	return ap
+}
+
+func TestAccountProcessor_GetAccountDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should compute the changes", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountDiffProcessor(t, map[string]testAccountState{
+			"100": {
+				account: data.Account{Balance: "1000", Nonce: 5},
+				tokens: map[string]interface{}{
+					"TKN-aaaaaa":    map[string]interface{}{"tokenIdentifier": "TKN-aaaaaa", "balance": "50"},
+					"NFT-bbbbbb-01": map[string]interface{}{"tokenIdentifier": "NFT-bbbbbb-01", "balance": "1", "nonce": 1},
+					"SAM-cccccc":    map[string]interface{}{"tokenIdentifier": "SAM-cccccc", "balance": "7"},
+				},
+			},
+			"200": {
+				account: data.Account{Balance: "400", Nonce: 8, Username: "alice.elrond"},
+				tokens: map[string]interface{}{
+					"TKN-aaaaaa":    map[string]interface{}{"tokenIdentifier": "TKN-aaaaaa", "balance": "80"},
+					"NFT-bbbbbb-02": map[string]interface{}{"tokenIdentifier": "NFT-bbbbbb-02", "balance": "1", "nonce": 2},
+					"SAM-cccccc":    map[string]interface{}{"tokenIdentifier": "SAM-cccccc", "balance": "7"},
+				},
+			},
+		})
+
+		diff, err := ap.GetAccountDiff(context.Background(), "DEADBEEF", common.AccountDiffOptions{FromNonce: 100, ToNonce: 200})
+		require.Nil(t, err)
+		require.Equal(t, "DEADBEEF", diff.Address)
+		require.Equal(t, uint64(100), diff.FromBlock.Nonce)
+		require.Equal(t, uint64(200), diff.ToBlock.Nonce)
+		require.Equal(t, data.BalanceDiff{From: "1000", To: "400", Delta: "-600"}, diff.Balance)
+		require.Equal(t, data.NonceDiff{From: 5, To: 8, Delta: 3}, diff.Nonce)
+		require.Equal(t, data.UsernameDiff{From: "", To: "alice.elrond", Changed: true}, diff.Username)
+		require.Equal(t, []*data.TokenBalanceDiff{
+			{Identifier: "NFT-bbbbbb-01", TokenIdentifier: "NFT-bbbbbb", Nonce: 1, From: "1", To: "0", Delta: "-1"},
+			{Identifier: "NFT-bbbbbb-02", TokenIdentifier: "NFT-bbbbbb", Nonce: 2, From: "0", To: "1", Delta: "1"},
+			{Identifier: "TKN-aaaaaa", TokenIdentifier: "TKN-aaaaaa", From: "50", To: "80", Delta: "30"},
+		}, diff.Tokens)
+	})
+	t.Run("unchanged account should return no token", func(t *testing.T) {
+		t.Parallel()
+
+		state := testAccountState{
+			account: data.Account{Balance: "1000", Nonce: 5},
+			tokens: map[string]interface{}{
+				"TKN-aaaaaa": map[string]interface{}{"tokenIdentifier": "TKN-aaaaaa", "balance": "50"},
+			},
+		}
+		ap := createAccountDiffProcessor(t, map[string]testAccountState{"100": state, "200": state})
+
+		diff, err := ap.GetAccountDiff(context.Background(), "DEADBEEF", common.AccountDiffOptions{FromNonce: 100, ToNonce: 200})
+		require.Nil(t, err)
+		require.Equal(t, "0", diff.Balance.Delta)
+		require.False(t, diff.Username.Changed)
+		require.Empty(t, diff.Tokens)
+	})
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountDiffProcessor(t, map[string]testAccountState{
+			"100": {account: data.Account{Balance: "1000"}},
+		})
+
+		diff, err := ap.GetAccountDiff(context.Background(), "DEADBEEF", common.AccountDiffOptions{FromNonce: 100, ToNonce: 200})
+		require.Nil(t, diff)
+		require.NotNil(t, err)
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountDiffProcessor(t, map[string]testAccountState{
+			"100": {account: data.Account{Balance: "1000"}},
+			"200": {account: data.Account{Balance: "not a number"}},
+		})
+
+		diff, err := ap.GetAccountDiff(context.Background(), "DEADBEEF", common.AccountDiffOptions{FromNonce: 100, ToNonce: 200})
+		require.Nil(t, diff)
+		require.True(t, errors.Is(err, process.ErrInvalidAccountBalance))
+	})
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -21,21 +22,30 @@ type AccountProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
 	availabilityProvider availabilityCommon.AvailabilityProvider
+	historyResolver      HistoricalCoordinatesResolver
 }
 
 // NewAccountProcessor creates a new instance of AccountProcessor
-func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*AccountProcessor, error) {
+func NewAccountProcessor(
+	proc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	historyResolver HistoricalCoordinatesResolver,
+) (*AccountProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(historyResolver) {
+		return nil, ErrNilHistoricalCoordinatesResolver
+	}
 
 	return &AccountProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
+		historyResolver:      historyResolver,
 	}, nil
 }
 
@@ -50,31 +60,27 @@ func (ap *AccountProcessor) GetShardIDForAddress(address string) (uint32, error)
 }
 
 // GetAccount resolves the request by sending the request to the right observer and returns the response
-func (ap *AccountProcessor) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+func (ap *AccountProcessor) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	observers, options, err := ap.getObserversForAccountQuery(ctx, address, options)
 	if err != nil {
 		return nil, err
 	}
 
 	responseAccount := data.AccountApiResponse{}
-	for _, observer := range observers {
-
-		url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
-		_, err = ap.proc.CallGetRestEndPoint(observer.Address, url, &responseAccount)
-		if err == nil {
-			log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
-			return &responseAccount.Data, nil
-		}
-
-		log.Error("account request", "observer", observer.Address, "address", address, "error", err.Error())
+	url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
+	observer, _, err := ap.proc.CallGetRestEndPointWithHedging(ctx, observers, url, &responseAccount)
+	if err == nil {
+		log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
+		return &responseAccount.Data, nil
 	}
 
+	log.Error("account request", "address", address, "error", err.Error())
+
 	return nil, WrapObserversError(responseAccount.Error)
 }
 
 // GetAccounts will return data about the provided accounts
-func (ap *AccountProcessor) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+func (ap *AccountProcessor) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	addressesInShards := make(map[uint32][]string)
 	var shardID uint32
 	var err error
@@ -97,7 +103,7 @@ func (ap *AccountProcessor) GetAccounts(addresses []string, options common.Accou
 	for shID, accounts := range addressesInShards {
 		go func(shID uint32, accounts []string) {
 			defer wg.Done()
-			accountsInShard, errGetAccounts := ap.getAccountsInShard(accounts, shID, options)
+			accountsInShard, errGetAccounts := ap.getAccountsInShard(ctx, accounts, shID, options)
 
 			mut.Lock()
 			defer mut.Unlock()
@@ -124,7 +130,14 @@ func (ap *AccountProcessor) GetAccounts(addresses []string, options common.Accou
 	}, nil
 }
 
-func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint32, options common.AccountQueryOptions) (map[string]*data.Account, error) {
+// getAccountsInShard resolves the timestamp or the epoch of the options, if any, for each shard on its own, as they
+// match a different block in each shard
+func (ap *AccountProcessor) getAccountsInShard(ctx context.Context, addresses []string, shardID uint32, options common.AccountQueryOptions) (map[string]*data.Account, error) {
+	options, err := ap.resolveAccountQueryOptions(ctx, shardID, options)
+	if err != nil {
+		return nil, err
+	}
+
 	observers, err := ap.proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -134,7 +147,7 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 	apiPath := addressPath + "bulk"
 	apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
 	for _, observer := range observers {
-		respCode, err := ap.proc.CallPostRestEndPoint(observer.Address, apiPath, addresses, &apiResponse)
+		respCode, err := ap.proc.CallPostRestEndPoint(ctx, observer.Address, apiPath, addresses, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("bulk accounts request",
 				"shard ID", observer.ShardId,
@@ -154,9 +167,8 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 }
 
 // GetValueForKey returns the value for the given address and key
-func (ap *AccountProcessor) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+func (ap *AccountProcessor) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
+	observers, options, err := ap.getObserversForAccountQuery(ctx, address, options)
 	if err != nil {
 		return "", err
 	}
@@ -165,7 +177,7 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/key/" + key
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account value for key request",
 				"address", address,
@@ -186,9 +198,8 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 }
 
 // GetESDTTokenData returns the token data for a token with the given name
-func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+func (ap *AccountProcessor) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, options, err := ap.getObserversForAccountQuery(ctx, address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +208,7 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdt/" + key
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDT token data",
 				"address", address,
@@ -219,9 +230,8 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 }
 
 // GetESDTsWithRole returns the token identifiers where the given address has the given role assigned
-func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.proc.GetObservers(core.MetachainShardId, availability)
+func (ap *AccountProcessor) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, options, err := ap.getShardObserversForAccountQuery(ctx, core.MetachainShardId, options)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +240,7 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdts-with-role/" + role
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDTs with role",
 				"address", address,
@@ -252,9 +262,8 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 }
 
 // GetESDTsRoles returns all the tokens and their roles for a given address
-func (ap *AccountProcessor) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.proc.GetObservers(core.MetachainShardId, availability)
+func (ap *AccountProcessor) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, options, err := ap.getShardObserversForAccountQuery(ctx, core.MetachainShardId, options)
 	if err != nil {
 		return nil, err
 	}
@@ -263,7 +272,7 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdts/roles"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, errGet := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, errGet := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if errGet == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDTs roles",
 				"address", address,
@@ -284,11 +293,10 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
-func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	//TODO: refactor the entire proxy so endpoints like this which simply forward the response will use a common
 	// component, as described in task EN-9857.
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.proc.GetObservers(core.MetachainShardId, availability)
+	observers, options, err := ap.getShardObserversForAccountQuery(ctx, core.MetachainShardId, options)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +305,7 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/registered-nfts/"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get owned NFTs",
 				"address", address,
@@ -318,9 +326,8 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 }
 
 // GetESDTNftTokenData returns the nft token data for a token with the given identifier and nonce
-func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+func (ap *AccountProcessor) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, options, err := ap.getObserversForAccountQuery(ctx, address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -330,7 +337,7 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 		nonceAsString := fmt.Sprintf("%d", nonce)
 		apiPath := addressPath + address + "/nft/" + key + "/nonce/" + nonceAsString
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDT NFT token data",
 				"address", address,
@@ -352,9 +359,8 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 }
 
 // GetAllESDTTokens returns all the tokens for a given address
-func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+func (ap *AccountProcessor) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, options, err := ap.getObserversForAccountQuery(ctx, address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +369,7 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdt"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account all ESDT tokens",
 				"address", address,
@@ -384,9 +390,8 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 }
 
 // GetKeyValuePairs returns all the key-value pairs for a given address
-func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+func (ap *AccountProcessor) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, options, err := ap.getObserversForAccountQuery(ctx, address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -395,7 +400,7 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/keys"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get all key-value pairs",
 				"address", address,
@@ -416,9 +421,8 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 }
 
 // GetGuardianData returns the guardian data for the given address
-func (ap *AccountProcessor) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+func (ap *AccountProcessor) GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, options, err := ap.getObserversForAccountQuery(ctx, address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -427,7 +431,7 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/guardian-data"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get guardian data",
 				"address", address,
@@ -448,9 +452,8 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 }
 
 // GetCodeHash returns the code hash for a given address
-func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+func (ap *AccountProcessor) GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, options, err := ap.getObserversForAccountQuery(ctx, address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -459,7 +462,7 @@ func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQu
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/code-hash"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get code hash",
 				"address", address,
@@ -488,22 +491,62 @@ func (ap *AccountProcessor) getShardIfOdAddress(address string) (uint32, error)
 	return ap.proc.ComputeShardId(addressBytes)
 }
 
-func (ap *AccountProcessor) getObserversForAddress(address string, availability data.ObserverDataAvailabilityType, forcedShardID core.OptionalUint32) ([]*data.NodeData, error) {
+func (ap *AccountProcessor) getShardIDForAccountQuery(address string, forcedShardID core.OptionalUint32) (uint32, error) {
 	if forcedShardID.HasValue {
-		return ap.proc.GetObservers(forcedShardID.Value, availability)
+		return forcedShardID.Value, nil
 	}
 
 	addressBytes, err := ap.pubKeyConverter.Decode(address)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	shardID, err := ap.proc.ComputeShardId(addressBytes)
+	return ap.proc.ComputeShardId(addressBytes)
+}
+
+func (ap *AccountProcessor) getObserversForAccountQuery(ctx context.Context, address string, options common.AccountQueryOptions) ([]*data.NodeData, common.AccountQueryOptions, error) {
+	shardID, err := ap.getShardIDForAccountQuery(address, options.ForcedShardID)
 	if err != nil {
-		return nil, err
+		return nil, common.AccountQueryOptions{}, err
 	}
 
-	return ap.proc.GetObservers(shardID, availability)
+	return ap.getShardObserversForAccountQuery(ctx, shardID, options)
+}
+
+// getShardObserversForAccountQuery returns the shard observers able to answer the query, along with the options to
+// forward them
+func (ap *AccountProcessor) getShardObserversForAccountQuery(ctx context.Context, shardID uint32, options common.AccountQueryOptions) ([]*data.NodeData, common.AccountQueryOptions, error) {
+	options, err := ap.resolveAccountQueryOptions(ctx, shardID, options)
+	if err != nil {
+		return nil, common.AccountQueryOptions{}, err
+	}
+
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.proc.GetObservers(shardID, availability)
+	if err != nil {
+		return nil, common.AccountQueryOptions{}, err
+	}
+
+	return observers, options, nil
+}
+
+// resolveAccountQueryOptions replaces the timestamp or the epoch of the options, if any, with the nonce and the epoch
+// of the matching block of the shard
+func (ap *AccountProcessor) resolveAccountQueryOptions(ctx context.Context, shardID uint32, options common.AccountQueryOptions) (common.AccountQueryOptions, error) {
+	if !options.Historical.IsSet() {
+		return options, nil
+	}
+
+	block, err := ap.historyResolver.Resolve(ctx, shardID, options.Historical)
+	if err != nil {
+		return common.AccountQueryOptions{}, err
+	}
+
+	options.BlockNonce = core.OptionalUint64{Value: block.Nonce, HasValue: true}
+	options.HintEpoch = core.OptionalUint32{Value: block.Epoch, HasValue: true}
+	options.Historical = common.HistoricalCoordinates{}
+
+	return options, nil
 }
 
 // GetBaseProcessor returns the base processor
@@ -512,8 +555,18 @@ func (ap *AccountProcessor) GetBaseProcessor() Processor {
 }
 
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
-func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address, data.AvailabilityRecent, options.ForcedShardID)
+func (ap *AccountProcessor) IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	shardID, err := ap.getShardIDForAccountQuery(address, options.ForcedShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err = ap.resolveAccountQueryOptions(ctx, shardID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	observers, err := ap.proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
 	}
@@ -522,7 +575,7 @@ func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.Ac
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/is-data-trie-migrated"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("is data trie migrated",
 				"address", address,
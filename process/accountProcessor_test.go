@@ -1,8 +1,10 @@
 package process_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -20,7 +22,7 @@ import (
 func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{}, &mock.HistoricalCoordinatesResolverStub{})
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -29,16 +31,25 @@ func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewAccountProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil)
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil, &mock.HistoricalCoordinatesResolverStub{})
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilPubKeyConverter, err)
 }
 
+func TestNewAccountProcessor_NilHistoricalCoordinatesResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil)
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilHistoricalCoordinatesResolver, err)
+}
+
 func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.HistoricalCoordinatesResolverStub{})
 
 	assert.NotNil(t, ap)
 	assert.Nil(t, err)
@@ -49,8 +60,8 @@ func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 func TestAccountProcessor_GetAccountInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	accnt, err := ap.GetAccount("invalid hex number", common.AccountQueryOptions{})
+	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.HistoricalCoordinatesResolverStub{})
+	accnt, err := ap.GetAccount(context.Background(), "invalid hex number", common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.NotNil(t, err)
@@ -68,9 +79,10 @@ func TestAccountProcessor_GetAccountComputeShardIdFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -90,9 +102,10 @@ func TestAccountProcessor_GetAccountGetObserversFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -118,9 +131,10 @@ func TestAccountProcessor_GetAccountSendingFailsOnAllObserversShouldErr(t *testi
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.True(t, errors.Is(err, process.ErrSendingRequest))
@@ -158,9 +172,10 @@ func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 	address := "DEADBEEF"
-	accountModel, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accountModel, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Equal(t, respondedAccount.Account, accountModel.Account)
 	assert.Nil(t, err)
@@ -187,11 +202,12 @@ func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key, common.AccountQueryOptions{})
+	value, err := ap.GetValueForKey(context.Background(), addr1, key, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, expectedValue, value)
 }
@@ -215,11 +231,12 @@ func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key, common.AccountQueryOptions{})
+	value, err := ap.GetValueForKey(context.Background(), addr1, key, common.AccountQueryOptions{})
 	assert.Equal(t, "", value)
 	assert.True(t, errors.Is(err, process.ErrSendingRequest))
 }
@@ -248,6 +265,7 @@ func TestAccountProcessor_GetShardIForAddressShouldWork(t *testing.T) {
 			},
 		},
 		bech32C,
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 
 	shardID, err := ap.GetShardIDForAddress(addressShard1)
@@ -270,6 +288,7 @@ func TestAccountProcessor_GetShardIDForAddressShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 
 	shardID, err := ap.GetShardIDForAddress("aaaa")
@@ -288,9 +307,10 @@ func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 
-	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsWithRole(context.Background(), "address", "role", common.AccountQueryOptions{})
 	require.Equal(t, expectedErr, err)
 	require.Nil(t, result)
 }
@@ -315,9 +335,10 @@ func TestAccountProcessor_GetESDTsWithRoleApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 
-	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsWithRole(context.Background(), "address", "role", common.AccountQueryOptions{})
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "sending request error"))
 	require.Nil(t, result)
@@ -343,9 +364,10 @@ func TestAccountProcessor_GetESDTsWithRoleShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetESDTsWithRole(address, "role", common.AccountQueryOptions{})
+	response, err := ap.GetESDTsWithRole(context.Background(), address, "role", common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
@@ -361,9 +383,10 @@ func TestAccountProcessor_GetESDTsRolesGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 
-	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsRoles(context.Background(), "address", common.AccountQueryOptions{})
 	require.Equal(t, expectedErr, err)
 	require.Nil(t, result)
 }
@@ -388,9 +411,10 @@ func TestAccountProcessor_GetESDTsRolesApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 
-	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsRoles(context.Background(), "address", common.AccountQueryOptions{})
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "sending request error"))
 	require.Nil(t, result)
@@ -416,9 +440,10 @@ func TestAccountProcessor_GetESDTsRolesShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetESDTsRoles(address, common.AccountQueryOptions{})
+	response, err := ap.GetESDTsRoles(context.Background(), address, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
@@ -443,9 +468,10 @@ func TestAccountProcessor_GetCodeHash(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.HistoricalCoordinatesResolverStub{},
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetCodeHash(address, common.AccountQueryOptions{})
+	response, err := ap.GetCodeHash(context.Background(), address, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "code-hash", response.Data.([]string)[0])
 }
@@ -461,9 +487,10 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{},
 		)
 
-		result, err := ap.IsDataTrieMigrated("address", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "address", common.AccountQueryOptions{})
 		require.Error(t, err)
 		require.Nil(t, result)
 	})
@@ -488,9 +515,10 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{},
 		)
 
-		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
 		require.Error(t, err)
 		require.Nil(t, result)
 	})
@@ -517,9 +545,10 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{},
 		)
 
-		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
 		require.NoError(t, err)
 		require.True(t, result.Data.(bool))
 	})
@@ -563,9 +592,10 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{},
 		)
 
-		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
+		result, err := ap.GetAccounts(context.Background(), []string{"aabb", "bbaa"}, common.AccountQueryOptions{})
 		require.Equal(t, expectedError, err.Error())
 		require.Empty(t, result)
 	})
@@ -608,9 +638,10 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{},
 		)
 
-		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
+		result, err := ap.GetAccounts(context.Background(), []string{"aabb", "bbaa"}, common.AccountQueryOptions{})
 		require.NoError(t, err)
 
 		require.Equal(t, map[string]*data.Account{
@@ -625,3 +656,142 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 		}, result.Accounts)
 	})
 }
+
+func TestAccountProcessor_HistoricalCoordinates(t *testing.T) {
+	t.Parallel()
+
+	timestamp := core.OptionalUint64{Value: 1700000000, HasValue: true}
+	t.Run("should query the resolved block of the address shard", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+					return 1, nil
+				},
+				GetObserversCalled: func(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					assert.Equal(t, uint32(1), shardID)
+					assert.Equal(t, data.AvailabilityAll, dataAvailability)
+					return []*data.NodeData{{Address: "observer", ShardId: shardID}}, nil
+				},
+				CallGetRestEndPointCalled: func(_ string, path string, _ interface{}) (int, error) {
+					assert.Contains(t, path, "blockNonce=37")
+					assert.Contains(t, path, "hintEpoch=3")
+					return 0, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{
+				ResolveCalled: func(shardID uint32, coordinates common.HistoricalCoordinates) (*data.BlockCoordinates, error) {
+					assert.Equal(t, uint32(1), shardID)
+					assert.Equal(t, timestamp, coordinates.Timestamp)
+					return &data.BlockCoordinates{Nonce: 37, Epoch: 3}, nil
+				},
+			},
+		)
+
+		_, err := ap.GetAccount(context.Background(), "aabb", common.AccountQueryOptions{
+			Historical: common.HistoricalCoordinates{Timestamp: timestamp},
+		})
+		require.Nil(t, err)
+	})
+	t.Run("metachain queries should resolve a metachain block", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: shardID}}, nil
+				},
+				CallGetRestEndPointCalled: func(_ string, path string, _ interface{}) (int, error) {
+					assert.Contains(t, path, "blockNonce=42")
+					return 0, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{
+				ResolveCalled: func(shardID uint32, _ common.HistoricalCoordinates) (*data.BlockCoordinates, error) {
+					assert.Equal(t, core.MetachainShardId, shardID)
+					return &data.BlockCoordinates{Nonce: 42}, nil
+				},
+			},
+		)
+
+		_, err := ap.GetESDTsRoles(context.Background(), "aabb", common.AccountQueryOptions{
+			Historical: common.HistoricalCoordinates{Timestamp: timestamp},
+		})
+		require.Nil(t, err)
+	})
+	t.Run("resolve error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					require.Fail(t, "should have not been called")
+					return nil, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{
+				ResolveCalled: func(_ uint32, _ common.HistoricalCoordinates) (*data.BlockCoordinates, error) {
+					return nil, expectedErr
+				},
+			},
+		)
+
+		account, err := ap.GetAccount(context.Background(), "aabb", common.AccountQueryOptions{
+			Historical: common.HistoricalCoordinates{Epoch: core.OptionalUint32{Value: 2, HasValue: true}},
+		})
+		require.Nil(t, account)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("bulk accounts should resolve a block per shard", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addr []byte) (uint32, error) {
+					if hex.EncodeToString(addr) == "aabb" {
+						return 0, nil
+					}
+
+					return 1, nil
+				},
+				GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardID), ShardId: shardID}}, nil
+				},
+				CallPostRestEndPointCalled: func(obsAddr string, path string, addresses interface{}, value interface{}) (int, error) {
+					expectedNonce := "blockNonce=100"
+					if obsAddr == "observer1" {
+						expectedNonce = "blockNonce=101"
+					}
+					assert.Contains(t, path, expectedNonce)
+
+					response := value.(*data.AccountsApiResponse)
+					response.Data.Accounts = make(map[string]*data.Account)
+					for _, address := range addresses.([]string) {
+						response.Data.Accounts[address] = &data.Account{Address: address}
+					}
+					return 0, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.HistoricalCoordinatesResolverStub{
+				ResolveCalled: func(shardID uint32, _ common.HistoricalCoordinates) (*data.BlockCoordinates, error) {
+					return &data.BlockCoordinates{Nonce: 100 + uint64(shardID)}, nil
+				},
+			},
+		)
+
+		result, err := ap.GetAccounts(context.Background(), []string{"aabb", "bbaa", "ccdd"}, common.AccountQueryOptions{
+			Historical: common.HistoricalCoordinates{Timestamp: timestamp},
+		})
+		require.Nil(t, err)
+		require.Len(t, result.Accounts, 3)
+	})
+}
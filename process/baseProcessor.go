@@ -17,12 +17,12 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 )
 
 var log = logger.GetOrCreate("process")
-var mutHttpClient sync.RWMutex
 
 const (
 	nodeSyncedNonceDifferenceThreshold = 10
@@ -43,6 +43,12 @@ type BaseProcessor struct {
 	delayForCheckingNodesSyncState time.Duration
 	cancelFunc                     func()
 	noStatusCheck                  bool
+	requestTimeout                 time.Duration
+	routeTimeouts                  []routeTimeout
+	nodesHealth                    observer.NodesHealthHandler
+	nodesLatency                   observer.NodesLatencyHandler
+	hedgingDelays                  *hedgingDelayTracker
+	maxHedgedRequests              int
 
 	httpClient *http.Client
 }
@@ -55,6 +61,10 @@ func NewBaseProcessor(
 	fullHistoryNodesProvider observer.NodesProviderHandler,
 	pubKeyConverter core.PubkeyConverter,
 	noStatusCheck bool,
+	transportConfig config.ObserverTransportConfig,
+	nodesHealth observer.NodesHealthHandler,
+	nodesLatency observer.NodesLatencyHandler,
+	hedgingConfig config.RequestHedgingConfig,
 ) (*BaseProcessor, error) {
 	if check.IfNil(shardCoord) {
 		return nil, ErrNilShardCoordinator
@@ -71,11 +81,30 @@ func NewBaseProcessor(
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(nodesHealth) {
+		return nil, ErrNilNodesHealthHandler
+	}
+	if check.IfNil(nodesLatency) {
+		return nil, ErrNilNodesLatencyHandler
+	}
+
+	routeTimeouts, err := createRouteTimeouts(transportConfig.RouteTimeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := newObserverHttpClient(transportConfig)
+	if err != nil {
+		return nil, err
+	}
 
-	httpClient := http.DefaultClient
-	mutHttpClient.Lock()
-	httpClient.Timeout = time.Duration(requestTimeoutSec) * time.Second
-	mutHttpClient.Unlock()
+	var hedgingDelays *hedgingDelayTracker
+	if hedgingConfig.Enabled {
+		hedgingDelays, err = newHedgingDelayTracker(hedgingConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	bp := &BaseProcessor{
 		shardCoordinator:               shardCoord,
@@ -87,6 +116,12 @@ func NewBaseProcessor(
 		delayForCheckingNodesSyncState: stepDelayForCheckingNodesSyncState,
 		chanTriggerNodesState:          make(chan struct{}),
 		noStatusCheck:                  noStatusCheck,
+		requestTimeout:                 time.Duration(requestTimeoutSec) * time.Second,
+		routeTimeouts:                  routeTimeouts,
+		nodesHealth:                    nodesHealth,
+		nodesLatency:                   nodesLatency,
+		hedgingDelays:                  hedgingDelays,
+		maxHedgedRequests:              hedgingConfig.MaxHedgedRequests,
 	}
 	bp.nodeStatusFetcher = bp.getNodeStatusResponseFromAPI
 
@@ -191,14 +226,18 @@ func (bp *BaseProcessor) ComputeShardId(addressBuff []byte) (uint32, error) {
 	return bp.shardCoordinator.ComputeId(addressBuff), nil
 }
 
-// CallGetRestEndPoint calls an external end point (sends a request on a node)
+// CallGetRestEndPoint calls an external end point (sends a request on a node). The request is aborted as soon as
+// the provided context is done
 func (bp *BaseProcessor) CallGetRestEndPoint(
+	ctx context.Context,
 	address string,
 	path string,
 	value interface{},
 ) (int, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, bp.getRequestTimeout(path))
+	defer cancel()
 
-	req, err := http.NewRequest("GET", address+path, nil)
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodGet, address+path, nil)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -207,8 +246,13 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", userAgent)
 
-	resp, err := bp.httpClient.Do(req)
+	resp, isProbe, err := bp.doRequest(ctx, address, req)
 	if err != nil {
+		if isContextDone(ctx) {
+			return http.StatusRequestTimeout, ctx.Err()
+		}
+
+		bp.nodesHealth.RecordFailure(address)
 		bp.triggerNodesSyncCheck(address)
 		if isTimeoutError(err) {
 			return http.StatusRequestTimeout, err
@@ -226,8 +270,15 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 
 	responseBodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
+		if isContextDone(ctx) {
+			bp.nodesHealth.RecordCancellation(address, isProbe)
+			return http.StatusRequestTimeout, ctx.Err()
+		}
+
+		bp.nodesHealth.RecordFailure(address)
 		return http.StatusInternalServerError, err
 	}
+	bp.recordResponseOutcome(address, resp.StatusCode, isProbe)
 
 	err = json.Unmarshal(responseBodyBytes, value)
 	if err != nil {
@@ -243,8 +294,10 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	return responseStatusCode, errors.New(string(responseBodyBytes))
 }
 
-// CallPostRestEndPoint calls an external end point (sends a request on a node)
+// CallPostRestEndPoint calls an external end point (sends a request on a node). The request is aborted as soon as
+// the provided context is done
 func (bp *BaseProcessor) CallPostRestEndPoint(
+	ctx context.Context,
 	address string,
 	path string,
 	data interface{},
@@ -256,7 +309,10 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 		return http.StatusInternalServerError, err
 	}
 
-	req, err := http.NewRequest("POST", address+path, bytes.NewReader(buff))
+	requestCtx, cancel := context.WithTimeout(ctx, bp.getRequestTimeout(path))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, address+path, bytes.NewReader(buff))
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -266,8 +322,13 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", userAgent)
 
-	resp, err := bp.httpClient.Do(req)
+	resp, isProbe, err := bp.doRequest(ctx, address, req)
 	if err != nil {
+		if isContextDone(ctx) {
+			return http.StatusRequestTimeout, ctx.Err()
+		}
+
+		bp.nodesHealth.RecordFailure(address)
 		bp.triggerNodesSyncCheck(address)
 		if isTimeoutError(err) {
 			return http.StatusRequestTimeout, err
@@ -285,8 +346,15 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 
 	responseBodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
+		if isContextDone(ctx) {
+			bp.nodesHealth.RecordCancellation(address, isProbe)
+			return http.StatusRequestTimeout, ctx.Err()
+		}
+
+		bp.nodesHealth.RecordFailure(address)
 		return http.StatusInternalServerError, err
 	}
+	bp.recordResponseOutcome(address, resp.StatusCode, isProbe)
 
 	responseStatusCode := resp.StatusCode
 	if responseStatusCode == http.StatusOK { // everything ok, return status ok and the expected response
@@ -303,6 +371,53 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	return responseStatusCode, errors.New(genericApiResponse.Error)
 }
 
+func (bp *BaseProcessor) getRequestTimeout(path string) time.Duration {
+	return getTimeoutForPath(path, bp.routeTimeouts, bp.requestTimeout)
+}
+
+// doRequest sends the request while keeping track of the in-flight requests and of the latency of the node. Sending
+// the request reserves a probe slot if the node's circuit is half-open, released once the outcome is recorded, hence
+// it returns whether the request is a probe. Only the successful responses update the latency: the failed requests are
+// penalized, while the ones cancelled by the caller (e.g. the losers of a hedged request) are not sampled at all
+func (bp *BaseProcessor) doRequest(ctx context.Context, address string, req *http.Request) (*http.Response, bool, error) {
+	isProbe := bp.nodesHealth.RecordRequestSent(address)
+	bp.nodesLatency.RequestStarted(address)
+	startTime := time.Now()
+
+	resp, err := bp.httpClient.Do(req)
+	switch {
+	case err != nil && isContextDone(ctx):
+		bp.nodesHealth.RecordCancellation(address, isProbe)
+		bp.nodesLatency.RequestCancelled(address)
+	case err != nil || isGatewayErrorStatus(resp.StatusCode):
+		bp.nodesLatency.RequestFailed(address)
+	default:
+		bp.nodesLatency.RequestFinished(address, time.Since(startTime))
+	}
+
+	return resp, isProbe, err
+}
+
+// recordResponseOutcome records the outcome of a request that received a response. Only the gateway-like errors are
+// counted as failures, as the other status codes are returned by healthy observers as well (e.g. for invalid requests)
+func (bp *BaseProcessor) recordResponseOutcome(address string, statusCode int, isProbe bool) {
+	if isGatewayErrorStatus(statusCode) {
+		bp.nodesHealth.RecordFailure(address)
+		return
+	}
+
+	bp.nodesHealth.RecordSuccess(address, isProbe)
+}
+
+func isGatewayErrorStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
 func (bp *BaseProcessor) triggerNodesSyncCheck(address string) {
 	log.Info("triggering nodes state checks because of an offline node", "address of offline node", address)
 	select {
@@ -311,6 +426,12 @@ func (bp *BaseProcessor) triggerNodesSyncCheck(address string) {
 	}
 }
 
+// isContextDone returns true if the caller's context was cancelled or its deadline passed, in which case a failed
+// request is not the observer's fault
+func isContextDone(ctx context.Context) bool {
+	return ctx.Err() != nil
+}
+
 func isTimeoutError(err error) bool {
 	if err, ok := err.(net.Error); ok && err.Timeout() {
 		return true
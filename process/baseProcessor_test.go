@@ -2,6 +2,7 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
@@ -54,6 +56,10 @@ func TestNewBaseProcessor_WithInvalidRequestTimeoutShouldErr(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	assert.Nil(t, bp)
@@ -70,6 +76,10 @@ func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	assert.Nil(t, bp)
@@ -86,6 +96,10 @@ func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 		nil,
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	assert.Nil(t, bp)
@@ -102,12 +116,81 @@ func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T)
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	assert.Nil(t, bp)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
 }
 
+func TestNewBaseProcessor_WithInvalidRouteTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transportConfig := config.ObserverTransportConfig{
+		RouteTimeouts: []config.RouteTimeoutConfig{
+			{Path: "/vm-values/query", TimeoutSec: 0},
+		},
+	}
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		false,
+		transportConfig,
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
+	)
+
+	assert.Nil(t, bp)
+	assert.True(t, errors.Is(err, process.ErrInvalidRouteTimeout))
+}
+
+func TestNewBaseProcessor_WithNilNodesHealthHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		false,
+		config.ObserverTransportConfig{},
+		nil,
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilNodesHealthHandler, err)
+}
+
+func TestNewBaseProcessor_WithNilNodesLatencyHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		nil,
+		config.RequestHedgingConfig{},
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilNodesLatencyHandler, err)
+}
+
 func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -118,6 +201,10 @@ func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	assert.NotNil(t, bp)
@@ -141,6 +228,10 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 	observers, err := bp.GetObservers(0, data.AvailabilityAll)
 
@@ -176,6 +267,10 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	//there are 2 shards, compute ID should correctly process
@@ -211,8 +306,12 @@ func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
-	_, err := bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", tsRecovered)
 
 	assert.Nil(t, err)
 	assert.Equal(t, ts, tsRecovered)
@@ -240,13 +339,352 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
-	_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", tsRecovered)
+	_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/some/path", tsRecovered)
 
 	assert.NotEqual(t, ts.Name, tsRecovered.Name)
 	assert.NotNil(t, err)
 }
 
+func TestBaseProcessor_CallGetRestEndPointShouldUseRouteTimeout(t *testing.T) {
+	t.Parallel()
+
+	ts := &testStruct{
+		Nonce: 10000,
+		Name:  "a test struct to be sent and received",
+	}
+	response, _ := json.Marshal(ts)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(1200 * time.Millisecond)
+		_, _ = rw.Write(response)
+	}))
+	defer testServer.Close()
+
+	transportConfig := config.ObserverTransportConfig{
+		RouteTimeouts: []config.RouteTimeoutConfig{
+			{Path: "/vm-values/query", TimeoutSec: 5},
+		},
+	}
+	bp, _ := process.NewBaseProcessor(
+		1,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		false,
+		transportConfig,
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
+	)
+
+	tsRecovered := &testStruct{}
+	_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/vm-values/query", tsRecovered)
+	assert.Nil(t, err)
+	assert.Equal(t, ts, tsRecovered)
+
+	tsRecovered = &testStruct{}
+	_, err = bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/some/path", tsRecovered)
+	assert.NotNil(t, err)
+	assert.NotEqual(t, ts.Name, tsRecovered.Name)
+}
+
+func TestBaseProcessor_CallRestEndPointShouldRecordNodesHealth(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/unavailable":
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		case "/bad-request":
+			rw.WriteHeader(http.StatusBadRequest)
+		}
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer testServer.Close()
+
+	numSuccesses := make(map[string]int)
+	numFailures := make(map[string]int)
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		true,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{
+			RecordSuccessCalled: func(address string, _ bool) {
+				numSuccesses[address]++
+			},
+			RecordFailureCalled: func(address string) {
+				numFailures[address]++
+			},
+		},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
+	)
+
+	response := &testStruct{}
+	_, _ = bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/ok", response)
+	_, _ = bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/bad-request", response)
+	_, _ = bp.CallPostRestEndPoint(context.Background(), testServer.URL, "/unavailable", response, response)
+	_, _ = bp.CallGetRestEndPoint(context.Background(), "http://127.0.0.1:1", "/ok", response)
+
+	assert.Equal(t, map[string]int{testServer.URL: 2}, numSuccesses)
+	assert.Equal(t, map[string]int{testServer.URL: 1, "http://127.0.0.1:1": 1}, numFailures)
+}
+
+func TestBaseProcessor_CallRestEndPointShouldStopOnContextDone(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		select {
+		case <-time.After(2 * time.Second):
+		case <-req.Context().Done():
+		}
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer testServer.Close()
+
+	numFailures := uint32(0)
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		true,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{
+			RecordFailureCalled: func(address string) {
+				atomic.AddUint32(&numFailures, 1)
+			},
+		},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
+	)
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+
+		startTime := time.Now()
+		response := &testStruct{}
+		statusCode, err := bp.CallGetRestEndPoint(ctx, testServer.URL, "/get", response)
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, http.StatusRequestTimeout, statusCode)
+		assert.Less(t, time.Since(startTime), time.Second)
+
+		_, err = bp.CallPostRestEndPoint(ctx, testServer.URL, "/post", response, response)
+		assert.Equal(t, context.Canceled, err)
+	})
+	t.Run("context deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		startTime := time.Now()
+		_, err := bp.CallPostRestEndPoint(ctx, testServer.URL, "/post", &testStruct{}, &testStruct{})
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Less(t, time.Since(startTime), time.Second)
+	})
+
+	assert.Zero(t, atomic.LoadUint32(&numFailures))
+}
+
+func TestBaseProcessor_CallRestEndPointShouldTrackNodesLatency(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer testServer.Close()
+
+	inFlightRequests := 0
+	durations := make([]time.Duration, 0)
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		true,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{
+			RequestStartedCalled: func(address string) {
+				assert.Equal(t, testServer.URL, address)
+				inFlightRequests++
+			},
+			RequestFinishedCalled: func(address string, duration time.Duration) {
+				assert.Equal(t, testServer.URL, address)
+				inFlightRequests--
+				durations = append(durations, duration)
+			},
+		},
+		config.RequestHedgingConfig{},
+	)
+
+	response := &testStruct{}
+	_, _ = bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/get", response)
+	_, _ = bp.CallPostRestEndPoint(context.Background(), testServer.URL, "/post", response, response)
+
+	assert.Zero(t, inFlightRequests)
+	require.Len(t, durations, 2)
+	for _, duration := range durations {
+		assert.GreaterOrEqual(t, duration, 50*time.Millisecond)
+	}
+}
+
+func TestBaseProcessor_CallRestEndPointShouldNotSampleFailedRequests(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(numFailed *uint32, numCancelled *uint32) process.Processor {
+		bp, _ := process.NewBaseProcessor(
+			5,
+			&mock.ShardCoordinatorMock{},
+			&mock.ObserversProviderStub{},
+			&mock.ObserversProviderStub{},
+			&mock.PubKeyConverterMock{},
+			true,
+			config.ObserverTransportConfig{},
+			&mock.NodesHealthHandlerStub{},
+			&mock.NodesLatencyHandlerStub{
+				RequestFinishedCalled: func(_ string, _ time.Duration) {
+					assert.Fail(t, "should have not been called")
+				},
+				RequestFailedCalled: func(_ string) {
+					atomic.AddUint32(numFailed, 1)
+				},
+				RequestCancelledCalled: func(_ string) {
+					atomic.AddUint32(numCancelled, 1)
+				},
+			},
+			config.RequestHedgingConfig{},
+		)
+
+		return bp
+	}
+
+	t.Run("unreachable node should be penalized", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+		testServer.Close()
+
+		numFailed, numCancelled := uint32(0), uint32(0)
+		bp := createProcessor(&numFailed, &numCancelled)
+		_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/get", &testStruct{})
+		require.NotNil(t, err)
+
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numFailed))
+		assert.Zero(t, atomic.LoadUint32(&numCancelled))
+	})
+	t.Run("gateway error should be penalized", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = rw.Write([]byte("{}"))
+		}))
+		defer testServer.Close()
+
+		numFailed, numCancelled := uint32(0), uint32(0)
+		bp := createProcessor(&numFailed, &numCancelled)
+		_, _ = bp.CallPostRestEndPoint(context.Background(), testServer.URL, "/post", &testStruct{}, &testStruct{})
+
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numFailed))
+		assert.Zero(t, atomic.LoadUint32(&numCancelled))
+	})
+	t.Run("request cancelled by the caller should not be sampled", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			select {
+			case <-req.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer testServer.Close()
+
+		numFailed, numCancelled := uint32(0), uint32(0)
+		bp := createProcessor(&numFailed, &numCancelled)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := bp.CallGetRestEndPoint(ctx, testServer.URL, "/get", &testStruct{})
+		require.NotNil(t, err)
+
+		assert.Zero(t, atomic.LoadUint32(&numFailed))
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCancelled))
+	})
+}
+
+func TestBaseProcessor_CallRestEndPointShouldRecordTheSentRequests(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/slow" {
+			select {
+			case <-req.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer testServer.Close()
+
+	numSent, numCancelled, numSuccesses := uint32(0), uint32(0), uint32(0)
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		true,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{
+			RecordRequestSentCalled: func(address string) bool {
+				assert.Equal(t, testServer.URL, address)
+				atomic.AddUint32(&numSent, 1)
+				return true
+			},
+			RecordCancellationCalled: func(_ string, isProbe bool) {
+				assert.True(t, isProbe)
+				atomic.AddUint32(&numCancelled, 1)
+			},
+			RecordSuccessCalled: func(_ string, isProbe bool) {
+				assert.True(t, isProbe)
+				atomic.AddUint32(&numSuccesses, 1)
+			},
+		},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
+	)
+
+	_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/get", &testStruct{})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = bp.CallGetRestEndPoint(ctx, testServer.URL, "/slow", &testStruct{})
+	require.NotNil(t, err)
+
+	// each sent request has its outcome recorded along with whether it is a probe, so that the probe slots of the
+	// half-open nodes get released
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numSent))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numSuccesses))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCancelled))
+}
+
 func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
@@ -265,8 +703,12 @@ func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
-	rc, err := bp.CallPostRestEndPoint(server.URL, "/some/path", ts, tsRecv)
+	rc, err := bp.CallPostRestEndPoint(context.Background(), server.URL, "/some/path", ts, tsRecv)
 
 	assert.Nil(t, err)
 	assert.Equal(t, ts, tsRecv)
@@ -296,8 +738,12 @@ func TestBaseProcessor_CallPostRestEndPointShouldTimeout(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
-	rc, err := bp.CallPostRestEndPoint(testServer.URL, "/some/path", ts, tsRecv)
+	rc, err := bp.CallPostRestEndPoint(context.Background(), testServer.URL, "/some/path", ts, tsRecv)
 
 	assert.NotEqual(t, tsRecv.Name, ts.Name)
 	assert.NotNil(t, err)
@@ -337,6 +783,10 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	assert.Nil(t, err)
@@ -388,6 +838,10 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
@@ -437,6 +891,10 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
@@ -486,6 +944,10 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
@@ -539,6 +1001,10 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	observers, err := bp.GetFullHistoryNodesOnePerShard(data.AvailabilityAll)
@@ -560,6 +1026,10 @@ func TestBaseProcessor_GetShardIDs(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	expected := []uint32{0, 1, 2, core.MetachainShardId}
@@ -588,6 +1058,10 @@ func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotR
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -649,6 +1123,10 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -711,6 +1189,10 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -727,7 +1209,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 	go func() {
 		// trigger a HTTP error that will trigger the nodes sync state checks
 		time.Sleep(90 * time.Millisecond)
-		_, _ = bp.CallGetRestEndPoint("address1", "/node/status", nil)
+		_, _ = bp.CallGetRestEndPoint(context.Background(), "address1", "/node/status", nil)
 	}()
 
 	time.Sleep(300 * time.Millisecond)
@@ -760,6 +1242,10 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -820,6 +1306,10 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		false,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -870,6 +1360,10 @@ func TestBaseProcessor_NoStatusCheck(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
 		true,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		config.RequestHedgingConfig{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -1,7 +1,9 @@
 package process
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -37,22 +39,79 @@ const (
 
 // BlockProcessor handles blocks retrieving
 type BlockProcessor struct {
-	proc Processor
+	proc          Processor
+	responseCache ResponseCacheHandler
+	responseTTL   ResponseTTLHandler
 }
 
 // NewBlockProcessor will create a new block processor
-func NewBlockProcessor(proc Processor) (*BlockProcessor, error) {
+func NewBlockProcessor(proc Processor, responseCache ResponseCacheHandler, responseTTL ResponseTTLHandler) (*BlockProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
+	if check.IfNil(responseCache) {
+		return nil, ErrNilResponseCache
+	}
+	if check.IfNil(responseTTL) {
+		return nil, ErrNilResponseTTLHandler
+	}
 
 	return &BlockProcessor{
-		proc: proc,
+		proc:          proc,
+		responseCache: responseCache,
+		responseTTL:   responseTTL,
 	}, nil
 }
 
 // GetBlockByHash will return the block based on its hash
-func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bp *BlockProcessor) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	cacheKey := fmt.Sprintf("block-by-hash:%d:%s:%+v", shardID, hash, options)
+
+	return bp.getBlockWithCache(ctx, cacheKey, func() (*data.BlockApiResponse, error) {
+		return bp.getBlockByHash(ctx, shardID, hash, options)
+	})
+}
+
+// GetBlockByNonce will return the block based on the nonce
+func (bp *BlockProcessor) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	cacheKey := fmt.Sprintf("block-by-nonce:%d:%d:%+v", shardID, nonce, options)
+
+	return bp.getBlockWithCache(ctx, cacheKey, func() (*data.BlockApiResponse, error) {
+		return bp.getBlockByNonce(ctx, shardID, nonce, options)
+	})
+}
+
+func (bp *BlockProcessor) getBlockWithCache(
+	ctx context.Context,
+	cacheKey string,
+	getBlockHandler func() (*data.BlockApiResponse, error),
+) (*data.BlockApiResponse, error) {
+	cachedResponse, found := bp.responseCache.Get(cacheKey)
+	if found {
+		return cachedResponse.(*data.BlockApiResponse), nil
+	}
+
+	response, err := getBlockHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	bp.responseCache.Put(cacheKey, response, bp.computeBlockTTL(ctx, &response.Data.Block))
+
+	return response, nil
+}
+
+// computeBlockTTL returns the ttl of a block response. The metachain blocks are checked against the latest final
+// hyperblock nonce, while the shard blocks are checked against the highest final nonce of their shard
+func (bp *BlockProcessor) computeBlockTTL(ctx context.Context, block *api.Block) time.Duration {
+	if block.Shard != core.MetachainShardId {
+		return bp.responseTTL.TTLForBlockNonce(ctx, block.Shard, block.Nonce)
+	}
+
+	return bp.responseTTL.TTLForHyperblockNonce(ctx, block.Nonce)
+}
+
+func (bp *BlockProcessor) getBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -63,7 +122,7 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 	response := data.BlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -77,8 +136,7 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 	return nil, WrapObserversError(response.Error)
 }
 
-// GetBlockByNonce will return the block based on the nonce
-func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bp *BlockProcessor) getBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -89,7 +147,7 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	response := data.BlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -113,7 +171,45 @@ func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*dat
 }
 
 // GetHyperBlockByHash returns the hyperblock by hash
-func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	cacheKey := fmt.Sprintf("hyperblock-by-hash:%s:%+v", hash, options)
+
+	return bp.getHyperBlockWithCache(ctx, cacheKey, func() (*data.HyperblockApiResponse, error) {
+		return bp.getHyperBlockByHash(ctx, hash, options)
+	})
+}
+
+// GetHyperBlockByNonce returns the hyperblock by nonce
+func (bp *BlockProcessor) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	cacheKey := fmt.Sprintf("hyperblock-by-nonce:%d:%+v", nonce, options)
+
+	return bp.getHyperBlockWithCache(ctx, cacheKey, func() (*data.HyperblockApiResponse, error) {
+		return bp.getHyperBlockByNonce(ctx, nonce, options)
+	})
+}
+
+func (bp *BlockProcessor) getHyperBlockWithCache(
+	ctx context.Context,
+	cacheKey string,
+	getHyperBlockHandler func() (*data.HyperblockApiResponse, error),
+) (*data.HyperblockApiResponse, error) {
+	cachedResponse, found := bp.responseCache.Get(cacheKey)
+	if found {
+		return cachedResponse.(*data.HyperblockApiResponse), nil
+	}
+
+	response, err := getHyperBlockHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := bp.responseTTL.TTLForHyperblockNonce(ctx, response.Data.Hyperblock.Nonce)
+	bp.responseCache.Put(cacheKey, response, ttl)
+
+	return response, nil
+}
+
+func (bp *BlockProcessor) getHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -122,7 +218,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 		ForHyperblock:    true,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByHash(core.MetachainShardId, hash, blockQueryOptions)
+	metaBlockResponse, err := bp.getBlockByHash(ctx, core.MetachainShardId, hash, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +226,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	err = bp.addShardBlocks(ctx, metaBlock, builder, options, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -140,18 +236,19 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 }
 
 func (bp *BlockProcessor) addShardBlocks(
+	ctx context.Context,
 	metaBlock api.Block,
 	builder *hyperblockBuilder,
 	options common.HyperblockQueryOptions,
 	blockQueryOptions common.BlockQueryOptions,
 ) error {
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+		shardBlockResponse, err := bp.getBlockByHash(ctx, notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
 		if err != nil {
 			return err
 		}
 
-		alteredAccounts, err := bp.getAlteredAccountsIfNeeded(options, notarizedBlock)
+		alteredAccounts, err := bp.getAlteredAccountsIfNeeded(ctx, options, notarizedBlock)
 		if err != nil {
 			return err
 		}
@@ -165,13 +262,13 @@ func (bp *BlockProcessor) addShardBlocks(
 	return nil
 }
 
-func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQueryOptions, notarizedBlock *api.NotarizedBlock) ([]*alteredAccount.AlteredAccount, error) {
+func (bp *BlockProcessor) getAlteredAccountsIfNeeded(ctx context.Context, options common.HyperblockQueryOptions, notarizedBlock *api.NotarizedBlock) ([]*alteredAccount.AlteredAccount, error) {
 	ret := make([]*alteredAccount.AlteredAccount, 0)
 	if !options.WithAlteredAccounts {
 		return ret, nil
 	}
 
-	alteredAccountsApiResponse, err := bp.GetAlteredAccountsByHash(notarizedBlock.Shard, notarizedBlock.Hash, options.AlteredAccountsOptions)
+	alteredAccountsApiResponse, err := bp.GetAlteredAccountsByHash(ctx, notarizedBlock.Shard, notarizedBlock.Hash, options.AlteredAccountsOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -179,8 +276,7 @@ func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQu
 	return alteredAccountsApiResponse.Data.Accounts, nil
 }
 
-// GetHyperBlockByNonce returns the hyperblock by nonce
-func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) getHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -189,7 +285,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 		ForHyperblock:    true,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByNonce(core.MetachainShardId, nonce, blockQueryOptions)
+	metaBlockResponse, err := bp.getBlockByNonce(ctx, core.MetachainShardId, nonce, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +293,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	err = bp.addShardBlocks(ctx, metaBlock, builder, options, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +303,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 }
 
 // GetInternalBlockByHash will return the internal block based on its hash
-func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -221,7 +317,7 @@ func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, fo
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -253,7 +349,7 @@ func getInternalBlockByHashPath(shardID uint32, format common.OutputFormat, hash
 }
 
 // GetInternalBlockByNonce will return the internal block based on its nonce
-func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -267,7 +363,7 @@ func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64,
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -299,7 +395,7 @@ func getInternalBlockByNoncePath(shardID uint32, format common.OutputFormat, non
 }
 
 // GetInternalMiniBlockByHash will return the miniblock based on its hash
-func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -314,7 +410,7 @@ func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string
 	response := data.InternalMiniBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("miniblock request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -344,7 +440,7 @@ func getOutputFormat(format common.OutputFormat) (string, error) {
 }
 
 // GetInternalStartOfEpochMetaBlock will return the internal start of epoch meta block based on epoch
-func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -360,7 +456,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -375,7 +471,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 }
 
 // GetInternalStartOfEpochValidatorsInfo will return the internal start of epoch validators info based on epoch
-func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -386,7 +482,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*
 	response := data.ValidatorsInfoApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("internal validators info request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -401,7 +497,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*
 }
 
 // GetAlteredAccountsByNonce will return altered accounts by block nonce
-func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (bp *BlockProcessor) GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	observers, err := bp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -411,7 +507,7 @@ func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64
 	response := data.AlteredAccountsApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("altered accounts request by nonce", "observer", observer.Address, "error", err.Error())
 			continue
@@ -426,7 +522,7 @@ func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64
 }
 
 // GetAlteredAccountsByHash will return altered accounts by block hash
-func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (bp *BlockProcessor) GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	observers, err := bp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -436,7 +532,7 @@ func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string,
 	response := data.AlteredAccountsApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("altered accounts request by hash", "observer", observer.Address, "hash", hash, "error", err.Error())
 			continue
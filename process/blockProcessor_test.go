@@ -1,10 +1,12 @@
 package process_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
@@ -13,6 +15,8 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,15 +25,31 @@ import (
 func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(nil)
+	bp, err := process.NewBlockProcessor(nil, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
 }
 
+func TestNewBlockProcessor_NilResponseCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, nil, &disabled.ResponseTTLProvider{})
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilResponseCache, err)
+}
+
+func TestNewBlockProcessor_NilResponseTTLHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, &disabled.ResponseCache{}, nil)
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilResponseTTLHandler, err)
+}
+
 func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 	require.NoError(t, err)
 }
@@ -51,10 +71,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -77,10 +97,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -99,10 +119,10 @@ func TestBlockProcessor_GetBlockByHashNoFullNodesOrObserversShouldErr(t *testing
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -120,10 +140,10 @@ func TestBlockProcessor_GetBlockByHashCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -143,10 +163,10 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -171,10 +191,10 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -200,10 +220,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -226,10 +246,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -248,10 +268,10 @@ func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testin
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -269,10 +289,10 @@ func TestBlockProcessor_GetBlockByNonceCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -292,10 +312,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, nonce, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, nonce, common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -320,10 +340,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 3, common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 3, common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -359,12 +379,12 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 		},
 	}
 
-	processor, err := process.NewBlockProcessor(proc)
+	processor, err := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.Nil(t, err)
 	require.NotNil(t, processor)
 
 	numGetBlockCalled = 0
-	response, err := processor.GetHyperBlockByHash("abcd", common.HyperblockQueryOptions{})
+	response, err := processor.GetHyperBlockByHash(context.Background(), "abcd", common.HyperblockQueryOptions{})
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -372,7 +392,7 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 	require.Equal(t, "abcd", response.Data.Hyperblock.Hash)
 
 	numGetBlockCalled = 0
-	response, err = processor.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{})
+	response, err = processor.GetHyperBlockByNonce(context.Background(), 42, common.HyperblockQueryOptions{})
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -391,10 +411,10 @@ func TestBlockProcessor_GetInternalBlockByNonceInvalidOutputFormat_ShouldFail(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalBlockByNonce(0, 0, 2)
+	blk, err := bp.GetInternalBlockByNonce(context.Background(), 0, 0, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -416,10 +436,10 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetFullHistoryNodes(t *test
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByNonce(0, 0, common.Internal)
+	_, _ = bp.GetInternalBlockByNonce(context.Background(), 0, 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -442,10 +462,10 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetObservers(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByNonce(0, 1, common.Internal)
+	_, _ = bp.GetInternalBlockByNonce(context.Background(), 0, 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -464,10 +484,10 @@ func TestBlockProcessor_GetInternalBlockByNonceNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, 1, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, 1, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -485,10 +505,10 @@ func TestBlockProcessor_GetInternalBlockByNonceCallGetFailsShouldErr(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, 0, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, 0, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -514,15 +534,15 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, nonce, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, nonce, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalBlockByNonce(core.MetachainShardId, nonce, common.Proto)
+	res, err = bp.GetInternalBlockByNonce(context.Background(), core.MetachainShardId, nonce, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -539,10 +559,10 @@ func TestBlockProcessor_GetInternalBlockByHashInvalidOutputFormat_ShouldFail(t *
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalBlockByHash(0, "aaaa", 2)
+	blk, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -564,10 +584,10 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetFullHistoryNodes(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	_, _ = bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -590,10 +610,10 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	_, _ = bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -612,10 +632,10 @@ func TestBlockProcessor_GetInternalBlockByHashNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -633,10 +653,10 @@ func TestBlockProcessor_GetInternalBlockByHashCallGetFailsShouldErr(t *testing.T
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -661,15 +681,15 @@ func TestBlockProcessor_GetInternalBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalBlockByHash(core.MetachainShardId, "aaaa", common.Proto)
+	res, err = bp.GetInternalBlockByHash(context.Background(), core.MetachainShardId, "aaaa", common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -686,10 +706,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashInvalidOutputFormat_ShouldFail
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, 2)
+	blk, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -711,10 +731,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetFullHistoryNodes(t *t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	_, _ = bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -737,10 +757,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetObservers(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	_, _ = bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -759,10 +779,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashNoFullNodesOrObserversShouldEr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -780,10 +800,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashCallGetFailsShouldErr(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -808,15 +828,15 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalMiniBlockByHash(core.MetachainShardId, "aaaa", 1, common.Proto)
+	res, err = bp.GetInternalMiniBlockByHash(context.Background(), core.MetachainShardId, "aaaa", 1, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -833,10 +853,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockInvalidOutputFormat_Shou
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalStartOfEpochMetaBlock(0, 2)
+	blk, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -858,10 +878,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetFullHistoryNode
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	_, _ = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -884,10 +904,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetObservers(t *te
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	_, _ = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -906,10 +926,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockNoFullNodesOrObserversSh
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -928,10 +948,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockCallGetFailsShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -956,15 +976,15 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldWork(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(1, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 1, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalStartOfEpochMetaBlock(1, common.Proto)
+	res, err = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 1, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -986,8 +1006,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
 	})
@@ -1015,8 +1035,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 		require.Nil(t, res)
@@ -1044,8 +1064,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
 			Data: data.AlteredAccountsPayload{
@@ -1073,8 +1093,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
 	})
@@ -1102,8 +1122,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 		require.Nil(t, res)
@@ -1131,8 +1151,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
 			Data: data.AlteredAccountsPayload{
@@ -1226,9 +1246,9 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 
-	res, err := bp.GetHyperBlockByNonce(4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
+	res, err := bp.GetHyperBlockByNonce(context.Background(), 4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
 
 	expectedHyperBlock := api.Hyperblock{
@@ -1343,9 +1363,9 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 
-	res, err := bp.GetHyperBlockByHash("abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
+	res, err := bp.GetHyperBlockByHash(context.Background(), "abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
 
 	expectedHyperBlock := api.Hyperblock{
@@ -1400,11 +1420,174 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochValidatorsInfo(1)
+	res, err := bp.GetInternalStartOfEpochValidatorsInfo(context.Background(), 1)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 }
+
+func TestBlockProcessor_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	createProcessorStub := func(numCalls *int) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, errors.New("no full history nodes")
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				*numCalls++
+				response := value.(*data.BlockApiResponse)
+				response.Data.Block = api.Block{
+					Nonce: 7,
+					Shard: core.MetachainShardId,
+				}
+				if strings.Contains(path, "shard") {
+					response.Data.Block.Shard = 0
+				}
+
+				return 0, nil
+			},
+		}
+	}
+
+	t.Run("final metachain block should be cached without expiration", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		var providedNonce uint64
+		var providedTTL time.Duration
+		responseCache := &mock.ResponseCacheStub{
+			PutCalled: func(key string, value interface{}, ttl time.Duration) {
+				providedTTL = ttl
+			},
+		}
+		responseTTL := &mock.ResponseTTLHandlerStub{
+			TTLForHyperblockNonceCalled: func(nonce uint64) time.Duration {
+				providedNonce = nonce
+				return cache.NoExpiration
+			},
+		}
+		bp, _ := process.NewBlockProcessor(createProcessorStub(&numCalls), responseCache, responseTTL)
+
+		_, err := bp.GetBlockByNonce(context.Background(), core.MetachainShardId, 7, common.BlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, uint64(7), providedNonce)
+		require.Equal(t, cache.NoExpiration, providedTTL)
+	})
+	t.Run("final shard block should be cached without expiration", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		providedShardID := core.MetachainShardId
+		var providedNonce uint64
+		var providedTTL time.Duration
+		responseCache := &mock.ResponseCacheStub{
+			PutCalled: func(key string, value interface{}, ttl time.Duration) {
+				providedTTL = ttl
+			},
+		}
+		responseTTL := &mock.ResponseTTLHandlerStub{
+			TTLForHyperblockNonceCalled: func(nonce uint64) time.Duration {
+				require.Fail(t, "should have not been called")
+				return 0
+			},
+			TTLForBlockNonceCalled: func(shardID uint32, nonce uint64) time.Duration {
+				providedShardID = shardID
+				providedNonce = nonce
+				return cache.NoExpiration
+			},
+		}
+		bp, _ := process.NewBlockProcessor(createProcessorStub(&numCalls), responseCache, responseTTL)
+
+		_, err := bp.GetBlockByHash(context.Background(), 0, "shard", common.BlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, uint32(0), providedShardID)
+		require.Equal(t, uint64(7), providedNonce)
+		require.Equal(t, cache.NoExpiration, providedTTL)
+	})
+	t.Run("non-final shard block should be cached with the non-final ttl", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		var providedTTL time.Duration
+		responseCache := &mock.ResponseCacheStub{
+			PutCalled: func(key string, value interface{}, ttl time.Duration) {
+				providedTTL = ttl
+			},
+		}
+		responseTTL := &mock.ResponseTTLHandlerStub{
+			TTLForHyperblockNonceCalled: func(nonce uint64) time.Duration {
+				require.Fail(t, "should have not been called")
+				return 0
+			},
+			TTLForBlockNonceCalled: func(shardID uint32, nonce uint64) time.Duration {
+				return time.Second
+			},
+		}
+		bp, _ := process.NewBlockProcessor(createProcessorStub(&numCalls), responseCache, responseTTL)
+
+		_, err := bp.GetBlockByHash(context.Background(), 0, "shard", common.BlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, time.Second, providedTTL)
+	})
+	t.Run("second call should be served from cache", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		responseCache, _ := cache.NewResponseCache(10)
+		responseTTL := &mock.ResponseTTLHandlerStub{
+			TTLForHyperblockNonceCalled: func(nonce uint64) time.Duration {
+				return cache.NoExpiration
+			},
+		}
+		bp, _ := process.NewBlockProcessor(createProcessorStub(&numCalls), responseCache, responseTTL)
+
+		firstResponse, err := bp.GetBlockByNonce(context.Background(), core.MetachainShardId, 7, common.BlockQueryOptions{})
+		require.Nil(t, err)
+		secondResponse, err := bp.GetBlockByNonce(context.Background(), core.MetachainShardId, 7, common.BlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, 1, numCalls)
+		require.Equal(t, firstResponse, secondResponse)
+
+		// different options should not hit the cached entry
+		_, err = bp.GetBlockByNonce(context.Background(), core.MetachainShardId, 7, common.BlockQueryOptions{WithTransactions: true})
+		require.Nil(t, err)
+		require.Equal(t, 2, numCalls)
+
+		_, err = bp.GetHyperBlockByNonce(context.Background(), 7, common.HyperblockQueryOptions{})
+		require.Nil(t, err)
+		numCallsAfterHyperblock := numCalls
+		_, err = bp.GetHyperBlockByNonce(context.Background(), 7, common.HyperblockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, numCallsAfterHyperblock, numCalls)
+	})
+	t.Run("errors should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		putCalled := false
+		responseCache := &mock.ResponseCacheStub{
+			PutCalled: func(key string, value interface{}, ttl time.Duration) {
+				putCalled = true
+			},
+		}
+		proc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, errors.New("no observers")
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, errors.New("no full history nodes")
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, responseCache, &mock.ResponseTTLHandlerStub{})
+
+		_, err := bp.GetHyperBlockByHash(context.Background(), "hash", common.HyperblockQueryOptions{})
+		require.NotNil(t, err)
+		require.False(t, putCalled)
+	})
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -33,7 +34,7 @@ func NewBlocksProcessor(proc Processor) (*BlocksProcessor, error) {
 // (from only one observer) and added in a slice of blocks => should have max blocks = no of shards.
 // If there are more observers in a shard which can be queried for a block by round, we get the block from
 // the first one which responds (no sanity checks are performed)
-func (bp *BlocksProcessor) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (bp *BlocksProcessor) GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
 	shardIDs := bp.proc.GetShardIDs()
 	ret := &data.BlocksApiResponse{
 		Data: data.BlocksApiResponsePayload{
@@ -50,7 +51,7 @@ func (bp *BlocksProcessor) GetBlocksByRound(round uint64, options common.BlockQu
 		}
 
 		for _, observer := range observers {
-			block, err := bp.getBlockFromObserver(observer, path)
+			block, err := bp.getBlockFromObserver(ctx, observer, path)
 			if err != nil {
 				log.Error("block request failed", "shard id", observer.ShardId, "observer", observer.Address, "error", err.Error())
 				continue
@@ -65,10 +66,10 @@ func (bp *BlocksProcessor) GetBlocksByRound(round uint64, options common.BlockQu
 	return ret, nil
 }
 
-func (bp *BlocksProcessor) getBlockFromObserver(observer *data.NodeData, path string) (*api.Block, error) {
+func (bp *BlocksProcessor) getBlockFromObserver(ctx context.Context, observer *data.NodeData, path string) (*api.Block, error) {
 	var response data.BlockApiResponse
 
-	_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+	_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -19,3 +19,30 @@ var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in ca
 
 // ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
 var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+
+// ErrInvalidResponseCacheCapacity signals that an invalid response cache capacity has been provided
+var ErrInvalidResponseCacheCapacity = errors.New("invalid response cache capacity")
+
+// ErrEmptyRespAddress signals that an empty RESP store address has been provided
+var ErrEmptyRespAddress = errors.New("empty RESP store address")
+
+// ErrInvalidRespDB signals that an invalid RESP store database index has been provided
+var ErrInvalidRespDB = errors.New("invalid RESP store database index")
+
+// ErrUnexpectedRespReply signals that the RESP store sent an unexpected reply
+var ErrUnexpectedRespReply = errors.New("unexpected RESP reply")
+
+// ErrRespClientClosed signals that the RESP client was closed
+var ErrRespClientClosed = errors.New("RESP client is closed")
+
+// ErrNilSharedStore signals that a nil shared store has been provided
+var ErrNilSharedStore = errors.New("nil shared store")
+
+// ErrEmptySharedStoreKey signals that an empty shared store key has been provided
+var ErrEmptySharedStoreKey = errors.New("empty shared store key")
+
+// ErrEmptyLeaseOwner signals that an empty lease owner has been provided
+var ErrEmptyLeaseOwner = errors.New("empty lease owner")
+
+// ErrInvalidLeaseDuration signals that an invalid lease duration has been provided
+var ErrInvalidLeaseDuration = errors.New("invalid lease duration")
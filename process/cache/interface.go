@@ -0,0 +1,13 @@
+package cache
+
+import "time"
+
+// SharedStoreHandler defines the operations needed from a key-value store shared between several proxy instances
+type SharedStoreHandler interface {
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte, ttl time.Duration) error
+	SetIfNotExists(key string, value []byte, ttl time.Duration) (bool, error)
+	Expire(key string, ttl time.Duration) (bool, error)
+	Delete(key string) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,382 @@
+package cache
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRespDialTimeout      = time.Second
+	defaultRespOperationTimeout = 500 * time.Millisecond
+	defaultRespMaxIdleConns     = 10
+)
+
+// ArgsRespClient holds the arguments needed to create a new RESP client
+type ArgsRespClient struct {
+	Address          string
+	Password         string
+	DB               int
+	DialTimeout      time.Duration
+	OperationTimeout time.Duration
+	MaxIdleConns     int
+}
+
+type respConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// respClient is a minimal client for stores speaking the Redis serialization protocol (RESP). It only implements the
+// commands needed by the shared caches and keeps a small pool of idle connections
+type respClient struct {
+	address          string
+	password         string
+	db               int
+	dialTimeout      time.Duration
+	operationTimeout time.Duration
+
+	idleConns chan *respConn
+	mutClosed sync.RWMutex
+	closed    bool
+}
+
+// NewRespClient returns a new instance of respClient
+func NewRespClient(args ArgsRespClient) (*respClient, error) {
+	if len(args.Address) == 0 {
+		return nil, ErrEmptyRespAddress
+	}
+	if args.DB < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidRespDB, args.DB)
+	}
+
+	dialTimeout := args.DialTimeout
+	if dialTimeout <= 0 {
+		dialTimeout = defaultRespDialTimeout
+	}
+	operationTimeout := args.OperationTimeout
+	if operationTimeout <= 0 {
+		operationTimeout = defaultRespOperationTimeout
+	}
+	maxIdleConns := args.MaxIdleConns
+	if maxIdleConns <= 0 {
+		maxIdleConns = defaultRespMaxIdleConns
+	}
+
+	return &respClient{
+		address:          args.Address,
+		password:         args.Password,
+		db:               args.DB,
+		dialTimeout:      dialTimeout,
+		operationTimeout: operationTimeout,
+		idleConns:        make(chan *respConn, maxIdleConns),
+	}, nil
+}
+
+// Get returns the value stored under the provided key. The returned flag is false if the key does not exist
+func (rc *respClient) Get(key string) ([]byte, bool, error) {
+	reply, err := rc.do("GET", key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("%w for GET: %T", ErrUnexpectedRespReply, reply)
+	}
+
+	return value, true, nil
+}
+
+// Set stores the value under the provided key. A ttl of NoExpiration keeps the value until it is overwritten
+func (rc *respClient) Set(key string, value []byte, ttl time.Duration) error {
+	args := []string{"SET", key, string(value)}
+	if ttl > 0 {
+		args = append(args, "PX", formatMilliseconds(ttl))
+	}
+
+	_, err := rc.do(args...)
+
+	return err
+}
+
+// SetIfNotExists stores the value under the provided key only if the key does not exist. Returns true if the value
+// was stored
+func (rc *respClient) SetIfNotExists(key string, value []byte, ttl time.Duration) (bool, error) {
+	args := []string{"SET", key, string(value), "NX"}
+	if ttl > 0 {
+		args = append(args, "PX", formatMilliseconds(ttl))
+	}
+
+	reply, err := rc.do(args...)
+	if err != nil {
+		return false, err
+	}
+
+	return reply != nil, nil
+}
+
+// Expire sets the ttl of an existing key. Returns false if the key does not exist
+func (rc *respClient) Expire(key string, ttl time.Duration) (bool, error) {
+	reply, err := rc.do("PEXPIRE", key, formatMilliseconds(ttl))
+	if err != nil {
+		return false, err
+	}
+
+	return reply == int64(1), nil
+}
+
+// Delete removes the provided key
+func (rc *respClient) Delete(key string) error {
+	_, err := rc.do("DEL", key)
+
+	return err
+}
+
+func (rc *respClient) do(args ...string) (interface{}, error) {
+	conn, err := rc.getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := rc.doOnConn(conn, args...)
+	if err != nil {
+		var replyErr respReplyError
+		if !errors.As(err, &replyErr) {
+			// the connection state is unknown after a network or protocol error
+			_ = conn.conn.Close()
+			return nil, err
+		}
+	}
+
+	rc.putConn(conn)
+
+	return reply, err
+}
+
+func (rc *respClient) doOnConn(conn *respConn, args ...string) (interface{}, error) {
+	err := conn.conn.SetDeadline(time.Now().Add(rc.operationTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = conn.conn.Write(encodeRespCommand(args))
+	if err != nil {
+		return nil, err
+	}
+
+	return readRespReply(conn.reader)
+}
+
+func (rc *respClient) getConn() (*respConn, error) {
+	rc.mutClosed.RLock()
+	defer rc.mutClosed.RUnlock()
+
+	if rc.closed {
+		return nil, ErrRespClientClosed
+	}
+
+	select {
+	case conn := <-rc.idleConns:
+		return conn, nil
+	default:
+		return rc.dial()
+	}
+}
+
+func (rc *respClient) putConn(conn *respConn) {
+	rc.mutClosed.RLock()
+	defer rc.mutClosed.RUnlock()
+
+	if rc.closed {
+		_ = conn.conn.Close()
+		return
+	}
+
+	select {
+	case rc.idleConns <- conn:
+	default:
+		_ = conn.conn.Close()
+	}
+}
+
+func (rc *respClient) dial() (*respConn, error) {
+	netConn, err := net.DialTimeout("tcp", rc.address, rc.dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &respConn{
+		conn:   netConn,
+		reader: bufio.NewReader(netConn),
+	}
+
+	err = rc.initConn(conn)
+	if err != nil {
+		_ = netConn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func (rc *respClient) initConn(conn *respConn) error {
+	if len(rc.password) > 0 {
+		_, err := rc.doOnConn(conn, "AUTH", rc.password)
+		if err != nil {
+			return err
+		}
+	}
+	if rc.db > 0 {
+		_, err := rc.doOnConn(conn, "SELECT", strconv.Itoa(rc.db))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes all the idle connections. The client can not be used afterwards
+func (rc *respClient) Close() error {
+	rc.mutClosed.Lock()
+	defer rc.mutClosed.Unlock()
+
+	if rc.closed {
+		return nil
+	}
+	rc.closed = true
+
+	for {
+		select {
+		case conn := <-rc.idleConns:
+			_ = conn.conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *respClient) IsInterfaceNil() bool {
+	return rc == nil
+}
+
+type respReplyError string
+
+// Error returns the error message sent by the store
+func (err respReplyError) Error() string {
+	return string(err)
+}
+
+func formatMilliseconds(duration time.Duration) string {
+	return strconv.FormatInt(duration.Milliseconds(), 10)
+}
+
+func encodeRespCommand(args []string) []byte {
+	buff := make([]byte, 0, 64)
+	buff = append(buff, '*')
+	buff = strconv.AppendInt(buff, int64(len(args)), 10)
+	buff = append(buff, '\r', '\n')
+	for _, arg := range args {
+		buff = append(buff, '$')
+		buff = strconv.AppendInt(buff, int64(len(arg)), 10)
+		buff = append(buff, '\r', '\n')
+		buff = append(buff, arg...)
+		buff = append(buff, '\r', '\n')
+	}
+
+	return buff
+}
+
+// readRespReply reads a single reply. Simple strings are returned as string, integers as int64, bulk strings as
+// []byte, arrays as []interface{} and the nil bulk strings or arrays as nil. The errors sent by the store are returned
+// as respReplyError
+func readRespReply(reader *bufio.Reader) (interface{}, error) {
+	line, err := readRespLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, fmt.Errorf("%w: empty line", ErrUnexpectedRespReply)
+	}
+
+	payload := string(line[1:])
+	switch line[0] {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, respReplyError(payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		return readRespBulkString(reader, payload)
+	case '*':
+		return readRespArray(reader, payload)
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q", ErrUnexpectedRespReply, line[0])
+	}
+}
+
+func readRespLine(reader *bufio.Reader) ([]byte, error) {
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("%w: malformed line", ErrUnexpectedRespReply)
+	}
+
+	return line[:len(line)-2], nil
+}
+
+func readRespBulkString(reader *bufio.Reader, lengthStr string) (interface{}, error) {
+	length, err := strconv.Atoi(lengthStr)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return nil, nil
+	}
+
+	buff := make([]byte, length+2)
+	_, err = io.ReadFull(reader, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff[:length], nil
+}
+
+func readRespArray(reader *bufio.Reader, lengthStr string) (interface{}, error) {
+	length, err := strconv.Atoi(lengthStr)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return nil, nil
+	}
+
+	elements := make([]interface{}, 0, length)
+	for i := 0; i < length; i++ {
+		element, errRead := readRespReply(reader)
+		if errRead != nil {
+			var replyErr respReplyError
+			if !errors.As(errRead, &replyErr) {
+				return nil, errRead
+			}
+			element = errRead
+		}
+		elements = append(elements, element)
+	}
+
+	return elements, nil
+}
@@ -0,0 +1,212 @@
+package cache_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createRespServerAndClient(t *testing.T, password string) (*mock.RespServerMock, cache.SharedStoreHandler) {
+	server, err := mock.NewRespServerMock(password)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+
+	client, err := cache.NewRespClient(cache.ArgsRespClient{
+		Address:  server.Address(),
+		Password: password,
+		DB:       1,
+	})
+	require.Nil(t, err)
+
+	return server, client
+}
+
+func TestNewRespClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := cache.NewRespClient(cache.ArgsRespClient{})
+		require.Equal(t, cache.ErrEmptyRespAddress, err)
+		require.Nil(t, client)
+	})
+	t.Run("invalid db should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := cache.NewRespClient(cache.ArgsRespClient{Address: "127.0.0.1:6379", DB: -1})
+		require.True(t, errors.Is(err, cache.ErrInvalidRespDB))
+		require.Nil(t, client)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := cache.NewRespClient(cache.ArgsRespClient{Address: "127.0.0.1:6379"})
+		require.Nil(t, err)
+		require.False(t, client.IsInterfaceNil())
+	})
+}
+
+func TestRespClient_Operations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get missing key should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, client := createRespServerAndClient(t, "")
+
+		value, found, err := client.Get("missing")
+		require.Nil(t, err)
+		require.False(t, found)
+		require.Nil(t, value)
+	})
+	t.Run("set then get should work", func(t *testing.T) {
+		t.Parallel()
+
+		_, client := createRespServerAndClient(t, "pass")
+
+		providedValue := []byte("value with\r\nspecial characters")
+		err := client.Set("key", providedValue, cache.NoExpiration)
+		require.Nil(t, err)
+
+		value, found, err := client.Get("key")
+		require.Nil(t, err)
+		require.True(t, found)
+		require.Equal(t, providedValue, value)
+
+		err = client.Delete("key")
+		require.Nil(t, err)
+		_, found, err = client.Get("key")
+		require.Nil(t, err)
+		require.False(t, found)
+	})
+	t.Run("set with ttl should expire", func(t *testing.T) {
+		t.Parallel()
+
+		_, client := createRespServerAndClient(t, "")
+
+		err := client.Set("key", []byte("value"), 50*time.Millisecond)
+		require.Nil(t, err)
+		_, found, _ := client.Get("key")
+		require.True(t, found)
+
+		time.Sleep(100 * time.Millisecond)
+		_, found, _ = client.Get("key")
+		require.False(t, found)
+	})
+	t.Run("set if not exists and expire should work", func(t *testing.T) {
+		t.Parallel()
+
+		_, client := createRespServerAndClient(t, "")
+
+		isSet, err := client.SetIfNotExists("key", []byte("first"), time.Minute)
+		require.Nil(t, err)
+		require.True(t, isSet)
+
+		isSet, err = client.SetIfNotExists("key", []byte("second"), time.Minute)
+		require.Nil(t, err)
+		require.False(t, isSet)
+
+		value, _, _ := client.Get("key")
+		require.Equal(t, []byte("first"), value)
+
+		isExtended, err := client.Expire("key", 50*time.Millisecond)
+		require.Nil(t, err)
+		require.True(t, isExtended)
+
+		time.Sleep(100 * time.Millisecond)
+		isExtended, err = client.Expire("key", time.Minute)
+		require.Nil(t, err)
+		require.False(t, isExtended)
+	})
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := mock.NewRespServerMock("pass")
+		require.Nil(t, err)
+		defer func() {
+			_ = server.Close()
+		}()
+
+		client, _ := cache.NewRespClient(cache.ArgsRespClient{
+			Address:  server.Address(),
+			Password: "wrong",
+		})
+		_, _, err = client.Get("key")
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "WRONGPASS")
+	})
+	t.Run("error replies should keep the connection", func(t *testing.T) {
+		t.Parallel()
+
+		server, client := createRespServerAndClient(t, "")
+
+		server.SetFailCommands(true)
+		err := client.Set("key", []byte("value"), cache.NoExpiration)
+		require.NotNil(t, err)
+
+		server.SetFailCommands(false)
+		err = client.Set("key", []byte("value"), cache.NoExpiration)
+		require.Nil(t, err)
+		require.Equal(t, 1, server.NumConnections())
+	})
+	t.Run("unreachable server should error", func(t *testing.T) {
+		t.Parallel()
+
+		server, client := createRespServerAndClient(t, "")
+		_ = server.Close()
+
+		_, _, err := client.Get("key")
+		require.NotNil(t, err)
+	})
+	t.Run("closed client should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, client := createRespServerAndClient(t, "")
+		closableClient := client.(interface{ Close() error })
+
+		require.Nil(t, closableClient.Close())
+		require.Nil(t, closableClient.Close())
+
+		_, _, err := client.Get("key")
+		require.Equal(t, cache.ErrRespClientClosed, err)
+	})
+}
+
+func TestRespClient_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	server, client := createRespServerAndClient(t, "")
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := fmt.Sprintf("key%d", idx%10)
+			switch idx % 4 {
+			case 0:
+				_ = client.Set(key, []byte("value"), time.Minute)
+			case 1:
+				_, _, _ = client.Get(key)
+			case 2:
+				_, _ = client.SetIfNotExists(key, []byte("value"), time.Minute)
+			case 3:
+				_ = client.Delete(key)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, server.NumConnections(), numCalls)
+}
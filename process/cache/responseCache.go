@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// NoExpiration is the ttl to be used for the responses that never become stale, such as the ones built from final data
+const NoExpiration = time.Duration(0)
+
+type responseCacheEntry struct {
+	key        string
+	value      interface{}
+	expiryTime time.Time
+}
+
+// responseCache is a size-bounded, least recently used evicted cache, holding the responses fetched from the
+// observers. Each entry has its own time to live
+type responseCache struct {
+	mutEntries     sync.Mutex
+	capacity       int
+	entries        map[string]*list.Element
+	evictionList   *list.List
+	getTimeHandler func() time.Time
+}
+
+// NewResponseCache returns a new instance of responseCache holding at most capacity entries
+func NewResponseCache(capacity int) (*responseCache, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidResponseCacheCapacity, capacity)
+	}
+
+	return &responseCache{
+		capacity:       capacity,
+		entries:        make(map[string]*list.Element),
+		evictionList:   list.New(),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// Get returns the value stored for the provided key, if found and not expired
+func (rc *responseCache) Get(key string) (interface{}, bool) {
+	rc.mutEntries.Lock()
+	defer rc.mutEntries.Unlock()
+
+	element, found := rc.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	entry := element.Value.(*responseCacheEntry)
+	if rc.isExpired(entry) {
+		rc.removeElement(element)
+		return nil, false
+	}
+
+	rc.evictionList.MoveToFront(element)
+
+	return entry.value, true
+}
+
+// Put stores the value for the provided key. A ttl equal to NoExpiration keeps the value until it is evicted
+// by newer entries
+func (rc *responseCache) Put(key string, value interface{}, ttl time.Duration) {
+	expiryTime := time.Time{}
+	if ttl != NoExpiration {
+		expiryTime = rc.getTimeHandler().Add(ttl)
+	}
+
+	rc.mutEntries.Lock()
+	defer rc.mutEntries.Unlock()
+
+	element, found := rc.entries[key]
+	if found {
+		entry := element.Value.(*responseCacheEntry)
+		entry.value = value
+		entry.expiryTime = expiryTime
+		rc.evictionList.MoveToFront(element)
+		return
+	}
+
+	rc.entries[key] = rc.evictionList.PushFront(&responseCacheEntry{
+		key:        key,
+		value:      value,
+		expiryTime: expiryTime,
+	})
+
+	if rc.evictionList.Len() > rc.capacity {
+		rc.removeElement(rc.evictionList.Back())
+	}
+}
+
+// Len returns the number of entries currently stored, including the expired ones not yet removed
+func (rc *responseCache) Len() int {
+	rc.mutEntries.Lock()
+	defer rc.mutEntries.Unlock()
+
+	return rc.evictionList.Len()
+}
+
+func (rc *responseCache) isExpired(entry *responseCacheEntry) bool {
+	if entry.expiryTime.IsZero() {
+		return false
+	}
+
+	return !rc.getTimeHandler().Before(entry.expiryTime)
+}
+
+func (rc *responseCache) removeElement(element *list.Element) {
+	entry := rc.evictionList.Remove(element).(*responseCacheEntry)
+	delete(rc.entries, entry.key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *responseCache) IsInterfaceNil() bool {
+	return rc == nil
+}
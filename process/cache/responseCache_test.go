@@ -0,0 +1,119 @@
+package cache_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResponseCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := cache.NewResponseCache(0)
+		require.True(t, errors.Is(err, cache.ErrInvalidResponseCacheCapacity))
+		require.Nil(t, rc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := cache.NewResponseCache(10)
+		require.NoError(t, err)
+		require.False(t, rc.IsInterfaceNil())
+		require.Zero(t, rc.Len())
+	})
+}
+
+func TestResponseCache_PutGet(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := cache.NewResponseCache(10)
+	value, found := rc.Get("key")
+	require.False(t, found)
+	require.Nil(t, value)
+
+	rc.Put("key", "value", cache.NoExpiration)
+	value, found = rc.Get("key")
+	require.True(t, found)
+	require.Equal(t, "value", value)
+
+	rc.Put("key", "new value", cache.NoExpiration)
+	value, _ = rc.Get("key")
+	require.Equal(t, "new value", value)
+	require.Equal(t, 1, rc.Len())
+}
+
+func TestResponseCache_ExpiredEntriesShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	rc, _ := cache.NewResponseCache(10)
+	rc.SetGetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	rc.Put("tip", "tip value", time.Second)
+	rc.Put("final", "final value", cache.NoExpiration)
+
+	currentTime = currentTime.Add(999 * time.Millisecond)
+	_, found := rc.Get("tip")
+	require.True(t, found)
+
+	currentTime = currentTime.Add(time.Millisecond)
+	_, found = rc.Get("tip")
+	require.False(t, found)
+	require.Equal(t, 1, rc.Len())
+
+	currentTime = currentTime.Add(time.Hour)
+	value, found := rc.Get("final")
+	require.True(t, found)
+	require.Equal(t, "final value", value)
+}
+
+func TestResponseCache_ShouldEvictTheLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := cache.NewResponseCache(2)
+	rc.Put("a", 1, cache.NoExpiration)
+	rc.Put("b", 2, cache.NoExpiration)
+	_, _ = rc.Get("a")
+	rc.Put("c", 3, cache.NoExpiration)
+
+	require.Equal(t, 2, rc.Len())
+	_, found := rc.Get("b")
+	require.False(t, found)
+	_, found = rc.Get("a")
+	require.True(t, found)
+	_, found = rc.Get("c")
+	require.True(t, found)
+}
+
+func TestResponseCache_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := cache.NewResponseCache(50)
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			key := fmt.Sprintf("key%d", idx%100)
+			if idx%2 == 0 {
+				rc.Put(key, idx, time.Millisecond*time.Duration(idx%3))
+			} else {
+				_, _ = rc.Get(key)
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, rc.Len(), 50)
+}
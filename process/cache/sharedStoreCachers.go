@@ -0,0 +1,202 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/cache")
+
+// sharedStoreEntry handles the json encoding of a single value kept in a shared store
+type sharedStoreEntry struct {
+	store SharedStoreHandler
+	key   string
+}
+
+func newSharedStoreEntry(store SharedStoreHandler, key string) (*sharedStoreEntry, error) {
+	if check.IfNil(store) {
+		return nil, ErrNilSharedStore
+	}
+	if len(key) == 0 {
+		return nil, ErrEmptySharedStoreKey
+	}
+
+	return &sharedStoreEntry{
+		store: store,
+		key:   key,
+	}, nil
+}
+
+// load decodes the stored value into the provided destination. Returns false if there is no stored value
+func (entry *sharedStoreEntry) load(destination interface{}) (bool, error) {
+	buff, found, err := entry.store.Get(entry.key)
+	if err != nil {
+		return false, fmt.Errorf("%w while loading %s from the shared store", err, entry.key)
+	}
+	if !found {
+		return false, nil
+	}
+
+	err = json.Unmarshal(buff, destination)
+	if err != nil {
+		return false, fmt.Errorf("%w while decoding %s from the shared store", err, entry.key)
+	}
+
+	return true, nil
+}
+
+func (entry *sharedStoreEntry) save(value interface{}) error {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return entry.store.Set(entry.key, buff, NoExpiration)
+}
+
+func (entry *sharedStoreEntry) remove() error {
+	return entry.store.Delete(entry.key)
+}
+
+// sharedStoreHeartbeatCacher will handle caching the heartbeats response in a store shared between several proxies
+type sharedStoreHeartbeatCacher struct {
+	entry *sharedStoreEntry
+}
+
+// NewSharedStoreHeartbeatCacher will return a new instance of sharedStoreHeartbeatCacher
+func NewSharedStoreHeartbeatCacher(store SharedStoreHandler, key string) (*sharedStoreHeartbeatCacher, error) {
+	entry, err := newSharedStoreEntry(store, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharedStoreHeartbeatCacher{
+		entry: entry,
+	}, nil
+}
+
+// LoadHeartbeats will return the heartbeats response stored in the shared store (if found)
+func (ssc *sharedStoreHeartbeatCacher) LoadHeartbeats() (*data.HeartbeatResponse, error) {
+	response := &data.HeartbeatResponse{}
+	found, err := ssc.entry.load(response)
+	if err != nil {
+		return nil, err
+	}
+	if !found || response.Heartbeats == nil {
+		return nil, ErrNilHeartbeatsInCache
+	}
+
+	return response, nil
+}
+
+// StoreHeartbeats will update the heartbeats response in the shared store
+func (ssc *sharedStoreHeartbeatCacher) StoreHeartbeats(hbts *data.HeartbeatResponse) error {
+	if hbts == nil {
+		return ErrNilHeartbeatsToStoreInCache
+	}
+
+	return ssc.entry.save(hbts)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (ssc *sharedStoreHeartbeatCacher) IsInterfaceNil() bool {
+	return ssc == nil
+}
+
+// sharedStoreValidatorsStatsCacher will handle caching the validators statistics in a store shared between several proxies
+type sharedStoreValidatorsStatsCacher struct {
+	entry *sharedStoreEntry
+}
+
+// NewSharedStoreValidatorsStatsCacher will return a new instance of sharedStoreValidatorsStatsCacher
+func NewSharedStoreValidatorsStatsCacher(store SharedStoreHandler, key string) (*sharedStoreValidatorsStatsCacher, error) {
+	entry, err := newSharedStoreEntry(store, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharedStoreValidatorsStatsCacher{
+		entry: entry,
+	}, nil
+}
+
+// LoadValStats will return the validators statistics stored in the shared store (if found)
+func (ssc *sharedStoreValidatorsStatsCacher) LoadValStats() (map[string]*data.ValidatorApiResponse, error) {
+	var valStats map[string]*data.ValidatorApiResponse
+	found, err := ssc.entry.load(&valStats)
+	if err != nil {
+		return nil, err
+	}
+	if !found || valStats == nil {
+		return nil, ErrNilValidatorStatsInCache
+	}
+
+	return valStats, nil
+}
+
+// StoreValStats will update the validators statistics in the shared store
+func (ssc *sharedStoreValidatorsStatsCacher) StoreValStats(valStats map[string]*data.ValidatorApiResponse) error {
+	if valStats == nil {
+		return ErrNilValidatorStatsToStoreInCache
+	}
+
+	return ssc.entry.save(valStats)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (ssc *sharedStoreValidatorsStatsCacher) IsInterfaceNil() bool {
+	return ssc == nil
+}
+
+// sharedStoreGenericApiResponseCacher will handle caching a generic api response in a store shared between several proxies
+type sharedStoreGenericApiResponseCacher struct {
+	entry *sharedStoreEntry
+}
+
+// NewSharedStoreGenericApiResponseCacher will return a new instance of sharedStoreGenericApiResponseCacher
+func NewSharedStoreGenericApiResponseCacher(store SharedStoreHandler, key string) (*sharedStoreGenericApiResponseCacher, error) {
+	entry, err := newSharedStoreEntry(store, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharedStoreGenericApiResponseCacher{
+		entry: entry,
+	}, nil
+}
+
+// Load will return the generic api response stored in the shared store (if found)
+func (ssc *sharedStoreGenericApiResponseCacher) Load() (*data.GenericAPIResponse, error) {
+	response := &data.GenericAPIResponse{}
+	found, err := ssc.entry.load(response)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNilGenericApiResponseInCache
+	}
+
+	return response, nil
+}
+
+// Store will update the generic api response in the shared store. A nil response removes the stored one
+func (ssc *sharedStoreGenericApiResponseCacher) Store(response *data.GenericAPIResponse) {
+	var err error
+	if response == nil {
+		err = ssc.entry.remove()
+	} else {
+		err = ssc.entry.save(response)
+	}
+	if err != nil {
+		log.Warn("cannot update the generic api response in the shared store", "key", ssc.entry.key, "error", err.Error())
+	}
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (ssc *sharedStoreGenericApiResponseCacher) IsInterfaceNil() bool {
+	return ssc == nil
+}
@@ -0,0 +1,147 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSharedStoreCachers(t *testing.T) {
+	t.Parallel()
+
+	_, store := createRespServerAndClient(t, "")
+
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		hbCacher, err := cache.NewSharedStoreHeartbeatCacher(nil, "key")
+		require.Equal(t, cache.ErrNilSharedStore, err)
+		require.Nil(t, hbCacher)
+
+		valStatsCacher, err := cache.NewSharedStoreValidatorsStatsCacher(nil, "key")
+		require.Equal(t, cache.ErrNilSharedStore, err)
+		require.Nil(t, valStatsCacher)
+
+		genericCacher, err := cache.NewSharedStoreGenericApiResponseCacher(nil, "key")
+		require.Equal(t, cache.ErrNilSharedStore, err)
+		require.Nil(t, genericCacher)
+	})
+	t.Run("empty key should error", func(t *testing.T) {
+		t.Parallel()
+
+		hbCacher, err := cache.NewSharedStoreHeartbeatCacher(store, "")
+		require.Equal(t, cache.ErrEmptySharedStoreKey, err)
+		require.Nil(t, hbCacher)
+
+		valStatsCacher, err := cache.NewSharedStoreValidatorsStatsCacher(store, "")
+		require.Equal(t, cache.ErrEmptySharedStoreKey, err)
+		require.Nil(t, valStatsCacher)
+
+		genericCacher, err := cache.NewSharedStoreGenericApiResponseCacher(store, "")
+		require.Equal(t, cache.ErrEmptySharedStoreKey, err)
+		require.Nil(t, genericCacher)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hbCacher, err := cache.NewSharedStoreHeartbeatCacher(store, "heartbeats")
+		require.Nil(t, err)
+		require.False(t, hbCacher.IsInterfaceNil())
+
+		valStatsCacher, err := cache.NewSharedStoreValidatorsStatsCacher(store, "validator-statistics")
+		require.Nil(t, err)
+		require.False(t, valStatsCacher.IsInterfaceNil())
+
+		genericCacher, err := cache.NewSharedStoreGenericApiResponseCacher(store, "economic-metrics")
+		require.Nil(t, err)
+		require.False(t, genericCacher.IsInterfaceNil())
+	})
+}
+
+func TestSharedStoreHeartbeatCacher(t *testing.T) {
+	t.Parallel()
+
+	server, store := createRespServerAndClient(t, "")
+	writer, _ := cache.NewSharedStoreHeartbeatCacher(store, "heartbeats")
+	reader, _ := cache.NewSharedStoreHeartbeatCacher(store, "heartbeats")
+
+	hbts, err := reader.LoadHeartbeats()
+	require.Equal(t, cache.ErrNilHeartbeatsInCache, err)
+	require.Nil(t, hbts)
+
+	err = writer.StoreHeartbeats(nil)
+	require.Equal(t, cache.ErrNilHeartbeatsToStoreInCache, err)
+
+	providedHeartbeats := &data.HeartbeatResponse{
+		Heartbeats: []data.PubKeyHeartbeat{
+			{NodeDisplayName: "node1", IsActive: true},
+			{NodeDisplayName: "node2"},
+		},
+	}
+	err = writer.StoreHeartbeats(providedHeartbeats)
+	require.Nil(t, err)
+
+	hbts, err = reader.LoadHeartbeats()
+	require.Nil(t, err)
+	require.Equal(t, providedHeartbeats, hbts)
+
+	server.SetFailCommands(true)
+	hbts, err = reader.LoadHeartbeats()
+	require.NotNil(t, err)
+	require.Nil(t, hbts)
+}
+
+func TestSharedStoreValidatorsStatsCacher(t *testing.T) {
+	t.Parallel()
+
+	_, store := createRespServerAndClient(t, "")
+	writer, _ := cache.NewSharedStoreValidatorsStatsCacher(store, "validator-statistics")
+	reader, _ := cache.NewSharedStoreValidatorsStatsCacher(store, "validator-statistics")
+
+	valStats, err := reader.LoadValStats()
+	require.Equal(t, cache.ErrNilValidatorStatsInCache, err)
+	require.Nil(t, valStats)
+
+	err = writer.StoreValStats(nil)
+	require.Equal(t, cache.ErrNilValidatorStatsToStoreInCache, err)
+
+	providedValStats := map[string]*data.ValidatorApiResponse{
+		"pubkey1": {TempRating: 50.5, NumLeaderSuccess: 10},
+		"pubkey2": {Rating: 100},
+	}
+	err = writer.StoreValStats(providedValStats)
+	require.Nil(t, err)
+
+	valStats, err = reader.LoadValStats()
+	require.Nil(t, err)
+	require.Equal(t, providedValStats, valStats)
+}
+
+func TestSharedStoreGenericApiResponseCacher(t *testing.T) {
+	t.Parallel()
+
+	_, store := createRespServerAndClient(t, "")
+	writer, _ := cache.NewSharedStoreGenericApiResponseCacher(store, "economic-metrics")
+	reader, _ := cache.NewSharedStoreGenericApiResponseCacher(store, "economic-metrics")
+
+	response, err := reader.Load()
+	require.Equal(t, cache.ErrNilGenericApiResponseInCache, err)
+	require.Nil(t, response)
+
+	providedResponse := &data.GenericAPIResponse{
+		Data: map[string]interface{}{"metric": "value"},
+		Code: data.ReturnCodeSuccess,
+	}
+	writer.Store(providedResponse)
+
+	response, err = reader.Load()
+	require.Nil(t, err)
+	require.Equal(t, providedResponse, response)
+
+	writer.Store(nil)
+	response, err = reader.Load()
+	require.Equal(t, cache.ErrNilGenericApiResponseInCache, err)
+	require.Nil(t, response)
+}
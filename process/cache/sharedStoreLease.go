@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// sharedStoreLease is a lease kept in a store shared between several proxies. It is used so only one of the proxies
+// refreshes a shared cache from the observers, while the others just read the cached values
+type sharedStoreLease struct {
+	store    SharedStoreHandler
+	key      string
+	owner    []byte
+	duration time.Duration
+}
+
+// NewSharedStoreLease returns a new instance of sharedStoreLease
+func NewSharedStoreLease(store SharedStoreHandler, key string, owner string, duration time.Duration) (*sharedStoreLease, error) {
+	if check.IfNil(store) {
+		return nil, ErrNilSharedStore
+	}
+	if len(key) == 0 {
+		return nil, ErrEmptySharedStoreKey
+	}
+	if len(owner) == 0 {
+		return nil, ErrEmptyLeaseOwner
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLeaseDuration, duration)
+	}
+
+	return &sharedStoreLease{
+		store:    store,
+		key:      key,
+		owner:    []byte(owner),
+		duration: duration,
+	}, nil
+}
+
+// TryAcquire acquires the lease if it is not held by another proxy, or extends it if it is already held by the
+// current one. Returns true if the current proxy holds the lease after the call. Errors are considered as the lease not
+// being held, as the refreshed values could not be stored anyway
+func (ssl *sharedStoreLease) TryAcquire() bool {
+	acquired, err := ssl.store.SetIfNotExists(ssl.key, ssl.owner, ssl.duration)
+	if err != nil {
+		log.Debug("cannot acquire the shared store lease", "key", ssl.key, "error", err.Error())
+		return false
+	}
+	if acquired {
+		return true
+	}
+
+	currentOwner, found, err := ssl.store.Get(ssl.key)
+	if err != nil {
+		log.Debug("cannot get the shared store lease owner", "key", ssl.key, "error", err.Error())
+		return false
+	}
+	if !found || !bytes.Equal(currentOwner, ssl.owner) {
+		return false
+	}
+
+	// the lease might expire and be acquired by another proxy between the two calls, in which case both proxies
+	// refresh the cache once, which is harmless
+	extended, err := ssl.store.Expire(ssl.key, ssl.duration)
+	if err != nil {
+		log.Debug("cannot extend the shared store lease", "key", ssl.key, "error", err.Error())
+		return false
+	}
+
+	return extended
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssl *sharedStoreLease) IsInterfaceNil() bool {
+	return ssl == nil
+}
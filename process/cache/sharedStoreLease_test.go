@@ -0,0 +1,113 @@
+package cache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSharedStoreLease(t *testing.T) {
+	t.Parallel()
+
+	_, store := createRespServerAndClient(t, "")
+
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		lease, err := cache.NewSharedStoreLease(nil, "lease", "owner", time.Second)
+		require.Equal(t, cache.ErrNilSharedStore, err)
+		require.Nil(t, lease)
+	})
+	t.Run("empty key should error", func(t *testing.T) {
+		t.Parallel()
+
+		lease, err := cache.NewSharedStoreLease(store, "", "owner", time.Second)
+		require.Equal(t, cache.ErrEmptySharedStoreKey, err)
+		require.Nil(t, lease)
+	})
+	t.Run("empty owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		lease, err := cache.NewSharedStoreLease(store, "lease", "", time.Second)
+		require.Equal(t, cache.ErrEmptyLeaseOwner, err)
+		require.Nil(t, lease)
+	})
+	t.Run("invalid duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		lease, err := cache.NewSharedStoreLease(store, "lease", "owner", 0)
+		require.True(t, errors.Is(err, cache.ErrInvalidLeaseDuration))
+		require.Nil(t, lease)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lease, err := cache.NewSharedStoreLease(store, "lease", "owner", time.Second)
+		require.Nil(t, err)
+		require.False(t, lease.IsInterfaceNil())
+	})
+}
+
+func TestSharedStoreLease_TryAcquire(t *testing.T) {
+	t.Parallel()
+
+	t.Run("only one owner should hold the lease", func(t *testing.T) {
+		t.Parallel()
+
+		_, store := createRespServerAndClient(t, "")
+		firstLease, _ := cache.NewSharedStoreLease(store, "lease", "first", time.Minute)
+		secondLease, _ := cache.NewSharedStoreLease(store, "lease", "second", time.Minute)
+		otherKeyLease, _ := cache.NewSharedStoreLease(store, "other-lease", "second", time.Minute)
+
+		require.True(t, firstLease.TryAcquire())
+		require.False(t, secondLease.TryAcquire())
+		require.True(t, otherKeyLease.TryAcquire())
+
+		// the holder renews the lease
+		require.True(t, firstLease.TryAcquire())
+		require.False(t, secondLease.TryAcquire())
+	})
+	t.Run("expired lease should be taken over", func(t *testing.T) {
+		t.Parallel()
+
+		_, store := createRespServerAndClient(t, "")
+		firstLease, _ := cache.NewSharedStoreLease(store, "lease", "first", 100*time.Millisecond)
+		secondLease, _ := cache.NewSharedStoreLease(store, "lease", "second", 100*time.Millisecond)
+
+		require.True(t, firstLease.TryAcquire())
+		require.False(t, secondLease.TryAcquire())
+
+		time.Sleep(150 * time.Millisecond)
+		require.True(t, secondLease.TryAcquire())
+		require.False(t, firstLease.TryAcquire())
+	})
+	t.Run("renewed lease should not expire", func(t *testing.T) {
+		t.Parallel()
+
+		_, store := createRespServerAndClient(t, "")
+		firstLease, _ := cache.NewSharedStoreLease(store, "lease", "first", 200*time.Millisecond)
+		secondLease, _ := cache.NewSharedStoreLease(store, "lease", "second", 200*time.Millisecond)
+
+		require.True(t, firstLease.TryAcquire())
+		for i := 0; i < 4; i++ {
+			time.Sleep(100 * time.Millisecond)
+			require.True(t, firstLease.TryAcquire())
+			require.False(t, secondLease.TryAcquire())
+		}
+	})
+	t.Run("store errors should not acquire the lease", func(t *testing.T) {
+		t.Parallel()
+
+		server, store := createRespServerAndClient(t, "")
+		lease, _ := cache.NewSharedStoreLease(store, "lease", "owner", time.Minute)
+
+		server.SetFailCommands(true)
+		require.False(t, lease.TryAcquire())
+
+		server.SetFailCommands(false)
+		require.True(t, lease.TryAcquire())
+	})
+}
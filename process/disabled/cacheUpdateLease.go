@@ -0,0 +1,15 @@
+package disabled
+
+// CacheUpdateLease represents a disabled struct that implements the CacheUpdateLeaseHandler interface
+type CacheUpdateLease struct {
+}
+
+// TryAcquire returns true as, without a shared cache, each proxy refreshes its own caches
+func (cul *CacheUpdateLease) TryAcquire() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cul *CacheUpdateLease) IsInterfaceNil() bool {
+	return cul == nil
+}
@@ -0,0 +1,38 @@
+package disabled
+
+import (
+	"context"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockStreamer represents a disabled struct that implements the HyperblockStreamer interface
+type HyperblockStreamer struct {
+}
+
+// IsEnabled returns false as this is a disabled component
+func (hs *HyperblockStreamer) IsEnabled() bool {
+	return false
+}
+
+// Subscribe returns the not enabled error as this is a disabled component
+func (hs *HyperblockStreamer) Subscribe(_ context.Context, _ common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+	return nil, apiErrors.ErrHyperblockStreamNotEnabled
+}
+
+// SubscribeToAddressActivity returns the not enabled error as this is a disabled component
+func (hs *HyperblockStreamer) SubscribeToAddressActivity(_ context.Context, _ common.AddressActivityOptions) (data.AddressActivitySubscription, error) {
+	return nil, apiErrors.ErrHyperblockStreamNotEnabled
+}
+
+// Close returns nil as this is a disabled component
+func (hs *HyperblockStreamer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hs *HyperblockStreamer) IsInterfaceNil() bool {
+	return hs == nil
+}
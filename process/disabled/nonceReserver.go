@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"context"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NonceReserver represents a disabled struct that implements the NonceReserver interface
+type NonceReserver struct {
+}
+
+// IsEnabled returns false as this is a disabled component
+func (nr *NonceReserver) IsEnabled() bool {
+	return false
+}
+
+// Reserve returns the not enabled error as this is a disabled component
+func (nr *NonceReserver) Reserve(_ context.Context, _ string) (*data.NonceReservation, error) {
+	return nil, apiErrors.ErrNonceReservationNotEnabled
+}
+
+// MarkSent won't do anything as this is a disabled component
+func (nr *NonceReserver) MarkSent(_ string, _ uint64) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nr *NonceReserver) IsInterfaceNil() bool {
+	return nr == nil
+}
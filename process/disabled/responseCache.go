@@ -0,0 +1,21 @@
+package disabled
+
+import "time"
+
+// ResponseCache represents a disabled struct that implements the ResponseCacheHandler interface
+type ResponseCache struct {
+}
+
+// Get returns false as this is a disabled component
+func (rc *ResponseCache) Get(_ string) (interface{}, bool) {
+	return nil, false
+}
+
+// Put won't do anything as this is a disabled component
+func (rc *ResponseCache) Put(_ string, _ interface{}, _ time.Duration) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *ResponseCache) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"context"
+	"time"
+)
+
+// ResponseTTLProvider represents a disabled struct that implements the ResponseTTLHandler interface
+type ResponseTTLProvider struct {
+}
+
+// TTLForHyperblockNonce returns 0 as this is a disabled component
+func (rtp *ResponseTTLProvider) TTLForHyperblockNonce(_ context.Context, _ uint64) time.Duration {
+	return 0
+}
+
+// TTLForBlockNonce returns 0 as this is a disabled component
+func (rtp *ResponseTTLProvider) TTLForBlockNonce(_ context.Context, _ uint32, _ uint64) time.Duration {
+	return 0
+}
+
+// NonFinalTTL returns 0 as this is a disabled component
+func (rtp *ResponseTTLProvider) NonFinalTTL() time.Duration {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rtp *ResponseTTLProvider) IsInterfaceNil() bool {
+	return rtp == nil
+}
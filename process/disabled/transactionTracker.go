@@ -0,0 +1,30 @@
+package disabled
+
+// TransactionTracker represents a disabled struct that implements the TransactionTracker interface
+type TransactionTracker struct {
+}
+
+// IsEnabled returns false as this is a disabled component
+func (tt *TransactionTracker) IsEnabled() bool {
+	return false
+}
+
+// CheckTracking returns nil as this is a disabled component
+func (tt *TransactionTracker) CheckTracking(_ string) error {
+	return nil
+}
+
+// Track won't do anything as this is a disabled component
+func (tt *TransactionTracker) Track(_ string, _ string) error {
+	return nil
+}
+
+// Close returns nil as this is a disabled component
+func (tt *TransactionTracker) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tt *TransactionTracker) IsInterfaceNil() bool {
+	return tt == nil
+}
@@ -14,24 +14,24 @@ const EconomicsDataPath = "/network/economics"
 const thresholdCountConsecutiveFails = 10
 
 // GetEconomicsDataMetrics will return the economic metrics from cache
-func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	return nsp.economicMetricsCacher.Load()
 }
 
-func (nsp *NodeStatusProcessor) getEconomicsDataMetricsFromApi() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getEconomicsDataMetricsFromApi(ctx context.Context) (*data.GenericAPIResponse, error) {
 	metaObservers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
 	}
 
-	return nsp.getEconomicsDataMetrics(metaObservers)
+	return nsp.getEconomicsDataMetrics(ctx, metaObservers)
 }
 
-func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(observers []*data.NodeData) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(ctx context.Context, observers []*data.NodeData) (*data.GenericAPIResponse, error) {
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, EconomicsDataPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, EconomicsDataPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("economics data request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -55,18 +55,18 @@ func (nsp *NodeStatusProcessor) StartCacheUpdate() {
 	ctx, nsp.cancelFunc = context.WithCancel(context.Background())
 
 	go func(ctx context.Context) {
-		timer := time.NewTimer(nsp.cacheValidityDuration)
+		timer := time.NewTimer(nsp.getCacheValidityDuration())
 		defer timer.Stop()
 
 		countConsecutiveFails := 0
-		nsp.handleCacheUpdate(&countConsecutiveFails)
+		nsp.handleCacheUpdate(ctx, &countConsecutiveFails)
 
 		for {
-			timer.Reset(nsp.cacheValidityDuration)
+			timer.Reset(nsp.getCacheValidityDuration())
 
 			select {
 			case <-timer.C:
-				nsp.handleCacheUpdate(&countConsecutiveFails)
+				nsp.handleCacheUpdate(ctx, &countConsecutiveFails)
 
 			case <-ctx.Done():
 				log.Debug("finishing NodeStatusProcessor cache update...")
@@ -76,8 +76,13 @@ func (nsp *NodeStatusProcessor) StartCacheUpdate() {
 	}(ctx)
 }
 
-func (nsp *NodeStatusProcessor) handleCacheUpdate(countConsecutiveFails *int) {
-	economicMetrics, err := nsp.getEconomicsDataMetricsFromApi()
+func (nsp *NodeStatusProcessor) handleCacheUpdate(ctx context.Context, countConsecutiveFails *int) {
+	if !nsp.cacheUpdateLease.TryAcquire() {
+		log.Debug("economic metrics: cache is updated by another proxy")
+		return
+	}
+
+	economicMetrics, err := nsp.getEconomicsDataMetricsFromApi(ctx)
 	if err != nil {
 		*countConsecutiveFails++
 		log.Warn("economic metrics: get from API", "error", err.Error())
@@ -93,6 +98,26 @@ func (nsp *NodeStatusProcessor) handleCacheUpdate(countConsecutiveFails *int) {
 	}
 }
 
+// SetCacheValidityDuration changes the period of the cache updates, starting with the next update
+func (nsp *NodeStatusProcessor) SetCacheValidityDuration(cacheValidityDuration time.Duration) error {
+	if cacheValidityDuration <= 0 {
+		return ErrInvalidCacheValidityDuration
+	}
+
+	nsp.mutCacheValidity.Lock()
+	nsp.cacheValidityDuration = cacheValidityDuration
+	nsp.mutCacheValidity.Unlock()
+
+	return nil
+}
+
+func (nsp *NodeStatusProcessor) getCacheValidityDuration() time.Duration {
+	nsp.mutCacheValidity.RLock()
+	defer nsp.mutCacheValidity.RUnlock()
+
+	return nsp.cacheValidityDuration
+}
+
 // Close will handle the closing of the cache update go routine
 func (nsp *NodeStatusProcessor) Close() error {
 	if nsp.cancelFunc != nil {
@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"encoding/json"
 	"sync/atomic"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -23,10 +25,10 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldReturnDataFromCacher(t
 	}
 
 	cacher := &mock.GenericApiResponseCacherMock{Data: respInCache}
-	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond, &disabled.ResponseCache{}, time.Minute, &disabled.CacheUpdateLease{})
 	assert.Nil(t, err)
 
-	res, err := hp.GetEconomicsDataMetrics()
+	res, err := hp.GetEconomicsDataMetrics(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, res, respInCache)
@@ -47,7 +49,11 @@ func TestNodeStatusProcessor_CacheShouldUpdate(t *testing.T) {
 		},
 	},
 		cacher,
-		25*time.Millisecond)
+		25*time.Millisecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
+	)
 
 	assert.Nil(t, err)
 	hp.StartCacheUpdate()
@@ -67,6 +73,34 @@ func TestNodeStatusProcessor_CacheShouldUpdate(t *testing.T) {
 	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
 }
 
+func TestNodeStatusProcessor_CacheShouldNotUpdateWithoutLease(t *testing.T) {
+	t.Parallel()
+
+	numOfTimesHttpWasCalled := int32(0)
+	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "obs1"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
+			return 0, nil
+		},
+	},
+		&mock.GenericApiResponseCacherMock{},
+		25*time.Millisecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&mock.CacheUpdateLeaseStub{},
+	)
+	assert.Nil(t, err)
+
+	hp.StartCacheUpdate()
+	time.Sleep(40 * time.Millisecond)
+	_ = hp.Close()
+
+	assert.Zero(t, atomic.LoadInt32(&numOfTimesHttpWasCalled))
+}
+
 func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -92,6 +126,9 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldWork(t *testing.T) {
 			Data: &data.GenericAPIResponse{Data: "default response"},
 		},
 		time.Millisecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
 	time.Sleep(2 * time.Millisecond)
@@ -100,7 +137,7 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldWork(t *testing.T) {
 
 	time.Sleep(10 * time.Millisecond)
 
-	actualResponse, err := nodeStatusProc.GetEconomicsDataMetrics()
+	actualResponse, err := nodeStatusProc.GetEconomicsDataMetrics(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, *expectedResponse, *actualResponse)
 }
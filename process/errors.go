@@ -62,6 +62,12 @@ var ErrNilPubKeyConverter = errors.New("nil pub key converter provided")
 // ErrNoValidTransactionToSend signals that no valid transaction were received
 var ErrNoValidTransactionToSend = errors.New("no valid transaction to send")
 
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrTransactionNotAcceptedByObserver signals that the observer did not accept a transaction of a bulk
+var ErrTransactionNotAcceptedByObserver = errors.New("transaction not accepted by the observer")
+
 // ErrCannotParseNodeStatusMetrics signals that the node status metrics cannot be parsed
 var ErrCannotParseNodeStatusMetrics = errors.New("cannot parse node status metrics")
 
@@ -112,3 +118,54 @@ var ErrEmptyPubKey = errors.New("public key is empty")
 
 // ErrNilHttpClient signals that a nil http client has been provided
 var ErrNilHttpClient = errors.New("nil http client")
+
+// ErrInvalidObserverTLSConfig signals that the TLS configuration for the observers calls is invalid
+var ErrInvalidObserverTLSConfig = errors.New("invalid observer TLS config")
+
+// ErrInvalidRouteTimeout signals that an invalid route timeout has been provided
+var ErrInvalidRouteTimeout = errors.New("invalid route timeout")
+
+// ErrNilNodesHealthHandler signals that a nil nodes health handler has been provided
+var ErrNilNodesHealthHandler = errors.New("nil nodes health handler")
+
+// ErrNilNodesLatencyHandler signals that a nil nodes latency handler has been provided
+var ErrNilNodesLatencyHandler = errors.New("nil nodes latency handler")
+
+// ErrInvalidRequestHedgingConfig signals that an invalid request hedging config has been provided
+var ErrInvalidRequestHedgingConfig = errors.New("invalid request hedging config")
+
+// ErrNilResponseCache signals that a nil response cache has been provided
+var ErrNilResponseCache = errors.New("nil response cache")
+
+// ErrNilResponseTTLHandler signals that a nil response ttl handler has been provided
+var ErrNilResponseTTLHandler = errors.New("nil response ttl handler")
+
+// ErrNilLatestFinalNonceHandler signals that a nil latest final nonce handler has been provided
+var ErrNilLatestFinalNonceHandler = errors.New("nil latest final nonce handler")
+
+// ErrInvalidResponseTTL signals that an invalid response ttl has been provided
+var ErrInvalidResponseTTL = errors.New("invalid response ttl")
+
+// ErrNilCacheUpdateLease signals that a nil cache update lease has been provided
+var ErrNilCacheUpdateLease = errors.New("nil cache update lease")
+
+// ErrInvalidTransactionBroadcastConfig signals that an invalid transaction broadcast config has been provided
+var ErrInvalidTransactionBroadcastConfig = errors.New("invalid transaction broadcast config")
+
+// ErrInvalidBroadcastQuorum signals that the broadcast quorum is not between 1 and the number of observers
+var ErrInvalidBroadcastQuorum = errors.New("the broadcast quorum must be between 1 and the number of observers")
+
+// ErrNotEnoughObserversForBroadcast signals that the shard has fewer observers than the broadcast quorum
+var ErrNotEnoughObserversForBroadcast = errors.New("not enough observers to reach the broadcast quorum")
+
+// ErrBroadcastQuorumNotReached signals that fewer observers than the broadcast quorum accepted the transaction
+var ErrBroadcastQuorumNotReached = errors.New("broadcast quorum not reached")
+
+// ErrInvalidGasPriceModifier signals that the gas price modifier of the network config cannot be parsed
+var ErrInvalidGasPriceModifier = errors.New("invalid gas price modifier")
+
+// ErrInvalidAccountBalance signals that a balance of an account cannot be parsed
+var ErrInvalidAccountBalance = errors.New("invalid account balance")
+
+// ErrNilHistoricalCoordinatesResolver signals that a nil historical coordinates resolver has been provided
+var ErrNilHistoricalCoordinatesResolver = errors.New("nil historical coordinates resolver")
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -29,10 +30,15 @@ func GetShortHashSize() int {
 
 // ComputeTransactionStatus -
 func (tp *TransactionProcessor) ComputeTransactionStatus(tx *transaction.ApiTransactionResult, withResults bool) *proxyData.ProcessStatusResponse {
-	return tp.computeTransactionStatus(tx, withResults)
+	return tp.computeTransactionStatus(context.Background(), tx, withResults)
 }
 
 // CheckIfFailed -
 func CheckIfFailed(logs []*transaction.ApiLogs) (bool, string) {
 	return checkIfFailed(logs)
 }
+
+// SetGetTimeHandler -
+func (rtp *responseTTLProvider) SetGetTimeHandler(handler func() time.Time) {
+	rtp.getTimeHandler = handler
+}
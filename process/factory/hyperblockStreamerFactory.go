@@ -0,0 +1,43 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/streaming"
+)
+
+// CreateHyperblockStreamer will return the hyperblock streamer needed for current settings. The returned streamer is
+// already started
+func CreateHyperblockStreamer(
+	cfg config.HyperblockStreamConfig,
+	hyperblockProvider streaming.HyperblockProvider,
+	latestNonceProvider streaming.LatestNonceProvider,
+	pubKeyConverter core.PubkeyConverter,
+) (facade.HyperblockStreamer, error) {
+	if !cfg.Enabled {
+		return &disabled.HyperblockStreamer{}, nil
+	}
+
+	streamer, err := streaming.NewHyperblockStreamer(streaming.ArgsHyperblockStreamer{
+		HyperblockProvider:    hyperblockProvider,
+		LatestNonceProvider:   latestNonceProvider,
+		PollInterval:          time.Duration(cfg.PollIntervalMs) * time.Millisecond,
+		MaxSubscribers:        cfg.MaxSubscribers,
+		SubscriberBufferSize:  cfg.SubscriberBufferSize,
+		MaxResumeDistance:     cfg.MaxResumeDistance,
+		MaxHyperblocksPerPoll: cfg.MaxHyperblocksPerPoll,
+		PubKeyConverter:       pubKeyConverter,
+		MaxActivityFilters:    cfg.MaxActivityFilters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	streamer.Start()
+
+	return streamer, nil
+}
@@ -0,0 +1,29 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/nonces"
+)
+
+// CreateNonceReserver will return the nonce reserver needed for current settings
+func CreateNonceReserver(
+	cfg config.NonceReservationConfig,
+	accountProvider nonces.AccountNonceProvider,
+	poolProvider nonces.PoolNonceProvider,
+) (facade.NonceReserver, error) {
+	if !cfg.Enabled {
+		return &disabled.NonceReserver{}, nil
+	}
+
+	return nonces.NewNonceReserver(nonces.ArgsNonceReserver{
+		AccountProvider:          accountProvider,
+		PoolProvider:             poolProvider,
+		ReservationTTL:           time.Duration(cfg.ReservationTTLSec) * time.Second,
+		MaxReservationsPerSender: cfg.MaxReservationsPerSender,
+		MaxSenders:               cfg.MaxSenders,
+	})
+}
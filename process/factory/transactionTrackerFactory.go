@@ -0,0 +1,49 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/txtracking"
+)
+
+// CreateTransactionTracker will return the transaction tracker needed for current settings. The returned tracker is
+// already started
+func CreateTransactionTracker(
+	cfg config.TransactionTrackingConfig,
+	statusProvider txtracking.TransactionStatusProvider,
+) (facade.TransactionTracker, error) {
+	if !cfg.Enabled {
+		return &disabled.TransactionTracker{}, nil
+	}
+
+	notifier, err := txtracking.NewWebhookNotifier(txtracking.ArgsWebhookNotifier{
+		SigningKey:            cfg.CallbackSigningKey,
+		RequestTimeout:        time.Duration(cfg.CallbackTimeoutMs) * time.Millisecond,
+		MaxRetries:            cfg.CallbackMaxRetries,
+		RetryDelay:            time.Duration(cfg.CallbackRetryDelayMs) * time.Millisecond,
+		AllowPrivateAddresses: cfg.AllowPrivateCallbackAddresses,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracker, err := txtracking.NewTransactionTracker(txtracking.ArgsTransactionTracker{
+		StatusProvider:             statusProvider,
+		Notifier:                   notifier,
+		PollInterval:               time.Duration(cfg.PollIntervalMs) * time.Millisecond,
+		Deadline:                   time.Duration(cfg.DeadlineSec) * time.Second,
+		MaxTrackedTransactions:     cfg.MaxTrackedTransactions,
+		MaxCallbacksPerTransaction: cfg.MaxCallbacksPerTransaction,
+		AllowedCallbackHosts:       cfg.AllowedCallbackHosts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracker.Start()
+
+	return tracker, nil
+}
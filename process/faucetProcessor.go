@@ -139,7 +139,7 @@ func (fp *FaucetProcessor) GenerateTxForSendUserFunds(
 }
 
 func (fp *FaucetProcessor) getSignedTx(tx *data.Transaction, privKey crypto.PrivateKey) (*data.Transaction, error) {
-	marshalizedTxBeforeSigning, err := fp.marshalTxForSigning(tx)
+	marshalizedTxBeforeSigning, err := marshalTxForSigning(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -155,17 +155,24 @@ func (fp *FaucetProcessor) getSignedTx(tx *data.Transaction, privKey crypto.Priv
 	return tx, nil
 }
 
-func (fp *FaucetProcessor) marshalTxForSigning(tx *data.Transaction) ([]byte, error) {
+// marshalTxForSigning returns the serialized transaction without its signatures, as it is signed by the sender, the
+// guardian and the relayer
+func marshalTxForSigning(tx *data.Transaction) ([]byte, error) {
 	erdTx := erdTransaction{
-		Nonce:    tx.Nonce,
-		Value:    tx.Value,
-		RcvAddr:  tx.Receiver,
-		SndAddr:  tx.Sender,
-		GasPrice: tx.GasPrice,
-		GasLimit: tx.GasLimit,
-		Data:     tx.Data,
-		ChainID:  tx.ChainID,
-		Version:  tx.Version,
+		Nonce:       tx.Nonce,
+		Value:       tx.Value,
+		RcvAddr:     tx.Receiver,
+		SndAddr:     tx.Sender,
+		SndUserName: tx.SenderUsername,
+		RcvUserName: tx.ReceiverUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     tx.ChainID,
+		Version:     tx.Version,
+		Options:     tx.Options,
+		Guardian:    tx.GuardianAddr,
+		Relayer:     tx.RelayerAddr,
 	}
 
 	return json.Marshal(erdTx)
@@ -0,0 +1,187 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// gasLimitSafetyMarginsPercent holds the margins added over the estimated execution gas units in the gas limit
+// suggestions, since the execution cost can change between the estimation and the execution
+var gasLimitSafetyMarginsPercent = []uint64{0, 10, 20, 50}
+
+// feeCalculator computes the fees the same way the economics component of the node does
+type feeCalculator struct {
+	gasPrice            uint64
+	processingGasPrice  uint64
+	moveBalanceGasUnits uint64
+	denomination        uint64
+}
+
+// EstimateTransactionFee estimates the gas units of the transaction through a cost request and computes the expected
+// fee against the provided network config, along with gas limit suggestions having safety margins. When the
+// transaction does not set a gas price, the network minimum gas price is used
+func (tp *TransactionProcessor) EstimateTransactionFee(
+	ctx context.Context,
+	tx *data.Transaction,
+	networkConfig *data.NetworkConfig,
+) (*data.TransactionFeeEstimation, error) {
+	cfg := networkConfig.Config
+	gasPriceModifier, err := parseGasPriceModifier(cfg.GasPriceModifier)
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := tp.TransactionCostRequest(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	isCrossShard, err := tp.isCrossShardTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := tx.GasPrice
+	if gasPrice == 0 {
+		gasPrice = cfg.MinGasPrice
+	}
+
+	estimation := &data.TransactionFeeEstimation{
+		GasPrice:            gasPrice,
+		GasPriceModifier:    gasPriceModifier,
+		IsCrossShard:        isCrossShard,
+		GasLimitSuggestions: make([]*data.GasLimitSuggestion, 0, len(gasLimitSafetyMarginsPercent)),
+		ReturnMessage:       cost.RetMessage,
+	}
+	if len(cost.RetMessage) > 0 {
+		return estimation, nil
+	}
+
+	calculator := &feeCalculator{
+		gasPrice:            gasPrice,
+		processingGasPrice:  uint64(float64(gasPrice) * gasPriceModifier),
+		moveBalanceGasUnits: computeMoveBalanceGasUnits(tx, networkConfig),
+		denomination:        cfg.Denomination,
+	}
+
+	gasUnits := core.MaxUint64(cost.TxCost, calculator.moveBalanceGasUnits)
+	executionGasUnits := gasUnits - calculator.moveBalanceGasUnits
+	moveBalanceFee := core.SafeMul(calculator.moveBalanceGasUnits, calculator.gasPrice)
+	executionFee := core.SafeMul(executionGasUnits, calculator.processingGasPrice)
+
+	estimation.GasUnits = gasUnits
+	estimation.MoveBalanceGasUnits = calculator.moveBalanceGasUnits
+	estimation.ExecutionGasUnits = executionGasUnits
+	estimation.MoveBalanceFee = calculator.toFeeAmount(moveBalanceFee)
+	estimation.ExecutionFee = calculator.toFeeAmount(executionFee)
+	estimation.Fee = calculator.toFeeAmount(big.NewInt(0).Add(moveBalanceFee, executionFee))
+
+	for _, marginPercent := range gasLimitSafetyMarginsPercent {
+		// the move balance gas units are deterministic, so the margin only covers the execution gas units
+		gasLimit := calculator.moveBalanceGasUnits + executionGasUnits*(100+marginPercent)/100
+		if cfg.MaxGasPerTransaction > 0 {
+			gasLimit = core.MaxUint64(gasUnits, core.MinUint64(gasLimit, cfg.MaxGasPerTransaction))
+		}
+
+		estimation.GasLimitSuggestions = append(estimation.GasLimitSuggestions, &data.GasLimitSuggestion{
+			SafetyMarginPercent: marginPercent,
+			GasLimit:            gasLimit,
+			MaxFee:              calculator.toFeeAmount(calculator.computeFeeForGasLimit(gasLimit)),
+			EstimatedRefund:     calculator.toFeeAmount(core.SafeMul(gasLimit-gasUnits, calculator.processingGasPrice)),
+		})
+	}
+
+	return estimation, nil
+}
+
+// computeMoveBalanceGasUnits mirrors the gas limit computed by the node for moving the balance: the minimum gas limit,
+// the gas for each byte of the data field, the extra gas of the guarded transactions and of the relayed ones
+func computeMoveBalanceGasUnits(tx *data.Transaction, networkConfig *data.NetworkConfig) uint64 {
+	cfg := networkConfig.Config
+	gasUnits := cfg.MinGasLimit + cfg.GasPerDataByte*uint64(len(tx.Data))
+	isGuarded := tx.Version > core.InitialVersionOfTransaction && tx.Options&transaction.MaskGuardedTransaction > 0
+	if isGuarded {
+		gasUnits += cfg.ExtraGasLimitGuarded
+	}
+	if len(tx.RelayerAddr) > 0 {
+		gasUnits += cfg.MinGasLimit
+	}
+
+	return gasUnits
+}
+
+func (tp *TransactionProcessor) isCrossShardTransaction(tx *data.Transaction) (bool, error) {
+	senderShardID, err := tp.getShardByAddress(tx.Sender)
+	if err != nil {
+		return false, err
+	}
+
+	receiverShardID, err := tp.getShardByAddress(tx.Receiver)
+	if err != nil {
+		return false, err
+	}
+
+	return senderShardID != receiverShardID, nil
+}
+
+// parseGasPriceModifier parses the gas price modifier as provided by the network config. A missing modifier means the
+// execution gas is paid at the full gas price
+func parseGasPriceModifier(gasPriceModifier string) (float64, error) {
+	if len(gasPriceModifier) == 0 {
+		return 1, nil
+	}
+
+	modifier, err := strconv.ParseFloat(gasPriceModifier, 64)
+	if err != nil || modifier < 0 || modifier > 1 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidGasPriceModifier, gasPriceModifier)
+	}
+
+	return modifier, nil
+}
+
+// computeFeeForGasLimit returns the fee paid upfront for the gas limit
+func (fc *feeCalculator) computeFeeForGasLimit(gasLimit uint64) *big.Int {
+	moveBalanceFee := core.SafeMul(fc.moveBalanceGasUnits, fc.gasPrice)
+	if gasLimit <= fc.moveBalanceGasUnits {
+		return moveBalanceFee
+	}
+
+	executionFee := core.SafeMul(gasLimit-fc.moveBalanceGasUnits, fc.processingGasPrice)
+
+	return moveBalanceFee.Add(moveBalanceFee, executionFee)
+}
+
+func (fc *feeCalculator) toFeeAmount(value *big.Int) *data.FeeAmount {
+	return &data.FeeAmount{
+		Value:       value.String(),
+		Denominated: denominate(value, fc.denomination),
+	}
+}
+
+// denominate formats the value having the provided number of decimals, without the trailing zeros
+func denominate(value *big.Int, denomination uint64) string {
+	digits := value.String()
+	if denomination == 0 {
+		return digits
+	}
+
+	numDecimals := int(denomination)
+	if len(digits) <= numDecimals {
+		digits = strings.Repeat("0", numDecimals-len(digits)+1) + digits
+	}
+
+	integerPart := digits[:len(digits)-numDecimals]
+	decimalPart := strings.TrimRight(digits[len(digits)-numDecimals:], "0")
+	if len(decimalPart) == 0 {
+		return integerPart
+	}
+
+	return integerPart + "." + decimalPart
+}
@@ -0,0 +1,186 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransactionProcessorForFeeEstimation(costResponse *data.TxCostResponseData, costErr error) *process.TransactionProcessor {
+	shardIDs := map[string]uint32{
+		hex.EncodeToString([]byte("sender")):      0,
+		hex.EncodeToString([]byte("contract")):    0,
+		hex.EncodeToString([]byte("other shard")): 1,
+	}
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return shardIDs[hex.EncodeToString(addressBuff)], nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		func() (process.TransactionCostHandler, error) {
+			return &mock.TransactionCostHandlerStub{
+				RezolveCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+					return costResponse, costErr
+				},
+			}, nil
+		},
+		logsMerger,
+		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
+	)
+
+	return tp
+}
+
+func createFeeEstimationNetworkConfig() *data.NetworkConfig {
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.MinGasLimit = 50000
+	networkConfig.Config.MinGasPrice = 1000000000
+	networkConfig.Config.GasPerDataByte = 1500
+	networkConfig.Config.MaxGasPerTransaction = 1400000
+	networkConfig.Config.GasPriceModifier = "0.01"
+	networkConfig.Config.Denomination = 18
+
+	return networkConfig
+}
+
+func TestTransactionProcessor_EstimateTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	createTx := func() *data.Transaction {
+		return &data.Transaction{
+			Sender:   hex.EncodeToString([]byte("sender")),
+			Receiver: hex.EncodeToString([]byte("contract")),
+			Value:    "0",
+			GasPrice: 1000000000,
+			Data:     []byte("add@01"),
+			ChainID:  "T",
+			Version:  1,
+		}
+	}
+
+	t.Run("invalid gas price modifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		networkConfig := createFeeEstimationNetworkConfig()
+		networkConfig.Config.GasPriceModifier = "not a number"
+		tp := createTransactionProcessorForFeeEstimation(&data.TxCostResponseData{}, nil)
+
+		estimation, err := tp.EstimateTransactionFee(context.Background(), createTx(), networkConfig)
+		require.Nil(t, estimation)
+		require.True(t, errors.Is(err, process.ErrInvalidGasPriceModifier))
+	})
+	t.Run("cost request error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		tp := createTransactionProcessorForFeeEstimation(nil, expectedErr)
+
+		estimation, err := tp.EstimateTransactionFee(context.Background(), createTx(), createFeeEstimationNetworkConfig())
+		require.Nil(t, estimation)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("failed execution should return the message", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForFeeEstimation(&data.TxCostResponseData{RetMessage: "invalid function"}, nil)
+
+		estimation, err := tp.EstimateTransactionFee(context.Background(), createTx(), createFeeEstimationNetworkConfig())
+		require.NoError(t, err)
+		assert.Equal(t, "invalid function", estimation.ReturnMessage)
+		assert.Zero(t, estimation.GasUnits)
+		assert.Nil(t, estimation.Fee)
+		assert.Empty(t, estimation.GasLimitSuggestions)
+	})
+	t.Run("contract call should split the fee and suggest gas limits", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForFeeEstimation(&data.TxCostResponseData{TxCost: 1059000}, nil)
+
+		estimation, err := tp.EstimateTransactionFee(context.Background(), createTx(), createFeeEstimationNetworkConfig())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1059000), estimation.GasUnits)
+		assert.Equal(t, uint64(59000), estimation.MoveBalanceGasUnits)
+		assert.Equal(t, uint64(1000000), estimation.ExecutionGasUnits)
+		assert.Equal(t, 0.01, estimation.GasPriceModifier)
+		assert.False(t, estimation.IsCrossShard)
+		assert.Equal(t, &data.FeeAmount{Value: "59000000000000", Denominated: "0.000059"}, estimation.MoveBalanceFee)
+		assert.Equal(t, &data.FeeAmount{Value: "10000000000000", Denominated: "0.00001"}, estimation.ExecutionFee)
+		assert.Equal(t, &data.FeeAmount{Value: "69000000000000", Denominated: "0.000069"}, estimation.Fee)
+
+		require.Len(t, estimation.GasLimitSuggestions, 4)
+		assert.Equal(t, &data.GasLimitSuggestion{
+			SafetyMarginPercent: 0,
+			GasLimit:            1059000,
+			MaxFee:              &data.FeeAmount{Value: "69000000000000", Denominated: "0.000069"},
+			EstimatedRefund:     &data.FeeAmount{Value: "0", Denominated: "0"},
+		}, estimation.GasLimitSuggestions[0])
+		assert.Equal(t, &data.GasLimitSuggestion{
+			SafetyMarginPercent: 10,
+			GasLimit:            1159000,
+			MaxFee:              &data.FeeAmount{Value: "70000000000000", Denominated: "0.00007"},
+			EstimatedRefund:     &data.FeeAmount{Value: "1000000000000", Denominated: "0.000001"},
+		}, estimation.GasLimitSuggestions[1])
+		// the largest margin is capped by the maximum gas limit of a transaction
+		assert.Equal(t, &data.GasLimitSuggestion{
+			SafetyMarginPercent: 50,
+			GasLimit:            1400000,
+			MaxFee:              &data.FeeAmount{Value: "72410000000000", Denominated: "0.00007241"},
+			EstimatedRefund:     &data.FeeAmount{Value: "3410000000000", Denominated: "0.00000341"},
+		}, estimation.GasLimitSuggestions[3])
+	})
+	t.Run("cross shard move balance should use the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Receiver = hex.EncodeToString([]byte("other shard"))
+		tx.GasPrice = 0
+		tx.Data = nil
+		tp := createTransactionProcessorForFeeEstimation(&data.TxCostResponseData{TxCost: 50000}, nil)
+
+		estimation, err := tp.EstimateTransactionFee(context.Background(), tx, createFeeEstimationNetworkConfig())
+		require.NoError(t, err)
+		assert.True(t, estimation.IsCrossShard)
+		assert.Equal(t, uint64(1000000000), estimation.GasPrice)
+		assert.Zero(t, estimation.ExecutionGasUnits)
+		assert.Equal(t, &data.FeeAmount{Value: "50000000000000", Denominated: "0.00005"}, estimation.Fee)
+		for _, suggestion := range estimation.GasLimitSuggestions {
+			assert.Equal(t, uint64(50000), suggestion.GasLimit)
+			assert.Equal(t, "0", suggestion.EstimatedRefund.Value)
+		}
+	})
+	t.Run("guarded and relayed transaction should pay the extra move balance gas", func(t *testing.T) {
+		t.Parallel()
+
+		networkConfig := createFeeEstimationNetworkConfig()
+		networkConfig.Config.ExtraGasLimitGuarded = 50000
+		networkConfig.Config.Denomination = 0
+		tx := createTx()
+		tx.Version = 2
+		tx.Options = 2
+		tx.RelayerAddr = hex.EncodeToString([]byte("relayer"))
+		tp := createTransactionProcessorForFeeEstimation(&data.TxCostResponseData{TxCost: 100000}, nil)
+
+		estimation, err := tp.EstimateTransactionFee(context.Background(), tx, networkConfig)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(159000), estimation.GasUnits)
+		assert.Equal(t, uint64(159000), estimation.MoveBalanceGasUnits)
+		assert.Equal(t, &data.FeeAmount{Value: "159000000000000", Denominated: "159000000000000"}, estimation.Fee)
+	})
+}
@@ -0,0 +1,155 @@
+package process
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"time"
+
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type getAttemptResult struct {
+	observer   *proxyData.NodeData
+	value      interface{}
+	statusCode int
+	err        error
+}
+
+// CallGetRestEndPointWithHedging sends the GET request to the provided observers, in order, and returns the observer
+// that answered successfully first. A failed attempt moves on to the next observer right away. If request hedging is
+// enabled and an observer has not answered within the hedging delay, the same request is also sent to the next
+// observer and the slower attempts are cancelled once one of them succeeds. The response of the last failed attempt
+// is kept in value when all the observers fail
+func (bp *BaseProcessor) CallGetRestEndPointWithHedging(
+	ctx context.Context,
+	observers []*proxyData.NodeData,
+	path string,
+	value interface{},
+) (*proxyData.NodeData, int, error) {
+	if len(observers) == 0 {
+		return nil, http.StatusInternalServerError, ErrMissingObserver
+	}
+
+	canHedge := bp.hedgingDelays != nil && len(observers) > 1 && isPointer(value)
+	if !canHedge {
+		return bp.callGetRestEndPointSequentially(ctx, observers, path, value)
+	}
+
+	return bp.callGetRestEndPointHedged(ctx, observers, path, value)
+}
+
+func (bp *BaseProcessor) callGetRestEndPointSequentially(
+	ctx context.Context,
+	observers []*proxyData.NodeData,
+	path string,
+	value interface{},
+) (*proxyData.NodeData, int, error) {
+	var statusCode int
+	var err error
+	for _, observer := range observers {
+		statusCode, err = bp.CallGetRestEndPoint(ctx, observer.Address, path, value)
+		if err == nil {
+			return observer, statusCode, nil
+		}
+		if isContextDone(ctx) {
+			return nil, statusCode, err
+		}
+	}
+
+	return nil, statusCode, err
+}
+
+func (bp *BaseProcessor) callGetRestEndPointHedged(
+	ctx context.Context,
+	observers []*proxyData.NodeData,
+	path string,
+	value interface{},
+) (*proxyData.NodeData, int, error) {
+	attemptsCtx, cancel := context.WithCancel(ctx)
+	// cancels the attempts still in flight when returning
+	defer cancel()
+
+	// buffered so the goroutines of the cancelled attempts never block
+	results := make(chan *getAttemptResult, len(observers))
+	startAttempt := func(observer *proxyData.NodeData) {
+		go func() {
+			attemptValue := reflect.New(reflect.TypeOf(value).Elem()).Interface()
+			startTime := time.Now()
+			statusCode, err := bp.CallGetRestEndPoint(attemptsCtx, observer.Address, path, attemptValue)
+			if err == nil {
+				bp.hedgingDelays.addSample(path, time.Since(startTime))
+			}
+
+			results <- &getAttemptResult{
+				observer:   observer,
+				value:      attemptValue,
+				statusCode: statusCode,
+				err:        err,
+			}
+		}()
+	}
+
+	hedgingDelay := bp.hedgingDelays.computeDelay(path)
+	hedgingTimer := time.NewTimer(hedgingDelay)
+	defer hedgingTimer.Stop()
+
+	startAttempt(observers[0])
+	nextObserverIndex := 1
+	numInFlight := 1
+	numHedgedRequests := 0
+	var lastResult *getAttemptResult
+	for numInFlight > 0 {
+		select {
+		case result := <-results:
+			numInFlight--
+			if result.err == nil {
+				setValue(value, result.value)
+				return result.observer, result.statusCode, nil
+			}
+
+			lastResult = result
+			if isContextDone(ctx) {
+				return nil, result.statusCode, result.err
+			}
+			if nextObserverIndex < len(observers) {
+				startAttempt(observers[nextObserverIndex])
+				nextObserverIndex++
+				numInFlight++
+				resetTimer(hedgingTimer, hedgingDelay)
+			}
+		case <-hedgingTimer.C:
+			if numHedgedRequests >= bp.maxHedgedRequests || nextObserverIndex >= len(observers) {
+				continue
+			}
+
+			log.Trace("hedging request", "path", path, "observer", observers[nextObserverIndex].Address, "delay", hedgingDelay)
+			startAttempt(observers[nextObserverIndex])
+			nextObserverIndex++
+			numInFlight++
+			numHedgedRequests++
+			hedgingTimer.Reset(hedgingDelay)
+		}
+	}
+
+	setValue(value, lastResult.value)
+	return nil, lastResult.statusCode, lastResult.err
+}
+
+func resetTimer(timer *time.Timer, duration time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	timer.Reset(duration)
+}
+
+func isPointer(value interface{}) bool {
+	return value != nil && reflect.TypeOf(value).Kind() == reflect.Ptr
+}
+
+func setValue(destination interface{}, source interface{}) {
+	reflect.ValueOf(destination).Elem().Set(reflect.ValueOf(source).Elem())
+}
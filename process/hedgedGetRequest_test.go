@@ -0,0 +1,203 @@
+package process_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDelayedTestHttpServer(delay time.Duration, statusCode int, response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return
+		}
+
+		rw.WriteHeader(statusCode)
+		_, _ = rw.Write([]byte(response))
+	}))
+}
+
+func createBaseProcessorWithHedging(
+	hedgingConfig config.RequestHedgingConfig,
+	nodesHealth *mock.NodesHealthHandlerStub,
+) *process.BaseProcessor {
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		true,
+		config.ObserverTransportConfig{},
+		nodesHealth,
+		&mock.NodesLatencyHandlerStub{},
+		hedgingConfig,
+	)
+
+	return bp
+}
+
+func createEnabledRequestHedgingConfig() config.RequestHedgingConfig {
+	return config.RequestHedgingConfig{
+		Enabled:           true,
+		DelayPercentile:   95,
+		MinDelayMs:        10,
+		MaxDelayMs:        50,
+		WindowSize:        100,
+		MinSamples:        10,
+		MaxHedgedRequests: 1,
+	}
+}
+
+func TestNewBaseProcessor_WithInvalidRequestHedgingConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hedgingConfig := createEnabledRequestHedgingConfig()
+	hedgingConfig.MaxHedgedRequests = 0
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		true,
+		config.ObserverTransportConfig{},
+		&mock.NodesHealthHandlerStub{},
+		&mock.NodesLatencyHandlerStub{},
+		hedgingConfig,
+	)
+
+	assert.Nil(t, bp)
+	assert.ErrorIs(t, err, process.ErrInvalidRequestHedgingConfig)
+}
+
+func TestBaseProcessor_CallGetRestEndPointWithHedging(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no observers should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp := createBaseProcessorWithHedging(createEnabledRequestHedgingConfig(), &mock.NodesHealthHandlerStub{})
+		observer, _, err := bp.CallGetRestEndPointWithHedging(context.Background(), nil, "/address/erd1", &testStruct{})
+		assert.Nil(t, observer)
+		assert.Equal(t, process.ErrMissingObserver, err)
+	})
+	t.Run("disabled hedging should try the observers in order", func(t *testing.T) {
+		t.Parallel()
+
+		failingServer := createDelayedTestHttpServer(0, http.StatusInternalServerError, "{}")
+		defer failingServer.Close()
+		workingServer := createDelayedTestHttpServer(0, http.StatusOK, `{"Nonce":1,"Name":"working"}`)
+		defer workingServer.Close()
+
+		bp := createBaseProcessorWithHedging(config.RequestHedgingConfig{}, &mock.NodesHealthHandlerStub{})
+		observers := []*data.NodeData{{Address: failingServer.URL}, {Address: workingServer.URL}}
+		response := &testStruct{}
+		observer, statusCode, err := bp.CallGetRestEndPointWithHedging(context.Background(), observers, "/address/erd1", response)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, observers[1], observer)
+		assert.Equal(t, &testStruct{Nonce: 1, Name: "working"}, response)
+	})
+	t.Run("slow observer should be hedged and the cancelled attempt not recorded as failure", func(t *testing.T) {
+		t.Parallel()
+
+		slowServer := createDelayedTestHttpServer(2*time.Second, http.StatusOK, `{"Nonce":1,"Name":"slow"}`)
+		defer slowServer.Close()
+		fastServer := createDelayedTestHttpServer(0, http.StatusOK, `{"Nonce":2,"Name":"fast"}`)
+		defer fastServer.Close()
+
+		mutFailures := sync.Mutex{}
+		failures := make([]string, 0)
+		bp := createBaseProcessorWithHedging(createEnabledRequestHedgingConfig(), &mock.NodesHealthHandlerStub{
+			RecordFailureCalled: func(address string) {
+				mutFailures.Lock()
+				failures = append(failures, address)
+				mutFailures.Unlock()
+			},
+		})
+
+		observers := []*data.NodeData{{Address: slowServer.URL}, {Address: fastServer.URL}}
+		response := &testStruct{}
+		startTime := time.Now()
+		observer, statusCode, err := bp.CallGetRestEndPointWithHedging(context.Background(), observers, "/address/erd1", response)
+		require.NoError(t, err)
+		assert.Less(t, time.Since(startTime), time.Second)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, observers[1], observer)
+		assert.Equal(t, &testStruct{Nonce: 2, Name: "fast"}, response)
+
+		time.Sleep(100 * time.Millisecond)
+		mutFailures.Lock()
+		assert.Empty(t, failures)
+		mutFailures.Unlock()
+	})
+	t.Run("failed attempt should move to the next observer without waiting", func(t *testing.T) {
+		t.Parallel()
+
+		failingServer := createDelayedTestHttpServer(0, http.StatusServiceUnavailable, "{}")
+		defer failingServer.Close()
+		workingServer := createDelayedTestHttpServer(0, http.StatusOK, `{"Nonce":3,"Name":"working"}`)
+		defer workingServer.Close()
+
+		hedgingConfig := createEnabledRequestHedgingConfig()
+		hedgingConfig.MaxDelayMs = 5000
+		bp := createBaseProcessorWithHedging(hedgingConfig, &mock.NodesHealthHandlerStub{})
+		observers := []*data.NodeData{{Address: failingServer.URL}, {Address: workingServer.URL}}
+		response := &testStruct{}
+		startTime := time.Now()
+		observer, _, err := bp.CallGetRestEndPointWithHedging(context.Background(), observers, "/address/erd1", response)
+		require.NoError(t, err)
+		assert.Less(t, time.Since(startTime), time.Second)
+		assert.Equal(t, observers[1], observer)
+		assert.Equal(t, &testStruct{Nonce: 3, Name: "working"}, response)
+	})
+	t.Run("cancelled context should not try the next observers", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			atomic.AddUint32(&numCalls, 1)
+			rw.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer testServer.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		bp := createBaseProcessorWithHedging(config.RequestHedgingConfig{}, &mock.NodesHealthHandlerStub{})
+		observers := []*data.NodeData{{Address: testServer.URL}, {Address: testServer.URL}}
+		observer, _, err := bp.CallGetRestEndPointWithHedging(ctx, observers, "/address/erd1", &testStruct{})
+		assert.Equal(t, context.Canceled, err)
+		assert.Nil(t, observer)
+		assert.Zero(t, atomic.LoadUint32(&numCalls))
+	})
+	t.Run("all observers failing should return the last error", func(t *testing.T) {
+		t.Parallel()
+
+		failingServer := createDelayedTestHttpServer(0, http.StatusInternalServerError, `{"Nonce":4,"Name":"failing"}`)
+		defer failingServer.Close()
+
+		bp := createBaseProcessorWithHedging(createEnabledRequestHedgingConfig(), &mock.NodesHealthHandlerStub{})
+		observers := []*data.NodeData{{Address: failingServer.URL}, {Address: failingServer.URL}}
+		response := &testStruct{}
+		observer, statusCode, err := bp.CallGetRestEndPointWithHedging(context.Background(), observers, "/address/erd1", response)
+		require.Error(t, err)
+		assert.Nil(t, observer)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+		assert.Equal(t, &testStruct{Nonce: 4, Name: "failing"}, response)
+	})
+}
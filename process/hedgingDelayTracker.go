@@ -0,0 +1,138 @@
+package process
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const maxDelayPercentile = 100
+
+type latencyWindow struct {
+	durations []time.Duration
+	nextIndex int
+}
+
+// hedgingDelayTracker keeps a sliding window with the latencies of the successful read-only requests, grouped by
+// the first segment of the observer path, and computes the delay after which a request should be hedged
+type hedgingDelayTracker struct {
+	mutWindows      sync.Mutex
+	windows         map[string]*latencyWindow
+	windowSize      int
+	minSamples      int
+	delayPercentile float64
+	minDelay        time.Duration
+	maxDelay        time.Duration
+}
+
+func newHedgingDelayTracker(cfg config.RequestHedgingConfig) (*hedgingDelayTracker, error) {
+	err := checkRequestHedgingConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hedgingDelayTracker{
+		windows:         make(map[string]*latencyWindow),
+		windowSize:      cfg.WindowSize,
+		minSamples:      cfg.MinSamples,
+		delayPercentile: cfg.DelayPercentile,
+		minDelay:        time.Duration(cfg.MinDelayMs) * time.Millisecond,
+		maxDelay:        time.Duration(cfg.MaxDelayMs) * time.Millisecond,
+	}, nil
+}
+
+func checkRequestHedgingConfig(cfg config.RequestHedgingConfig) error {
+	if cfg.DelayPercentile <= 0 || cfg.DelayPercentile > maxDelayPercentile {
+		return fmt.Errorf("%w: DelayPercentile should be in (0, %d], %f provided", ErrInvalidRequestHedgingConfig, maxDelayPercentile, cfg.DelayPercentile)
+	}
+	if cfg.MinDelayMs < 0 {
+		return fmt.Errorf("%w: MinDelayMs should not be negative, %d provided", ErrInvalidRequestHedgingConfig, cfg.MinDelayMs)
+	}
+	if cfg.MaxDelayMs <= 0 || cfg.MaxDelayMs < cfg.MinDelayMs {
+		return fmt.Errorf("%w: MaxDelayMs should be positive and not lower than MinDelayMs, %d provided", ErrInvalidRequestHedgingConfig, cfg.MaxDelayMs)
+	}
+	if cfg.WindowSize <= 0 {
+		return fmt.Errorf("%w: WindowSize should be positive, %d provided", ErrInvalidRequestHedgingConfig, cfg.WindowSize)
+	}
+	if cfg.MinSamples <= 0 || cfg.MinSamples > cfg.WindowSize {
+		return fmt.Errorf("%w: MinSamples should be in [1, WindowSize], %d provided", ErrInvalidRequestHedgingConfig, cfg.MinSamples)
+	}
+	if cfg.MaxHedgedRequests <= 0 {
+		return fmt.Errorf("%w: MaxHedgedRequests should be positive, %d provided", ErrInvalidRequestHedgingConfig, cfg.MaxHedgedRequests)
+	}
+
+	return nil
+}
+
+// addSample adds the latency of a successful request in the window of the provided path
+func (hdt *hedgingDelayTracker) addSample(path string, duration time.Duration) {
+	key := getHedgingKey(path)
+
+	hdt.mutWindows.Lock()
+	defer hdt.mutWindows.Unlock()
+
+	window, found := hdt.windows[key]
+	if !found {
+		window = &latencyWindow{
+			durations: make([]time.Duration, 0, hdt.windowSize),
+		}
+		hdt.windows[key] = window
+	}
+
+	if len(window.durations) < hdt.windowSize {
+		window.durations = append(window.durations, duration)
+		return
+	}
+
+	window.durations[window.nextIndex] = duration
+	window.nextIndex = (window.nextIndex + 1) % hdt.windowSize
+}
+
+// computeDelay returns the configured percentile of the latencies recorded for the provided path, bounded by the
+// minimum and maximum delays. The maximum delay is returned until enough samples are recorded
+func (hdt *hedgingDelayTracker) computeDelay(path string) time.Duration {
+	key := getHedgingKey(path)
+
+	hdt.mutWindows.Lock()
+	window, found := hdt.windows[key]
+	if !found || len(window.durations) < hdt.minSamples {
+		hdt.mutWindows.Unlock()
+		return hdt.maxDelay
+	}
+
+	durations := make([]time.Duration, len(window.durations))
+	copy(durations, window.durations)
+	hdt.mutWindows.Unlock()
+
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+
+	index := int(math.Ceil(hdt.delayPercentile/maxDelayPercentile*float64(len(durations)))) - 1
+	if index < 0 {
+		index = 0
+	}
+
+	delay := durations[index]
+	if delay < hdt.minDelay {
+		return hdt.minDelay
+	}
+	if delay > hdt.maxDelay {
+		return hdt.maxDelay
+	}
+
+	return delay
+}
+
+func getHedgingKey(path string) string {
+	segments := splitPathInSegments(path)
+	if len(segments) == 0 {
+		return ""
+	}
+
+	return segments[0]
+}
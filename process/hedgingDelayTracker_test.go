@@ -0,0 +1,123 @@
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestRequestHedgingConfig() config.RequestHedgingConfig {
+	return config.RequestHedgingConfig{
+		Enabled:           true,
+		DelayPercentile:   50,
+		MinDelayMs:        10,
+		MaxDelayMs:        100,
+		WindowSize:        4,
+		MinSamples:        2,
+		MaxHedgedRequests: 1,
+	}
+}
+
+func TestNewHedgingDelayTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidConfigs := make([]config.RequestHedgingConfig, 0)
+		cfg := createTestRequestHedgingConfig()
+		cfg.DelayPercentile = 0
+		invalidConfigs = append(invalidConfigs, cfg)
+
+		cfg = createTestRequestHedgingConfig()
+		cfg.DelayPercentile = 101
+		invalidConfigs = append(invalidConfigs, cfg)
+
+		cfg = createTestRequestHedgingConfig()
+		cfg.MinDelayMs = -1
+		invalidConfigs = append(invalidConfigs, cfg)
+
+		cfg = createTestRequestHedgingConfig()
+		cfg.MaxDelayMs = 5
+		invalidConfigs = append(invalidConfigs, cfg)
+
+		cfg = createTestRequestHedgingConfig()
+		cfg.WindowSize = 0
+		invalidConfigs = append(invalidConfigs, cfg)
+
+		cfg = createTestRequestHedgingConfig()
+		cfg.MinSamples = 5
+		invalidConfigs = append(invalidConfigs, cfg)
+
+		cfg = createTestRequestHedgingConfig()
+		cfg.MaxHedgedRequests = 0
+		invalidConfigs = append(invalidConfigs, cfg)
+
+		for _, invalidConfig := range invalidConfigs {
+			tracker, err := newHedgingDelayTracker(invalidConfig)
+			require.True(t, errors.Is(err, ErrInvalidRequestHedgingConfig))
+			require.Nil(t, tracker)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, err := newHedgingDelayTracker(createTestRequestHedgingConfig())
+		require.NoError(t, err)
+		require.NotNil(t, tracker)
+	})
+}
+
+func TestHedgingDelayTracker_ComputeDelay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not enough samples should return the max delay", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, _ := newHedgingDelayTracker(createTestRequestHedgingConfig())
+		require.Equal(t, 100*time.Millisecond, tracker.computeDelay("/address/erd1"))
+
+		tracker.addSample("/address/erd1", 20*time.Millisecond)
+		require.Equal(t, 100*time.Millisecond, tracker.computeDelay("/address/erd1"))
+	})
+	t.Run("should return the percentile of the path's window", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, _ := newHedgingDelayTracker(createTestRequestHedgingConfig())
+		tracker.addSample("/address/erd1", 40*time.Millisecond)
+		tracker.addSample("/address/erd2?onFinalBlock=true", 20*time.Millisecond)
+		tracker.addSample("/address/erd3", 30*time.Millisecond)
+		tracker.addSample("/transaction/hash", 90*time.Millisecond)
+
+		require.Equal(t, 30*time.Millisecond, tracker.computeDelay("/address/erd4"))
+		require.Equal(t, 100*time.Millisecond, tracker.computeDelay("/transaction/hash"))
+	})
+	t.Run("should clamp the delay", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, _ := newHedgingDelayTracker(createTestRequestHedgingConfig())
+		tracker.addSample("/address/erd1", time.Millisecond)
+		tracker.addSample("/address/erd1", 2*time.Millisecond)
+		require.Equal(t, 10*time.Millisecond, tracker.computeDelay("/address/erd1"))
+
+		tracker.addSample("/transaction/hash", time.Second)
+		tracker.addSample("/transaction/hash", 2*time.Second)
+		require.Equal(t, 100*time.Millisecond, tracker.computeDelay("/transaction/hash"))
+	})
+	t.Run("old samples should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, _ := newHedgingDelayTracker(createTestRequestHedgingConfig())
+		for i := 0; i < 4; i++ {
+			tracker.addSample("/address/erd1", 90*time.Millisecond)
+		}
+		for i := 0; i < 4; i++ {
+			tracker.addSample("/address/erd1", 15*time.Millisecond)
+		}
+
+		require.Equal(t, 15*time.Millisecond, tracker.computeDelay("/address/erd1"))
+	})
+}
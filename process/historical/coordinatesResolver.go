@@ -0,0 +1,362 @@
+package historical
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/historical")
+
+const genesisNonce = uint64(0)
+
+// ArgsCoordinatesResolver holds the arguments needed for creating a coordinates resolver
+type ArgsCoordinatesResolver struct {
+	BlockProvider         BlockProvider
+	NetworkStatusProvider NetworkStatusProvider
+	IndexedBlocksPerShard int
+}
+
+// blockSample holds the epoch and the timestamp of a final block, both growing along with the nonces
+type blockSample struct {
+	nonce     uint64
+	epoch     uint32
+	timestamp uint64
+}
+
+// coordinatesResolver resolves the timestamps and the epochs of the historical queries to final blocks, by binary
+// searching the blocks of the queried shard. The fetched blocks are kept in a per shard index, sorted by nonce, which
+// narrows the later searches, so that the repeated queries of the same moment need no block request at all
+type coordinatesResolver struct {
+	blockProvider         BlockProvider
+	networkStatusProvider NetworkStatusProvider
+	indexedBlocksPerShard int
+
+	mutIndex sync.RWMutex
+	index    map[uint32][]blockSample
+}
+
+// NewCoordinatesResolver returns a new instance of coordinatesResolver
+func NewCoordinatesResolver(args ArgsCoordinatesResolver) (*coordinatesResolver, error) {
+	if args.BlockProvider == nil {
+		return nil, ErrNilBlockProvider
+	}
+	if args.NetworkStatusProvider == nil {
+		return nil, ErrNilNetworkStatusProvider
+	}
+	if args.IndexedBlocksPerShard < 2 {
+		return nil, fmt.Errorf("%w: IndexedBlocksPerShard must be at least 2", ErrInvalidIndexedBlocksPerShard)
+	}
+
+	return &coordinatesResolver{
+		blockProvider:         args.BlockProvider,
+		networkStatusProvider: args.NetworkStatusProvider,
+		indexedBlocksPerShard: args.IndexedBlocksPerShard,
+		index:                 make(map[uint32][]blockSample),
+	}, nil
+}
+
+// Resolve returns the final block of the shard matching the coordinates: the last block produced at or before the
+// timestamp, otherwise the first or the last block of the epoch
+func (cr *coordinatesResolver) Resolve(ctx context.Context, shardID uint32, coordinates common.HistoricalCoordinates) (*data.BlockCoordinates, error) {
+	if !coordinates.IsSet() {
+		return nil, fmt.Errorf("%w: neither the timestamp nor the epoch is set", apiErrors.ErrNoBlockAtHistoricalCoordinates)
+	}
+
+	highestFinalNonce, err := cr.getHighestFinalNonce(ctx, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sample blockSample
+	switch {
+	case coordinates.Timestamp.HasValue:
+		sample, err = cr.resolveTimestamp(ctx, shardID, highestFinalNonce, coordinates.Timestamp.Value)
+	case coordinates.AtEpochStart:
+		sample, err = cr.resolveEpochStart(ctx, shardID, highestFinalNonce, coordinates.Epoch.Value)
+	default:
+		sample, err = cr.resolveEpochEnd(ctx, shardID, highestFinalNonce, coordinates.Epoch.Value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("resolved historical coordinates", "shard", shardID, "nonce", sample.nonce, "epoch", sample.epoch)
+
+	return &data.BlockCoordinates{
+		Nonce:     sample.nonce,
+		Epoch:     sample.epoch,
+		Timestamp: sample.timestamp,
+	}, nil
+}
+
+func (cr *coordinatesResolver) resolveTimestamp(ctx context.Context, shardID uint32, highestFinalNonce uint64, timestamp uint64) (blockSample, error) {
+	sample, found, err := cr.findLast(ctx, shardID, highestFinalNonce, timestampKey, timestamp)
+	if err != nil {
+		return blockSample{}, err
+	}
+	if !found {
+		return blockSample{}, fmt.Errorf("%w: the timestamp %d is before the genesis of shard %d",
+			apiErrors.ErrNoBlockAtHistoricalCoordinates, timestamp, shardID)
+	}
+
+	return sample, nil
+}
+
+// resolveEpochEnd returns the last final block of the epoch, which is the highest final block for the current epoch
+func (cr *coordinatesResolver) resolveEpochEnd(ctx context.Context, shardID uint32, highestFinalNonce uint64, epoch uint32) (blockSample, error) {
+	sample, found, err := cr.findLast(ctx, shardID, highestFinalNonce, epochKey, uint64(epoch))
+	if err != nil {
+		return blockSample{}, err
+	}
+	if !found || sample.epoch != epoch {
+		return blockSample{}, epochNotFoundError(shardID, epoch)
+	}
+
+	return sample, nil
+}
+
+// resolveEpochStart returns the first block of the epoch, following the last block of the previous epoch
+func (cr *coordinatesResolver) resolveEpochStart(ctx context.Context, shardID uint32, highestFinalNonce uint64, epoch uint32) (blockSample, error) {
+	firstNonce := genesisNonce
+	if epoch > 0 {
+		previous, found, err := cr.findLast(ctx, shardID, highestFinalNonce, epochKey, uint64(epoch-1))
+		if err != nil {
+			return blockSample{}, err
+		}
+		if found {
+			firstNonce = previous.nonce + 1
+		}
+	}
+	if firstNonce > highestFinalNonce {
+		return blockSample{}, epochNotFoundError(shardID, epoch)
+	}
+
+	sample, err := cr.getSample(ctx, shardID, firstNonce)
+	if err != nil {
+		return blockSample{}, err
+	}
+	if sample.epoch != epoch {
+		return blockSample{}, epochNotFoundError(shardID, epoch)
+	}
+
+	return sample, nil
+}
+
+func epochNotFoundError(shardID uint32, epoch uint32) error {
+	return fmt.Errorf("%w: shard %d has no final block in the epoch %d", apiErrors.ErrNoBlockAtHistoricalCoordinates, shardID, epoch)
+}
+
+func timestampKey(sample blockSample) uint64 {
+	return sample.timestamp
+}
+
+func epochKey(sample blockSample) uint64 {
+	return uint64(sample.epoch)
+}
+
+// findLast returns the final block with the highest nonce whose key is not greater than the target, along with
+// whether such a block exists. The keys must grow along with the nonces
+func (cr *coordinatesResolver) findLast(
+	ctx context.Context,
+	shardID uint32,
+	highestFinalNonce uint64,
+	key func(blockSample) uint64,
+	target uint64,
+) (blockSample, bool, error) {
+	lower, hasLower, upper, hasUpper := cr.indexBounds(shardID, highestFinalNonce, key, target)
+
+	var err error
+	if !hasLower {
+		lower, err = cr.getSample(ctx, shardID, genesisNonce)
+		if err != nil {
+			return blockSample{}, false, err
+		}
+		if key(lower) > target {
+			return blockSample{}, false, nil
+		}
+	}
+	if !hasUpper {
+		if lower.nonce == highestFinalNonce {
+			return lower, true, nil
+		}
+
+		upper, err = cr.getSample(ctx, shardID, highestFinalNonce)
+		if err != nil {
+			return blockSample{}, false, err
+		}
+		if key(upper) <= target {
+			return upper, true, nil
+		}
+	}
+
+	// invariant: key(lower) <= target < key(upper)
+	for upper.nonce-lower.nonce > 1 {
+		middle, errGet := cr.getSample(ctx, shardID, lower.nonce+(upper.nonce-lower.nonce)/2)
+		if errGet != nil {
+			return blockSample{}, false, errGet
+		}
+
+		if key(middle) <= target {
+			lower = middle
+		} else {
+			upper = middle
+		}
+	}
+
+	return lower, true, nil
+}
+
+// indexBounds returns the indexed blocks surrounding the target: the last one whose key is not greater than the
+// target and the first one whose key is greater
+func (cr *coordinatesResolver) indexBounds(
+	shardID uint32,
+	highestFinalNonce uint64,
+	key func(blockSample) uint64,
+	target uint64,
+) (blockSample, bool, blockSample, bool) {
+	cr.mutIndex.RLock()
+	defer cr.mutIndex.RUnlock()
+
+	samples := cr.index[shardID]
+	numFinal := sort.Search(len(samples), func(i int) bool {
+		return samples[i].nonce > highestFinalNonce
+	})
+	samples = samples[:numFinal]
+
+	upperIdx := sort.Search(len(samples), func(i int) bool {
+		return key(samples[i]) > target
+	})
+
+	var lower, upper blockSample
+	hasLower := upperIdx > 0
+	if hasLower {
+		lower = samples[upperIdx-1]
+	}
+	hasUpper := upperIdx < len(samples)
+	if hasUpper {
+		upper = samples[upperIdx]
+	}
+
+	return lower, hasLower, upper, hasUpper
+}
+
+func (cr *coordinatesResolver) getSample(ctx context.Context, shardID uint32, nonce uint64) (blockSample, error) {
+	sample, found := cr.getIndexedSample(shardID, nonce)
+	if found {
+		return sample, nil
+	}
+
+	response, err := cr.blockProvider.GetBlockByNonce(ctx, shardID, nonce, common.BlockQueryOptions{})
+	if err != nil {
+		return blockSample{}, err
+	}
+	if response == nil {
+		return blockSample{}, fmt.Errorf("nil response for the block with nonce %d of shard %d", nonce, shardID)
+	}
+
+	block := response.Data.Block
+	sample = blockSample{
+		nonce:     nonce,
+		epoch:     block.Epoch,
+		timestamp: uint64(block.Timestamp),
+	}
+	cr.addSample(shardID, sample)
+
+	return sample, nil
+}
+
+func (cr *coordinatesResolver) getIndexedSample(shardID uint32, nonce uint64) (blockSample, bool) {
+	cr.mutIndex.RLock()
+	defer cr.mutIndex.RUnlock()
+
+	samples := cr.index[shardID]
+	idx := sort.Search(len(samples), func(i int) bool {
+		return samples[i].nonce >= nonce
+	})
+	if idx < len(samples) && samples[idx].nonce == nonce {
+		return samples[idx], true
+	}
+
+	return blockSample{}, false
+}
+
+// addSample inserts the block into the index of the shard. When the index is full, every other block is dropped, so
+// that the index keeps covering the whole history, only less densely
+func (cr *coordinatesResolver) addSample(shardID uint32, sample blockSample) {
+	cr.mutIndex.Lock()
+	defer cr.mutIndex.Unlock()
+
+	samples := cr.index[shardID]
+	idx := sort.Search(len(samples), func(i int) bool {
+		return samples[i].nonce >= sample.nonce
+	})
+	if idx < len(samples) && samples[idx].nonce == sample.nonce {
+		return
+	}
+
+	samples = append(samples, blockSample{})
+	copy(samples[idx+1:], samples[idx:])
+	samples[idx] = sample
+
+	if len(samples) > cr.indexedBlocksPerShard {
+		samples = thinOut(samples)
+	}
+
+	cr.index[shardID] = samples
+}
+
+func thinOut(samples []blockSample) []blockSample {
+	thinned := make([]blockSample, 0, len(samples)/2+1)
+	for idx := 0; idx < len(samples); idx += 2 {
+		thinned = append(thinned, samples[idx])
+	}
+
+	return thinned
+}
+
+func (cr *coordinatesResolver) getHighestFinalNonce(ctx context.Context, shardID uint32) (uint64, error) {
+	response, err := cr.networkStatusProvider.GetNetworkStatusMetrics(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+	if response == nil {
+		return 0, ErrCannotReadHighestFinalNonce
+	}
+	if len(response.Error) > 0 {
+		return 0, errors.New(response.Error)
+	}
+
+	buff, err := json.Marshal(response.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	payload := struct {
+		Status struct {
+			HighestFinalNonce *uint64 `json:"erd_highest_final_nonce"`
+		} `json:"status"`
+	}{}
+	err = json.Unmarshal(buff, &payload)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrCannotReadHighestFinalNonce, err.Error())
+	}
+	if payload.Status.HighestFinalNonce == nil {
+		return 0, ErrCannotReadHighestFinalNonce
+	}
+
+	return *payload.Status.HighestFinalNonce, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *coordinatesResolver) IsInterfaceNil() bool {
+	return cr == nil
+}
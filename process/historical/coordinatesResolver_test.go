@@ -0,0 +1,327 @@
+package historical_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/historical"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHighestFinalNonce = uint64(100)
+	testGenesisTimestamp  = uint64(1000)
+	testRoundDuration     = uint64(6)
+	testBlocksPerEpoch    = uint64(10)
+)
+
+type coordinatesResolverHandler interface {
+	Resolve(ctx context.Context, shardID uint32, coordinates common.HistoricalCoordinates) (*data.BlockCoordinates, error)
+}
+
+// testChain simulates a shard producing a block every round, with epochs of testBlocksPerEpoch blocks
+type testChain struct {
+	numFetches int
+}
+
+func (tc *testChain) blockProvider() *mock.BlockProviderStub {
+	return &mock.BlockProviderStub{
+		GetBlockByNonceCalled: func(_ uint32, nonce uint64) (*data.BlockApiResponse, error) {
+			tc.numFetches++
+			if nonce > testHighestFinalNonce+1 {
+				return nil, errors.New("block not found")
+			}
+
+			return &data.BlockApiResponse{
+				Data: data.BlockApiResponsePayload{
+					Block: api.Block{
+						Nonce:     nonce,
+						Epoch:     uint32(nonce / testBlocksPerEpoch),
+						Timestamp: time.Duration(testGenesisTimestamp + nonce*testRoundDuration),
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func createNetworkStatusProvider(highestFinalNonce uint64) *mock.NetworkStatusProviderStub {
+	return &mock.NetworkStatusProviderStub{
+		GetNetworkStatusMetricsCalled: func(_ uint32) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"status": map[string]interface{}{
+						"erd_highest_final_nonce": highestFinalNonce,
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func createResolver(t *testing.T, chain *testChain, indexedBlocksPerShard int) coordinatesResolverHandler {
+	resolver, err := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+		BlockProvider:         chain.blockProvider(),
+		NetworkStatusProvider: createNetworkStatusProvider(testHighestFinalNonce),
+		IndexedBlocksPerShard: indexedBlocksPerShard,
+	})
+	require.Nil(t, err)
+
+	return resolver
+}
+
+func timestampCoordinates(timestamp uint64) common.HistoricalCoordinates {
+	return common.HistoricalCoordinates{
+		Timestamp: core.OptionalUint64{Value: timestamp, HasValue: true},
+	}
+}
+
+func epochCoordinates(epoch uint32, atEpochStart bool) common.HistoricalCoordinates {
+	return common.HistoricalCoordinates{
+		Epoch:        core.OptionalUint32{Value: epoch, HasValue: true},
+		AtEpochStart: atEpochStart,
+	}
+}
+
+func TestNewCoordinatesResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil block provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+			NetworkStatusProvider: &mock.NetworkStatusProviderStub{},
+			IndexedBlocksPerShard: 10,
+		})
+		require.Nil(t, resolver)
+		require.Equal(t, historical.ErrNilBlockProvider, err)
+	})
+	t.Run("nil network status provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+			BlockProvider:         &mock.BlockProviderStub{},
+			IndexedBlocksPerShard: 10,
+		})
+		require.Nil(t, resolver)
+		require.Equal(t, historical.ErrNilNetworkStatusProvider, err)
+	})
+	t.Run("invalid indexed blocks per shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+			BlockProvider:         &mock.BlockProviderStub{},
+			NetworkStatusProvider: &mock.NetworkStatusProviderStub{},
+			IndexedBlocksPerShard: 1,
+		})
+		require.Nil(t, resolver)
+		require.True(t, errors.Is(err, historical.ErrInvalidIndexedBlocksPerShard))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+			BlockProvider:         &mock.BlockProviderStub{},
+			NetworkStatusProvider: &mock.NetworkStatusProviderStub{},
+			IndexedBlocksPerShard: 2,
+		})
+		require.Nil(t, err)
+		require.False(t, resolver.IsInterfaceNil())
+	})
+}
+
+func TestCoordinatesResolver_ResolveErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unset coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, common.HistoricalCoordinates{})
+		require.Nil(t, coordinates)
+		require.True(t, errors.Is(err, apiErrors.ErrNoBlockAtHistoricalCoordinates))
+	})
+	t.Run("network status error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		resolver, _ := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+			BlockProvider: &mock.BlockProviderStub{},
+			NetworkStatusProvider: &mock.NetworkStatusProviderStub{
+				GetNetworkStatusMetricsCalled: func(_ uint32) (*data.GenericAPIResponse, error) {
+					return nil, expectedErr
+				},
+			},
+			IndexedBlocksPerShard: 10,
+		})
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(1100))
+		require.Nil(t, coordinates)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("missing highest final nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, _ := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+			BlockProvider:         &mock.BlockProviderStub{},
+			NetworkStatusProvider: &mock.NetworkStatusProviderStub{},
+			IndexedBlocksPerShard: 10,
+		})
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(1100))
+		require.Nil(t, coordinates)
+		require.Equal(t, historical.ErrCannotReadHighestFinalNonce, err)
+	})
+	t.Run("block provider error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		resolver, _ := historical.NewCoordinatesResolver(historical.ArgsCoordinatesResolver{
+			BlockProvider: &mock.BlockProviderStub{
+				GetBlockByNonceCalled: func(_ uint32, _ uint64) (*data.BlockApiResponse, error) {
+					return nil, expectedErr
+				},
+			},
+			NetworkStatusProvider: createNetworkStatusProvider(testHighestFinalNonce),
+			IndexedBlocksPerShard: 10,
+		})
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(1100))
+		require.Nil(t, coordinates)
+		require.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCoordinatesResolver_ResolveTimestamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("timestamp before genesis should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(testGenesisTimestamp-1))
+		require.Nil(t, coordinates)
+		require.True(t, errors.Is(err, apiErrors.ErrNoBlockAtHistoricalCoordinates))
+	})
+	t.Run("timestamp of a block should return that block", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(1222))
+		require.Nil(t, err)
+		require.Equal(t, &data.BlockCoordinates{Nonce: 37, Epoch: 3, Timestamp: 1222}, coordinates)
+	})
+	t.Run("timestamp between blocks should return the previous block", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(1227))
+		require.Nil(t, err)
+		require.Equal(t, uint64(37), coordinates.Nonce)
+	})
+	t.Run("timestamp of genesis should return genesis", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(testGenesisTimestamp))
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), coordinates.Nonce)
+	})
+	t.Run("future timestamp should return the highest final block", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(5000))
+		require.Nil(t, err)
+		require.Equal(t, testHighestFinalNonce, coordinates.Nonce)
+	})
+}
+
+func TestCoordinatesResolver_ResolveEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("end of a past epoch should return its last block", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, epochCoordinates(3, false))
+		require.Nil(t, err)
+		require.Equal(t, &data.BlockCoordinates{Nonce: 39, Epoch: 3, Timestamp: 1234}, coordinates)
+	})
+	t.Run("end of the current epoch should return the highest final block", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, epochCoordinates(10, false))
+		require.Nil(t, err)
+		require.Equal(t, testHighestFinalNonce, coordinates.Nonce)
+	})
+	t.Run("start of the genesis epoch should return genesis", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, epochCoordinates(0, true))
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), coordinates.Nonce)
+	})
+	t.Run("start of an epoch should return its first block", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, epochCoordinates(3, true))
+		require.Nil(t, err)
+		require.Equal(t, &data.BlockCoordinates{Nonce: 30, Epoch: 3, Timestamp: 1180}, coordinates)
+	})
+	t.Run("epoch not reached should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := createResolver(t, &testChain{}, 10)
+		coordinates, err := resolver.Resolve(context.Background(), 0, epochCoordinates(11, false))
+		require.Nil(t, coordinates)
+		require.True(t, errors.Is(err, apiErrors.ErrNoBlockAtHistoricalCoordinates))
+
+		coordinates, err = resolver.Resolve(context.Background(), 0, epochCoordinates(11, true))
+		require.Nil(t, coordinates)
+		require.True(t, errors.Is(err, apiErrors.ErrNoBlockAtHistoricalCoordinates))
+	})
+}
+
+func TestCoordinatesResolver_ResolveShouldReuseTheIndex(t *testing.T) {
+	t.Parallel()
+
+	chain := &testChain{}
+	resolver := createResolver(t, chain, 1000)
+
+	coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(1222))
+	require.Nil(t, err)
+	require.Equal(t, uint64(37), coordinates.Nonce)
+	require.True(t, chain.numFetches > 0)
+
+	chain.numFetches = 0
+	coordinates, err = resolver.Resolve(context.Background(), 0, timestampCoordinates(1222))
+	require.Nil(t, err)
+	require.Equal(t, uint64(37), coordinates.Nonce)
+	require.Zero(t, chain.numFetches)
+}
+
+func TestCoordinatesResolver_ResolveWithThinnedIndexShouldWork(t *testing.T) {
+	t.Parallel()
+
+	resolver := createResolver(t, &testChain{}, 2)
+
+	for nonce := uint64(0); nonce <= testHighestFinalNonce; nonce += 7 {
+		timestamp := testGenesisTimestamp + nonce*testRoundDuration
+		coordinates, err := resolver.Resolve(context.Background(), 0, timestampCoordinates(timestamp))
+		require.Nil(t, err)
+		require.Equal(t, nonce, coordinates.Nonce)
+	}
+
+	coordinates, err := resolver.Resolve(context.Background(), 0, epochCoordinates(5, true))
+	require.Nil(t, err)
+	require.Equal(t, uint64(50), coordinates.Nonce)
+}
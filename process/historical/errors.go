@@ -0,0 +1,16 @@
+package historical
+
+import "errors"
+
+// ErrNilBlockProvider signals that a nil block provider has been provided
+var ErrNilBlockProvider = errors.New("nil block provider")
+
+// ErrNilNetworkStatusProvider signals that a nil network status provider has been provided
+var ErrNilNetworkStatusProvider = errors.New("nil network status provider")
+
+// ErrInvalidIndexedBlocksPerShard signals that an invalid number of indexed blocks per shard has been provided
+var ErrInvalidIndexedBlocksPerShard = errors.New("invalid number of indexed blocks per shard")
+
+// ErrCannotReadHighestFinalNonce signals that the highest final nonce of a shard could not be read from its network
+// status metrics
+var ErrCannotReadHighestFinalNonce = errors.New("cannot read the highest final nonce from the network status metrics")
@@ -0,0 +1,19 @@
+package historical
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// BlockProvider defines a component able to fetch the blocks of a shard by their nonces
+type BlockProvider interface {
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+}
+
+// NetworkStatusProvider defines a component able to fetch the network status metrics of a shard, holding its highest
+// final block nonce
+type NetworkStatusProvider interface {
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
+}
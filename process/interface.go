@@ -1,7 +1,9 @@
 package process
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -22,8 +24,9 @@ type Processor interface {
 	GetAllFullHistoryNodes(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	ComputeShardId(addressBuff []byte) (uint32, error)
-	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointWithHedging(ctx context.Context, observers []*data.NodeData, path string, value interface{}) (*data.NodeData, int, error)
+	CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
 	GetShardCoordinator() common.Coordinator
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
@@ -57,9 +60,38 @@ type GenericApiResponseCacheHandler interface {
 	IsInterfaceNil() bool
 }
 
+// CacheUpdateLeaseHandler will define what a lease deciding which proxy refreshes a shared cache should do
+type CacheUpdateLeaseHandler interface {
+	TryAcquire() bool
+	IsInterfaceNil() bool
+}
+
+// ResponseCacheHandler will define what a keyed cache holding the responses fetched from the observers should do
+type ResponseCacheHandler interface {
+	Get(key string) (interface{}, bool)
+	Put(key string, value interface{}, ttl time.Duration)
+	IsInterfaceNil() bool
+}
+
+// ResponseTTLHandler will define what a component able to tell for how long a response can be cached should do
+type ResponseTTLHandler interface {
+	TTLForHyperblockNonce(ctx context.Context, nonce uint64) time.Duration
+	TTLForBlockNonce(ctx context.Context, shardID uint32, nonce uint64) time.Duration
+	NonFinalTTL() time.Duration
+	IsInterfaceNil() bool
+}
+
+// LatestFinalNonceHandler defines a component able to fetch the latest fully synchronized hyperblock nonce and the
+// latest final block nonce of a shard
+type LatestFinalNonceHandler interface {
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
+	GetLatestFinalBlockNonce(ctx context.Context, shardID uint32) (uint64, error)
+	IsInterfaceNil() bool
+}
+
 // TransactionCostHandler will define what a real transaction cost handler should do
 type TransactionCostHandler interface {
-	ResolveCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	ResolveCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
 }
 
 // LogsMergerHandler will define what a real merge logs handler should do
@@ -70,7 +102,14 @@ type LogsMergerHandler interface {
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	IsInterfaceNil() bool
+}
+
+// HistoricalCoordinatesResolver defines what a component resolving the timestamps and the epochs of the historical
+// queries to blocks should do
+type HistoricalCoordinatesResolver interface {
+	Resolve(ctx context.Context, shardID uint32, coordinates common.HistoricalCoordinates) (*data.BlockCoordinates, error)
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// BlockProviderStub -
+type BlockProviderStub struct {
+	GetBlockByNonceCalled func(shardID uint32, nonce uint64) (*data.BlockApiResponse, error)
+}
+
+// GetBlockByNonce -
+func (stub *BlockProviderStub) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if stub.GetBlockByNonceCalled != nil {
+		return stub.GetBlockByNonceCalled(shardID, nonce)
+	}
+
+	return &data.BlockApiResponse{}, nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+// CacheUpdateLeaseStub -
+type CacheUpdateLeaseStub struct {
+	TryAcquireCalled func() bool
+}
+
+// TryAcquire -
+func (stub *CacheUpdateLeaseStub) TryAcquire() bool {
+	if stub.TryAcquireCalled != nil {
+		return stub.TryAcquireCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *CacheUpdateLeaseStub) IsInterfaceNil() bool {
+	return stub == nil
+}
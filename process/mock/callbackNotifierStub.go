@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// CallbackNotifierStub -
+type CallbackNotifierStub struct {
+	NotifyCalled func(callbackURL string, payload *data.TransactionTrackingCallback) error
+}
+
+// Notify -
+func (stub *CallbackNotifierStub) Notify(_ context.Context, callbackURL string, payload *data.TransactionTrackingCallback) error {
+	if stub.NotifyCalled != nil {
+		return stub.NotifyCalled(callbackURL, payload)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *CallbackNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HistoricalCoordinatesResolverStub -
+type HistoricalCoordinatesResolverStub struct {
+	ResolveCalled func(shardID uint32, coordinates common.HistoricalCoordinates) (*data.BlockCoordinates, error)
+}
+
+// Resolve -
+func (stub *HistoricalCoordinatesResolverStub) Resolve(_ context.Context, shardID uint32, coordinates common.HistoricalCoordinates) (*data.BlockCoordinates, error) {
+	if stub.ResolveCalled != nil {
+		return stub.ResolveCalled(shardID, coordinates)
+	}
+
+	return &data.BlockCoordinates{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *HistoricalCoordinatesResolverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
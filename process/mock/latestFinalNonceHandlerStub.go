@@ -0,0 +1,32 @@
+package mock
+
+import "context"
+
+// LatestFinalNonceHandlerStub -
+type LatestFinalNonceHandlerStub struct {
+	GetLatestFullySynchronizedHyperblockNonceCalled func() (uint64, error)
+	GetLatestFinalBlockNonceCalled                  func(shardID uint32) (uint64, error)
+}
+
+// GetLatestFullySynchronizedHyperblockNonce -
+func (stub *LatestFinalNonceHandlerStub) GetLatestFullySynchronizedHyperblockNonce(_ context.Context) (uint64, error) {
+	if stub.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
+		return stub.GetLatestFullySynchronizedHyperblockNonceCalled()
+	}
+
+	return 0, nil
+}
+
+// GetLatestFinalBlockNonce -
+func (stub *LatestFinalNonceHandlerStub) GetLatestFinalBlockNonce(_ context.Context, shardID uint32) (uint64, error) {
+	if stub.GetLatestFinalBlockNonceCalled != nil {
+		return stub.GetLatestFinalBlockNonceCalled(shardID)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *LatestFinalNonceHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NetworkStatusProviderStub -
+type NetworkStatusProviderStub struct {
+	GetNetworkStatusMetricsCalled func(shardID uint32) (*data.GenericAPIResponse, error)
+}
+
+// GetNetworkStatusMetrics -
+func (stub *NetworkStatusProviderStub) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	if stub.GetNetworkStatusMetricsCalled != nil {
+		return stub.GetNetworkStatusMetricsCalled(shardID)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
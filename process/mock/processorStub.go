@@ -1,6 +1,9 @@
 package mock
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
@@ -10,6 +13,7 @@ import (
 )
 
 var errNotImplemented = errors.New("not implemented")
+var errUnexpectedStatusCode = errors.New("unexpected status code")
 
 type ProcessorStub struct {
 	ApplyConfigCalled                    func(cfg *config.Config) error
@@ -22,6 +26,7 @@ type ProcessorStub struct {
 	GetShardIDsCalled                    func() []uint32
 	ComputeShardIdCalled                 func(addressBuff []byte) (uint32, error)
 	CallGetRestEndPointCalled            func(address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointWithHedgingCalled func(observers []*data.NodeData, path string, value interface{}) (*data.NodeData, int, error)
 	CallPostRestEndPointCalled           func(address string, path string, data interface{}, response interface{}) (int, error)
 	GetShardCoordinatorCalled            func() common.Coordinator
 	GetPubKeyConverterCalled             func() core.PubkeyConverter
@@ -93,7 +98,7 @@ func (ps *ProcessorStub) ComputeShardId(addressBuff []byte) (uint32, error) {
 }
 
 // CallGetRestEndPoint will call the CallGetRestEndPointCalled if not nil
-func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value interface{}) (int, error) {
+func (ps *ProcessorStub) CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error) {
 	if ps.CallGetRestEndPointCalled != nil {
 		return ps.CallGetRestEndPointCalled(address, path, value)
 	}
@@ -101,8 +106,31 @@ func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value
 	return 0, errNotImplemented
 }
 
+// CallGetRestEndPointWithHedging will call the CallGetRestEndPointWithHedgingCalled if not nil, otherwise it will call
+// CallGetRestEndPoint for each observer until one of them succeeds
+func (ps *ProcessorStub) CallGetRestEndPointWithHedging(ctx context.Context, observers []*data.NodeData, path string, value interface{}) (*data.NodeData, int, error) {
+	if ps.CallGetRestEndPointWithHedgingCalled != nil {
+		return ps.CallGetRestEndPointWithHedgingCalled(observers, path, value)
+	}
+
+	statusCode, err := 0, errNotImplemented
+	for _, observer := range observers {
+		statusCode, err = ps.CallGetRestEndPoint(ctx, observer.Address, path, value)
+		// stubs not setting the status code are considered successful
+		isUnexpectedStatusCode := statusCode != 0 && statusCode != http.StatusOK
+		if err == nil && isUnexpectedStatusCode {
+			err = errUnexpectedStatusCode
+		}
+		if err == nil {
+			return observer, statusCode, nil
+		}
+	}
+
+	return nil, statusCode, err
+}
+
 // CallPostRestEndPoint will call the CallPostRestEndPoint if not nil
-func (ps *ProcessorStub) CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error) {
+func (ps *ProcessorStub) CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
 	if ps.CallPostRestEndPointCalled != nil {
 		return ps.CallPostRestEndPointCalled(address, path, data, response)
 	}
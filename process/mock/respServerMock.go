@@ -0,0 +1,319 @@
+package mock
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type respServerEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// RespServerMock is an in-process server speaking the subset of the Redis serialization protocol used by the proxy.
+// It supports the PING, AUTH, SELECT, GET, SET (with the NX, XX, PX and EX options), DEL and PEXPIRE commands
+type RespServerMock struct {
+	listener net.Listener
+	password string
+
+	mutData        sync.Mutex
+	entries        map[string]*respServerEntry
+	failCommands   bool
+	numConnections int
+	conns          map[net.Conn]struct{}
+	closed         bool
+	wg             sync.WaitGroup
+}
+
+// NewRespServerMock starts a new RespServerMock on a random local port. An empty password disables the authentication
+func NewRespServerMock(password string) (*RespServerMock, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	server := &RespServerMock{
+		listener: listener,
+		password: password,
+		entries:  make(map[string]*respServerEntry),
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	server.wg.Add(1)
+	go server.acceptConnections()
+
+	return server, nil
+}
+
+// Address returns the address the server listens on
+func (server *RespServerMock) Address() string {
+	return server.listener.Addr().String()
+}
+
+// SetFailCommands makes all the following data commands fail with an error reply
+func (server *RespServerMock) SetFailCommands(fail bool) {
+	server.mutData.Lock()
+	server.failCommands = fail
+	server.mutData.Unlock()
+}
+
+// NumConnections returns the number of connections accepted so far
+func (server *RespServerMock) NumConnections() int {
+	server.mutData.Lock()
+	defer server.mutData.Unlock()
+
+	return server.numConnections
+}
+
+// Close stops the server and closes all the opened connections
+func (server *RespServerMock) Close() error {
+	err := server.listener.Close()
+
+	server.mutData.Lock()
+	server.closed = true
+	for conn := range server.conns {
+		_ = conn.Close()
+	}
+	server.mutData.Unlock()
+
+	server.wg.Wait()
+
+	return err
+}
+
+func (server *RespServerMock) acceptConnections() {
+	defer server.wg.Done()
+
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		server.mutData.Lock()
+		if server.closed {
+			server.mutData.Unlock()
+			_ = conn.Close()
+			return
+		}
+		server.numConnections++
+		server.conns[conn] = struct{}{}
+		server.wg.Add(1)
+		server.mutData.Unlock()
+
+		go server.serveConnection(conn)
+	}
+}
+
+func (server *RespServerMock) serveConnection(conn net.Conn) {
+	defer func() {
+		server.mutData.Lock()
+		delete(server.conns, conn)
+		server.mutData.Unlock()
+
+		_ = conn.Close()
+		server.wg.Done()
+	}()
+
+	reader := bufio.NewReader(conn)
+	isAuthenticated := len(server.password) == 0
+	for {
+		args, err := readRespServerCommand(reader)
+		if err != nil {
+			return
+		}
+
+		var reply string
+		command := strings.ToUpper(args[0])
+		switch {
+		case command == "AUTH":
+			isAuthenticated = len(args) == 2 && args[1] == server.password
+			reply = "+OK\r\n"
+			if !isAuthenticated {
+				reply = "-WRONGPASS invalid password\r\n"
+			}
+		case !isAuthenticated:
+			reply = "-NOAUTH Authentication required.\r\n"
+		default:
+			reply = server.handleCommand(command, args[1:])
+		}
+
+		_, err = io.WriteString(conn, reply)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (server *RespServerMock) handleCommand(command string, args []string) string {
+	server.mutData.Lock()
+	defer server.mutData.Unlock()
+
+	switch command {
+	case "PING":
+		return "+PONG\r\n"
+	case "SELECT":
+		return "+OK\r\n"
+	}
+
+	if server.failCommands {
+		return "-ERR command failed\r\n"
+	}
+
+	switch command {
+	case "GET":
+		if len(args) != 1 {
+			return "-ERR wrong number of arguments\r\n"
+		}
+		entry, found := server.getEntry(args[0])
+		if !found {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(entry.value), entry.value)
+	case "SET":
+		return server.handleSet(args)
+	case "DEL":
+		numDeleted := 0
+		for _, key := range args {
+			_, found := server.getEntry(key)
+			if found {
+				delete(server.entries, key)
+				numDeleted++
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", numDeleted)
+	case "PEXPIRE":
+		if len(args) != 2 {
+			return "-ERR wrong number of arguments\r\n"
+		}
+		milliseconds, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return "-ERR value is not an integer or out of range\r\n"
+		}
+		entry, found := server.getEntry(args[0])
+		if !found {
+			return ":0\r\n"
+		}
+		entry.expiresAt = time.Now().Add(time.Duration(milliseconds) * time.Millisecond)
+		return ":1\r\n"
+	default:
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", command)
+	}
+}
+
+func (server *RespServerMock) handleSet(args []string) string {
+	if len(args) < 2 {
+		return "-ERR wrong number of arguments\r\n"
+	}
+
+	key, value := args[0], args[1]
+	onlyIfNotExists, onlyIfExists := false, false
+	var ttl time.Duration
+	for i := 2; i < len(args); i++ {
+		option := strings.ToUpper(args[i])
+		switch option {
+		case "NX":
+			onlyIfNotExists = true
+		case "XX":
+			onlyIfExists = true
+		case "PX", "EX":
+			if i+1 >= len(args) {
+				return "-ERR syntax error\r\n"
+			}
+			amount, err := strconv.ParseInt(args[i+1], 10, 64)
+			if err != nil || amount <= 0 {
+				return "-ERR invalid expire time in 'set' command\r\n"
+			}
+			ttl = time.Duration(amount) * time.Millisecond
+			if option == "EX" {
+				ttl = time.Duration(amount) * time.Second
+			}
+			i++
+		default:
+			return "-ERR syntax error\r\n"
+		}
+	}
+
+	_, exists := server.getEntry(key)
+	if (onlyIfNotExists && exists) || (onlyIfExists && !exists) {
+		return "$-1\r\n"
+	}
+
+	entry := &respServerEntry{
+		value: []byte(value),
+	}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	server.entries[key] = entry
+
+	return "+OK\r\n"
+}
+
+func (server *RespServerMock) getEntry(key string) (*respServerEntry, bool) {
+	entry, found := server.entries[key]
+	if !found {
+		return nil, false
+	}
+	if !entry.expiresAt.IsZero() && !time.Now().Before(entry.expiresAt) {
+		delete(server.entries, key)
+		return nil, false
+	}
+
+	return entry, true
+}
+
+func readRespServerCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := readRespServerLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[0] != '*' {
+		return nil, fmt.Errorf("unexpected command line %q", line)
+	}
+
+	numArgs, err := strconv.Atoi(line[1:])
+	if err != nil || numArgs < 1 {
+		return nil, fmt.Errorf("invalid number of arguments %q", line)
+	}
+
+	args := make([]string, 0, numArgs)
+	for i := 0; i < numArgs; i++ {
+		line, err = readRespServerLine(reader)
+		if err != nil {
+			return nil, err
+		}
+		if len(line) < 2 || line[0] != '$' {
+			return nil, fmt.Errorf("unexpected argument line %q", line)
+		}
+
+		length, errConv := strconv.Atoi(line[1:])
+		if errConv != nil || length < 0 {
+			return nil, fmt.Errorf("invalid argument length %q", line)
+		}
+
+		buff := make([]byte, length+2)
+		_, err = io.ReadFull(reader, buff)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(buff[:length]))
+	}
+
+	return args, nil
+}
+
+func readRespServerLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(line, "\r\n"), nil
+}
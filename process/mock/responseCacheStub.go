@@ -0,0 +1,30 @@
+package mock
+
+import "time"
+
+// ResponseCacheStub -
+type ResponseCacheStub struct {
+	GetCalled func(key string) (interface{}, bool)
+	PutCalled func(key string, value interface{}, ttl time.Duration)
+}
+
+// Get -
+func (stub *ResponseCacheStub) Get(key string) (interface{}, bool) {
+	if stub.GetCalled != nil {
+		return stub.GetCalled(key)
+	}
+
+	return nil, false
+}
+
+// Put -
+func (stub *ResponseCacheStub) Put(key string, value interface{}, ttl time.Duration) {
+	if stub.PutCalled != nil {
+		stub.PutCalled(key, value, ttl)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ResponseCacheStub) IsInterfaceNil() bool {
+	return stub == nil
+}
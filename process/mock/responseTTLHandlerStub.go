@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"context"
+	"time"
+)
+
+// ResponseTTLHandlerStub -
+type ResponseTTLHandlerStub struct {
+	TTLForHyperblockNonceCalled func(nonce uint64) time.Duration
+	TTLForBlockNonceCalled      func(shardID uint32, nonce uint64) time.Duration
+	NonFinalTTLCalled           func() time.Duration
+}
+
+// TTLForHyperblockNonce -
+func (stub *ResponseTTLHandlerStub) TTLForHyperblockNonce(_ context.Context, nonce uint64) time.Duration {
+	if stub.TTLForHyperblockNonceCalled != nil {
+		return stub.TTLForHyperblockNonceCalled(nonce)
+	}
+
+	return 0
+}
+
+// TTLForBlockNonce -
+func (stub *ResponseTTLHandlerStub) TTLForBlockNonce(_ context.Context, shardID uint32, nonce uint64) time.Duration {
+	if stub.TTLForBlockNonceCalled != nil {
+		return stub.TTLForBlockNonceCalled(shardID, nonce)
+	}
+
+	return 0
+}
+
+// NonFinalTTL -
+func (stub *ResponseTTLHandlerStub) NonFinalTTL() time.Duration {
+	if stub.NonFinalTTLCalled != nil {
+		return stub.NonFinalTTLCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *ResponseTTLHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionStatusProviderStub -
+type TransactionStatusProviderStub struct {
+	GetProcessedTransactionStatusCalled func(txHash string) (*data.ProcessStatusResponse, error)
+}
+
+// GetProcessedTransactionStatus -
+func (stub *TransactionStatusProviderStub) GetProcessedTransactionStatus(_ context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+	if stub.GetProcessedTransactionStatusCalled != nil {
+		return stub.GetProcessedTransactionStatusCalled(txHash)
+	}
+
+	return &data.ProcessStatusResponse{Status: string(data.TxStatusUnknown)}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"net/http"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -28,6 +29,8 @@ type NodeGroupProcessor struct {
 	proc                  Processor
 	cacher                HeartbeatCacheHandler
 	cacheValidityDuration time.Duration
+	mutCacheValidity      sync.RWMutex
+	cacheUpdateLease      CacheUpdateLeaseHandler
 	cancelFunc            func()
 }
 
@@ -36,6 +39,7 @@ func NewNodeGroupProcessor(
 	proc Processor,
 	cacher HeartbeatCacheHandler,
 	cacheValidityDuration time.Duration,
+	cacheUpdateLease CacheUpdateLeaseHandler,
 ) (*NodeGroupProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -46,17 +50,21 @@ func NewNodeGroupProcessor(
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
+	if check.IfNil(cacheUpdateLease) {
+		return nil, ErrNilCacheUpdateLease
+	}
 	ngp := &NodeGroupProcessor{
 		proc:                  proc,
 		cacher:                cacher,
 		cacheValidityDuration: cacheValidityDuration,
+		cacheUpdateLease:      cacheUpdateLease,
 	}
 
 	return ngp, nil
 }
 
 // IsOldStorageForToken returns true if the token is stored in the old fashion
-func (ngp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (ngp *NodeGroupProcessor) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
 	observers, err := ngp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return false, err
@@ -71,7 +79,7 @@ func (ngp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64
 
 		apiResponse := data.AccountKeyValueResponse{}
 		apiPath := addressPath + systemAccountAddress + "/key/" + tokenStorageKey
-		respCode, err := ngp.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ngp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account value for key request",
 				"address", systemAccountAddress,
@@ -108,7 +116,7 @@ func computeTokenStorageKey(tokenID string, nonce uint64) string {
 }
 
 // GetHeartbeatData will simply forward the heartbeat status from an observer
-func (ngp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (ngp *NodeGroupProcessor) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
 	heartbeatsToReturn, err := ngp.cacher.LoadHeartbeats()
 	if err == nil {
 		return heartbeatsToReturn, nil
@@ -116,10 +124,10 @@ func (ngp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, erro
 
 	log.Info("heartbeat: cannot get from cache. Will fetch from API", "error", err.Error())
 
-	return ngp.getHeartbeatsFromApi()
+	return ngp.getHeartbeatsFromApi(ctx)
 }
 
-func (ngp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse, error) {
+func (ngp *NodeGroupProcessor) getHeartbeatsFromApi(ctx context.Context) (*data.HeartbeatResponse, error) {
 	shardIDs := ngp.proc.GetShardIDs()
 
 	responseMap := make(map[string]data.PubKeyHeartbeat)
@@ -133,7 +141,7 @@ func (ngp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse,
 		errorsCount := 0
 		var response data.HeartbeatApiResponse
 		for _, observer := range observers {
-			_, err = ngp.proc.CallGetRestEndPoint(observer.Address, heartbeatPath, &response)
+			_, err = ngp.proc.CallGetRestEndPoint(ctx, observer.Address, heartbeatPath, &response)
 			heartbeats := response.Data.Heartbeats
 			if err == nil && len(heartbeats) > 0 {
 				ngp.addMessagesToMap(responseMap, heartbeats, shard)
@@ -209,17 +217,17 @@ func (ngp *NodeGroupProcessor) StartCacheUpdate() {
 	ctx, ngp.cancelFunc = context.WithCancel(context.Background())
 
 	go func(ctx context.Context) {
-		timer := time.NewTimer(ngp.cacheValidityDuration)
+		timer := time.NewTimer(ngp.getCacheValidityDuration())
 		defer timer.Stop()
 
-		ngp.handleHeartbeatCacheUpdate()
+		ngp.handleHeartbeatCacheUpdate(ctx)
 
 		for {
-			timer.Reset(ngp.cacheValidityDuration)
+			timer.Reset(ngp.getCacheValidityDuration())
 
 			select {
 			case <-timer.C:
-				ngp.handleHeartbeatCacheUpdate()
+				ngp.handleHeartbeatCacheUpdate(ctx)
 			case <-ctx.Done():
 				log.Debug("finishing NodeGroupProcessor cache update...")
 				return
@@ -228,8 +236,13 @@ func (ngp *NodeGroupProcessor) StartCacheUpdate() {
 	}(ctx)
 }
 
-func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate() {
-	hbts, err := ngp.getHeartbeatsFromApi()
+func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate(ctx context.Context) {
+	if !ngp.cacheUpdateLease.TryAcquire() {
+		log.Debug("heartbeat: cache is updated by another proxy")
+		return
+	}
+
+	hbts, err := ngp.getHeartbeatsFromApi(ctx)
 	if err != nil {
 		log.Warn("heartbeat: get from API", "error", err.Error())
 	}
@@ -243,7 +256,7 @@ func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate() {
 }
 
 // GetWaitingEpochsLeftForPublicKey returns the number of epochs left for the public key until it becomes eligible
-func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if len(publicKey) == 0 {
 		return nil, ErrEmptyPubKey
 	}
@@ -257,7 +270,7 @@ func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(publicKey string
 	responseWaitingEpochsLeft := data.WaitingEpochsLeftApiResponse{}
 	path := fmt.Sprintf(waitingEpochsLeftPath, publicKey)
 	for _, observer := range observers {
-		_, lastErr = ngp.proc.CallGetRestEndPoint(observer.Address, path, &responseWaitingEpochsLeft)
+		_, lastErr = ngp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &responseWaitingEpochsLeft)
 		if lastErr != nil {
 			log.Error("waiting epochs left request", "observer", observer.Address, "public key", publicKey, "error", lastErr.Error())
 			continue
@@ -271,6 +284,26 @@ func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(publicKey string
 	return nil, WrapObserversError(responseWaitingEpochsLeft.Error)
 }
 
+// SetCacheValidityDuration changes the period of the cache updates, starting with the next update
+func (ngp *NodeGroupProcessor) SetCacheValidityDuration(cacheValidityDuration time.Duration) error {
+	if cacheValidityDuration <= 0 {
+		return ErrInvalidCacheValidityDuration
+	}
+
+	ngp.mutCacheValidity.Lock()
+	ngp.cacheValidityDuration = cacheValidityDuration
+	ngp.mutCacheValidity.Unlock()
+
+	return nil
+}
+
+func (ngp *NodeGroupProcessor) getCacheValidityDuration() time.Duration {
+	ngp.mutCacheValidity.RLock()
+	defer ngp.mutCacheValidity.RUnlock()
+
+	return ngp.cacheValidityDuration
+}
+
 // Close will handle the closing of the cache update go routine
 func (ngp *NodeGroupProcessor) Close() error {
 	if ngp.cancelFunc != nil {
@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -12,6 +13,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,7 +23,7 @@ import (
 func TestNewNodeGroupProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewNodeGroupProcessor(nil, &mock.HeartbeatCacherMock{}, time.Second)
+	hp, err := process.NewNodeGroupProcessor(nil, &mock.HeartbeatCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -29,7 +32,7 @@ func TestNewNodeGroupProcessor_NilProcessorShouldErr(t *testing.T) {
 func TestNewNodeGroupProcessor_NilCacherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, nil, time.Second, &disabled.CacheUpdateLease{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilHeartbeatCacher, err)
@@ -38,16 +41,25 @@ func TestNewNodeGroupProcessor_NilCacherShouldErr(t *testing.T) {
 func TestNewNodeGroupProcessor_InvalidCacheValidityDurationShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, -time.Second)
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, -time.Second, &disabled.CacheUpdateLease{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrInvalidCacheValidityDuration, err)
 }
 
+func TestNewNodeGroupProcessor_NilCacheUpdateLeaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second, nil)
+
+	assert.Nil(t, hp)
+	assert.Equal(t, process.ErrNilCacheUpdateLease, err)
+}
+
 func TestNewNodeGroupProcessor_WithOkProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hbp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second)
+	hbp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
 
 	assert.NotNil(t, hbp)
 	assert.Nil(t, err)
@@ -56,10 +68,10 @@ func TestNewNodeGroupProcessor_WithOkProcessorShouldErr(t *testing.T) {
 func TestNodeGroupProcessor_GetHeartbeatDataWrongValuesShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second)
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -148,11 +160,12 @@ func TestNodeGroupProcessor_GetHeartbeatDataOkValuesShouldPass(t *testing.T) {
 	},
 		&mock.HeartbeatCacherMock{},
 		time.Second,
+		&disabled.CacheUpdateLease{},
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 
@@ -231,10 +244,11 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 		},
 		cacher,
 		time.Second,
+		&disabled.CacheUpdateLease{},
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetHeartbeatData()
+	_, err = hp.GetHeartbeatData(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -364,10 +378,11 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 		},
 		cacher,
 		time.Second,
+		&disabled.CacheUpdateLease{},
 	)
 	assert.Nil(t, err)
 
-	heartbeats, err := hp.GetHeartbeatData()
+	heartbeats, err := hp.GetHeartbeatData(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 	assert.Equal(t, expectedHeartbeats, heartbeats)
@@ -387,10 +402,10 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromCacher(t *testin
 		},
 	}
 	cacher := &mock.HeartbeatCacherMock{Data: &hbtsResp}
-	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond, &disabled.CacheUpdateLease{})
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, *res, hbtsResp)
@@ -422,7 +437,9 @@ func TestNodeGroupProcessor_CacheShouldUpdate(t *testing.T) {
 		},
 	},
 		cacher,
-		25*time.Millisecond)
+		25*time.Millisecond,
+		&disabled.CacheUpdateLease{},
+	)
 
 	assert.Nil(t, err)
 	hp.StartCacheUpdate()
@@ -442,6 +459,102 @@ func TestNodeGroupProcessor_CacheShouldUpdate(t *testing.T) {
 	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
 }
 
+func TestNodeGroupProcessor_CacheShouldNotUpdateWithoutLease(t *testing.T) {
+	t.Parallel()
+
+	numOfTimesHttpWasCalled := int32(0)
+	numOfTimesLeaseWasChecked := int32(0)
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0}
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: 0, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
+			return 0, nil
+		},
+	},
+		&mock.HeartbeatCacherMock{},
+		25*time.Millisecond,
+		&mock.CacheUpdateLeaseStub{
+			TryAcquireCalled: func() bool {
+				atomic.AddInt32(&numOfTimesLeaseWasChecked, 1)
+				return false
+			},
+		},
+	)
+	require.Nil(t, err)
+
+	hp.StartCacheUpdate()
+	time.Sleep(40 * time.Millisecond)
+	_ = hp.Close()
+
+	assert.Zero(t, atomic.LoadInt32(&numOfTimesHttpWasCalled))
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&numOfTimesLeaseWasChecked), int32(2))
+}
+
+func TestNodeGroupProcessor_SharedCacheShouldBeUpdatedByASingleProxy(t *testing.T) {
+	t.Parallel()
+
+	server, err := mock.NewRespServerMock("")
+	require.Nil(t, err)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	providedHeartbeats := []data.PubKeyHeartbeat{
+		{PublicKey: "pk01", ComputedShardID: 0, IsActive: true},
+	}
+	numOfTimesHttpWasCalled := int32(0)
+	createProxyNodeGroupProcessor := func(owner string) *process.NodeGroupProcessor {
+		store, _ := cache.NewRespClient(cache.ArgsRespClient{Address: server.Address()})
+		cacher, _ := cache.NewSharedStoreHeartbeatCacher(store, "heartbeats")
+		lease, _ := cache.NewSharedStoreLease(store, "heartbeats-lease", owner, time.Minute)
+
+		hp, _ := process.NewNodeGroupProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: 0, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
+				value.(*data.HeartbeatApiResponse).Data.Heartbeats = providedHeartbeats
+				return 0, nil
+			},
+		},
+			cacher,
+			20*time.Millisecond,
+			lease,
+		)
+
+		return hp
+	}
+
+	firstProc := createProxyNodeGroupProcessor("first")
+	secondProc := createProxyNodeGroupProcessor("second")
+
+	firstProc.StartCacheUpdate()
+	time.Sleep(10 * time.Millisecond)
+	secondProc.StartCacheUpdate()
+	time.Sleep(50 * time.Millisecond)
+	_ = firstProc.Close()
+	_ = secondProc.Close()
+
+	// only the first proxy refreshed the cache: once at start and then at each 20ms period
+	numCalls := atomic.LoadInt32(&numOfTimesHttpWasCalled)
+	assert.GreaterOrEqual(t, numCalls, int32(2))
+	assert.LessOrEqual(t, numCalls, int32(5))
+
+	response, err := secondProc.GetHeartbeatData(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, providedHeartbeats, response.Heartbeats)
+	assert.Equal(t, numCalls, atomic.LoadInt32(&numOfTimesHttpWasCalled))
+}
+
 func TestNodeGroupProcessor_NoDataForAShardShouldNotUpdateCache(t *testing.T) {
 	t.Parallel()
 
@@ -509,10 +622,11 @@ func TestNodeGroupProcessor_NoDataForAShardShouldNotUpdateCache(t *testing.T) {
 		},
 		cacher,
 		time.Second,
+		&disabled.CacheUpdateLease{},
 	)
 	assert.Nil(t, err)
 
-	messages, err := hp.GetHeartbeatData()
+	messages, err := hp.GetHeartbeatData(context.Background())
 	assert.Equal(t, process.ErrHeartbeatNotAvailable, err)
 	assert.Nil(t, messages)
 }
@@ -537,9 +651,10 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 			},
 			&mock.HeartbeatCacherMock{},
 			10,
+			&disabled.CacheUpdateLease{},
 		)
 
-		_, err := proc.IsOldStorageForToken("token", 37)
+		_, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 	})
 
@@ -563,9 +678,10 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 			},
 			&mock.HeartbeatCacherMock{},
 			10,
+			&disabled.CacheUpdateLease{},
 		)
 
-		_, err := proc.IsOldStorageForToken("token", 37)
+		_, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 	})
 
@@ -588,9 +704,10 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 			},
 			&mock.HeartbeatCacherMock{},
 			10,
+			&disabled.CacheUpdateLease{},
 		)
 
-		isOldStorage, err := proc.IsOldStorageForToken("token", 37)
+		isOldStorage, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.False(t, isOldStorage)
 		require.NoError(t, err)
 	})
@@ -614,9 +731,10 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 			},
 			&mock.HeartbeatCacherMock{},
 			10,
+			&disabled.CacheUpdateLease{},
 		)
 
-		isOldStorage, err := proc.IsOldStorageForToken("token", 37)
+		isOldStorage, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.True(t, isOldStorage)
 		require.NoError(t, err)
 	})
@@ -644,9 +762,10 @@ func TestNodeGroupProcessor_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			&mock.ProcessorStub{},
 			&mock.HeartbeatCacherMock{},
 			10,
+			&disabled.CacheUpdateLease{},
 		)
 
-		response, err := proc.GetWaitingEpochsLeftForPublicKey("")
+		response, err := proc.GetWaitingEpochsLeftForPublicKey(context.Background(), "")
 		require.Nil(t, response)
 		require.Equal(t, process.ErrEmptyPubKey, err)
 	})
@@ -661,9 +780,10 @@ func TestNodeGroupProcessor_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			},
 			&mock.HeartbeatCacherMock{},
 			10,
+			&disabled.CacheUpdateLease{},
 		)
 
-		response, err := proc.GetWaitingEpochsLeftForPublicKey("key")
+		response, err := proc.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 		require.Nil(t, response)
 		require.Equal(t, expectedErr, err)
 	})
@@ -684,9 +804,10 @@ func TestNodeGroupProcessor_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			},
 			&mock.HeartbeatCacherMock{},
 			10,
+			&disabled.CacheUpdateLease{},
 		)
 
-		response, err := proc.GetWaitingEpochsLeftForPublicKey("key")
+		response, err := proc.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 		require.Nil(t, response)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 	})
@@ -710,9 +831,10 @@ func TestNodeGroupProcessor_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			},
 			&mock.HeartbeatCacherMock{},
 			10,
+			&disabled.CacheUpdateLease{},
 		)
 
-		response, err := proc.GetWaitingEpochsLeftForPublicKey("key")
+		response, err := proc.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 		require.NoError(t, err)
 		require.Equal(t, providedEpochsLeft, response.Data.EpochsLeft)
 	})
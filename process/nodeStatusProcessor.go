@@ -1,11 +1,13 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -55,6 +57,11 @@ const (
 
 	// MetricNonce is the metric for monitoring the nonce of a node
 	MetricNonce = "erd_nonce"
+
+	// MetricHighestFinalNonce is the metric for monitoring the highest final block nonce of the shard of a node
+	MetricHighestFinalNonce = "erd_highest_final_nonce"
+
+	enableEpochsCacheKey = "enable-epochs"
 )
 
 // NodeStatusProcessor handles the action needed for fetching data related to status metrics from nodes
@@ -62,6 +69,10 @@ type NodeStatusProcessor struct {
 	proc                  Processor
 	economicMetricsCacher GenericApiResponseCacheHandler
 	cacheValidityDuration time.Duration
+	mutCacheValidity      sync.RWMutex
+	responseCache         ResponseCacheHandler
+	staticDataTTL         time.Duration
+	cacheUpdateLease      CacheUpdateLeaseHandler
 	cancelFunc            func()
 }
 
@@ -70,6 +81,9 @@ func NewNodeStatusProcessor(
 	processor Processor,
 	economicMetricsCacher GenericApiResponseCacheHandler,
 	cacheValidityDuration time.Duration,
+	responseCache ResponseCacheHandler,
+	staticDataTTL time.Duration,
+	cacheUpdateLease CacheUpdateLeaseHandler,
 ) (*NodeStatusProcessor, error) {
 	if check.IfNil(processor) {
 		return nil, ErrNilCoreProcessor
@@ -80,16 +94,28 @@ func NewNodeStatusProcessor(
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
+	if check.IfNil(responseCache) {
+		return nil, ErrNilResponseCache
+	}
+	if staticDataTTL < 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponseTTL, staticDataTTL)
+	}
+	if check.IfNil(cacheUpdateLease) {
+		return nil, ErrNilCacheUpdateLease
+	}
 
 	return &NodeStatusProcessor{
 		proc:                  processor,
 		economicMetricsCacher: economicMetricsCacher,
 		cacheValidityDuration: cacheValidityDuration,
+		responseCache:         responseCache,
+		staticDataTTL:         staticDataTTL,
+		cacheUpdateLease:      cacheUpdateLease,
 	}, nil
 }
 
 // GetNetworkStatusMetrics will simply forward the network status metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -98,7 +124,7 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, NetworkStatusPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -113,7 +139,7 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 }
 
 // GetNetworkConfigMetrics will simply forward the network config metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -122,7 +148,7 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, NetworkConfigPath, &responseNetworkMetrics)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkConfigPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -137,7 +163,23 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 }
 
 // GetEnableEpochsMetrics will simply forward the activation epochs config metrics from an observer
-func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	cachedResponse, found := nsp.responseCache.Get(enableEpochsCacheKey)
+	if found {
+		return cachedResponse.(*data.GenericAPIResponse), nil
+	}
+
+	response, err := nsp.getEnableEpochsMetricsFromApi(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nsp.responseCache.Put(enableEpochsCacheKey, response, nsp.staticDataTTL)
+
+	return response, nil
+}
+
+func (nsp *NodeStatusProcessor) getEnableEpochsMetricsFromApi(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -146,7 +188,7 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 	responseEnableEpochsMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, EnableEpochsPath, &responseEnableEpochsMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, EnableEpochsPath, &responseEnableEpochsMetrics)
 		if err != nil {
 			log.Error("enable epochs metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -160,7 +202,7 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 }
 
 // GetAllIssuedESDTs will forward the issued ESDTs based on the provided type
-func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if !data.IsValidEsdtPath(tokenType) && tokenType != "" {
 		return nil, ErrInvalidTokenType
 	}
@@ -177,7 +219,7 @@ func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.Gener
 		if tokenType != "" {
 			path = fmt.Sprintf("%s/%s", NetworkEsdtTokensPrefix, tokenType)
 		}
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, path, &responseAllIssuedESDTs)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &responseAllIssuedESDTs)
 		if err != nil {
 			log.Error("all issued esdts request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -192,7 +234,7 @@ func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.Gener
 }
 
 // GetDelegatedInfo returns the delegated info from nodes
-func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -201,7 +243,7 @@ func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, er
 	delegatedInfoResponse := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, DelegatedInfoPath, &delegatedInfoResponse)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, DelegatedInfoPath, &delegatedInfoResponse)
 		if err != nil {
 			log.Error("network delegated info request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -216,7 +258,7 @@ func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, er
 }
 
 // GetDirectStakedInfo returns the delegated info from nodes
-func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -225,7 +267,7 @@ func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse,
 	directStakedResponse := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, DirectStakedPath, &directStakedResponse)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, DirectStakedPath, &directStakedResponse)
 		if err != nil {
 			log.Error("network direct staked request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -240,7 +282,7 @@ func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse,
 }
 
 // GetRatingsConfig will simply forward the ratings configuration from an observer
-func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -249,7 +291,7 @@ func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, er
 	responseRatingsConfig := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, RatingsConfigPath, &responseRatingsConfig)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, RatingsConfigPath, &responseRatingsConfig)
 		if err != nil {
 			log.Error("ratings metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -263,7 +305,7 @@ func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, er
 	return nil, WrapObserversError(responseRatingsConfig.Error)
 }
 
-func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getNodeStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -272,7 +314,7 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, NodeStatusPath, &responseNetworkMetrics)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NodeStatusPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("node status metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -287,7 +329,7 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 }
 
 // GetLatestFullySynchronizedHyperblockNonce will compute nonce of the latest hyperblock that can be returned
-func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
 	shardsIDs, err := nsp.getShardsIDs()
 	if err != nil {
 		return 0, err
@@ -295,7 +337,7 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 
 	nonces := make([]uint64, 0)
 	for shardID := range shardsIDs {
-		nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+		nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
 		if err != nil {
 			return 0, err
 		}
@@ -321,9 +363,27 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 	return getMinNonce(nonces), nil
 }
 
+// GetLatestFinalBlockNonce returns the nonce of the highest final block of the shard
+func (nsp *NodeStatusProcessor) GetLatestFinalBlockNonce(ctx context.Context, shardID uint32) (uint64, error) {
+	nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+	if nodeStatusResponse.Error != "" {
+		return 0, errors.New(nodeStatusResponse.Error)
+	}
+
+	metric, ok := getMetric(nodeStatusResponse.Data, MetricHighestFinalNonce)
+	if !ok {
+		return 0, ErrCannotParseNodeStatusMetrics
+	}
+
+	return getUint(metric), nil
+}
+
 // GetTriesStatistics will return trie statistics
-func (nsp *NodeStatusProcessor) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
-	nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+func (nsp *NodeStatusProcessor) GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+	nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +505,7 @@ func getUint(value interface{}) uint64 {
 }
 
 // GetGenesisNodesPubKeys will return genesis nodes public keys
-func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -454,7 +514,7 @@ func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIRespon
 	response := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, GenesisNodesConfigPath, &response)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, GenesisNodesConfigPath, &response)
 		if err != nil {
 			log.Error("genesis nodes request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -469,7 +529,7 @@ func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIRespon
 }
 
 // GetGasConfigs will return gas configs
-func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -478,7 +538,7 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 	responseGenesisNodesConfig := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, GasConfigsPath, &responseGenesisNodesConfig)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, GasConfigsPath, &responseGenesisNodesConfig)
 		if err != nil {
 			log.Error("gas configs request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -493,7 +553,7 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 }
 
 // GetEpochStartData will return the epoch-start data for the given epoch and shard
-func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -503,7 +563,7 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 	path := fmt.Sprintf("/node/epoch-start/%d", epoch)
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, path, &responseEpochStartData)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &responseEpochStartData)
 		if err != nil {
 			log.Error("epoch start data request", "observer", observer.Address, "shard ID", observer.ShardId, "error", err)
 			continue
@@ -515,3 +575,8 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 
 	return nil, WrapObserversError(responseEpochStartData.Error)
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsp *NodeStatusProcessor) IsInterfaceNil() bool {
+	return nsp == nil
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +18,7 @@ import (
 func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.GenericApiResponseCacherMock{}, time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.GenericApiResponseCacherMock{}, time.Second, &disabled.ResponseCache{}, time.Minute, &disabled.CacheUpdateLease{})
 
 	require.Equal(t, ErrNilCoreProcessor, err)
 	require.Nil(t, nodeStatusProc)
@@ -24,7 +27,7 @@ func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 func TestNewNodeStatusProcessor_NilCacher(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, time.Second, &disabled.ResponseCache{}, time.Minute, &disabled.CacheUpdateLease{})
 
 	require.Equal(t, ErrNilEconomicMetricsCacher, err)
 	require.Nil(t, nodeStatusProc)
@@ -33,12 +36,39 @@ func TestNewNodeStatusProcessor_NilCacher(t *testing.T) {
 func TestNewNodeStatusProcessor_InvalidCacheValidityDuration(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, -1*time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, -1*time.Second, &disabled.ResponseCache{}, time.Minute, &disabled.CacheUpdateLease{})
 
 	require.Equal(t, ErrInvalidCacheValidityDuration, err)
 	require.Nil(t, nodeStatusProc)
 }
 
+func TestNewNodeStatusProcessor_NilResponseCache(t *testing.T) {
+	t.Parallel()
+
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, time.Second, nil, time.Minute, &disabled.CacheUpdateLease{})
+
+	require.Equal(t, ErrNilResponseCache, err)
+	require.Nil(t, nodeStatusProc)
+}
+
+func TestNewNodeStatusProcessor_InvalidStaticDataTTL(t *testing.T) {
+	t.Parallel()
+
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, time.Second, &disabled.ResponseCache{}, -1*time.Second, &disabled.CacheUpdateLease{})
+
+	require.True(t, errors.Is(err, ErrInvalidResponseTTL))
+	require.Nil(t, nodeStatusProc)
+}
+
+func TestNewNodeStatusProcessor_NilCacheUpdateLease(t *testing.T) {
+	t.Parallel()
+
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, time.Second, &disabled.ResponseCache{}, time.Minute, nil)
+
+	require.Equal(t, ErrNilCacheUpdateLease, err)
+	require.Nil(t, nodeStatusProc)
+}
+
 func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T) {
 	t.Parallel()
 
@@ -55,9 +85,12 @@ func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetNetworkConfigMetrics()
+	status, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -83,9 +116,12 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics()
+	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -109,9 +145,12 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetObserversFailedShouldErr(t *tes
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -132,9 +171,12 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -160,9 +202,12 @@ func TestNodeStatusProcessor_GetNetworkMetrics(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -221,13 +266,78 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, uint64(122), nonce)
 }
 
+func TestNodeStatusProcessor_GetLatestFinalBlockNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the highest final nonce of the shard", func(t *testing.T) {
+		t.Parallel()
+
+		nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				require.Equal(t, uint32(1), shardId)
+				return []*data.NodeData{{Address: "address1", ShardId: 1}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				genericResp := &data.GenericAPIResponse{Data: map[string]interface{}{
+					"metrics": map[string]interface{}{
+						"erd_nonce":               125,
+						"erd_highest_final_nonce": 123,
+					},
+				}}
+				genRespBytes, _ := json.Marshal(genericResp)
+
+				return 0, json.Unmarshal(genRespBytes, value)
+			},
+		},
+			&mock.GenericApiResponseCacherMock{},
+			time.Nanosecond,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
+		)
+
+		nonce, err := nodeStatusProc.GetLatestFinalBlockNonce(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(123), nonce)
+	})
+	t.Run("missing metric should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "address1", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				genericResp := &data.GenericAPIResponse{Data: map[string]interface{}{
+					"metrics": map[string]interface{}{"erd_nonce": 125},
+				}}
+				genRespBytes, _ := json.Marshal(genericResp)
+
+				return 0, json.Unmarshal(genRespBytes, value)
+			},
+		},
+			&mock.GenericApiResponseCacherMock{},
+			time.Nanosecond,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
+		)
+
+		_, err := nodeStatusProc.GetLatestFinalBlockNonce(context.Background(), 0)
+		require.Equal(t, ErrCannotParseNodeStatusMetrics, err)
+	})
+}
+
 func TestNodeStatusProcessor_GetAllIssuedEDTsGetObserversFailedShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -239,9 +349,12 @@ func TestNodeStatusProcessor_GetAllIssuedEDTsGetObserversFailedShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetAllIssuedESDTs("")
+	status, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -262,9 +375,12 @@ func TestNodeStatusProcessor_GetAllIssuedESDTsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetAllIssuedESDTs("")
+	status, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -288,9 +404,12 @@ func TestNodeStatusProcessor_GetAllIssuedESDTs(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs("")
+	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -325,9 +444,12 @@ func TestNodeStatusProcessor_ApiPathIsCorrect(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	_, err := nodeStatusProc.GetAllIssuedESDTs(data.SemiFungibleTokens)
+	_, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), data.SemiFungibleTokens)
 	require.Nil(t, err)
 }
 
@@ -342,9 +464,12 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetObserversFailedShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetDelegatedInfo()
+	status, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -365,9 +490,12 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetDelegatedInfo()
+	status, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -390,9 +518,12 @@ func TestNodeStatusProcessor_GetDelegatedInfo(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	actualResponse, err := nodeStatusProc.GetDelegatedInfo()
+	actualResponse, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -408,9 +539,12 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetObserversFailedShouldErr(t *t
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetDirectStakedInfo()
+	status, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -431,9 +565,12 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetRestEndPointError(t *testing.
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetDirectStakedInfo()
+	status, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -456,9 +593,12 @@ func TestNodeStatusProcessor_GetDirectStakedInfo(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	actualResponse, err := nodeStatusProc.GetDirectStakedInfo()
+	actualResponse, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -479,9 +619,12 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetEndpointErr(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodesStatusProc.GetEnableEpochsMetrics()
+	status, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -509,9 +652,12 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsShouldWork(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics()
+	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -523,6 +669,40 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsShouldWork(t *testing.T) {
 	require.Equal(t, expectedValue, actualValue)
 }
 
+func TestNodeStatusProcessor_GetEnableEpochsMetricsShouldUseTheResponseCache(t *testing.T) {
+	t.Parallel()
+
+	responseCache, _ := cache.NewResponseCache(10)
+	numCalls := 0
+	nodesStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{
+		GetAllObserversCalled: func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{
+				{Address: "addr1", ShardId: 0},
+			}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			numCalls++
+			value.(*data.GenericAPIResponse).Data = "enable epochs"
+
+			return 0, nil
+		},
+	},
+		&mock.GenericApiResponseCacherMock{},
+		time.Nanosecond,
+		responseCache,
+		time.Minute,
+		&disabled.CacheUpdateLease{},
+	)
+
+	firstResponse, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
+	require.Nil(t, err)
+	secondResponse, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
+	require.Nil(t, err)
+
+	require.Equal(t, 1, numCalls)
+	require.Equal(t, firstResponse, secondResponse)
+}
+
 func TestNodeStatusProcessor_GetEnableEpochsMetricsGetObserversShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -534,9 +714,12 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetObserversShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetEnableEpochsMetrics()
+	status, err := nodeStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -552,9 +735,12 @@ func TestNodeStatusProcessor_GetRatingsConfigGetAllObserversShouldFail(t *testin
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	status, err := nodeStatusProc.GetRatingsConfig()
+	status, err := nodeStatusProc.GetRatingsConfig(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -577,9 +763,12 @@ func TestNodeStatusProcessor_GetRatingsConfig(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	actualResponse, err := nodeStatusProc.GetRatingsConfig()
+	actualResponse, err := nodeStatusProc.GetRatingsConfig(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -602,9 +791,12 @@ func TestNodeStatusProcessor_GetGenesisNodesPubKeys(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.ResponseCache{},
+		time.Minute,
+		&disabled.CacheUpdateLease{},
 	)
 
-	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys()
+	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -627,9 +819,12 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
 		)
 
-		actualResponse, err := nodeStatusProc.GetGasConfigs()
+		actualResponse, err := nodeStatusProc.GetGasConfigs(context.Background())
 		require.Nil(t, actualResponse)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -652,9 +847,12 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
 		)
 
-		actualResponse, err := nodeStatusProc.GetGasConfigs()
+		actualResponse, err := nodeStatusProc.GetGasConfigs(context.Background())
 		require.Nil(t, err)
 		require.Equal(t, expectedResp, actualResponse)
 	})
@@ -678,9 +876,12 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Second,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, response)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -706,9 +907,12 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Second,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, response)
 		require.Equal(t, ErrCannotParseNodeStatusMetrics, err)
 	})
@@ -737,9 +941,12 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, err)
 		require.Equal(t, providedNumNodes, response.Data.AccountsSnapshotNumNodes)
 	})
@@ -763,9 +970,12 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
 		)
 
-		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
+		actualResponse, err := nodeStatusProc.GetEpochStartData(context.Background(), 0, 0)
 		require.Nil(t, actualResponse)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -788,9 +998,12 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.ResponseCache{},
+			time.Minute,
+			&disabled.CacheUpdateLease{},
 		)
 
-		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
+		actualResponse, err := nodeStatusProc.GetEpochStartData(context.Background(), 0, 0)
 		require.Nil(t, err)
 		require.Equal(t, expectedResp, actualResponse)
 	})
@@ -0,0 +1,21 @@
+package nonces
+
+import "errors"
+
+// ErrNilAccountNonceProvider signals that a nil account nonce provider has been provided
+var ErrNilAccountNonceProvider = errors.New("nil account nonce provider")
+
+// ErrNilPoolNonceProvider signals that a nil pool nonce provider has been provided
+var ErrNilPoolNonceProvider = errors.New("nil pool nonce provider")
+
+// ErrInvalidReservationTTL signals that an invalid nonce reservation TTL has been provided
+var ErrInvalidReservationTTL = errors.New("invalid nonce reservation TTL")
+
+// ErrInvalidMaxReservationsPerSender signals that an invalid maximum number of reservations per sender has been provided
+var ErrInvalidMaxReservationsPerSender = errors.New("invalid maximum number of nonce reservations per sender")
+
+// ErrInvalidMaxSenders signals that an invalid maximum number of senders has been provided
+var ErrInvalidMaxSenders = errors.New("invalid maximum number of senders with nonce reservations")
+
+// ErrNilTransactionsPoolProvider signals that a nil transactions pool provider has been provided
+var ErrNilTransactionsPoolProvider = errors.New("nil transactions pool provider")
@@ -0,0 +1,23 @@
+package nonces
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// AccountNonceProvider defines a component able to fetch an account, holding its current nonce
+type AccountNonceProvider interface {
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// PoolNonceProvider defines a component able to fetch the last nonce of the sender's transactions from the pool
+type PoolNonceProvider interface {
+	GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error)
+}
+
+// TransactionsPoolProvider defines a component able to fetch the sender's transactions from the pool
+type TransactionsPoolProvider interface {
+	GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error)
+}
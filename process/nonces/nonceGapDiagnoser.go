@@ -0,0 +1,176 @@
+package nonces
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	poolTransactionFields = "hash,nonce"
+	hashField             = "hash"
+	nonceField            = "nonce"
+)
+
+// ArgsNonceGapDiagnoser holds the arguments needed for creating a nonce gap diagnoser
+type ArgsNonceGapDiagnoser struct {
+	AccountProvider AccountNonceProvider
+	PoolProvider    TransactionsPoolProvider
+}
+
+// nonceGapDiagnoser compares the transactions of a sender from the pool against its account nonce, in order to find
+// out why they are not executed
+type nonceGapDiagnoser struct {
+	accountProvider AccountNonceProvider
+	poolProvider    TransactionsPoolProvider
+}
+
+// NewNonceGapDiagnoser returns a new instance of nonceGapDiagnoser
+func NewNonceGapDiagnoser(args ArgsNonceGapDiagnoser) (*nonceGapDiagnoser, error) {
+	if args.AccountProvider == nil {
+		return nil, ErrNilAccountNonceProvider
+	}
+	if args.PoolProvider == nil {
+		return nil, ErrNilTransactionsPoolProvider
+	}
+
+	return &nonceGapDiagnoser{
+		accountProvider: args.AccountProvider,
+		poolProvider:    args.PoolProvider,
+	}, nil
+}
+
+// Diagnose returns the missing nonces of the sender, along with the transactions from the pool split by whether they
+// can be executed, are stuck behind a missing nonce or will never execute
+func (ngd *nonceGapDiagnoser) Diagnose(ctx context.Context, sender string) (*data.SenderNonceDiagnosis, error) {
+	account, err := ngd.accountProvider.GetAccount(ctx, sender, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	txPool, err := ngd.poolProvider.GetTransactionsPoolForSender(ctx, sender, poolTransactionFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return diagnose(sender, account.Account.Nonce, txPool.Transactions), nil
+}
+
+func diagnose(sender string, accountNonce uint64, wrappedTxs []data.WrappedTransaction) *data.SenderNonceDiagnosis {
+	diagnosis := &data.SenderNonceDiagnosis{
+		Address:                sender,
+		AccountNonce:           accountNonce,
+		MissingNonces:          make([]data.NonceGap, 0),
+		ExecutableTransactions: make([]data.PoolTransaction, 0),
+		StuckTransactions:      make([]data.PoolTransaction, 0),
+		StaleTransactions:      make([]data.PoolTransaction, 0),
+	}
+
+	poolTxs := make([]data.PoolTransaction, 0, len(wrappedTxs))
+	for _, wrappedTx := range wrappedTxs {
+		poolTx, ok := toPoolTransaction(wrappedTx)
+		if !ok {
+			log.Debug("cannot read the nonce of a transaction from the pool", "sender", sender)
+			continue
+		}
+		poolTxs = append(poolTxs, poolTx)
+	}
+	sort.SliceStable(poolTxs, func(i, j int) bool {
+		return poolTxs[i].Nonce < poolTxs[j].Nonce
+	})
+
+	expectedNonce := accountNonce
+	for _, poolTx := range poolTxs {
+		if poolTx.Nonce < accountNonce {
+			diagnosis.StaleTransactions = append(diagnosis.StaleTransactions, poolTx)
+			continue
+		}
+		if poolTx.Nonce > expectedNonce {
+			diagnosis.MissingNonces = append(diagnosis.MissingNonces, data.NonceGap{
+				From: expectedNonce,
+				To:   poolTx.Nonce - 1,
+			})
+		}
+		if poolTx.Nonce >= expectedNonce {
+			expectedNonce = poolTx.Nonce + 1
+		}
+
+		if len(diagnosis.MissingNonces) > 0 {
+			diagnosis.StuckTransactions = append(diagnosis.StuckTransactions, poolTx)
+		} else {
+			diagnosis.ExecutableTransactions = append(diagnosis.ExecutableTransactions, poolTx)
+		}
+	}
+
+	return diagnosis
+}
+
+// toPoolTransaction reads the hash and the nonce of a transaction from the pool. The numbers of the decoded JSON
+// responses are float64 values
+func toPoolTransaction(wrappedTx data.WrappedTransaction) (data.PoolTransaction, bool) {
+	nonce, ok := wrappedTx.TxFields[nonceField].(float64)
+	if !ok || nonce < 0 {
+		return data.PoolTransaction{}, false
+	}
+
+	hash, _ := wrappedTx.TxFields[hashField].(string)
+
+	return data.PoolTransaction{
+		Hash:  hash,
+		Nonce: uint64(nonce),
+	}, true
+}
+
+// SortReplacementTransactions checks that the replacement transactions fill the missing nonces of the diagnosed
+// sender and returns their indexes in nonce order, which is the order they have to be sent in
+func (ngd *nonceGapDiagnoser) SortReplacementTransactions(diagnosis *data.SenderNonceDiagnosis, txs []*data.Transaction) ([]int, error) {
+	if len(txs) == 0 {
+		return nil, fmt.Errorf("%w: no transaction provided", apiErrors.ErrInvalidReplacementTransactions)
+	}
+
+	indexes := make([]int, 0, len(txs))
+	nonces := make(map[uint64]struct{}, len(txs))
+	for idx, tx := range txs {
+		if tx == nil {
+			return nil, fmt.Errorf("%w: nil transaction at index %d", apiErrors.ErrInvalidReplacementTransactions, idx)
+		}
+		if tx.Sender != diagnosis.Address {
+			return nil, fmt.Errorf("%w: the transaction at index %d has a different sender", apiErrors.ErrInvalidReplacementTransactions, idx)
+		}
+		_, isDuplicated := nonces[tx.Nonce]
+		if isDuplicated {
+			return nil, fmt.Errorf("%w: duplicated nonce %d", apiErrors.ErrInvalidReplacementTransactions, tx.Nonce)
+		}
+		if !isMissingNonce(diagnosis.MissingNonces, tx.Nonce) {
+			return nil, fmt.Errorf("%w: nonce %d is not missing", apiErrors.ErrInvalidReplacementTransactions, tx.Nonce)
+		}
+
+		nonces[tx.Nonce] = struct{}{}
+		indexes = append(indexes, idx)
+	}
+
+	sort.Slice(indexes, func(i, j int) bool {
+		return txs[indexes[i]].Nonce < txs[indexes[j]].Nonce
+	})
+
+	return indexes, nil
+}
+
+func isMissingNonce(missingNonces []data.NonceGap, nonce uint64) bool {
+	for _, gap := range missingNonces {
+		if nonce >= gap.From && nonce <= gap.To {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ngd *nonceGapDiagnoser) IsInterfaceNil() bool {
+	return ngd == nil
+}
@@ -0,0 +1,226 @@
+package nonces_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/nonces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type transactionsPoolProviderStub struct {
+	transactions []data.WrappedTransaction
+	err          error
+}
+
+func (stub *transactionsPoolProviderStub) GetTransactionsPoolForSender(_ context.Context, _, _ string) (*data.TransactionsPoolForSender, error) {
+	if stub.err != nil {
+		return nil, stub.err
+	}
+
+	return &data.TransactionsPoolForSender{Transactions: stub.transactions}, nil
+}
+
+func wrappedPoolTransaction(hash string, nonce uint64) data.WrappedTransaction {
+	return data.WrappedTransaction{
+		TxFields: map[string]interface{}{
+			"hash":  hash,
+			"nonce": float64(nonce),
+		},
+	}
+}
+
+func TestNewNonceGapDiagnoser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil account provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		ngd, err := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+			PoolProvider: &transactionsPoolProviderStub{},
+		})
+		require.Nil(t, ngd)
+		require.Equal(t, nonces.ErrNilAccountNonceProvider, err)
+	})
+	t.Run("nil pool provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		ngd, err := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+			AccountProvider: &accountNonceProviderStub{},
+		})
+		require.Nil(t, ngd)
+		require.Equal(t, nonces.ErrNilTransactionsPoolProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ngd, err := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+			AccountProvider: &accountNonceProviderStub{},
+			PoolProvider:    &transactionsPoolProviderStub{},
+		})
+		require.NoError(t, err)
+		require.False(t, ngd.IsInterfaceNil())
+	})
+}
+
+func TestNonceGapDiagnoser_Diagnose(t *testing.T) {
+	t.Parallel()
+
+	t.Run("account error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		ngd, _ := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+			AccountProvider: &accountNonceProviderStub{err: expectedErr},
+			PoolProvider:    &transactionsPoolProviderStub{},
+		})
+
+		diagnosis, err := ngd.Diagnose(context.Background(), "alice")
+		require.Nil(t, diagnosis)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("pool error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		ngd, _ := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+			AccountProvider: &accountNonceProviderStub{},
+			PoolProvider:    &transactionsPoolProviderStub{err: expectedErr},
+		})
+
+		diagnosis, err := ngd.Diagnose(context.Background(), "alice")
+		require.Nil(t, diagnosis)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("empty pool should have nothing to report", func(t *testing.T) {
+		t.Parallel()
+
+		ngd, _ := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+			AccountProvider: &accountNonceProviderStub{nonce: 5},
+			PoolProvider:    &transactionsPoolProviderStub{},
+		})
+
+		diagnosis, err := ngd.Diagnose(context.Background(), "alice")
+		require.NoError(t, err)
+		require.Equal(t, &data.SenderNonceDiagnosis{
+			Address:                "alice",
+			AccountNonce:           5,
+			MissingNonces:          []data.NonceGap{},
+			ExecutableTransactions: []data.PoolTransaction{},
+			StuckTransactions:      []data.PoolTransaction{},
+			StaleTransactions:      []data.PoolTransaction{},
+		}, diagnosis)
+	})
+	t.Run("should split the transactions around the gaps", func(t *testing.T) {
+		t.Parallel()
+
+		ngd, _ := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+			AccountProvider: &accountNonceProviderStub{nonce: 5},
+			PoolProvider: &transactionsPoolProviderStub{
+				transactions: []data.WrappedTransaction{
+					wrappedPoolTransaction("h12", 12),
+					wrappedPoolTransaction("h3", 3),
+					wrappedPoolTransaction("h6", 6),
+					wrappedPoolTransaction("h5", 5),
+					wrappedPoolTransaction("h9", 9),
+					{TxFields: map[string]interface{}{"hash": "no nonce"}},
+				},
+			},
+		})
+
+		diagnosis, err := ngd.Diagnose(context.Background(), "alice")
+		require.NoError(t, err)
+		assert.Equal(t, []data.NonceGap{{From: 7, To: 8}, {From: 10, To: 11}}, diagnosis.MissingNonces)
+		assert.Equal(t, []data.PoolTransaction{{Hash: "h5", Nonce: 5}, {Hash: "h6", Nonce: 6}}, diagnosis.ExecutableTransactions)
+		assert.Equal(t, []data.PoolTransaction{{Hash: "h9", Nonce: 9}, {Hash: "h12", Nonce: 12}}, diagnosis.StuckTransactions)
+		assert.Equal(t, []data.PoolTransaction{{Hash: "h3", Nonce: 3}}, diagnosis.StaleTransactions)
+	})
+	t.Run("gap right at the account nonce should block everything", func(t *testing.T) {
+		t.Parallel()
+
+		ngd, _ := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+			AccountProvider: &accountNonceProviderStub{nonce: 5},
+			PoolProvider: &transactionsPoolProviderStub{
+				transactions: []data.WrappedTransaction{
+					wrappedPoolTransaction("h7", 7),
+				},
+			},
+		})
+
+		diagnosis, err := ngd.Diagnose(context.Background(), "alice")
+		require.NoError(t, err)
+		assert.Equal(t, []data.NonceGap{{From: 5, To: 6}}, diagnosis.MissingNonces)
+		assert.Empty(t, diagnosis.ExecutableTransactions)
+		assert.Equal(t, []data.PoolTransaction{{Hash: "h7", Nonce: 7}}, diagnosis.StuckTransactions)
+	})
+}
+
+func TestNonceGapDiagnoser_SortReplacementTransactions(t *testing.T) {
+	t.Parallel()
+
+	ngd, _ := nonces.NewNonceGapDiagnoser(nonces.ArgsNonceGapDiagnoser{
+		AccountProvider: &accountNonceProviderStub{},
+		PoolProvider:    &transactionsPoolProviderStub{},
+	})
+	diagnosis := &data.SenderNonceDiagnosis{
+		Address:       "alice",
+		AccountNonce:  5,
+		MissingNonces: []data.NonceGap{{From: 7, To: 8}, {From: 10, To: 10}},
+	}
+
+	t.Run("no transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		indexes, err := ngd.SortReplacementTransactions(diagnosis, nil)
+		require.Nil(t, indexes)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidReplacementTransactions))
+	})
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		indexes, err := ngd.SortReplacementTransactions(diagnosis, []*data.Transaction{nil})
+		require.Nil(t, indexes)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidReplacementTransactions))
+	})
+	t.Run("different sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		indexes, err := ngd.SortReplacementTransactions(diagnosis, []*data.Transaction{{Sender: "bob", Nonce: 7}})
+		require.Nil(t, indexes)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidReplacementTransactions))
+	})
+	t.Run("duplicated nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		indexes, err := ngd.SortReplacementTransactions(diagnosis, []*data.Transaction{
+			{Sender: "alice", Nonce: 7},
+			{Sender: "alice", Nonce: 7},
+		})
+		require.Nil(t, indexes)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidReplacementTransactions))
+	})
+	t.Run("nonce not missing should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, nonce := range []uint64{4, 6, 9, 11} {
+			indexes, err := ngd.SortReplacementTransactions(diagnosis, []*data.Transaction{{Sender: "alice", Nonce: nonce}})
+			require.Nil(t, indexes)
+			require.True(t, errors.Is(err, apiErrors.ErrInvalidReplacementTransactions))
+		}
+	})
+	t.Run("should sort by nonce", func(t *testing.T) {
+		t.Parallel()
+
+		indexes, err := ngd.SortReplacementTransactions(diagnosis, []*data.Transaction{
+			{Sender: "alice", Nonce: 10},
+			{Sender: "alice", Nonce: 7},
+			{Sender: "alice", Nonce: 8},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 0}, indexes)
+	})
+}
@@ -0,0 +1,181 @@
+package nonces
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/nonces")
+
+// ArgsNonceReserver holds the arguments needed for creating a nonce reserver
+type ArgsNonceReserver struct {
+	AccountProvider          AccountNonceProvider
+	PoolProvider             PoolNonceProvider
+	ReservationTTL           time.Duration
+	MaxReservationsPerSender int
+	MaxSenders               int
+}
+
+// nonceReserver hands out the nonces of a sender to concurrent clients. The next nonce is computed from the account
+// nonce, the last nonce in the transactions pool and the nonces already reserved. A reservation expires if no
+// transaction using it is sent through the proxy within the reservation TTL. The reservations are kept in memory, so
+// they are not shared between the proxy instances
+type nonceReserver struct {
+	accountProvider          AccountNonceProvider
+	poolProvider             PoolNonceProvider
+	reservationTTL           time.Duration
+	maxReservationsPerSender int
+	maxSenders               int
+
+	mut          sync.Mutex
+	reservations map[string]map[uint64]time.Time
+}
+
+// NewNonceReserver returns a new instance of nonceReserver
+func NewNonceReserver(args ArgsNonceReserver) (*nonceReserver, error) {
+	if args.AccountProvider == nil {
+		return nil, ErrNilAccountNonceProvider
+	}
+	if args.PoolProvider == nil {
+		return nil, ErrNilPoolNonceProvider
+	}
+	if args.ReservationTTL <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidReservationTTL, args.ReservationTTL)
+	}
+	if args.MaxReservationsPerSender <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxReservationsPerSender, args.MaxReservationsPerSender)
+	}
+	if args.MaxSenders <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxSenders, args.MaxSenders)
+	}
+
+	return &nonceReserver{
+		accountProvider:          args.AccountProvider,
+		poolProvider:             args.PoolProvider,
+		reservationTTL:           args.ReservationTTL,
+		maxReservationsPerSender: args.MaxReservationsPerSender,
+		maxSenders:               args.MaxSenders,
+		reservations:             make(map[string]map[uint64]time.Time),
+	}, nil
+}
+
+// IsEnabled returns true as the nonce reservation is enabled
+func (nr *nonceReserver) IsEnabled() bool {
+	return true
+}
+
+// Reserve reserves the next nonce of the sender
+func (nr *nonceReserver) Reserve(ctx context.Context, sender string) (*data.NonceReservation, error) {
+	account, err := nr.accountProvider.GetAccount(ctx, sender, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	accountNonce := account.Account.Nonce
+	firstFreeNonce := accountNonce
+	// the pool answers with 0 when it holds no transaction of the sender, so 0 cannot be told apart from a single
+	// pending transaction with nonce 0
+	lastPoolNonce, err := nr.poolProvider.GetLastPoolNonceForSender(ctx, sender)
+	if err != nil {
+		log.Debug("cannot get the last pool nonce, using the account nonce", "sender", sender, "error", err.Error())
+	}
+	if err == nil && lastPoolNonce > 0 && lastPoolNonce >= accountNonce {
+		firstFreeNonce = lastPoolNonce + 1
+	}
+
+	now := time.Now()
+
+	nr.mut.Lock()
+	defer nr.mut.Unlock()
+
+	senderReservations, found := nr.reservations[sender]
+	if !found {
+		err = nr.checkSendersCapacity(now)
+		if err != nil {
+			return nil, err
+		}
+
+		senderReservations = make(map[uint64]time.Time)
+		nr.reservations[sender] = senderReservations
+	}
+
+	removeStaleReservations(senderReservations, accountNonce, now)
+	if len(senderReservations) >= nr.maxReservationsPerSender {
+		return nil, fmt.Errorf("%w: the limit is %d", apiErrors.ErrTooManyNonceReservations, nr.maxReservationsPerSender)
+	}
+
+	nonce := firstFreeNonce
+	for {
+		_, isReserved := senderReservations[nonce]
+		if !isReserved {
+			break
+		}
+		nonce++
+	}
+
+	expiresAt := now.Add(nr.reservationTTL)
+	senderReservations[nonce] = expiresAt
+
+	return &data.NonceReservation{
+		Address:      sender,
+		Nonce:        nonce,
+		AccountNonce: accountNonce,
+		ExpiresAt:    expiresAt.Unix(),
+	}, nil
+}
+
+// checkSendersCapacity must be called under mut
+func (nr *nonceReserver) checkSendersCapacity(now time.Time) error {
+	if len(nr.reservations) < nr.maxSenders {
+		return nil
+	}
+
+	for sender, senderReservations := range nr.reservations {
+		removeStaleReservations(senderReservations, 0, now)
+		if len(senderReservations) == 0 {
+			delete(nr.reservations, sender)
+		}
+	}
+	if len(nr.reservations) < nr.maxSenders {
+		return nil
+	}
+
+	return fmt.Errorf("%w: the limit is %d", apiErrors.ErrTooManyNonceReservationSenders, nr.maxSenders)
+}
+
+// removeStaleReservations removes the expired reservations and the ones already used by the executed transactions
+func removeStaleReservations(senderReservations map[uint64]time.Time, accountNonce uint64, now time.Time) {
+	for nonce, expiresAt := range senderReservations {
+		if nonce < accountNonce || now.After(expiresAt) {
+			delete(senderReservations, nonce)
+		}
+	}
+}
+
+// MarkSent keeps the reservation of the nonce, if any, until the sent transaction reaches the pool or gets executed
+func (nr *nonceReserver) MarkSent(sender string, nonce uint64) {
+	nr.mut.Lock()
+	defer nr.mut.Unlock()
+
+	senderReservations, found := nr.reservations[sender]
+	if !found {
+		return
+	}
+
+	_, found = senderReservations[nonce]
+	if found {
+		senderReservations[nonce] = time.Now().Add(nr.reservationTTL)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nr *nonceReserver) IsInterfaceNil() bool {
+	return nr == nil
+}
@@ -0,0 +1,270 @@
+package nonces_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/nonces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type accountNonceProviderStub struct {
+	mut   sync.Mutex
+	nonce uint64
+	err   error
+}
+
+func (stub *accountNonceProviderStub) GetAccount(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	if stub.err != nil {
+		return nil, stub.err
+	}
+
+	return &data.AccountModel{
+		Account: data.Account{
+			Address: address,
+			Nonce:   stub.nonce,
+		},
+	}, nil
+}
+
+func (stub *accountNonceProviderStub) setNonce(nonce uint64) {
+	stub.mut.Lock()
+	stub.nonce = nonce
+	stub.mut.Unlock()
+}
+
+type poolNonceProviderStub struct {
+	lastNonce uint64
+	err       error
+}
+
+func (stub *poolNonceProviderStub) GetLastPoolNonceForSender(_ context.Context, _ string) (uint64, error) {
+	return stub.lastNonce, stub.err
+}
+
+func createMockArgsNonceReserver() nonces.ArgsNonceReserver {
+	return nonces.ArgsNonceReserver{
+		AccountProvider:          &accountNonceProviderStub{},
+		PoolProvider:             &poolNonceProviderStub{},
+		ReservationTTL:           time.Minute,
+		MaxReservationsPerSender: 10,
+		MaxSenders:               10,
+	}
+}
+
+func TestNewNonceReserver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil account provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.AccountProvider = nil
+		nr, err := nonces.NewNonceReserver(args)
+		require.Nil(t, nr)
+		require.Equal(t, nonces.ErrNilAccountNonceProvider, err)
+	})
+	t.Run("nil pool provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.PoolProvider = nil
+		nr, err := nonces.NewNonceReserver(args)
+		require.Nil(t, nr)
+		require.Equal(t, nonces.ErrNilPoolNonceProvider, err)
+	})
+	t.Run("invalid reservation TTL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.ReservationTTL = 0
+		nr, err := nonces.NewNonceReserver(args)
+		require.Nil(t, nr)
+		require.True(t, errors.Is(err, nonces.ErrInvalidReservationTTL))
+	})
+	t.Run("invalid max reservations per sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.MaxReservationsPerSender = 0
+		nr, err := nonces.NewNonceReserver(args)
+		require.Nil(t, nr)
+		require.True(t, errors.Is(err, nonces.ErrInvalidMaxReservationsPerSender))
+	})
+	t.Run("invalid max senders should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.MaxSenders = 0
+		nr, err := nonces.NewNonceReserver(args)
+		require.Nil(t, nr)
+		require.True(t, errors.Is(err, nonces.ErrInvalidMaxSenders))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nr, err := nonces.NewNonceReserver(createMockArgsNonceReserver())
+		require.NoError(t, err)
+		require.False(t, nr.IsInterfaceNil())
+		require.True(t, nr.IsEnabled())
+	})
+}
+
+func TestNonceReserver_Reserve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("account error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsNonceReserver()
+		args.AccountProvider = &accountNonceProviderStub{err: expectedErr}
+		nr, _ := nonces.NewNonceReserver(args)
+
+		reservation, err := nr.Reserve(context.Background(), "alice")
+		require.Nil(t, reservation)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("concurrent senders should get consecutive nonces", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.AccountProvider = &accountNonceProviderStub{nonce: 5}
+		nr, _ := nonces.NewNonceReserver(args)
+
+		numReservations := 10
+		reservedNonces := make(chan uint64, numReservations)
+		wg := sync.WaitGroup{}
+		wg.Add(numReservations)
+		for i := 0; i < numReservations; i++ {
+			go func() {
+				defer wg.Done()
+
+				reservation, err := nr.Reserve(context.Background(), "alice")
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(5), reservation.AccountNonce)
+				reservedNonces <- reservation.Nonce
+			}()
+		}
+		wg.Wait()
+		close(reservedNonces)
+
+		seen := make(map[uint64]struct{})
+		for nonce := range reservedNonces {
+			seen[nonce] = struct{}{}
+		}
+		require.Len(t, seen, numReservations)
+		for nonce := uint64(5); nonce < 15; nonce++ {
+			assert.Contains(t, seen, nonce)
+		}
+	})
+	t.Run("should start after the last pool nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.AccountProvider = &accountNonceProviderStub{nonce: 5}
+		args.PoolProvider = &poolNonceProviderStub{lastNonce: 7}
+		nr, _ := nonces.NewNonceReserver(args)
+
+		reservation, err := nr.Reserve(context.Background(), "alice")
+		require.NoError(t, err)
+		require.Equal(t, uint64(8), reservation.Nonce)
+		require.Equal(t, uint64(5), reservation.AccountNonce)
+		require.Equal(t, "alice", reservation.Address)
+	})
+	t.Run("pool error should fall back to the account nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.AccountProvider = &accountNonceProviderStub{nonce: 5}
+		args.PoolProvider = &poolNonceProviderStub{lastNonce: 7, err: errors.New("pool error")}
+		nr, _ := nonces.NewNonceReserver(args)
+
+		reservation, err := nr.Reserve(context.Background(), "alice")
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), reservation.Nonce)
+	})
+	t.Run("executed nonces should be released", func(t *testing.T) {
+		t.Parallel()
+
+		accountProvider := &accountNonceProviderStub{nonce: 5}
+		args := createMockArgsNonceReserver()
+		args.AccountProvider = accountProvider
+		args.MaxReservationsPerSender = 2
+		nr, _ := nonces.NewNonceReserver(args)
+
+		_, _ = nr.Reserve(context.Background(), "alice")
+		_, _ = nr.Reserve(context.Background(), "alice")
+		_, err := nr.Reserve(context.Background(), "alice")
+		require.True(t, errors.Is(err, apiErrors.ErrTooManyNonceReservations))
+
+		accountProvider.setNonce(7)
+		reservation, err := nr.Reserve(context.Background(), "alice")
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), reservation.Nonce)
+	})
+	t.Run("expired reservations should be released", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.ReservationTTL = time.Millisecond * 10
+		nr, _ := nonces.NewNonceReserver(args)
+
+		first, _ := nr.Reserve(context.Background(), "alice")
+		require.Equal(t, uint64(0), first.Nonce)
+
+		time.Sleep(time.Millisecond * 20)
+
+		second, err := nr.Reserve(context.Background(), "alice")
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), second.Nonce)
+	})
+	t.Run("too many senders should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNonceReserver()
+		args.MaxSenders = 1
+		nr, _ := nonces.NewNonceReserver(args)
+
+		_, err := nr.Reserve(context.Background(), "alice")
+		require.NoError(t, err)
+
+		reservation, err := nr.Reserve(context.Background(), "bob")
+		require.Nil(t, reservation)
+		require.True(t, errors.Is(err, apiErrors.ErrTooManyNonceReservationSenders))
+	})
+}
+
+func TestNonceReserver_MarkSent(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNonceReserver()
+	args.ReservationTTL = time.Millisecond * 100
+	nr, _ := nonces.NewNonceReserver(args)
+
+	reservation, _ := nr.Reserve(context.Background(), "alice")
+	require.Equal(t, uint64(0), reservation.Nonce)
+
+	// unknown senders and nonces are ignored
+	nr.MarkSent("bob", 0)
+	nr.MarkSent("alice", 1)
+
+	time.Sleep(time.Millisecond * 60)
+	nr.MarkSent("alice", 0)
+	time.Sleep(time.Millisecond * 60)
+
+	// the reservation was refreshed by the sent transaction, so it is still held
+	reservation, err := nr.Reserve(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), reservation.Nonce)
+}
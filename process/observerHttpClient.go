@@ -0,0 +1,199 @@
+package process
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const (
+	defaultMaxIdleConns           = 100
+	defaultMaxIdleConnsPerHost    = 10
+	defaultIdleConnTimeout        = 90 * time.Second
+	defaultTLSHandshakeTimeout    = 10 * time.Second
+	defaultDialTimeout            = 30 * time.Second
+	defaultDialKeepAlive          = 30 * time.Second
+	defaultExpectContinueTimeout  = 1 * time.Second
+	minTLSVersionForObserverCalls = tls.VersionTLS12
+)
+
+const (
+	pathSeparator        = "/"
+	pathWildcardPrefix   = ":"
+	queryStringSeparator = "?"
+)
+
+type routeTimeout struct {
+	path     string
+	segments []string
+	timeout  time.Duration
+}
+
+// newObserverHttpClient creates the dedicated http client used for all the calls towards the observers.
+// The client itself has no timeout set, as the deadline of each request is set through its context, so the
+// per-route timeouts can exceed the default one
+func newObserverHttpClient(cfg config.ObserverTransportConfig) (*http.Client, error) {
+	tlsConfig, err := createObserverTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   defaultDialTimeout,
+		KeepAlive: defaultDialKeepAlive,
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          valueOrDefault(cfg.MaxIdleConns, defaultMaxIdleConns),
+		MaxIdleConnsPerHost:   valueOrDefault(cfg.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost),
+		MaxConnsPerHost:       cfg.MaxConnsPerHost,
+		IdleConnTimeout:       durationOrDefault(cfg.IdleConnTimeoutSec, defaultIdleConnTimeout),
+		TLSHandshakeTimeout:   durationOrDefault(cfg.TLSHandshakeTimeoutSec, defaultTLSHandshakeTimeout),
+		ExpectContinueTimeout: defaultExpectContinueTimeout,
+		ForceAttemptHTTP2:     cfg.ForceAttemptHTTP2,
+		TLSClientConfig:       tlsConfig,
+	}
+
+	return &http.Client{
+		Transport: transport,
+	}, nil
+}
+
+func createObserverTLSConfig(cfg config.ObserverTLSConfig) (*tls.Config, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         minTLSVersionForObserverCalls,
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	hasCertificate := len(cfg.CertificateFile) > 0
+	hasPrivateKey := len(cfg.PrivateKeyFile) > 0
+	if hasCertificate != hasPrivateKey {
+		return nil, fmt.Errorf("%w: both CertificateFile and PrivateKeyFile should be provided", ErrInvalidObserverTLSConfig)
+	}
+	if hasCertificate {
+		certificate, err := tls.LoadX509KeyPair(cfg.CertificateFile, cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the client certificate: %s", ErrInvalidObserverTLSConfig, err.Error())
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if len(cfg.CACertificateFile) > 0 {
+		caCertificate, err := os.ReadFile(cfg.CACertificateFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the CA certificate: %s", ErrInvalidObserverTLSConfig, err.Error())
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCertificate) {
+			return nil, fmt.Errorf("%w: no valid certificate found in %s", ErrInvalidObserverTLSConfig, cfg.CACertificateFile)
+		}
+
+		tlsConfig.RootCAs = certPool
+	}
+
+	return tlsConfig, nil
+}
+
+// createRouteTimeouts returns the configured route timeouts, sorted so the most specific path is checked first
+func createRouteTimeouts(routeTimeoutsConfig []config.RouteTimeoutConfig) ([]routeTimeout, error) {
+	routeTimeouts := make([]routeTimeout, 0, len(routeTimeoutsConfig))
+	for _, routeConfig := range routeTimeoutsConfig {
+		segments := splitPathInSegments(routeConfig.Path)
+		if len(segments) == 0 {
+			return nil, fmt.Errorf("%w: empty path", ErrInvalidRouteTimeout)
+		}
+		if routeConfig.TimeoutSec <= 0 {
+			return nil, fmt.Errorf("%w for path %s: %d", ErrInvalidRouteTimeout, routeConfig.Path, routeConfig.TimeoutSec)
+		}
+
+		routeTimeouts = append(routeTimeouts, routeTimeout{
+			path:     routeConfig.Path,
+			segments: segments,
+			timeout:  time.Duration(routeConfig.TimeoutSec) * time.Second,
+		})
+	}
+
+	sort.SliceStable(routeTimeouts, func(i, j int) bool {
+		return len(routeTimeouts[i].segments) > len(routeTimeouts[j].segments)
+	})
+
+	return routeTimeouts, nil
+}
+
+func getTimeoutForPath(path string, routeTimeouts []routeTimeout, defaultTimeout time.Duration) time.Duration {
+	if len(routeTimeouts) == 0 {
+		return defaultTimeout
+	}
+
+	pathSegments := splitPathInSegments(path)
+	for _, rt := range routeTimeouts {
+		if segmentsMatch(rt.segments, pathSegments) {
+			return rt.timeout
+		}
+	}
+
+	return defaultTimeout
+}
+
+func segmentsMatch(patternSegments []string, pathSegments []string) bool {
+	if len(patternSegments) > len(pathSegments) {
+		return false
+	}
+
+	for i, patternSegment := range patternSegments {
+		if strings.HasPrefix(patternSegment, pathWildcardPrefix) {
+			continue
+		}
+		if patternSegment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func splitPathInSegments(path string) []string {
+	path = strings.SplitN(path, queryStringSeparator, 2)[0]
+
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(path, pathSeparator) {
+		if len(segment) > 0 {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
+}
+
+func valueOrDefault(value int, defaultValue int) int {
+	if value > 0 {
+		return value
+	}
+
+	return defaultValue
+}
+
+func durationOrDefault(valueInSec int, defaultValue time.Duration) time.Duration {
+	if valueInSec > 0 {
+		return time.Duration(valueInSec) * time.Second
+	}
+
+	return defaultValue
+}
@@ -0,0 +1,150 @@
+package process
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObserverHttpClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty config should use defaults", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := newObserverHttpClient(config.ObserverTransportConfig{})
+		require.NoError(t, err)
+		require.NotSame(t, http.DefaultClient, client)
+		require.Zero(t, client.Timeout)
+
+		transport, ok := client.Transport.(*http.Transport)
+		require.True(t, ok)
+		require.Equal(t, defaultMaxIdleConns, transport.MaxIdleConns)
+		require.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+		require.Equal(t, defaultIdleConnTimeout, transport.IdleConnTimeout)
+		require.Equal(t, defaultTLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+		require.Zero(t, transport.MaxConnsPerHost)
+		require.Nil(t, transport.TLSClientConfig)
+	})
+	t.Run("provided values should be used", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.ObserverTransportConfig{
+			MaxIdleConns:           500,
+			MaxIdleConnsPerHost:    50,
+			MaxConnsPerHost:        200,
+			IdleConnTimeoutSec:     30,
+			TLSHandshakeTimeoutSec: 3,
+			ForceAttemptHTTP2:      true,
+		}
+		client, err := newObserverHttpClient(cfg)
+		require.NoError(t, err)
+
+		transport := client.Transport.(*http.Transport)
+		require.Equal(t, 500, transport.MaxIdleConns)
+		require.Equal(t, 50, transport.MaxIdleConnsPerHost)
+		require.Equal(t, 200, transport.MaxConnsPerHost)
+		require.Equal(t, 30*time.Second, transport.IdleConnTimeout)
+		require.Equal(t, 3*time.Second, transport.TLSHandshakeTimeout)
+		require.True(t, transport.ForceAttemptHTTP2)
+	})
+	t.Run("TLS enabled without certificates should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.ObserverTransportConfig{
+			TLS: config.ObserverTLSConfig{
+				Enabled:    true,
+				ServerName: "observer.local",
+			},
+		}
+		client, err := newObserverHttpClient(cfg)
+		require.NoError(t, err)
+
+		transport := client.Transport.(*http.Transport)
+		require.NotNil(t, transport.TLSClientConfig)
+		require.Equal(t, "observer.local", transport.TLSClientConfig.ServerName)
+		require.Empty(t, transport.TLSClientConfig.Certificates)
+	})
+	t.Run("TLS with certificate but without private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.ObserverTransportConfig{
+			TLS: config.ObserverTLSConfig{
+				Enabled:         true,
+				CertificateFile: "cert.pem",
+			},
+		}
+		client, err := newObserverHttpClient(cfg)
+		require.True(t, errors.Is(err, ErrInvalidObserverTLSConfig))
+		require.Nil(t, client)
+	})
+	t.Run("TLS with missing certificate files should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.ObserverTransportConfig{
+			TLS: config.ObserverTLSConfig{
+				Enabled:         true,
+				CertificateFile: "missing-cert.pem",
+				PrivateKeyFile:  "missing-key.pem",
+			},
+		}
+		client, err := newObserverHttpClient(cfg)
+		require.True(t, errors.Is(err, ErrInvalidObserverTLSConfig))
+		require.Nil(t, client)
+	})
+	t.Run("TLS with missing CA file should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.ObserverTransportConfig{
+			TLS: config.ObserverTLSConfig{
+				Enabled:           true,
+				CACertificateFile: "missing-ca.pem",
+			},
+		}
+		client, err := newObserverHttpClient(cfg)
+		require.True(t, errors.Is(err, ErrInvalidObserverTLSConfig))
+		require.Nil(t, client)
+	})
+}
+
+func TestCreateRouteTimeouts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		routeTimeouts, err := createRouteTimeouts([]config.RouteTimeoutConfig{{Path: "/", TimeoutSec: 1}})
+		require.True(t, errors.Is(err, ErrInvalidRouteTimeout))
+		require.Nil(t, routeTimeouts)
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		routeTimeouts, err := createRouteTimeouts([]config.RouteTimeoutConfig{{Path: "/vm-values", TimeoutSec: 0}})
+		require.True(t, errors.Is(err, ErrInvalidRouteTimeout))
+		require.Nil(t, routeTimeouts)
+	})
+	t.Run("should return the timeout of the most specific path", func(t *testing.T) {
+		t.Parallel()
+
+		routeTimeouts, err := createRouteTimeouts([]config.RouteTimeoutConfig{
+			{Path: "/address", TimeoutSec: 10},
+			{Path: "/address/:address/esdt", TimeoutSec: 30},
+			{Path: "/vm-values/query", TimeoutSec: 120},
+		})
+		require.NoError(t, err)
+		require.Len(t, routeTimeouts, 3)
+
+		defaultTimeout := 80 * time.Second
+		require.Equal(t, 120*time.Second, getTimeoutForPath("/vm-values/query", routeTimeouts, defaultTimeout))
+		require.Equal(t, 30*time.Second, getTimeoutForPath("/address/erd1addr/esdt?blockNonce=5", routeTimeouts, defaultTimeout))
+		require.Equal(t, 10*time.Second, getTimeoutForPath("/address/erd1addr", routeTimeouts, defaultTimeout))
+		require.Equal(t, 10*time.Second, getTimeoutForPath("/address/erd1addr/key/aa", routeTimeouts, defaultTimeout))
+		require.Equal(t, defaultTimeout, getTimeoutForPath("/vm-values/int", routeTimeouts, defaultTimeout))
+		require.Equal(t, defaultTimeout, getTimeoutForPath("/transaction/send", routeTimeouts, defaultTimeout))
+	})
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -8,29 +9,72 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
 )
 
 type ProofProcessor struct {
 	proc            Processor
 	pubKeyConverter core.PubkeyConverter
+	responseCache   ResponseCacheHandler
 }
 
-func NewProofProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*ProofProcessor, error) {
+func NewProofProcessor(proc Processor, pubKeyConverter core.PubkeyConverter, responseCache ResponseCacheHandler) (*ProofProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(responseCache) {
+		return nil, ErrNilResponseCache
+	}
 
 	return &ProofProcessor{
 		proc:            proc,
 		pubKeyConverter: pubKeyConverter,
+		responseCache:   responseCache,
 	}, nil
 }
 
 // GetProof sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
+	cacheKey := fmt.Sprintf("proof:%s:%s", rootHash, address)
+
+	return pp.getProofWithCache(cacheKey, func() (*data.GenericAPIResponse, error) {
+		return pp.getProof(ctx, rootHash, address)
+	})
+}
+
+// GetProofDataTrie sends the request to the right observer and then replies with the returned answer
+func (pp *ProofProcessor) GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+	cacheKey := fmt.Sprintf("proof-data-trie:%s:%s:%s", rootHash, address, key)
+
+	return pp.getProofWithCache(cacheKey, func() (*data.GenericAPIResponse, error) {
+		return pp.getProofDataTrie(ctx, rootHash, address, key)
+	})
+}
+
+// getProofWithCache caches the proofs indefinitely, as a proof for a given root hash never changes
+func (pp *ProofProcessor) getProofWithCache(
+	cacheKey string,
+	getProofHandler func() (*data.GenericAPIResponse, error),
+) (*data.GenericAPIResponse, error) {
+	cachedResponse, found := pp.responseCache.Get(cacheKey)
+	if found {
+		return cachedResponse.(*data.GenericAPIResponse), nil
+	}
+
+	response, err := getProofHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	pp.responseCache.Put(cacheKey, response, cache.NoExpiration)
+
+	return response, nil
+}
+
+func (pp *ProofProcessor) getProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -40,7 +84,7 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 	getProofEndpoint := "/proof/root-hash/" + rootHash + "/address/" + address
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPoint(ctx, observer.Address, getProofEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
@@ -72,8 +116,7 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 	return nil, WrapObserversError(responseGetProof.Error)
 }
 
-// GetProofDataTrie sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) getProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -83,7 +126,7 @@ func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key
 	getProofDataTrieEndpoint := fmt.Sprintf("/proof/root-hash/%s/address/%s/key/%s", rootHash, address, key)
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofDataTrieEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPoint(ctx, observer.Address, getProofDataTrieEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
@@ -116,7 +159,7 @@ func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key
 }
 
 // GetProofCurrentRootHash sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -126,7 +169,7 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 	getProofEndpoint := "/proof/address/" + address
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPoint(ctx, observer.Address, getProofEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
@@ -158,7 +201,7 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 }
 
 // VerifyProof sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -173,7 +216,7 @@ func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []s
 	responseVerifyProof := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallPostRestEndPoint(observer.Address, verifyProofEndpoint, requestParams, &responseVerifyProof)
+		respCode, err := pp.proc.CallPostRestEndPoint(ctx, observer.Address, verifyProofEndpoint, requestParams, &responseVerifyProof)
 
 		if responseVerifyProof.Error != "" {
 			return nil, errors.New(responseVerifyProof.Error)
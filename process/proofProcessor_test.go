@@ -1,20 +1,25 @@
 package process_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewProofProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	pp, err := process.NewProofProcessor(nil, &mock.PubKeyConverterMock{})
+	pp, err := process.NewProofProcessor(nil, &mock.PubKeyConverterMock{}, &disabled.ResponseCache{})
 
 	assert.Nil(t, pp)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -23,7 +28,7 @@ func TestNewProofProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewProofProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	pp, err := process.NewProofProcessor(&mock.ProcessorStub{}, nil)
+	pp, err := process.NewProofProcessor(&mock.ProcessorStub{}, nil, &disabled.ResponseCache{})
 
 	assert.Nil(t, pp)
 	assert.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -32,7 +37,7 @@ func TestNewProofProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewProofProcessor(t *testing.T) {
 	t.Parallel()
 
-	pp, err := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+	pp, err := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &disabled.ResponseCache{})
 
 	assert.NotNil(t, pp)
 	assert.Nil(t, err)
@@ -41,8 +46,8 @@ func TestNewProofProcessor(t *testing.T) {
 func TestProofProcessor_GetProofInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	proof, err := pp.GetProof("rootHash", "invalid hex number")
+	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &disabled.ResponseCache{})
+	proof, err := pp.GetProof(context.Background(), "rootHash", "invalid hex number")
 
 	assert.Nil(t, proof)
 	assert.NotNil(t, err)
@@ -78,9 +83,10 @@ func TestProofProcessor_GetProofSendingFailsOnFirstObserverShouldStillSend(t *te
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&disabled.ResponseCache{},
 	)
 
-	response, err := pp.GetProof("rootHash", "deadbeef")
+	response, err := pp.GetProof(context.Background(), "rootHash", "deadbeef")
 	assert.Nil(t, err)
 
 	proofs, ok := response.Data.([]string)
@@ -90,11 +96,64 @@ func TestProofProcessor_GetProofSendingFailsOnFirstObserverShouldStillSend(t *te
 	assert.Equal(t, returnedProof[1], proofs[1])
 }
 
+func TestNewProofProcessor_NilResponseCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pp, err := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil)
+
+	assert.Nil(t, pp)
+	assert.Equal(t, process.ErrNilResponseCache, err)
+}
+
+func TestProofProcessor_GetProofShouldBeCachedWithoutExpiration(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	var providedTTL time.Duration
+	responseCache, _ := cache.NewResponseCache(10)
+	pp, _ := process.NewProofProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "address", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				numCalls++
+				value.(*data.GenericAPIResponse).Data = []string{"proof"}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.ResponseCacheStub{
+			GetCalled: responseCache.Get,
+			PutCalled: func(key string, value interface{}, ttl time.Duration) {
+				providedTTL = ttl
+				responseCache.Put(key, value, ttl)
+			},
+		},
+	)
+
+	firstResponse, err := pp.GetProof(context.Background(), "rootHash", "deadbeef")
+	require.Nil(t, err)
+	secondResponse, err := pp.GetProof(context.Background(), "rootHash", "deadbeef")
+	require.Nil(t, err)
+
+	assert.Equal(t, 1, numCalls)
+	assert.Equal(t, cache.NoExpiration, providedTTL)
+	assert.Equal(t, firstResponse, secondResponse)
+
+	_, err = pp.GetProof(context.Background(), "otherRootHash", "deadbeef")
+	require.Nil(t, err)
+	assert.Equal(t, 2, numCalls)
+}
+
 func TestProofProcessor_VerifyProofInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	resp, err := pp.VerifyProof("rootHash", "invalid hex number", []string{})
+	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &disabled.ResponseCache{})
+	resp, err := pp.VerifyProof(context.Background(), "rootHash", "invalid hex number", []string{})
 
 	assert.Nil(t, resp)
 	assert.NotNil(t, err)
@@ -130,9 +189,10 @@ func TestProofProcessor_VerifyProofSendingFailsOnFirstObserverShouldStillSend(t
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&disabled.ResponseCache{},
 	)
 
-	resp, err := pp.VerifyProof("rootHash", "deadbeef", proof)
+	resp, err := pp.VerifyProof(context.Background(), "rootHash", "deadbeef", proof)
 	assert.Nil(t, err)
 
 	isValid, ok := resp.Data.(bool)
@@ -143,8 +203,8 @@ func TestProofProcessor_VerifyProofSendingFailsOnFirstObserverShouldStillSend(t
 func TestProofProcessor_GetProofDataTrieInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	proof, err := pp.GetProofDataTrie("abcd", "invalid hex number", "0123")
+	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &disabled.ResponseCache{})
+	proof, err := pp.GetProofDataTrie(context.Background(), "abcd", "invalid hex number", "0123")
 
 	assert.Nil(t, proof)
 	assert.NotNil(t, err)
@@ -180,9 +240,10 @@ func TestProofProcessor_GetProofDataTrieSendingFailsOnFirstObserverShouldStillSe
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&disabled.ResponseCache{},
 	)
 
-	response, err := pp.GetProofDataTrie("rootHash", "deadbeef", "key")
+	response, err := pp.GetProofDataTrie(context.Background(), "rootHash", "deadbeef", "key")
 	assert.Nil(t, err)
 
 	proofs, ok := response.Data.([]string)
@@ -195,8 +256,8 @@ func TestProofProcessor_GetProofDataTrieSendingFailsOnFirstObserverShouldStillSe
 func TestProofProcessor_GetProofCurrentRootHashInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	proof, err := pp.GetProofCurrentRootHash("invalid hex number")
+	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &disabled.ResponseCache{})
+	proof, err := pp.GetProofCurrentRootHash(context.Background(), "invalid hex number")
 
 	assert.Nil(t, proof)
 	assert.NotNil(t, err)
@@ -232,9 +293,10 @@ func TestProofProcessor_GetProofCurrentRootHashSendingFailsOnFirstObserverShould
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&disabled.ResponseCache{},
 	)
 
-	response, err := pp.GetProofCurrentRootHash("deadbeef")
+	response, err := pp.GetProofCurrentRootHash(context.Background(), "deadbeef")
 	assert.Nil(t, err)
 
 	proofs, ok := response.Data.([]string)
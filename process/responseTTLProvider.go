@@ -0,0 +1,161 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+// hyperblocksFinalityKey identifies the latest final hyperblock nonce among the latest final block nonces of the shards
+const hyperblocksFinalityKey = "hyperblocks"
+
+// finalNonce holds the latest known final nonce of a chain and when it was last fetched
+type finalNonce struct {
+	nonce          uint64
+	lastUpdateTime time.Time
+}
+
+// responseTTLProvider computes for how long a response can be cached, based on the finality of the data it was
+// built from. Final data is cached indefinitely, while the tip-of-chain data gets a short ttl. The latest final
+// hyperblock nonce and the latest final block nonce of each shard are fetched from the observers at most once per
+// non-final ttl
+type responseTTLProvider struct {
+	finalNonceHandler LatestFinalNonceHandler
+	nonFinalTTL       time.Duration
+	getTimeHandler    func() time.Time
+
+	mutFinalNonces sync.RWMutex
+	finalNonces    map[string]*finalNonce
+	mutUpdate      sync.Mutex
+}
+
+// NewResponseTTLProvider returns a new instance of responseTTLProvider
+func NewResponseTTLProvider(finalNonceHandler LatestFinalNonceHandler, nonFinalTTL time.Duration) (*responseTTLProvider, error) {
+	if check.IfNil(finalNonceHandler) {
+		return nil, ErrNilLatestFinalNonceHandler
+	}
+	if nonFinalTTL <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponseTTL, nonFinalTTL)
+	}
+
+	return &responseTTLProvider{
+		finalNonceHandler: finalNonceHandler,
+		nonFinalTTL:       nonFinalTTL,
+		getTimeHandler:    time.Now,
+		finalNonces:       make(map[string]*finalNonce),
+	}, nil
+}
+
+// TTLForHyperblockNonce returns cache.NoExpiration if the provided hyperblock nonce is final, the non-final ttl otherwise
+func (rtp *responseTTLProvider) TTLForHyperblockNonce(ctx context.Context, nonce uint64) time.Duration {
+	return rtp.computeTTL(ctx, hyperblocksFinalityKey, nonce, rtp.finalNonceHandler.GetLatestFullySynchronizedHyperblockNonce)
+}
+
+// TTLForBlockNonce returns cache.NoExpiration if the provided block nonce is final in its shard, the non-final ttl
+// otherwise
+func (rtp *responseTTLProvider) TTLForBlockNonce(ctx context.Context, shardID uint32, nonce uint64) time.Duration {
+	fetchFinalNonce := func(ctx context.Context) (uint64, error) {
+		return rtp.finalNonceHandler.GetLatestFinalBlockNonce(ctx, shardID)
+	}
+
+	return rtp.computeTTL(ctx, fmt.Sprintf("shard-%d", shardID), nonce, fetchFinalNonce)
+}
+
+// NonFinalTTL returns the ttl of the responses built from tip-of-chain data
+func (rtp *responseTTLProvider) NonFinalTTL() time.Duration {
+	return rtp.nonFinalTTL
+}
+
+func (rtp *responseTTLProvider) computeTTL(
+	ctx context.Context,
+	key string,
+	nonce uint64,
+	fetchFinalNonce func(ctx context.Context) (uint64, error),
+) time.Duration {
+	if rtp.isFinal(ctx, key, nonce, fetchFinalNonce) {
+		return cache.NoExpiration
+	}
+
+	return rtp.nonFinalTTL
+}
+
+func (rtp *responseTTLProvider) isFinal(
+	ctx context.Context,
+	key string,
+	nonce uint64,
+	fetchFinalNonce func(ctx context.Context) (uint64, error),
+) bool {
+	latestFinalNonce, isRecent := rtp.getLatestFinalNonce(key)
+	// the final nonce only increases, so there is no need to refresh it for the nonces already known as final
+	if nonce <= latestFinalNonce {
+		return true
+	}
+	if isRecent {
+		return false
+	}
+
+	return nonce <= rtp.updateLatestFinalNonce(ctx, key, fetchFinalNonce)
+}
+
+func (rtp *responseTTLProvider) getLatestFinalNonce(key string) (uint64, bool) {
+	rtp.mutFinalNonces.RLock()
+	defer rtp.mutFinalNonces.RUnlock()
+
+	latest, found := rtp.finalNonces[key]
+	if !found {
+		return 0, false
+	}
+
+	isRecent := rtp.getTimeHandler().Sub(latest.lastUpdateTime) < rtp.nonFinalTTL
+
+	return latest.nonce, isRecent
+}
+
+func (rtp *responseTTLProvider) updateLatestFinalNonce(
+	ctx context.Context,
+	key string,
+	fetchFinalNonce func(ctx context.Context) (uint64, error),
+) uint64 {
+	// a single update at a time, the concurrent callers will use its result
+	rtp.mutUpdate.Lock()
+	defer rtp.mutUpdate.Unlock()
+
+	latestFinalNonce, isRecent := rtp.getLatestFinalNonce(key)
+	if isRecent {
+		return latestFinalNonce
+	}
+
+	nonce, err := fetchFinalNonce(ctx)
+	if err != nil {
+		log.Debug("cannot get the latest final nonce", "chain", key, "error", err)
+	}
+
+	rtp.mutFinalNonces.Lock()
+	defer rtp.mutFinalNonces.Unlock()
+
+	latest, found := rtp.finalNonces[key]
+	if !found {
+		latest = &finalNonce{}
+		rtp.finalNonces[key] = latest
+	}
+
+	// no shard being reachable results in the maximum value, which should not be considered final
+	isValidNonce := err == nil && nonce != math.MaxUint64
+	if isValidNonce && nonce > latest.nonce {
+		latest.nonce = nonce
+	}
+	// the update time is set on errors as well, so unreachable observers are not queried on each request
+	latest.lastUpdateTime = rtp.getTimeHandler()
+
+	return latest.nonce
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rtp *responseTTLProvider) IsInterfaceNil() bool {
+	return rtp == nil
+}
@@ -0,0 +1,179 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResponseTTLProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil final nonce handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := process.NewResponseTTLProvider(nil, time.Second)
+		require.Equal(t, process.ErrNilLatestFinalNonceHandler, err)
+		require.Nil(t, provider)
+	})
+	t.Run("invalid non-final ttl should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := process.NewResponseTTLProvider(&mock.LatestFinalNonceHandlerStub{}, 0)
+		require.True(t, errors.Is(err, process.ErrInvalidResponseTTL))
+		require.Nil(t, provider)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := process.NewResponseTTLProvider(&mock.LatestFinalNonceHandlerStub{}, time.Second)
+		require.Nil(t, err)
+		require.False(t, provider.IsInterfaceNil())
+		require.Equal(t, time.Second, provider.NonFinalTTL())
+	})
+}
+
+func TestResponseTTLProvider_TTLForHyperblockNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("final nonces should not expire", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := process.NewResponseTTLProvider(&mock.LatestFinalNonceHandlerStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 100, nil
+			},
+		}, time.Second)
+
+		require.Equal(t, cache.NoExpiration, provider.TTLForHyperblockNonce(context.Background(), 99))
+		require.Equal(t, cache.NoExpiration, provider.TTLForHyperblockNonce(context.Background(), 100))
+		require.Equal(t, time.Second, provider.TTLForHyperblockNonce(context.Background(), 101))
+	})
+	t.Run("should not query the final nonce more than once per non-final ttl", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		latestFinalNonce := uint64(10)
+		provider, _ := process.NewResponseTTLProvider(&mock.LatestFinalNonceHandlerStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				numCalls++
+				return latestFinalNonce, nil
+			},
+		}, time.Second)
+		currentTime := time.Now()
+		provider.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		require.Equal(t, time.Second, provider.TTLForHyperblockNonce(context.Background(), 11))
+		latestFinalNonce = 11
+		require.Equal(t, time.Second, provider.TTLForHyperblockNonce(context.Background(), 11))
+		require.Equal(t, 1, numCalls)
+
+		currentTime = currentTime.Add(time.Second)
+		require.Equal(t, cache.NoExpiration, provider.TTLForHyperblockNonce(context.Background(), 11))
+		require.Equal(t, 2, numCalls)
+
+		// already known as final, no refresh needed
+		currentTime = currentTime.Add(time.Second)
+		require.Equal(t, cache.NoExpiration, provider.TTLForHyperblockNonce(context.Background(), 5))
+		require.Equal(t, 2, numCalls)
+	})
+	t.Run("errors and unknown final nonce should not be considered final", func(t *testing.T) {
+		t.Parallel()
+
+		providedNonce := uint64(math.MaxUint64)
+		var providedErr error
+		provider, _ := process.NewResponseTTLProvider(&mock.LatestFinalNonceHandlerStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return providedNonce, providedErr
+			},
+		}, time.Second)
+		currentTime := time.Now()
+		provider.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		require.Equal(t, time.Second, provider.TTLForHyperblockNonce(context.Background(), 1))
+
+		currentTime = currentTime.Add(time.Second)
+		providedNonce = 10
+		providedErr = errors.New("expected error")
+		require.Equal(t, time.Second, provider.TTLForHyperblockNonce(context.Background(), 1))
+	})
+	t.Run("concurrent calls should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := process.NewResponseTTLProvider(&mock.LatestFinalNonceHandlerStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 50, nil
+			},
+		}, time.Millisecond)
+
+		numCalls := 100
+		wg := sync.WaitGroup{}
+		wg.Add(numCalls)
+		for i := 0; i < numCalls; i++ {
+			go func(idx int) {
+				_ = provider.TTLForHyperblockNonce(context.Background(), uint64(idx))
+				wg.Done()
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func TestResponseTTLProvider_TTLForBlockNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("final nonces should not expire", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := process.NewResponseTTLProvider(&mock.LatestFinalNonceHandlerStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				require.Fail(t, "should have not been called")
+				return 0, nil
+			},
+			GetLatestFinalBlockNonceCalled: func(shardID uint32) (uint64, error) {
+				return uint64(100 + shardID), nil
+			},
+		}, time.Second)
+
+		require.Equal(t, cache.NoExpiration, provider.TTLForBlockNonce(context.Background(), 0, 100))
+		require.Equal(t, time.Second, provider.TTLForBlockNonce(context.Background(), 0, 101))
+		require.Equal(t, cache.NoExpiration, provider.TTLForBlockNonce(context.Background(), 1, 101))
+		require.Equal(t, time.Second, provider.TTLForBlockNonce(context.Background(), 1, 102))
+	})
+	t.Run("the shards and the hyperblocks should be tracked separately", func(t *testing.T) {
+		t.Parallel()
+
+		numShardCalls := make(map[uint32]int)
+		provider, _ := process.NewResponseTTLProvider(&mock.LatestFinalNonceHandlerStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 5, nil
+			},
+			GetLatestFinalBlockNonceCalled: func(shardID uint32) (uint64, error) {
+				numShardCalls[shardID]++
+				return 50, nil
+			},
+		}, time.Second)
+		currentTime := time.Now()
+		provider.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		require.Equal(t, cache.NoExpiration, provider.TTLForBlockNonce(context.Background(), 0, 50))
+		require.Equal(t, time.Second, provider.TTLForHyperblockNonce(context.Background(), 50))
+		require.Equal(t, time.Second, provider.TTLForBlockNonce(context.Background(), 0, 51))
+		require.Equal(t, time.Second, provider.TTLForBlockNonce(context.Background(), 1, 51))
+		require.Equal(t, map[uint32]int{0: 1, 1: 1}, numShardCalls)
+	})
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/availabilityCommon"
 )
@@ -23,26 +25,35 @@ type SCQueryProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
 	availabilityProvider availabilityCommon.AvailabilityProvider
+	historyResolver      HistoricalCoordinatesResolver
 }
 
 // NewSCQueryProcessor creates a new instance of SCQueryProcessor
-func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*SCQueryProcessor, error) {
+func NewSCQueryProcessor(
+	proc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	historyResolver HistoricalCoordinatesResolver,
+) (*SCQueryProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(historyResolver) {
+		return nil, ErrNilHistoricalCoordinatesResolver
+	}
 
 	return &SCQueryProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
+		historyResolver:      historyResolver,
 	}, nil
 }
 
 // ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer
-func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
@@ -53,6 +64,11 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 		return nil, data.BlockInfo{}, err
 	}
 
+	query, err = scQueryProcessor.resolveHistoricalQuery(ctx, shardID, query)
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
+
 	availability := scQueryProcessor.availabilityProvider.AvailabilityForVmQuery(query)
 	observers, err := scQueryProcessor.proc.GetObservers(shardID, availability)
 	if err != nil {
@@ -77,7 +93,11 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 			path = path + "?" + queryParams
 		}
 
-		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(observer.Address, path, request, &response)
+		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(ctx, observer.Address, path, request, &response)
+		if isContextDone(ctx) {
+			return nil, data.BlockInfo{}, ctx.Err()
+		}
+
 		isObserverDown := httpStatus == http.StatusNotFound || httpStatus == http.StatusRequestTimeout
 		isOk := httpStatus == http.StatusOK
 		responseHasExplicitError := len(response.Error) > 0
@@ -102,6 +122,25 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 	return nil, data.BlockInfo{}, WrapObserversError(response.Error)
 }
 
+// resolveHistoricalQuery returns a copy of the query whose timestamp or epoch, if any, got replaced with the nonce of the
+// matching block of the shard
+func (scQueryProcessor *SCQueryProcessor) resolveHistoricalQuery(ctx context.Context, shardID uint32, query *data.SCQuery) (*data.SCQuery, error) {
+	if !query.Historical.IsSet() {
+		return query, nil
+	}
+
+	block, err := scQueryProcessor.historyResolver.Resolve(ctx, shardID, query.Historical)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedQuery := *query
+	resolvedQuery.BlockNonce = core.OptionalUint64{Value: block.Nonce, HasValue: true}
+	resolvedQuery.Historical = common.HistoricalCoordinates{}
+
+	return &resolvedQuery, nil
+}
+
 func (scQueryProcessor *SCQueryProcessor) createRequestFromQuery(query *data.SCQuery) data.VmValueRequest {
 	request := data.VmValueRequest{}
 	request.Address = query.ScAddress
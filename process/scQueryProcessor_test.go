@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
@@ -21,7 +23,7 @@ var dummyScAddress = "erd1l453hd0gt5gzdp7czpuall8ggt2dcv5zwmfdf3sd3lguxseux2fsms
 func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilCoreProcessor, err)
 }
@@ -29,15 +31,23 @@ func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewSCQueryProcessor_NilPubConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil, &mock.HistoricalCoordinatesResolverStub{})
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilPubKeyConverter, err)
 }
 
+func TestNewSCQueryProcessor_NilHistoricalCoordinatesResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, nil)
+	require.Nil(t, processor)
+	require.Equal(t, ErrNilHistoricalCoordinatesResolver, err)
+}
+
 func TestNewSCQueryProcessor_WithCoreProcessor(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 	require.NotNil(t, processor)
 	require.Nil(t, err)
 }
@@ -50,9 +60,9 @@ func TestSCQueryProcessor_ExecuteQueryComputeShardIdFailsShouldErr(t *testing.T)
 		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
 			return 0, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -68,9 +78,9 @@ func TestSCQueryProcessor_ExecuteQueryGetObserversFailsShouldErr(t *testing.T) {
 		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
 			return nil, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -92,9 +102,9 @@ func TestSCQueryProcessor_ExecuteQuerySendingFailsOnAllObserversShouldErr(t *tes
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusNotFound, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.True(t, errors.Is(err, ErrSendingRequest))
 }
@@ -124,9 +134,9 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 
 			return http.StatusOK, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 
-	value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+	value, blockInfo, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -168,9 +178,9 @@ func TestSCQueryProcessor_ExecuteQueryWithCoordinates(t *testing.T) {
 
 			return http.StatusOK, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 
-	value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+	value, blockInfo, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -203,9 +213,9 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnRandomErrorShouldErr(t *testing.T)
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusInternalServerError, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -228,9 +238,72 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnBadRequestWithExplicitErrorShouldEr
 			response.(*data.ResponseVmValue).Error = errExpected.Error()
 			return http.StatusBadRequest, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
+
+func TestSCQueryProcessor_ExecuteQueryShouldStopOnContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	numCalls := 0
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+			return 0, nil
+		},
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+			return []*data.NodeData{
+				{Address: "address1", ShardId: 0},
+				{Address: "address2", ShardId: 0},
+			}, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			numCalls++
+			cancel()
+			return http.StatusRequestTimeout, context.Canceled
+		},
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{})
+
+	value, _, err := processor.ExecuteQuery(ctx, &data.SCQuery{ScAddress: dummyScAddress})
+	require.Empty(t, value)
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, 1, numCalls)
+}
+
+func TestSCQueryProcessor_ExecuteQueryWithHistoricalCoordinates(t *testing.T) {
+	t.Parallel()
+
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+			return 2, nil
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+			require.Equal(t, data.AvailabilityAll, dataAvailability)
+			return []*data.NodeData{
+				{Address: "adress1", ShardId: 2},
+			}, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			require.Equal(t, scQueryServicePath+"?blockNonce=37", path)
+			response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{}
+
+			return http.StatusOK, nil
+		},
+	}, testPubKeyConverter, &mock.HistoricalCoordinatesResolverStub{
+		ResolveCalled: func(shardID uint32, coordinates common.HistoricalCoordinates) (*data.BlockCoordinates, error) {
+			require.Equal(t, uint32(2), shardID)
+			return &data.BlockCoordinates{Nonce: 37}, nil
+		},
+	})
+
+	query := &data.SCQuery{
+		ScAddress:  dummyScAddress,
+		Historical: common.HistoricalCoordinates{Epoch: core.OptionalUint32{Value: 3, HasValue: true}},
+	}
+	_, _, err := processor.ExecuteQuery(context.Background(), query)
+	require.Nil(t, err)
+	require.True(t, query.Historical.IsSet())
+}
@@ -1,28 +1,47 @@
 package process
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
 )
 
+var circuitBreakerStateValues = map[data.CircuitBreakerState]int{
+	data.CircuitBreakerClosed:   0,
+	data.CircuitBreakerHalfOpen: 1,
+	data.CircuitBreakerOpen:     2,
+}
+
 // StatusProcessor is able to process status requests
 type StatusProcessor struct {
 	proc                  Processor
 	statusMetricsProvider StatusMetricsProvider
+	nodesHealth           observer.NodesHealthHandler
 }
 
 // NewStatusProcessor creates a new instance of AccountProcessor
-func NewStatusProcessor(proc Processor, statusMetricsProvider StatusMetricsProvider) (*StatusProcessor, error) {
+func NewStatusProcessor(
+	proc Processor,
+	statusMetricsProvider StatusMetricsProvider,
+	nodesHealth observer.NodesHealthHandler,
+) (*StatusProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(statusMetricsProvider) {
 		return nil, ErrNilStatusMetricsProvider
 	}
+	if check.IfNil(nodesHealth) {
+		return nil, ErrNilNodesHealthHandler
+	}
 
 	return &StatusProcessor{
 		proc:                  proc,
 		statusMetricsProvider: statusMetricsProvider,
+		nodesHealth:           nodesHealth,
 	}, nil
 }
 
@@ -33,5 +52,23 @@ func (sp *StatusProcessor) GetMetrics() map[string]*data.EndpointMetrics {
 
 // GetMetricsForPrometheus returns the metrics in a prometheus format
 func (sp *StatusProcessor) GetMetricsForPrometheus() string {
-	return sp.statusMetricsProvider.GetMetricsForPrometheus()
+	stringBuilder := strings.Builder{}
+	stringBuilder.WriteString(sp.statusMetricsProvider.GetMetricsForPrometheus())
+
+	// the circuit breaker state is exported as 0 - closed, 1 - half-open, 2 - open
+	for _, nodeHealth := range sp.nodesHealth.GetNodesHealth() {
+		stringBuilder.WriteString(fmt.Sprintf("observer_circuit_breaker_state{observer=\"%s\"} %d\n", nodeHealth.Address, circuitBreakerStateValues[nodeHealth.State]))
+		stringBuilder.WriteString(fmt.Sprintf("observer_health_score{observer=\"%s\"} %f\n", nodeHealth.Address, nodeHealth.HealthScore))
+		stringBuilder.WriteString(fmt.Sprintf("observer_consecutive_failures{observer=\"%s\"} %d\n", nodeHealth.Address, nodeHealth.ConsecutiveFailures))
+		stringBuilder.WriteString(fmt.Sprintf("observer_num_successes{observer=\"%s\"} %d\n", nodeHealth.Address, nodeHealth.NumSuccesses))
+		stringBuilder.WriteString(fmt.Sprintf("observer_num_failures{observer=\"%s\"} %d\n", nodeHealth.Address, nodeHealth.NumFailures))
+		stringBuilder.WriteString(fmt.Sprintf("observer_circuit_breaker_num_times_opened{observer=\"%s\"} %d\n", nodeHealth.Address, nodeHealth.NumTimesOpened))
+	}
+
+	return stringBuilder.String()
+}
+
+// GetObserversHealth returns the health details of the observers, as tracked by the circuit breaker
+func (sp *StatusProcessor) GetObserversHealth() []*data.NodeHealth {
+	return sp.nodesHealth.GetNodesHealth()
 }
@@ -14,7 +14,7 @@ func TestNewStatusProcessor(t *testing.T) {
 	t.Run("nil base processor - should error", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(nil, &mock.StatusMetricsProviderStub{})
+		sp, err := NewStatusProcessor(nil, &mock.StatusMetricsProviderStub{}, &mock.NodesHealthHandlerStub{})
 		require.Nil(t, sp)
 		require.Equal(t, ErrNilCoreProcessor, err)
 	})
@@ -22,15 +22,23 @@ func TestNewStatusProcessor(t *testing.T) {
 	t.Run("nil status metric provider - should error", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, nil)
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, nil, &mock.NodesHealthHandlerStub{})
 		require.Nil(t, sp)
 		require.Equal(t, ErrNilStatusMetricsProvider, err)
 	})
 
+	t.Run("nil nodes health handler - should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, nil)
+		require.Nil(t, sp)
+		require.Equal(t, ErrNilNodesHealthHandler, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{})
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, &mock.NodesHealthHandlerStub{})
 		require.NoError(t, err)
 		require.NotNil(t, sp)
 	})
@@ -48,7 +56,7 @@ func TestStatusProcessor_GetMetrics(t *testing.T) {
 			return expectedMetrics
 		},
 	}
-	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider)
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider, &mock.NodesHealthHandlerStub{})
 	require.NoError(t, err)
 	require.NotNil(t, sp)
 
@@ -66,7 +74,7 @@ func TestStatusProcessor_GetMetricsForPrometheus(t *testing.T) {
 			return expectedOutput
 		},
 	}
-	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider)
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider, &mock.NodesHealthHandlerStub{})
 	require.NoError(t, err)
 	require.NotNil(t, sp)
 
@@ -74,3 +82,55 @@ func TestStatusProcessor_GetMetricsForPrometheus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, metrics)
 }
+
+func TestStatusProcessor_GetMetricsForPrometheusShouldIncludeObserversHealth(t *testing.T) {
+	t.Parallel()
+
+	statusProvider := &mock.StatusMetricsProviderStub{
+		GetMetricsForPrometheusCalled: func() string {
+			return "metrics\n"
+		},
+	}
+	nodesHealth := &mock.NodesHealthHandlerStub{
+		GetNodesHealthCalled: func() []*data.NodeHealth {
+			return []*data.NodeHealth{
+				{
+					Address:             "http://observer0",
+					State:               data.CircuitBreakerOpen,
+					HealthScore:         0.5,
+					ConsecutiveFailures: 5,
+					NumSuccesses:        10,
+					NumFailures:         7,
+					NumTimesOpened:      1,
+				},
+			}
+		},
+	}
+	sp, _ := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider, nodesHealth)
+
+	expectedOutput := "metrics\n" +
+		"observer_circuit_breaker_state{observer=\"http://observer0\"} 2\n" +
+		"observer_health_score{observer=\"http://observer0\"} 0.500000\n" +
+		"observer_consecutive_failures{observer=\"http://observer0\"} 5\n" +
+		"observer_num_successes{observer=\"http://observer0\"} 10\n" +
+		"observer_num_failures{observer=\"http://observer0\"} 7\n" +
+		"observer_circuit_breaker_num_times_opened{observer=\"http://observer0\"} 1\n"
+	require.Equal(t, expectedOutput, sp.GetMetricsForPrometheus())
+}
+
+func TestStatusProcessor_GetObserversHealth(t *testing.T) {
+	t.Parallel()
+
+	expectedHealth := []*data.NodeHealth{
+		{Address: "http://observer0", State: data.CircuitBreakerClosed},
+		{Address: "http://observer1", State: data.CircuitBreakerHalfOpen},
+	}
+	nodesHealth := &mock.NodesHealthHandlerStub{
+		GetNodesHealthCalled: func() []*data.NodeHealth {
+			return expectedHealth
+		},
+	}
+	sp, _ := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, nodesHealth)
+
+	require.Equal(t, expectedHealth, sp.GetObserversHealth())
+}
@@ -0,0 +1,187 @@
+package streaming
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// activityFilter selects the transactions, smart contract results and log events involving a set of addresses,
+// token identifiers or event identifiers
+type activityFilter struct {
+	addresses map[string]struct{}
+	tokens    map[string]struct{}
+	events    map[string]struct{}
+}
+
+func newActivityFilter(addresses []string, tokens []string, events []string) *activityFilter {
+	return &activityFilter{
+		addresses: toSet(addresses),
+		tokens:    toSet(tokens),
+		events:    toSet(events),
+	}
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+// match returns the activity of the hyperblock matching the filter or nil if nothing matches. The smart contract
+// results are part of the hyperblock transactions, so they are matched the same way
+func (af *activityFilter) match(hyperblock *api.Hyperblock) *data.AddressActivity {
+	var transactions []*transaction.ApiTransactionResult
+	var events []*data.AddressActivityEvent
+	for _, tx := range hyperblock.Transactions {
+		if af.matchesTransaction(tx) {
+			transactions = append(transactions, tx)
+		}
+		if tx.Logs == nil {
+			continue
+		}
+
+		for _, event := range tx.Logs.Events {
+			if event != nil && af.matchesEvent(event) {
+				events = append(events, &data.AddressActivityEvent{TxHash: tx.Hash, Event: event})
+			}
+		}
+	}
+
+	if len(transactions) == 0 && len(events) == 0 {
+		return nil
+	}
+
+	return &data.AddressActivity{
+		HyperblockNonce: hyperblock.Nonce,
+		HyperblockHash:  hyperblock.Hash,
+		Timestamp:       hyperblock.Timestamp,
+		Transactions:    transactions,
+		Events:          events,
+	}
+}
+
+func (af *activityFilter) matchesTransaction(tx *transaction.ApiTransactionResult) bool {
+	if af.hasAddress(tx.Sender) || af.hasAddress(tx.Receiver) || af.hasAddress(tx.OriginalSender) {
+		return true
+	}
+	for _, receiver := range tx.Receivers {
+		if af.hasAddress(receiver) {
+			return true
+		}
+	}
+	for _, token := range tx.Tokens {
+		_, found := af.tokens[token]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesEvent checks the address and the identifier of the event. The token identifiers are looked up in the topics,
+// where the ESDT events hold them
+func (af *activityFilter) matchesEvent(event *transaction.Events) bool {
+	if af.hasAddress(event.Address) {
+		return true
+	}
+	_, found := af.events[event.Identifier]
+	if found {
+		return true
+	}
+	if len(af.tokens) == 0 {
+		return false
+	}
+
+	for _, topic := range event.Topics {
+		_, found = af.tokens[string(topic)]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (af *activityFilter) hasAddress(address string) bool {
+	if len(address) == 0 {
+		return false
+	}
+
+	_, found := af.addresses[address]
+	return found
+}
+
+// activitySubscription filters the hyperblocks of an underlying hyperblock subscription. A slow client blocks the
+// filtering, so the underlying subscription ends the same way it does for the hyperblock subscribers
+type activitySubscription struct {
+	hyperblocks data.HyperblockSubscription
+	filter      *activityFilter
+	ch          chan *data.AddressActivity
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+func newActivitySubscription(hyperblocks data.HyperblockSubscription, filter *activityFilter) *activitySubscription {
+	as := &activitySubscription{
+		hyperblocks: hyperblocks,
+		filter:      filter,
+		ch:          make(chan *data.AddressActivity),
+		done:        make(chan struct{}),
+	}
+
+	go as.filterHyperblocks()
+
+	return as
+}
+
+func (as *activitySubscription) filterHyperblocks() {
+	defer close(as.ch)
+
+	for {
+		select {
+		case hyperblock, ok := <-as.hyperblocks.Hyperblocks():
+			if !ok {
+				return
+			}
+
+			activity := as.filter.match(hyperblock)
+			if activity == nil {
+				continue
+			}
+
+			select {
+			case as.ch <- activity:
+			case <-as.done:
+				return
+			}
+		case <-as.done:
+			return
+		}
+	}
+}
+
+// Activities returns the channel on which the matching activity is pushed. The channel is closed when the
+// subscription ends
+func (as *activitySubscription) Activities() <-chan *data.AddressActivity {
+	return as.ch
+}
+
+// Err returns the reason why the subscription ended, if the stream ended it
+func (as *activitySubscription) Err() error {
+	return as.hyperblocks.Err()
+}
+
+// Close ends the subscription
+func (as *activitySubscription) Close() {
+	as.closeOnce.Do(func() {
+		close(as.done)
+		as.hyperblocks.Close()
+	})
+}
@@ -0,0 +1,33 @@
+package streaming
+
+import "errors"
+
+// ErrNilHyperblockProvider signals that a nil hyperblock provider has been provided
+var ErrNilHyperblockProvider = errors.New("nil hyperblock provider")
+
+// ErrNilLatestNonceProvider signals that a nil latest nonce provider has been provided
+var ErrNilLatestNonceProvider = errors.New("nil latest nonce provider")
+
+// ErrInvalidPollInterval signals that an invalid poll interval has been provided
+var ErrInvalidPollInterval = errors.New("invalid poll interval")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidSubscriberBufferSize signals that an invalid subscriber buffer size has been provided
+var ErrInvalidSubscriberBufferSize = errors.New("invalid subscriber buffer size")
+
+// ErrInvalidMaxHyperblocksPerPoll signals that an invalid maximum number of hyperblocks per poll has been provided
+var ErrInvalidMaxHyperblocksPerPoll = errors.New("invalid maximum number of hyperblocks per poll")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidMaxActivityFilters signals that an invalid maximum number of address activity filters has been provided
+var ErrInvalidMaxActivityFilters = errors.New("invalid maximum number of address activity filters")
+
+// ErrSlowSubscriber signals that a subscriber was disconnected because it did not keep up with the stream
+var ErrSlowSubscriber = errors.New("the subscriber did not keep up with the stream, resume from the next nonce")
+
+// ErrStreamClosed signals that the stream has been closed
+var ErrStreamClosed = errors.New("the hyperblock stream has been closed")
@@ -0,0 +1,329 @@
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/streaming")
+
+// ArgsHyperblockStreamer holds the arguments needed for creating a hyperblock streamer
+type ArgsHyperblockStreamer struct {
+	HyperblockProvider    HyperblockProvider
+	LatestNonceProvider   LatestNonceProvider
+	PollInterval          time.Duration
+	MaxSubscribers        int
+	SubscriberBufferSize  int
+	MaxResumeDistance     uint64
+	MaxHyperblocksPerPoll int
+	PubKeyConverter       core.PubkeyConverter
+	MaxActivityFilters    int
+}
+
+type hyperblockKey struct {
+	nonce               uint64
+	withLogs            bool
+	withAlteredAccounts bool
+}
+
+// hyperblockStreamer follows the latest fully synchronized hyperblock nonce and pushes the new hyperblocks to the
+// subscribers. Each hyperblock is built once for all the subscribers requesting the same options
+type hyperblockStreamer struct {
+	hyperblockProvider    HyperblockProvider
+	latestNonceProvider   LatestNonceProvider
+	pollInterval          time.Duration
+	maxSubscribers        int
+	subscriberBufferSize  int
+	maxResumeDistance     uint64
+	maxHyperblocksPerPoll int
+	pubKeyConverter       core.PubkeyConverter
+	maxActivityFilters    int
+	ctx                   context.Context
+	cancelFunc            func()
+
+	// cache is only accessed by the polling goroutine
+	cache map[hyperblockKey]*api.Hyperblock
+
+	mutSubscribers sync.Mutex
+	subscribers    map[*subscription]struct{}
+}
+
+// NewHyperblockStreamer returns a new instance of hyperblockStreamer
+func NewHyperblockStreamer(args ArgsHyperblockStreamer) (*hyperblockStreamer, error) {
+	if args.HyperblockProvider == nil {
+		return nil, ErrNilHyperblockProvider
+	}
+	if args.LatestNonceProvider == nil {
+		return nil, ErrNilLatestNonceProvider
+	}
+	if args.PollInterval <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPollInterval, args.PollInterval)
+	}
+	if args.MaxSubscribers <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxSubscribers, args.MaxSubscribers)
+	}
+	if args.SubscriberBufferSize <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSubscriberBufferSize, args.SubscriberBufferSize)
+	}
+	if args.MaxHyperblocksPerPoll <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxHyperblocksPerPoll, args.MaxHyperblocksPerPoll)
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if args.MaxActivityFilters <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxActivityFilters, args.MaxActivityFilters)
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return &hyperblockStreamer{
+		hyperblockProvider:    args.HyperblockProvider,
+		latestNonceProvider:   args.LatestNonceProvider,
+		pollInterval:          args.PollInterval,
+		maxSubscribers:        args.MaxSubscribers,
+		subscriberBufferSize:  args.SubscriberBufferSize,
+		maxResumeDistance:     args.MaxResumeDistance,
+		maxHyperblocksPerPoll: args.MaxHyperblocksPerPoll,
+		pubKeyConverter:       args.PubKeyConverter,
+		maxActivityFilters:    args.MaxActivityFilters,
+		ctx:                   ctx,
+		cancelFunc:            cancelFunc,
+		cache:                 make(map[hyperblockKey]*api.Hyperblock),
+		subscribers:           make(map[*subscription]struct{}),
+	}, nil
+}
+
+// IsEnabled returns true as the hyperblocks stream is enabled
+func (hs *hyperblockStreamer) IsEnabled() bool {
+	return true
+}
+
+// Subscribe registers a new subscriber. Without a starting nonce, the subscriber receives the hyperblocks following
+// the latest fully synchronized one
+func (hs *hyperblockStreamer) Subscribe(ctx context.Context, options common.HyperblockStreamOptions) (data.HyperblockSubscription, error) {
+	latestNonce, err := hs.latestNonceProvider.GetLatestFullySynchronizedHyperblockNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextNonce := latestNonce + 1
+	if options.FromNonce.HasValue {
+		nextNonce = options.FromNonce.Value
+		if latestNonce > nextNonce && latestNonce-nextNonce > hs.maxResumeDistance {
+			return nil, fmt.Errorf("%w: the latest nonce is %d and at most %d nonces can be resumed",
+				apiErrors.ErrHyperblockResumeNonceTooOld, latestNonce, hs.maxResumeDistance)
+		}
+	}
+
+	sub := &subscription{
+		streamer:  hs,
+		options:   options,
+		nextNonce: nextNonce,
+		ch:        make(chan *api.Hyperblock, hs.subscriberBufferSize),
+	}
+
+	hs.mutSubscribers.Lock()
+	defer hs.mutSubscribers.Unlock()
+
+	if hs.ctx.Err() != nil {
+		return nil, ErrStreamClosed
+	}
+	if len(hs.subscribers) >= hs.maxSubscribers {
+		return nil, fmt.Errorf("%w: the limit is %d", apiErrors.ErrTooManyHyperblockSubscribers, hs.maxSubscribers)
+	}
+	hs.subscribers[sub] = struct{}{}
+
+	return sub, nil
+}
+
+// SubscribeToAddressActivity registers a new subscriber to the transactions, smart contract results and log events
+// involving the provided addresses, token identifiers or event identifiers
+func (hs *hyperblockStreamer) SubscribeToAddressActivity(ctx context.Context, options common.AddressActivityOptions) (data.AddressActivitySubscription, error) {
+	err := hs.checkActivityOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblocks, err := hs.Subscribe(ctx, common.HyperblockStreamOptions{
+		FromNonce: options.FromNonce,
+		WithLogs:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filter := newActivityFilter(options.Addresses, options.Tokens, options.Events)
+
+	return newActivitySubscription(hyperblocks, filter), nil
+}
+
+func (hs *hyperblockStreamer) checkActivityOptions(options common.AddressActivityOptions) error {
+	numFilters := len(options.Addresses) + len(options.Tokens) + len(options.Events)
+	if numFilters == 0 {
+		return fmt.Errorf("%w: at least an address, a token or an event must be provided", apiErrors.ErrInvalidActivityFilters)
+	}
+	if numFilters > hs.maxActivityFilters {
+		return fmt.Errorf("%w: at most %d filters can be provided", apiErrors.ErrInvalidActivityFilters, hs.maxActivityFilters)
+	}
+
+	for _, address := range options.Addresses {
+		_, err := hs.pubKeyConverter.Decode(address)
+		if err != nil {
+			return fmt.Errorf("%w: invalid address %s: %s", apiErrors.ErrInvalidActivityFilters, address, err.Error())
+		}
+	}
+	for _, identifiers := range [][]string{options.Tokens, options.Events} {
+		for _, identifier := range identifiers {
+			if len(identifier) == 0 {
+				return fmt.Errorf("%w: empty token or event identifier", apiErrors.ErrInvalidActivityFilters)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (hs *hyperblockStreamer) unsubscribe(sub *subscription) {
+	hs.mutSubscribers.Lock()
+	delete(hs.subscribers, sub)
+	hs.mutSubscribers.Unlock()
+}
+
+// Start starts following the latest fully synchronized hyperblock nonce
+func (hs *hyperblockStreamer) Start() {
+	go hs.streamHyperblocks()
+}
+
+func (hs *hyperblockStreamer) streamHyperblocks() {
+	timer := time.NewTimer(hs.pollInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(hs.pollInterval)
+
+		select {
+		case <-timer.C:
+			hs.pushNewHyperblocks()
+		case <-hs.ctx.Done():
+			log.Debug("finishing hyperblockStreamer...")
+			return
+		}
+	}
+}
+
+func (hs *hyperblockStreamer) pushNewHyperblocks() {
+	subscribers := hs.getSubscribers()
+	if len(subscribers) == 0 {
+		hs.cache = make(map[hyperblockKey]*api.Hyperblock)
+		return
+	}
+
+	latestNonce, err := hs.latestNonceProvider.GetLatestFullySynchronizedHyperblockNonce(hs.ctx)
+	if err != nil {
+		log.Debug("cannot get the latest fully synchronized hyperblock nonce", "error", err.Error())
+		return
+	}
+
+	for _, sub := range subscribers {
+		hs.pushToSubscriber(sub, latestNonce)
+	}
+
+	hs.pruneCache(subscribers)
+}
+
+func (hs *hyperblockStreamer) getSubscribers() []*subscription {
+	hs.mutSubscribers.Lock()
+	defer hs.mutSubscribers.Unlock()
+
+	subscribers := make([]*subscription, 0, len(hs.subscribers))
+	for sub := range hs.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+
+	return subscribers
+}
+
+func (hs *hyperblockStreamer) pushToSubscriber(sub *subscription, latestNonce uint64) {
+	for i := 0; i < hs.maxHyperblocksPerPoll && sub.nextNonce <= latestNonce; i++ {
+		hyperblock, err := hs.getHyperblock(sub.nextNonce, sub.options)
+		if err != nil {
+			log.Debug("cannot build the hyperblock to stream", "nonce", sub.nextNonce, "error", err.Error())
+			return
+		}
+
+		if !sub.push(hyperblock) {
+			return
+		}
+		sub.nextNonce++
+	}
+}
+
+func (hs *hyperblockStreamer) getHyperblock(nonce uint64, options common.HyperblockStreamOptions) (*api.Hyperblock, error) {
+	key := hyperblockKey{
+		nonce:               nonce,
+		withLogs:            options.WithLogs,
+		withAlteredAccounts: options.WithAlteredAccounts,
+	}
+	hyperblock, found := hs.cache[key]
+	if found {
+		return hyperblock, nil
+	}
+
+	queryOptions := common.HyperblockQueryOptions{
+		WithLogs:            options.WithLogs,
+		WithAlteredAccounts: options.WithAlteredAccounts,
+	}
+	response, err := hs.hyperblockProvider.GetHyperBlockByNonce(hs.ctx, nonce, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblock = &response.Data.Hyperblock
+	hs.cache[key] = hyperblock
+
+	return hyperblock, nil
+}
+
+// pruneCache removes the hyperblocks no subscriber still needs
+func (hs *hyperblockStreamer) pruneCache(subscribers []*subscription) {
+	minNextNonce := subscribers[0].nextNonce
+	for _, sub := range subscribers[1:] {
+		if sub.nextNonce < minNextNonce {
+			minNextNonce = sub.nextNonce
+		}
+	}
+
+	for key := range hs.cache {
+		if key.nonce < minNextNonce {
+			delete(hs.cache, key)
+		}
+	}
+}
+
+// Close stops the stream and ends all the subscriptions
+func (hs *hyperblockStreamer) Close() error {
+	hs.cancelFunc()
+
+	for _, sub := range hs.getSubscribers() {
+		hs.unsubscribe(sub)
+		sub.end(ErrStreamClosed)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hs *hyperblockStreamer) IsInterfaceNil() bool {
+	return hs == nil
+}
@@ -0,0 +1,379 @@
+package streaming_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/streaming"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+type hyperblockProviderStub struct {
+	mut          sync.Mutex
+	numQueries   map[uint64]int
+	transactions []*transaction.ApiTransactionResult
+}
+
+func newHyperblockProviderStub() *hyperblockProviderStub {
+	return &hyperblockProviderStub{
+		numQueries: make(map[uint64]int),
+	}
+}
+
+func (stub *hyperblockProviderStub) GetHyperBlockByNonce(_ context.Context, nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	stub.mut.Lock()
+	stub.numQueries[nonce]++
+	stub.mut.Unlock()
+
+	return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce, Transactions: stub.transactions}), nil
+}
+
+func (stub *hyperblockProviderStub) getNumQueries(nonce uint64) int {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	return stub.numQueries[nonce]
+}
+
+type latestNonceProviderStub struct {
+	latestNonce uint64
+}
+
+func (stub *latestNonceProviderStub) GetLatestFullySynchronizedHyperblockNonce(_ context.Context) (uint64, error) {
+	return atomic.LoadUint64(&stub.latestNonce), nil
+}
+
+func (stub *latestNonceProviderStub) setLatestNonce(nonce uint64) {
+	atomic.StoreUint64(&stub.latestNonce, nonce)
+}
+
+func createMockArgsHyperblockStreamer() streaming.ArgsHyperblockStreamer {
+	return streaming.ArgsHyperblockStreamer{
+		HyperblockProvider:    newHyperblockProviderStub(),
+		LatestNonceProvider:   &latestNonceProviderStub{},
+		PollInterval:          5 * time.Millisecond,
+		MaxSubscribers:        10,
+		SubscriberBufferSize:  10,
+		MaxResumeDistance:     100,
+		MaxHyperblocksPerPoll: 10,
+		PubKeyConverter:       testPubKeyConverter,
+		MaxActivityFilters:    3,
+	}
+}
+
+func receiveNonces(t *testing.T, subscription data.HyperblockSubscription, numHyperblocks int) []uint64 {
+	nonces := make([]uint64, 0, numHyperblocks)
+	for len(nonces) < numHyperblocks {
+		select {
+		case hyperblock, ok := <-subscription.Hyperblocks():
+			require.True(t, ok)
+			nonces = append(nonces, hyperblock.Nonce)
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout receiving the hyperblocks")
+		}
+	}
+
+	return nonces
+}
+
+func TestNewHyperblockStreamer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hyperblock provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockStreamer()
+		args.HyperblockProvider = nil
+		streamer, err := streaming.NewHyperblockStreamer(args)
+
+		assert.Nil(t, streamer)
+		assert.Equal(t, streaming.ErrNilHyperblockProvider, err)
+	})
+	t.Run("nil latest nonce provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockStreamer()
+		args.LatestNonceProvider = nil
+		streamer, err := streaming.NewHyperblockStreamer(args)
+
+		assert.Nil(t, streamer)
+		assert.Equal(t, streaming.ErrNilLatestNonceProvider, err)
+	})
+	t.Run("invalid poll interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockStreamer()
+		args.PollInterval = 0
+		streamer, err := streaming.NewHyperblockStreamer(args)
+
+		assert.Nil(t, streamer)
+		assert.True(t, errors.Is(err, streaming.ErrInvalidPollInterval))
+	})
+	t.Run("invalid maximum number of subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockStreamer()
+		args.MaxSubscribers = 0
+		streamer, err := streaming.NewHyperblockStreamer(args)
+
+		assert.Nil(t, streamer)
+		assert.True(t, errors.Is(err, streaming.ErrInvalidMaxSubscribers))
+	})
+	t.Run("invalid subscriber buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockStreamer()
+		args.SubscriberBufferSize = 0
+		streamer, err := streaming.NewHyperblockStreamer(args)
+
+		assert.Nil(t, streamer)
+		assert.True(t, errors.Is(err, streaming.ErrInvalidSubscriberBufferSize))
+	})
+	t.Run("invalid maximum number of hyperblocks per poll should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockStreamer()
+		args.MaxHyperblocksPerPoll = 0
+		streamer, err := streaming.NewHyperblockStreamer(args)
+
+		assert.Nil(t, streamer)
+		assert.True(t, errors.Is(err, streaming.ErrInvalidMaxHyperblocksPerPoll))
+	})
+	t.Run("nil public key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockStreamer()
+		args.PubKeyConverter = nil
+		streamer, err := streaming.NewHyperblockStreamer(args)
+
+		assert.Nil(t, streamer)
+		assert.Equal(t, streaming.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid maximum number of activity filters should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockStreamer()
+		args.MaxActivityFilters = 0
+		streamer, err := streaming.NewHyperblockStreamer(args)
+
+		assert.Nil(t, streamer)
+		assert.True(t, errors.Is(err, streaming.ErrInvalidMaxActivityFilters))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		streamer, err := streaming.NewHyperblockStreamer(createMockArgsHyperblockStreamer())
+
+		assert.Nil(t, err)
+		assert.False(t, streamer.IsInterfaceNil())
+		assert.True(t, streamer.IsEnabled())
+	})
+}
+
+func TestHyperblockStreamer_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHyperblockStreamer()
+	args.MaxSubscribers = 1
+	args.MaxResumeDistance = 10
+	args.LatestNonceProvider = &latestNonceProviderStub{latestNonce: 100}
+	streamer, _ := streaming.NewHyperblockStreamer(args)
+
+	options := common.HyperblockStreamOptions{FromNonce: core.OptionalUint64{Value: 89, HasValue: true}}
+	_, err := streamer.Subscribe(context.Background(), options)
+	assert.True(t, errors.Is(err, apiErrors.ErrHyperblockResumeNonceTooOld))
+
+	options.FromNonce.Value = 90
+	subscription, err := streamer.Subscribe(context.Background(), options)
+	require.Nil(t, err)
+
+	_, err = streamer.Subscribe(context.Background(), common.HyperblockStreamOptions{})
+	assert.True(t, errors.Is(err, apiErrors.ErrTooManyHyperblockSubscribers))
+
+	subscription.Close()
+	_, ok := <-subscription.Hyperblocks()
+	assert.False(t, ok)
+	assert.Nil(t, subscription.Err())
+
+	_, err = streamer.Subscribe(context.Background(), common.HyperblockStreamOptions{})
+	assert.Nil(t, err)
+
+	_ = streamer.Close()
+	_, err = streamer.Subscribe(context.Background(), common.HyperblockStreamOptions{})
+	assert.Equal(t, streaming.ErrStreamClosed, err)
+}
+
+func TestHyperblockStreamer_ShouldBuildEachHyperblockOnce(t *testing.T) {
+	t.Parallel()
+
+	hyperblockProvider := newHyperblockProviderStub()
+	latestNonceProvider := &latestNonceProviderStub{latestNonce: 10}
+	args := createMockArgsHyperblockStreamer()
+	args.HyperblockProvider = hyperblockProvider
+	args.LatestNonceProvider = latestNonceProvider
+	streamer, _ := streaming.NewHyperblockStreamer(args)
+
+	firstSubscription, _ := streamer.Subscribe(context.Background(), common.HyperblockStreamOptions{})
+	secondSubscription, _ := streamer.Subscribe(context.Background(), common.HyperblockStreamOptions{})
+	resumedSubscription, _ := streamer.Subscribe(context.Background(), common.HyperblockStreamOptions{
+		FromNonce: core.OptionalUint64{Value: 9, HasValue: true},
+	})
+
+	streamer.Start()
+	defer func() {
+		_ = streamer.Close()
+	}()
+
+	latestNonceProvider.setLatestNonce(12)
+
+	assert.Equal(t, []uint64{11, 12}, receiveNonces(t, firstSubscription, 2))
+	assert.Equal(t, []uint64{11, 12}, receiveNonces(t, secondSubscription, 2))
+	assert.Equal(t, []uint64{9, 10, 11, 12}, receiveNonces(t, resumedSubscription, 4))
+	assert.Equal(t, 1, hyperblockProvider.getNumQueries(11))
+	assert.Equal(t, 1, hyperblockProvider.getNumQueries(12))
+}
+
+func TestHyperblockStreamer_ShouldDropTheSlowSubscribers(t *testing.T) {
+	t.Parallel()
+
+	latestNonceProvider := &latestNonceProviderStub{}
+	args := createMockArgsHyperblockStreamer()
+	args.SubscriberBufferSize = 2
+	args.LatestNonceProvider = latestNonceProvider
+	streamer, _ := streaming.NewHyperblockStreamer(args)
+
+	subscription, _ := streamer.Subscribe(context.Background(), common.HyperblockStreamOptions{})
+	streamer.Start()
+	defer func() {
+		_ = streamer.Close()
+	}()
+
+	latestNonceProvider.setLatestNonce(5)
+	// the subscriber does not read while the hyperblocks are pushed
+	time.Sleep(50 * time.Millisecond)
+
+	nonces := make([]uint64, 0)
+	for hyperblock := range subscription.Hyperblocks() {
+		nonces = append(nonces, hyperblock.Nonce)
+	}
+
+	assert.Equal(t, []uint64{1, 2}, nonces)
+	assert.Equal(t, streaming.ErrSlowSubscriber, subscription.Err())
+}
+
+func TestHyperblockStreamer_CloseShouldEndTheSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	streamer, _ := streaming.NewHyperblockStreamer(createMockArgsHyperblockStreamer())
+	subscription, _ := streamer.Subscribe(context.Background(), common.HyperblockStreamOptions{})
+
+	err := streamer.Close()
+	assert.Nil(t, err)
+
+	_, ok := <-subscription.Hyperblocks()
+	assert.False(t, ok)
+	assert.Equal(t, streaming.ErrStreamClosed, subscription.Err())
+}
+
+func TestHyperblockStreamer_SubscribeToAddressActivity(t *testing.T) {
+	t.Parallel()
+
+	address := testPubKeyConverter.SilentEncode(bytes.Repeat([]byte{1}, 32), nil)
+	otherAddress := testPubKeyConverter.SilentEncode(bytes.Repeat([]byte{2}, 32), nil)
+
+	t.Run("invalid filters should error", func(t *testing.T) {
+		t.Parallel()
+
+		streamer, _ := streaming.NewHyperblockStreamer(createMockArgsHyperblockStreamer())
+
+		_, err := streamer.SubscribeToAddressActivity(context.Background(), common.AddressActivityOptions{})
+		assert.True(t, errors.Is(err, apiErrors.ErrInvalidActivityFilters))
+
+		_, err = streamer.SubscribeToAddressActivity(context.Background(), common.AddressActivityOptions{
+			Addresses: []string{address, otherAddress},
+			Tokens:    []string{"TKN-abcdef", "OTHER-abcdef"},
+		})
+		assert.True(t, errors.Is(err, apiErrors.ErrInvalidActivityFilters))
+
+		_, err = streamer.SubscribeToAddressActivity(context.Background(), common.AddressActivityOptions{
+			Addresses: []string{"erd1invalid"},
+		})
+		assert.True(t, errors.Is(err, apiErrors.ErrInvalidActivityFilters))
+
+		_, err = streamer.SubscribeToAddressActivity(context.Background(), common.AddressActivityOptions{
+			Events: []string{""},
+		})
+		assert.True(t, errors.Is(err, apiErrors.ErrInvalidActivityFilters))
+	})
+	t.Run("should push only the matching activity", func(t *testing.T) {
+		t.Parallel()
+
+		receivedTx := &transaction.ApiTransactionResult{Hash: "received", Sender: otherAddress, Receiver: address}
+		tokenTx := &transaction.ApiTransactionResult{Hash: "token", Sender: otherAddress, Receiver: otherAddress, Tokens: []string{"TKN-abcdef"}}
+		unrelatedTx := &transaction.ApiTransactionResult{
+			Hash:     "unrelated",
+			Sender:   otherAddress,
+			Receiver: otherAddress,
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{Address: otherAddress, Identifier: "ESDTTransfer", Topics: [][]byte{[]byte("TKN-abcdef")}},
+					{Address: otherAddress, Identifier: "completedTxEvent"},
+					{Address: otherAddress, Identifier: "signalError"},
+				},
+			},
+		}
+		hyperblockProvider := newHyperblockProviderStub()
+		hyperblockProvider.transactions = []*transaction.ApiTransactionResult{receivedTx, tokenTx, unrelatedTx}
+		args := createMockArgsHyperblockStreamer()
+		args.HyperblockProvider = hyperblockProvider
+		args.LatestNonceProvider = &latestNonceProviderStub{latestNonce: 5}
+		streamer, _ := streaming.NewHyperblockStreamer(args)
+
+		subscription, err := streamer.SubscribeToAddressActivity(context.Background(), common.AddressActivityOptions{
+			FromNonce: core.OptionalUint64{Value: 5, HasValue: true},
+			Addresses: []string{address},
+			Tokens:    []string{"TKN-abcdef"},
+			Events:    []string{"signalError"},
+		})
+		require.Nil(t, err)
+		streamer.Start()
+		defer func() {
+			_ = streamer.Close()
+		}()
+
+		var activity *data.AddressActivity
+		select {
+		case activity = <-subscription.Activities():
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout receiving the activity")
+		}
+
+		require.NotNil(t, activity)
+		assert.Equal(t, uint64(5), activity.HyperblockNonce)
+		assert.Equal(t, []*transaction.ApiTransactionResult{receivedTx, tokenTx}, activity.Transactions)
+		expectedEvents := []*data.AddressActivityEvent{
+			{TxHash: "unrelated", Event: unrelatedTx.Logs.Events[0]},
+			{TxHash: "unrelated", Event: unrelatedTx.Logs.Events[2]},
+		}
+		assert.Equal(t, expectedEvents, activity.Events)
+
+		subscription.Close()
+		_, ok := <-subscription.Activities()
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,18 @@
+package streaming
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockProvider defines a component able to build the hyperblock of a nonce
+type HyperblockProvider interface {
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+}
+
+// LatestNonceProvider defines a component able to fetch the nonce of the latest fully synchronized hyperblock
+type LatestNonceProvider interface {
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
+}
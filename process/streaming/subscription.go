@@ -0,0 +1,76 @@
+package streaming
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+// subscription is the subscription of a client to the hyperblocks stream. The nextNonce field is only accessed
+// by the polling goroutine of the streamer, once the subscription has been registered
+type subscription struct {
+	streamer  *hyperblockStreamer
+	options   common.HyperblockStreamOptions
+	nextNonce uint64
+	ch        chan *api.Hyperblock
+
+	mut    sync.Mutex
+	closed bool
+	err    error
+}
+
+// Hyperblocks returns the channel on which the hyperblocks are pushed. The channel is closed when the subscription ends
+func (s *subscription) Hyperblocks() <-chan *api.Hyperblock {
+	return s.ch
+}
+
+// Err returns the reason why the subscription ended, if the stream ended it
+func (s *subscription) Err() error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	return s.err
+}
+
+// Close ends the subscription
+func (s *subscription) Close() {
+	s.streamer.unsubscribe(s)
+	s.end(nil)
+}
+
+// push adds the hyperblock to the buffer of the subscription without blocking. If the buffer is full, the subscription
+// is ended, so a slow client cannot hold back the stream nor make the proxy buffer hyperblocks without limit
+func (s *subscription) push(hyperblock *api.Hyperblock) bool {
+	s.mut.Lock()
+	if s.closed {
+		s.mut.Unlock()
+		return false
+	}
+
+	select {
+	case s.ch <- hyperblock:
+		s.mut.Unlock()
+		return true
+	default:
+		s.mut.Unlock()
+	}
+
+	s.streamer.unsubscribe(s)
+	s.end(ErrSlowSubscriber)
+
+	return false
+}
+
+func (s *subscription) end(err error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	s.err = err
+	close(s.ch)
+}
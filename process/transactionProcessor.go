@@ -1,10 +1,13 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -12,6 +15,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -57,16 +62,21 @@ const (
 )
 
 type erdTransaction struct {
-	Nonce     uint64 `json:"nonce"`
-	Value     string `json:"value"`
-	RcvAddr   string `json:"receiver"`
-	SndAddr   string `json:"sender"`
-	GasPrice  uint64 `json:"gasPrice,omitempty"`
-	GasLimit  uint64 `json:"gasLimit,omitempty"`
-	Data      []byte `json:"data,omitempty"`
-	Signature string `json:"signature,omitempty"`
-	ChainID   string `json:"chainID"`
-	Version   uint32 `json:"version"`
+	Nonce       uint64 `json:"nonce"`
+	Value       string `json:"value"`
+	RcvAddr     string `json:"receiver"`
+	SndAddr     string `json:"sender"`
+	SndUserName []byte `json:"senderUsername,omitempty"`
+	RcvUserName []byte `json:"receiverUsername,omitempty"`
+	GasPrice    uint64 `json:"gasPrice,omitempty"`
+	GasLimit    uint64 `json:"gasLimit,omitempty"`
+	Data        []byte `json:"data,omitempty"`
+	Signature   string `json:"signature,omitempty"`
+	ChainID     string `json:"chainID"`
+	Version     uint32 `json:"version"`
+	Options     uint32 `json:"options,omitempty"`
+	Guardian    string `json:"guardian,omitempty"`
+	Relayer     string `json:"relayer,omitempty"`
 }
 
 type tupleHashWasFetched struct {
@@ -84,6 +94,9 @@ type TransactionProcessor struct {
 	newTxCostProcessor           func() (TransactionCostHandler, error)
 	mergeLogsHandler             LogsMergerHandler
 	shouldAllowEntireTxPoolFetch bool
+	responseCache                ResponseCacheHandler
+	responseTTL                  ResponseTTLHandler
+	broadcastConfig              config.TransactionBroadcastConfig
 }
 
 // NewTransactionProcessor creates a new instance of TransactionProcessor
@@ -95,6 +108,9 @@ func NewTransactionProcessor(
 	newTxCostProcessor func() (TransactionCostHandler, error),
 	logsMerger LogsMergerHandler,
 	allowEntireTxPoolFetch bool,
+	responseCache ResponseCacheHandler,
+	responseTTL ResponseTTLHandler,
+	broadcastConfig config.TransactionBroadcastConfig,
 ) (*TransactionProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -114,6 +130,15 @@ func NewTransactionProcessor(
 	if check.IfNil(logsMerger) {
 		return nil, ErrNilLogsMerger
 	}
+	if check.IfNil(responseCache) {
+		return nil, ErrNilResponseCache
+	}
+	if check.IfNil(responseTTL) {
+		return nil, ErrNilResponseTTLHandler
+	}
+	if broadcastConfig.Enabled && !isBroadcastQuorumValid(broadcastConfig.NumObservers, broadcastConfig.Quorum) {
+		return nil, ErrInvalidTransactionBroadcastConfig
+	}
 
 	// no reason to get this from configs. If we are going to change the marshaller for the relayed transaction v1,
 	// we will need also an enable epoch handler
@@ -127,11 +152,14 @@ func NewTransactionProcessor(
 		mergeLogsHandler:             logsMerger,
 		shouldAllowEntireTxPoolFetch: allowEntireTxPoolFetch,
 		relayedTxsMarshaller:         relayedTxsMarshaller,
+		responseCache:                responseCache,
+		responseTTL:                  responseTTL,
+		broadcastConfig:              broadcastConfig,
 	}, nil
 }
 
 // SendTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tp *TransactionProcessor) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return http.StatusBadRequest, "", err
@@ -155,7 +183,7 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 	txResponse := data.ResponseTransaction{}
 	for _, observer := range observers {
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, TransactionSendPath, tx, &txResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, TransactionSendPath, tx, &txResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info(fmt.Sprintf("Transaction sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
@@ -178,8 +206,142 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 	return http.StatusInternalServerError, "", WrapObserversError(txResponse.Error)
 }
 
+// IsBroadcastEnabled returns true if the transactions should be broadcast to multiple observers by default
+func (tp *TransactionProcessor) IsBroadcastEnabled() bool {
+	return tp.broadcastConfig.Enabled
+}
+
+// BroadcastTransaction sends the transaction in parallel to multiple observers of the sender's shard and succeeds only
+// if at least the quorum of them accept it. The returned response holds the observers that accepted and rejected it
+func (tp *TransactionProcessor) BroadcastTransaction(
+	ctx context.Context,
+	tx *data.Transaction,
+	options common.TransactionBroadcastOptions,
+) (int, *data.TransactionBroadcastResponse, error) {
+	numObservers := tp.broadcastConfig.NumObservers
+	if options.NumObservers.HasValue {
+		numObservers = options.NumObservers.Value
+	}
+	quorum := tp.broadcastConfig.Quorum
+	if options.Quorum.HasValue {
+		quorum = options.Quorum.Value
+	}
+	if !isBroadcastQuorumValid(numObservers, quorum) {
+		return http.StatusBadRequest, nil, ErrInvalidBroadcastQuorum
+	}
+
+	err := tp.checkTransactionFields(tx)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
+	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
+	shardID, err := tp.proc.ComputeShardId(senderBuff)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	observers, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	if uint32(len(observers)) < quorum {
+		return http.StatusInternalServerError, nil, fmt.Errorf("%w: shard %d has %d observers, quorum is %d",
+			ErrNotEnoughObserversForBroadcast, shardID, len(observers), quorum)
+	}
+	if uint32(len(observers)) > numObservers {
+		observers = observers[:numObservers]
+	}
+
+	response := tp.broadcastToObservers(ctx, observers, tx)
+	response.Quorum = quorum
+	if uint32(len(response.Accepted)) >= quorum {
+		log.Info(fmt.Sprintf("Transaction broadcast successfully to %d of %d observers from shard %v, received tx hash %s",
+			len(response.Accepted),
+			len(observers),
+			shardID,
+			response.TxHash,
+		))
+		return http.StatusOK, response, nil
+	}
+
+	// if no observer accepted the transaction and all of them found it invalid, return the observers' answer
+	if len(response.Accepted) == 0 && areAllRejectionsBadRequests(response.Rejected) {
+		return http.StatusBadRequest, response, WrapObserversError(response.Rejected[0].Error)
+	}
+
+	return http.StatusInternalServerError, response, fmt.Errorf("%w: %d of %d observers accepted the transaction, quorum is %d",
+		ErrBroadcastQuorumNotReached, len(response.Accepted), len(observers), quorum)
+}
+
+func (tp *TransactionProcessor) broadcastToObservers(
+	ctx context.Context,
+	observers []*data.NodeData,
+	tx *data.Transaction,
+) *data.TransactionBroadcastResponse {
+	txResponses := make([]data.ResponseTransaction, len(observers))
+	respCodes := make([]int, len(observers))
+	errs := make([]error, len(observers))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(observers))
+	for idx, observer := range observers {
+		go func(index int, address string) {
+			defer wg.Done()
+
+			respCodes[index], errs[index] = tp.proc.CallPostRestEndPoint(ctx, address, TransactionSendPath, tx, &txResponses[index])
+		}(idx, observer.Address)
+	}
+	wg.Wait()
+
+	response := &data.TransactionBroadcastResponse{
+		Accepted: make([]string, 0, len(observers)),
+		Rejected: make([]*data.TransactionBroadcastRejection, 0),
+	}
+	for idx, observer := range observers {
+		if respCodes[idx] == http.StatusOK && errs[idx] == nil {
+			if len(response.TxHash) == 0 {
+				response.TxHash = txResponses[idx].Data.TxHash
+			}
+			response.Accepted = append(response.Accepted, observer.Address)
+			continue
+		}
+
+		rejection := &data.TransactionBroadcastRejection{
+			Observer: observer.Address,
+			Code:     respCodes[idx],
+			Error:    txResponses[idx].Error,
+		}
+		if errs[idx] != nil {
+			rejection.Error = errs[idx].Error()
+		}
+		log.Debug("transaction broadcast rejected", "observer", observer.Address, "code", rejection.Code, "error", rejection.Error)
+		response.Rejected = append(response.Rejected, rejection)
+	}
+
+	return response
+}
+
+func areAllRejectionsBadRequests(rejections []*data.TransactionBroadcastRejection) bool {
+	for _, rejection := range rejections {
+		if rejection.Code != http.StatusBadRequest {
+			return false
+		}
+	}
+
+	return len(rejections) > 0
+}
+
+func isBroadcastQuorumValid(numObservers uint32, quorum uint32) bool {
+	return quorum > 0 && quorum <= numObservers
+}
+
 // SimulateTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (tp *TransactionProcessor) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
@@ -200,7 +362,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 		return nil, err
 	}
 
-	response, err := tp.simulateTransaction(observers, tx, checkSignature)
+	response, err := tp.simulateTransaction(ctx, observers, tx, checkSignature)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on sender shard (shard %d)", err, senderShardID)
 	}
@@ -228,7 +390,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 		return nil, err
 	}
 
-	responseFromReceiverShard, err := tp.simulateTransaction(observersForReceiverShard, tx, checkSignature)
+	responseFromReceiverShard, err := tp.simulateTransaction(ctx, observersForReceiverShard, tx, checkSignature)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on receiver shard (shard %d)", err, receiverShardID)
 	}
@@ -247,6 +409,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 }
 
 func (tp *TransactionProcessor) simulateTransaction(
+	ctx context.Context,
 	observers []*data.NodeData,
 	tx *data.Transaction,
 	checkSignature bool,
@@ -259,7 +422,7 @@ func (tp *TransactionProcessor) simulateTransaction(
 	txResponse := data.ResponseTransactionSimulation{}
 	for _, observer := range observers {
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, txSimulatePath, tx, &txResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, txSimulatePath, tx, &txResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info(fmt.Sprintf("Transaction simulation sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
@@ -282,69 +445,159 @@ func (tp *TransactionProcessor) simulateTransaction(
 	return nil, WrapObserversError(txResponse.Error)
 }
 
-// SendMultipleTransactions relays the post request by sending the request to the first available observer and replies back the answer
-func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction) (
+// SendMultipleTransactions relays the transactions to the observers of their senders' shards, the shards being handled
+// concurrently. Every transaction, keyed by its index, gets either a hash or the reason it was not sent, so an
+// unavailable shard does not prevent sending the transactions of the other shards
+func (tp *TransactionProcessor) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (
 	data.MultipleTransactionsResponseData, error,
 ) {
-	// TODO: Analyze and improve the robustness of this function. Currently, an error within `GetObservers`
-	// breaks the function and returns nothing (but an error) even if some transactions were actually sent, successfully.
-
-	totalTxsSent := uint64(0)
-	txsToSend := make([]*data.Transaction, 0)
-	for i := 0; i < len(txs); i++ {
-		currentTx := txs[i]
-		err := tp.checkTransactionFields(currentTx)
+	if len(txs) == 0 {
+		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
+	}
+
+	response := data.MultipleTransactionsResponseData{
+		TxsHashes: make(map[int]string),
+		TxsErrors: make(map[int]*data.TransactionSendError),
+	}
+	txsByShardID := make(map[uint32][]*data.Transaction)
+	for idx, tx := range txs {
+		shardID, err := tp.checkTransactionOfBulk(tx)
 		if err != nil {
-			log.Warn("invalid tx received",
-				"sender", currentTx.Sender,
-				"receiver", currentTx.Receiver,
-				"error", err)
+			log.Warn("invalid tx received", "index", idx, "error", err)
+			response.TxsErrors[idx] = &data.TransactionSendError{
+				Code:   data.TxSendErrorValidation,
+				Reason: err.Error(),
+			}
 			continue
 		}
-		txsToSend = append(txsToSend, currentTx)
-	}
-	if len(txsToSend) == 0 {
-		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
+
+		tx.Index = idx
+		txsByShardID[shardID] = append(txsByShardID[shardID], tx)
 	}
 
-	txsHashes := make(map[int]string)
-	txsByShardID := tp.groupTxsByShard(txsToSend)
+	mutResponse := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	wg.Add(len(txsByShardID))
 	for shardID, groupOfTxs := range txsByShardID {
-		observersInShard, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
-		if err != nil {
-			return data.MultipleTransactionsResponseData{}, ErrMissingObserver
-		}
-
-		for _, observer := range observersInShard {
-			txResponse := &data.ResponseMultipleTransactions{}
-			respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, MultipleTransactionsPath, groupOfTxs, txResponse)
-			if respCode == http.StatusOK && err == nil {
-				log.Info("transactions sent",
-					"observer", observer.Address,
-					"shard ID", shardID,
-					"total processed", txResponse.Data.NumOfTxs,
-				)
-				totalTxsSent += txResponse.Data.NumOfTxs
-
-				for key, hash := range txResponse.Data.TxsHashes {
-					txsHashes[groupOfTxs[key].Index] = hash
+		go func(shardID uint32, groupOfTxs []*data.Transaction) {
+			defer wg.Done()
+
+			outcome := tp.sendMultipleTransactionsToShard(ctx, shardID, groupOfTxs)
+
+			mutResponse.Lock()
+			response.NumOfTxs += outcome.numOfTxs
+			for idx, hash := range outcome.txsHashes {
+				response.TxsHashes[idx] = hash
+			}
+			for idx, txErr := range outcome.txsErrors {
+				response.TxsErrors[idx] = txErr
+			}
+			mutResponse.Unlock()
+		}(shardID, groupOfTxs)
+	}
+	wg.Wait()
+
+	return response, nil
+}
+
+func (tp *TransactionProcessor) checkTransactionOfBulk(tx *data.Transaction) (uint32, error) {
+	if tx == nil {
+		return 0, ErrNilTransaction
+	}
+
+	err := tp.checkTransactionFields(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	senderBytes, err := tp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return 0, err
+	}
+
+	return tp.proc.ComputeShardId(senderBytes)
+}
+
+type shardTransactionsOutcome struct {
+	numOfTxs  uint64
+	txsHashes map[int]string
+	txsErrors map[int]*data.TransactionSendError
+}
+
+// sendMultipleTransactionsToShard sends the transactions to the first observer of the shard accepting them. The
+// transactions missing from the observer's response are reported as rejected
+func (tp *TransactionProcessor) sendMultipleTransactionsToShard(
+	ctx context.Context,
+	shardID uint32,
+	txs []*data.Transaction,
+) *shardTransactionsOutcome {
+	outcome := &shardTransactionsOutcome{
+		txsHashes: make(map[int]string),
+		txsErrors: make(map[int]*data.TransactionSendError),
+	}
+
+	observersInShard, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil || len(observersInShard) == 0 {
+		log.Warn("cannot send transactions", "shard ID", shardID, "error", ErrMissingObserver)
+		outcome.setErrorForAll(txs, data.TxSendErrorNoObserver, ErrMissingObserver.Error())
+		return outcome
+	}
+
+	errCode := data.TxSendErrorRejected
+	reason := ""
+	for _, observer := range observersInShard {
+		txResponse := &data.ResponseMultipleTransactions{}
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, MultipleTransactionsPath, txs, txResponse)
+		if respCode == http.StatusOK && err == nil {
+			log.Info("transactions sent",
+				"observer", observer.Address,
+				"shard ID", shardID,
+				"total processed", txResponse.Data.NumOfTxs,
+			)
+			outcome.numOfTxs = txResponse.Data.NumOfTxs
+			for key, tx := range txs {
+				hash, found := txResponse.Data.TxsHashes[key]
+				if !found {
+					outcome.txsErrors[tx.Index] = &data.TransactionSendError{
+						Code:   data.TxSendErrorRejected,
+						Reason: ErrTransactionNotAcceptedByObserver.Error(),
+					}
+					continue
 				}
 
-				break
+				outcome.txsHashes[tx.Index] = hash
 			}
 
-			log.LogIfError(err)
+			return outcome
+		}
+
+		log.LogIfError(err)
+		errCode = data.TxSendErrorRejected
+		if respCode == http.StatusRequestTimeout {
+			errCode = data.TxSendErrorTimeout
+		}
+		reason = fmt.Sprintf("observer responded with status code %d", respCode)
+		if err != nil {
+			reason = err.Error()
 		}
 	}
 
-	return data.MultipleTransactionsResponseData{
-		NumOfTxs:  totalTxsSent,
-		TxsHashes: txsHashes,
-	}, nil
+	outcome.setErrorForAll(txs, errCode, reason)
+
+	return outcome
+}
+
+func (outcome *shardTransactionsOutcome) setErrorForAll(txs []*data.Transaction, code data.TransactionSendErrorCode, reason string) {
+	for _, tx := range txs {
+		outcome.txsErrors[tx.Index] = &data.TransactionSendError{
+			Code:   code,
+			Reason: reason,
+		}
+	}
 }
 
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tp *TransactionProcessor) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
@@ -355,12 +608,18 @@ func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*d
 		return nil, err
 	}
 
-	return newTxCostProcessor.ResolveCostRequest(tx)
+	return newTxCostProcessor.ResolveCostRequest(ctx, tx)
 }
 
 // GetTransaction should return a transaction from observer
-func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	tx, err := tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, withResults)
+func (tp *TransactionProcessor) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	cacheKey := fmt.Sprintf("transaction:%s:%t", txHash, withResults)
+	cachedTx, found := tp.responseCache.Get(cacheKey)
+	if found {
+		return cachedTx.(*transaction.ApiTransactionResult), nil
+	}
+
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeFullHistoryNodes, withResults)
 	if err != nil {
 		return nil, err
 	}
@@ -368,16 +627,32 @@ func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool)
 	tx.HyperblockNonce = tx.NotarizedAtDestinationInMetaNonce
 	tx.HyperblockHash = tx.NotarizedAtDestinationInMetaHash
 
+	tp.responseCache.Put(cacheKey, tx, tp.computeTransactionTTL(ctx, tx, withResults))
+
 	return tx, nil
 }
 
+// computeTransactionTTL returns the ttl of a transaction response. A transaction is considered final once it was
+// executed and notarized at destination in a final hyperblock. The results might still be added by later blocks
+// (e.g. async calls), so the transactions fetched with results are always considered as tip-of-chain data
+func (tp *TransactionProcessor) computeTransactionTTL(ctx context.Context, tx *transaction.ApiTransactionResult, withResults bool) time.Duration {
+	isExecuted := tx.Status != transaction.TxStatusPending && len(tx.Status) > 0
+	isNotarizedAtDestination := tx.NotarizedAtDestinationInMetaNonce > 0
+	if withResults || !isExecuted || !isNotarizedAtDestination {
+		return tp.responseTTL.NonFinalTTL()
+	}
+
+	return tp.responseTTL.TTLForHyperblockNonce(ctx, tx.NotarizedAtDestinationInMetaNonce)
+}
+
 // GetTransactionByHashAndSenderAddress returns a transaction
 func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(
+	ctx context.Context,
 	txHash string,
 	sndAddr string,
 	withResults bool,
 ) (*transaction.ApiTransactionResult, int, error) {
-	tx, err := tp.getTxWithSenderAddr(txHash, sndAddr, withResults)
+	tx, err := tp.getTxWithSenderAddr(ctx, txHash, sndAddr, withResults)
 	if err != nil {
 		return nil, http.StatusNotFound, err
 	}
@@ -405,8 +680,8 @@ func (tp *TransactionProcessor) getShardByAddress(address string) (uint32, error
 }
 
 // GetTransactionStatus returns the status of a transaction
-func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender string) (string, error) {
-	tx, err := tp.getTransaction(txHash, sender, false)
+func (tp *TransactionProcessor) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	tx, err := tp.getTransaction(ctx, txHash, sender, false)
 	if err != nil {
 		return string(data.TxStatusUnknown), err
 	}
@@ -414,29 +689,29 @@ func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender strin
 	return string(tx.Status), nil
 }
 
-func (tp *TransactionProcessor) getTransaction(txHash string, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTransaction(ctx context.Context, txHash string, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	if sender != "" {
-		return tp.getTxWithSenderAddr(txHash, sender, withResults)
+		return tp.getTxWithSenderAddr(ctx, txHash, sender, withResults)
 	}
 
 	// get status of transaction from random observers
-	return tp.getTxFromObservers(txHash, requestTypeObservers, withResults)
+	return tp.getTxFromObservers(ctx, txHash, requestTypeObservers, withResults)
 }
 
 // GetProcessedTransactionStatus returns the status of a transaction after local processing
-func (tp *TransactionProcessor) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+func (tp *TransactionProcessor) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 	const withResults = true
-	tx, err := tp.getTxFromObservers(txHash, requestTypeObservers, withResults)
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeObservers, withResults)
 	if err != nil {
 		return &data.ProcessStatusResponse{
 			Status: string(data.TxStatusUnknown),
 		}, err
 	}
 
-	return tp.computeTransactionStatus(tx, withResults), nil
+	return tp.computeTransactionStatus(ctx, tx, withResults), nil
 }
 
-func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTransactionResult, withResults bool) *data.ProcessStatusResponse {
+func (tp *TransactionProcessor) computeTransactionStatus(ctx context.Context, tx *transaction.ApiTransactionResult, withResults bool) *data.ProcessStatusResponse {
 	if !withResults {
 		return &data.ProcessStatusResponse{
 			Status: string(data.TxStatusUnknown),
@@ -460,7 +735,7 @@ func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTran
 		}
 	}
 
-	allLogs, allScrs, err := tp.gatherAllLogsAndScrs(tx)
+	allLogs, allScrs, err := tp.gatherAllLogsAndScrs(ctx, tx)
 	if err != nil {
 		log.Warn("error in TransactionProcessor.computeTransactionStatus", "error", err)
 		return &data.ProcessStatusResponse{
@@ -703,7 +978,7 @@ func findIdentifierInSingleLog(log *transaction.ApiLogs, identifier string) (boo
 	return false, []byte(emptyDataStr)
 }
 
-func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransactionResult) ([]*transaction.ApiLogs, []*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) gatherAllLogsAndScrs(ctx context.Context, tx *transaction.ApiTransactionResult) ([]*transaction.ApiLogs, []*transaction.ApiTransactionResult, error) {
 	const withResults = true
 	allLogs := make([]*transaction.ApiLogs, 0)
 	allScrs := make([]*transaction.ApiTransactionResult, 0)
@@ -713,7 +988,7 @@ func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransact
 	}
 
 	for _, scrFromTx := range tx.SmartContractResults {
-		scr, err := tp.GetTransaction(scrFromTx.Hash, withResults)
+		scr, err := tp.GetTransaction(ctx, scrFromTx.Hash, withResults)
 		if err != nil {
 			return nil, nil, fmt.Errorf("%w for scr hash %s", err, scrFromTx.Hash)
 		}
@@ -732,25 +1007,20 @@ func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransact
 	return allLogs, allScrs, nil
 }
 
-func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTxFromObservers(ctx context.Context, txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
 	observersShardIDs := tp.proc.GetShardIDs()
 	shardIDWasFetch := make(map[uint32]*tupleHashWasFetched)
 	for _, observerShardID := range observersShardIDs {
+		if isContextDone(ctx) {
+			return nil, ctx.Err()
+		}
+
 		nodesInShard, err := tp.getNodesInShard(observerShardID, reqType)
 		if err != nil {
 			return nil, err
 		}
 
-		var getTxResponse *data.GetTransactionResponse
-		var withHttpError bool
-		var ok bool
-		for _, observerInShard := range nodesInShard {
-			getTxResponse, ok, withHttpError = tp.getTxFromObserver(observerInShard, txHash, withResults)
-			if !withHttpError {
-				break
-			}
-		}
-
+		getTxResponse, ok := tp.getTxFromObserversInShard(ctx, nodesInShard, txHash, withResults)
 		if !ok || getTxResponse == nil {
 			continue
 		}
@@ -792,11 +1062,11 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		if observerIsInDestShard {
 			// need to get transaction from source shard and merge scResults
 			// if withEvents is true
-			txFromSource := tp.alterTxWithScResultsFromSourceIfNeeded(txHash, &getTxResponse.Data.Transaction, withResults, shardIDWasFetch)
+			txFromSource := tp.alterTxWithScResultsFromSourceIfNeeded(ctx, txHash, &getTxResponse.Data.Transaction, withResults, shardIDWasFetch)
 
 			tp.extraShardFromSCRs(txFromSource.SmartContractResults, shardIDWasFetch)
 
-			err = tp.fetchSCRSBasedOnShardMap(txFromSource, shardIDWasFetch)
+			err = tp.fetchSCRSBasedOnShardMap(ctx, txFromSource, shardIDWasFetch)
 			if err != nil {
 				return nil, err
 			}
@@ -805,13 +1075,13 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		}
 
 		// get transaction from observer that is in destination shard
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withResults)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withResults)
 		if ok {
 			tp.extraShardFromSCRs(txFromDstShard.SmartContractResults, shardIDWasFetch)
 
 			alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
 
-			err = tp.fetchSCRSBasedOnShardMap(alteredTxFromDest, shardIDWasFetch)
+			err = tp.fetchSCRSBasedOnShardMap(ctx, alteredTxFromDest, shardIDWasFetch)
 			if err != nil {
 				return nil, err
 			}
@@ -822,7 +1092,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		// return transaction from observer from source shard
 		// if did not get ok responses from observers from destination shard
 
-		err = tp.fetchSCRSBasedOnShardMap(&getTxResponse.Data.Transaction, shardIDWasFetch)
+		err = tp.fetchSCRSBasedOnShardMap(ctx, &getTxResponse.Data.Transaction, shardIDWasFetch)
 		if err != nil {
 			return nil, err
 		}
@@ -833,9 +1103,9 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 	return nil, errors.ErrTransactionNotFound
 }
 
-func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(tx *transaction.ApiTransactionResult, shardIDWasFetch map[uint32]*tupleHashWasFetched) error {
+func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(ctx context.Context, tx *transaction.ApiTransactionResult, shardIDWasFetch map[uint32]*tupleHashWasFetched) error {
 	for shardID, info := range shardIDWasFetch {
-		scrs, err := tp.fetchSCRs(tx.Hash, info.hash, shardID)
+		scrs, err := tp.fetchSCRs(ctx, tx.Hash, info.hash, shardID)
 		if err != nil {
 			return err
 		}
@@ -850,7 +1120,7 @@ func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(tx *transaction.ApiTran
 	return nil
 }
 
-func (tp *TransactionProcessor) fetchSCRs(txHash, scrHash string, shardID uint32) ([]*transaction.ApiSmartContractResult, error) {
+func (tp *TransactionProcessor) fetchSCRs(ctx context.Context, txHash, scrHash string, shardID uint32) ([]*transaction.ApiSmartContractResult, error) {
 	observers, err := tp.getNodesInShard(shardID, requestTypeFullHistoryNodes)
 	if err != nil {
 		return nil, err
@@ -859,7 +1129,7 @@ func (tp *TransactionProcessor) fetchSCRs(txHash, scrHash string, shardID uint32
 	apiPath := SCRsByTxHash + txHash + fmt.Sprintf(scrHashParam, scrHash)
 	for _, observer := range observers {
 		getTxResponseDst := &data.GetSCRsResponse{}
-		respCode, errG := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, getTxResponseDst)
+		respCode, errG := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, getTxResponseDst)
 		if errG != nil {
 			log.Trace("cannot get smart contract results", "address", observer.Address, "error", errG)
 			continue
@@ -912,7 +1182,7 @@ func (tp *TransactionProcessor) extraShardFromSCRs(scrs []*transaction.ApiSmartC
 	}
 }
 
-func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash string, tx *transaction.ApiTransactionResult, withResults bool, shardIDWasFetch map[uint32]*tupleHashWasFetched) *transaction.ApiTransactionResult {
+func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(ctx context.Context, txHash string, tx *transaction.ApiTransactionResult, withResults bool, shardIDWasFetch map[uint32]*tupleHashWasFetched) *transaction.ApiTransactionResult {
 	if !withResults || len(tx.SmartContractResults) == 0 {
 		return tx
 	}
@@ -923,7 +1193,7 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withResults)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withResults)
 		if !ok {
 			continue
 		}
@@ -941,40 +1211,36 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	return tx
 }
 
-func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTxWithSenderAddr(ctx context.Context, txHash, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	observers, sndShardID, err := tp.getShardObserversForSender(sender, requestTypeFullHistoryNodes)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withResults)
-		if !ok {
-			continue
-		}
-
-		rcvShardID, err := tp.getShardByAddress(getTxResponse.Data.Transaction.Receiver)
-		if err != nil {
-			log.Warn("cannot compute shard ID from receiver address",
-				"receiver address", getTxResponse.Data.Transaction.Receiver,
-				"error", err.Error())
-		}
-
-		isIntraShard := rcvShardID == sndShardID
-		if isIntraShard {
-			return &getTxResponse.Data.Transaction, nil
-		}
+	getTxResponse, ok := tp.getTxFromObserversInShard(ctx, observers, txHash, withResults)
+	if !ok {
+		return nil, errors.ErrTransactionNotFound
+	}
 
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withResults)
-		if ok {
-			alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
-			return alteredTxFromDest, nil
-		}
+	rcvShardID, err := tp.getShardByAddress(getTxResponse.Data.Transaction.Receiver)
+	if err != nil {
+		log.Warn("cannot compute shard ID from receiver address",
+			"receiver address", getTxResponse.Data.Transaction.Receiver,
+			"error", err.Error())
+	}
 
+	isIntraShard := rcvShardID == sndShardID
+	if isIntraShard {
 		return &getTxResponse.Data.Transaction, nil
 	}
 
-	return nil, errors.ErrTransactionNotFound
+	txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withResults)
+	if ok {
+		alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
+		return alteredTxFromDest, nil
+	}
+
+	return &getTxResponse.Data.Transaction, nil
 }
 
 func (tp *TransactionProcessor) mergeScResultsFromSourceAndDestIfNeeded(
@@ -1016,7 +1282,36 @@ func (tp *TransactionProcessor) getScResultsUnion(scResults []*transaction.ApiSm
 	return newSlice
 }
 
+// getTxFromObserversInShard returns the transaction from the first observer that answers successfully, the request
+// being hedged if enabled
+func (tp *TransactionProcessor) getTxFromObserversInShard(
+	ctx context.Context,
+	observers []*data.NodeData,
+	txHash string,
+	withResults bool,
+) (*data.GetTransactionResponse, bool) {
+	getTxResponse := &data.GetTransactionResponse{}
+	apiPath := TransactionPath + txHash
+	if withResults {
+		apiPath += withResultsParam
+	}
+
+	_, respCode, err := tp.proc.CallGetRestEndPointWithHedging(ctx, observers, apiPath, getTxResponse)
+	if err != nil {
+		log.Trace("cannot get transaction", "tx hash", txHash, "error", err)
+
+		if respCode == http.StatusTooManyRequests {
+			log.Warn("too many requests while getting tx from observers", "tx hash", txHash)
+		}
+
+		return nil, false
+	}
+
+	return getTxResponse, true
+}
+
 func (tp *TransactionProcessor) getTxFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	txHash string,
 	withResults bool,
@@ -1027,7 +1322,7 @@ func (tp *TransactionProcessor) getTxFromObserver(
 		apiPath += withResultsParam
 	}
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, getTxResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, getTxResponse)
 	if err != nil {
 		log.Trace("cannot get transaction", "address", observer.Address, "error", err)
 
@@ -1045,7 +1340,7 @@ func (tp *TransactionProcessor) getTxFromObserver(
 	return getTxResponse, true, false
 }
 
-func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uint32, withEvents bool) (*transaction.ApiTransactionResult, bool) {
+func (tp *TransactionProcessor) getTxFromDestShard(ctx context.Context, txHash string, dstShardID uint32, withEvents bool) (*transaction.ApiTransactionResult, bool) {
 	// cross shard transaction
 	destinationShardObservers, err := tp.proc.GetObservers(dstShardID, data.AvailabilityAll)
 	if err != nil {
@@ -1059,7 +1354,7 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 
 	for _, dstObserver := range destinationShardObservers {
 		getTxResponseDst := &data.GetTransactionResponse{}
-		respCode, err := tp.proc.CallGetRestEndPoint(dstObserver.Address, apiPath, getTxResponseDst)
+		respCode, err := tp.proc.CallGetRestEndPoint(ctx, dstObserver.Address, apiPath, getTxResponseDst)
 		if err != nil {
 			log.Trace("cannot get transaction", "address", dstObserver.Address, "error", err)
 			continue
@@ -1075,26 +1370,6 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 	return nil, false
 }
 
-func (tp *TransactionProcessor) groupTxsByShard(txs []*data.Transaction) map[uint32][]*data.Transaction {
-	txsMap := make(map[uint32][]*data.Transaction)
-	for idx, tx := range txs {
-		senderBytes, err := tp.pubKeyConverter.Decode(tx.Sender)
-		if err != nil {
-			continue
-		}
-
-		senderShardID, err := tp.proc.ComputeShardId(senderBytes)
-		if err != nil {
-			continue
-		}
-
-		tx.Index = idx
-		txsMap[senderShardID] = append(txsMap[senderShardID], tx)
-	}
-
-	return txsMap
-}
-
 func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) error {
 	_, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
@@ -1227,12 +1502,12 @@ func (tp *TransactionProcessor) getNodesInShard(shardID uint32, reqType requestT
 }
 
 // GetTransactionsPool should return all transactions from all shards pool
-func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPool(fields)
+	txPool, err := tp.getTxPool(ctx, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1241,12 +1516,12 @@ func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.Transa
 }
 
 // GetTransactionsPoolForShard should return transactions pool from one observer from shard
-func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPoolForShard(shardID, fields)
+	txPool, err := tp.getTxPoolForShard(ctx, shardID, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1255,8 +1530,8 @@ func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fiel
 }
 
 // GetTransactionsPoolForSender should return transactions for sender from observer's pool
-func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	txPool, err := tp.getTxPoolForSender(sender, fields)
+func (tp *TransactionProcessor) GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
+	txPool, err := tp.getTxPoolForSender(ctx, sender, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1265,13 +1540,13 @@ func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields stri
 }
 
 // GetLastPoolNonceForSender should return last nonce for sender from observer's pool
-func (tp *TransactionProcessor) GetLastPoolNonceForSender(sender string) (uint64, error) {
-	return tp.getLastTxPoolNonceForSender(sender)
+func (tp *TransactionProcessor) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	return tp.getLastTxPoolNonceForSender(ctx, sender)
 }
 
 // GetTransactionsPoolNonceGapsForSender should return nonce gaps for sender from observer's pool
-func (tp *TransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	return tp.getTxPoolNonceGapsForSender(sender)
+func (tp *TransactionProcessor) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	return tp.getTxPoolNonceGapsForSender(ctx, sender)
 }
 
 func (tp *TransactionProcessor) getShardObserversForSender(sender string, observersType requestType) ([]*data.NodeData, uint32, error) {
@@ -1288,7 +1563,7 @@ func (tp *TransactionProcessor) getShardObserversForSender(sender string, observ
 	return observers, sndShardID, nil
 }
 
-func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	shardIDs := tp.proc.GetShardIDs()
 	txs := &data.TransactionsPool{
 		RegularTransactions:  make([]data.WrappedTransaction, 0),
@@ -1296,7 +1571,7 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 		Rewards:              make([]data.WrappedTransaction, 0),
 	}
 	for _, shard := range shardIDs {
-		intraShardTxs, err := tp.getTxPoolForShard(shard, fields)
+		intraShardTxs, err := tp.getTxPoolForShard(ctx, shard, fields)
 		if err != nil {
 			continue
 		}
@@ -1309,7 +1584,7 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 	return txs, nil
 }
 
-func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
 	if err != nil {
 		log.Trace("cannot get observers for shard", "shard", shardID, "error", err)
@@ -1317,7 +1592,7 @@ func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string)
 	}
 
 	for _, observer := range observers {
-		txs, ok := tp.getTxPoolFromObserver(observer, fields)
+		txs, ok := tp.getTxPoolFromObserver(ctx, observer, fields)
 		if !ok {
 			continue
 		}
@@ -1330,13 +1605,14 @@ func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string)
 }
 
 func (tp *TransactionProcessor) getTxPoolFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	fields string,
 ) (*data.TransactionsPool, bool) {
 	txsPoolResponse := &data.TransactionsPoolApiResponse{}
 	apiPath := TransactionsPoolPath + fieldsParam + fields
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, txsPoolResponse)
 	if err != nil {
 		log.Trace("cannot get tx pool", "address", observer.Address, "error", err)
 
@@ -1354,7 +1630,7 @@ func (tp *TransactionProcessor) getTxPoolFromObserver(
 	return &txsPoolResponse.Data.Transactions, true
 }
 
-func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (tp *TransactionProcessor) getTxPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return nil, err
@@ -1365,7 +1641,7 @@ func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data
 	}
 	var ok bool
 	for _, observer := range observers {
-		txsInPool, ok = tp.getTxPoolForSenderFromObserver(observer, sender, fields)
+		txsInPool, ok = tp.getTxPoolForSenderFromObserver(ctx, observer, sender, fields)
 		if ok {
 			break
 		}
@@ -1375,6 +1651,7 @@ func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data
 }
 
 func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 	fields string,
@@ -1382,7 +1659,7 @@ func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
 	txsPoolResponse := &data.TransactionsPoolForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + fieldsParam + fields + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, txsPoolResponse)
 	if err != nil {
 		log.Trace("cannot get tx pool for sender", "address", observer.Address, "sender", sender, "error", err)
 
@@ -1400,14 +1677,14 @@ func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
 	return &txsPoolResponse.Data.TxPool, true
 }
 
-func (tp *TransactionProcessor) getLastTxPoolNonceForSender(sender string) (uint64, error) {
+func (tp *TransactionProcessor) getLastTxPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return 0, err
 	}
 
 	for _, observer := range observers {
-		nonce, ok := tp.getLastTxPoolNonceFromObserver(observer, sender)
+		nonce, ok := tp.getLastTxPoolNonceFromObserver(ctx, observer, sender)
 		if !ok {
 			continue
 		}
@@ -1419,13 +1696,14 @@ func (tp *TransactionProcessor) getLastTxPoolNonceForSender(sender string) (uint
 }
 
 func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 ) (uint64, bool) {
 	lastNonceResponse := &data.TransactionsPoolLastNonceForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + lastNonceParam + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, lastNonceResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, lastNonceResponse)
 	if err != nil {
 		log.Trace("cannot get last nonce from tx pool", "address", observer.Address, "sender", sender, "error", err)
 
@@ -1443,7 +1721,7 @@ func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(
 	return lastNonceResponse.Data.Nonce, true
 }
 
-func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return nil, err
@@ -1454,7 +1732,7 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 	}
 	var ok bool
 	for _, observer := range observers {
-		nonceGaps, ok = tp.getTxPoolNonceGapsFromObserver(observer, sender)
+		nonceGaps, ok = tp.getTxPoolNonceGapsFromObserver(ctx, observer, sender)
 		if ok {
 			break
 		}
@@ -1464,13 +1742,14 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 }
 
 func (tp *TransactionProcessor) getTxPoolNonceGapsFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 ) (*data.TransactionsPoolNonceGaps, bool) {
 	nonceGapsResponse := &data.TransactionsPoolNonceGapsForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + nonceGapsParam + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, nonceGapsResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, nonceGapsResponse)
 	if err != nil {
 		log.Warn("cannot get nonce gaps from tx pool", "address", observer.Address, "sender", sender, "error", err)
 
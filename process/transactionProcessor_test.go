@@ -2,6 +2,7 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
@@ -21,8 +23,12 @@ import (
 	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/logsevents"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
@@ -92,6 +98,9 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 		funcNewTxCostHandler,
 		logsMerger,
 		false,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
 	return tp
@@ -100,7 +109,7 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
@@ -109,7 +118,7 @@ func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -118,7 +127,7 @@ func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilHasher, err)
@@ -127,7 +136,7 @@ func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilMarshalizer, err)
@@ -136,16 +145,34 @@ func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilLogsMergerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilLogsMerger, err)
 }
 
+func TestNewTransactionProcessor_NilResponseCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, nil, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilResponseCache, err)
+}
+
+func TestNewTransactionProcessor_NilResponseTTLHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, nil, config.TransactionBroadcastConfig{})
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilResponseTTLHandler, err)
+}
+
 func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	require.NotNil(t, tp)
 	require.Nil(t, err)
@@ -156,8 +183,8 @@ func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender: "invalid hex number",
 	})
 
@@ -170,8 +197,8 @@ func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testin
 func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{})
 
 	require.Empty(t, txHash)
 	require.NotNil(t, err)
@@ -182,8 +209,8 @@ func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 func TestTransactionProcessor_SendTransactionNoVersionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chainID",
 	})
 
@@ -209,8 +236,11 @@ func TestTransactionProcessor_SendTransactionComputeShardIdFailsShouldErr(t *tes
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chain",
 		Version: 1,
 	})
@@ -239,9 +269,12 @@ func TestTransactionProcessor_SendTransactionGetObserversFailsShouldErr(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -277,9 +310,12 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnAllObserversShouldErr
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -318,9 +354,12 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 	address := "DEADBEEF"
-	rc, resultedTxHash, err := tp.SendTransaction(&data.Transaction{
+	rc, resultedTxHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -331,6 +370,157 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 	require.Equal(t, http.StatusOK, rc)
 }
 
+func TestNewTransactionProcessor_InvalidBroadcastConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	broadcastConfig := config.TransactionBroadcastConfig{Enabled: true, NumObservers: 2, Quorum: 3}
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, broadcastConfig)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrInvalidTransactionBroadcastConfig, err)
+}
+
+func TestTransactionProcessor_BroadcastTransaction(t *testing.T) {
+	t.Parallel()
+
+	txHash := "DEADBEEF01234567890"
+	observers := []*data.NodeData{
+		{Address: "address1", ShardId: 0},
+		{Address: "address2", ShardId: 0},
+		{Address: "address3", ShardId: 0},
+		{Address: "address4", ShardId: 0},
+	}
+	createTransactionProcessor := func(rejectingObservers map[string]int, numCalls *int32) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return observers, nil
+				},
+				CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+					atomic.AddInt32(numCalls, 1)
+					code, isRejecting := rejectingObservers[address]
+					if isRejecting {
+						return code, errors.New("rejected by " + address)
+					}
+
+					txResponse := response.(*data.ResponseTransaction)
+					txResponse.Data.TxHash = txHash
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			&disabled.ResponseCache{},
+			&disabled.ResponseTTLProvider{},
+			config.TransactionBroadcastConfig{NumObservers: 3, Quorum: 2},
+		)
+
+		return tp
+	}
+	tx := &data.Transaction{
+		Sender:  "DEADBEEF",
+		ChainID: "chain",
+		Version: 1,
+	}
+
+	t.Run("invalid quorum should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		tp := createTransactionProcessor(nil, &numCalls)
+		options := common.TransactionBroadcastOptions{
+			Broadcast: true,
+			Quorum:    core.OptionalUint32{Value: 4, HasValue: true},
+		}
+		rc, response, err := tp.BroadcastTransaction(context.Background(), tx, options)
+
+		require.Equal(t, process.ErrInvalidBroadcastQuorum, err)
+		require.Equal(t, http.StatusBadRequest, rc)
+		require.Nil(t, response)
+		require.Zero(t, atomic.LoadInt32(&numCalls))
+	})
+	t.Run("not enough observers should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		tp := createTransactionProcessor(nil, &numCalls)
+		options := common.TransactionBroadcastOptions{
+			Broadcast:    true,
+			NumObservers: core.OptionalUint32{Value: 6, HasValue: true},
+			Quorum:       core.OptionalUint32{Value: 5, HasValue: true},
+		}
+		rc, response, err := tp.BroadcastTransaction(context.Background(), tx, options)
+
+		require.True(t, errors.Is(err, process.ErrNotEnoughObserversForBroadcast))
+		require.Equal(t, http.StatusInternalServerError, rc)
+		require.Nil(t, response)
+		require.Zero(t, atomic.LoadInt32(&numCalls))
+	})
+	t.Run("quorum reached should work", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		tp := createTransactionProcessor(map[string]int{"address2": http.StatusNotFound}, &numCalls)
+		rc, response, err := tp.BroadcastTransaction(context.Background(), tx, common.TransactionBroadcastOptions{Broadcast: true})
+
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, rc)
+		require.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+		require.Equal(t, txHash, response.TxHash)
+		require.Equal(t, uint32(2), response.Quorum)
+		require.Equal(t, []string{"address1", "address3"}, response.Accepted)
+		require.Equal(t, 1, len(response.Rejected))
+		require.Equal(t, "address2", response.Rejected[0].Observer)
+		require.Equal(t, http.StatusNotFound, response.Rejected[0].Code)
+		require.Equal(t, "rejected by address2", response.Rejected[0].Error)
+	})
+	t.Run("quorum not reached should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		rejectingObservers := map[string]int{
+			"address1": http.StatusNotFound,
+			"address3": http.StatusRequestTimeout,
+		}
+		tp := createTransactionProcessor(rejectingObservers, &numCalls)
+		rc, response, err := tp.BroadcastTransaction(context.Background(), tx, common.TransactionBroadcastOptions{Broadcast: true})
+
+		require.True(t, errors.Is(err, process.ErrBroadcastQuorumNotReached))
+		require.Equal(t, http.StatusInternalServerError, rc)
+		require.Equal(t, txHash, response.TxHash)
+		require.Equal(t, []string{"address2"}, response.Accepted)
+		require.Equal(t, 2, len(response.Rejected))
+	})
+	t.Run("transaction rejected as invalid by all observers should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		rejectingObservers := map[string]int{
+			"address1": http.StatusBadRequest,
+			"address2": http.StatusBadRequest,
+		}
+		tp := createTransactionProcessor(rejectingObservers, &numCalls)
+		options := common.TransactionBroadcastOptions{
+			Broadcast:    true,
+			NumObservers: core.OptionalUint32{Value: 2, HasValue: true},
+			Quorum:       core.OptionalUint32{Value: 1, HasValue: true},
+		}
+		rc, response, err := tp.BroadcastTransaction(context.Background(), tx, options)
+
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.Equal(t, http.StatusBadRequest, rc)
+		require.Empty(t, response.Accepted)
+		require.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+	})
+}
+
 // //------- SendMultipleTransactions
 
 func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
@@ -369,9 +559,12 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, len(response.TxsHashes), len(txsToSend))
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
@@ -442,9 +635,12 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
 	require.Equal(t, uint32(2), atomic.LoadUint32(&numOfTimesPostEndpointWasCalled))
@@ -457,6 +653,107 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 	)
 }
 
+func TestTransactionProcessor_SendMultipleTransactionsShouldReportEachTransaction(t *testing.T) {
+	t.Parallel()
+
+	sndrShard0 := hex.EncodeToString([]byte("bbbbbb"))
+	sndrShard1 := hex.EncodeToString([]byte("cccccc"))
+	sndrShard2 := hex.EncodeToString([]byte("dddddd"))
+	sndrShard3 := hex.EncodeToString([]byte("eeeeee"))
+	txsToSend := []*data.Transaction{
+		{Receiver: "aaaaaa", Sender: sndrShard0, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: "not hex", ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard1, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard0, ChainID: "chain", Version: 1},
+		nil,
+		{Receiver: "aaaaaa", Sender: sndrShard2, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard3, ChainID: "chain", Version: 1},
+	}
+	shardIDs := map[string]uint32{
+		sndrShard0: 0,
+		sndrShard1: 1,
+		sndrShard2: 2,
+		sndrShard3: 3,
+	}
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return shardIDs[hex.EncodeToString(addressBuff)], nil
+			},
+			GetObserversCalled: func(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				if shardID == 1 {
+					return nil, errors.New("no observer")
+				}
+
+				return []*data.NodeData{
+					{Address: fmt.Sprintf("observer%d", shardID), ShardId: shardID},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				switch address {
+				case "observer2":
+					return http.StatusRequestTimeout, errors.New("timeout")
+				case "observer3":
+					return http.StatusBadRequest, errors.New("bad transactions")
+				}
+
+				// the observer accepts only the first transaction of the shard
+				resp := response.(*data.ResponseMultipleTransactions)
+				resp.Data.NumOfTxs = 1
+				resp.Data.TxsHashes = map[int]string{0: "hash0"}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
+	)
+
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), response.NumOfTxs)
+	require.Equal(t, map[int]string{0: "hash0"}, response.TxsHashes)
+
+	require.Len(t, response.TxsErrors, len(txsToSend)-1)
+	require.Equal(t, data.TxSendErrorValidation, response.TxsErrors[1].Code)
+	require.Equal(t, data.TxSendErrorNoObserver, response.TxsErrors[2].Code)
+	require.Equal(t, data.TxSendErrorRejected, response.TxsErrors[3].Code)
+	require.Equal(t, process.ErrTransactionNotAcceptedByObserver.Error(), response.TxsErrors[3].Reason)
+	require.Equal(t, data.TxSendErrorValidation, response.TxsErrors[4].Code)
+	require.Equal(t, process.ErrNilTransaction.Error(), response.TxsErrors[4].Reason)
+	require.Equal(t, data.TxSendErrorTimeout, response.TxsErrors[5].Code)
+	require.Equal(t, data.TxSendErrorRejected, response.TxsErrors[6].Code)
+	require.Equal(t, "bad transactions", response.TxsErrors[6].Reason)
+}
+
+func TestTransactionProcessor_SendMultipleTransactionsNoTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
+	)
+
+	response, err := tp.SendMultipleTransactions(context.Background(), nil)
+	require.Equal(t, process.ErrNoValidTransactionToSend, err)
+	require.Empty(t, response.TxsHashes)
+}
+
 func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -486,9 +783,12 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate, true)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate, true)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseData)
@@ -541,9 +841,12 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate, true)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate, true)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseDataCrossShard)
@@ -611,9 +914,12 @@ func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -669,9 +975,12 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransaction(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -740,9 +1049,12 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinati
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -809,9 +1121,12 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressCrossShard(t
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -831,9 +1146,12 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderInvaidSender(t *test
 		marshalizer, funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "blablabla")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "blablabla")
 	assert.Error(t, err)
 	assert.Equal(t, string(data.TxStatusUnknown), txStatus)
 }
@@ -887,9 +1205,12 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -911,7 +1232,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidTransactionValue(t *testi
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidTransactionValueField, err)
@@ -934,7 +1255,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidReceiverAddress(t *testin
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -956,7 +1277,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSenderAddress(t *testing.
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -978,7 +1299,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSignaturesBytes(t *testin
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidSignatureBytes, err)
@@ -1001,7 +1322,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork1(t *testing.T) {
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	txHashHex := "891694ae6307ee9f17f861816187a6729268397f8fabc055d5b334f552cd3cfb"
 	txHash, err := tp.ComputeTransactionHash(tx)
@@ -1028,7 +1349,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork2(t *testing.T) {
 	protoTxHash := hex.EncodeToString(protoTxHashBytes)
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 
 	txHash, err := tp.ComputeTransactionHash(&data.Transaction{
 		Nonce:     protoTx.Nonce,
@@ -1105,13 +1426,140 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), false)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 }
 
+func TestTransactionProcessor_GetTransactionResponseCache(t *testing.T) {
+	t.Parallel()
+
+	createProcessorStub := func(numCalls *int, status transaction.TxStatus, notarizedAtDestinationInMetaNonce uint64) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				*numCalls++
+				responseGetTx := value.(*data.GetTransactionResponse)
+				responseGetTx.Data.Transaction = transaction.ApiTransactionResult{
+					Nonce:                             37,
+					Status:                            status,
+					NotarizedAtDestinationInMetaNonce: notarizedAtDestinationInMetaNonce,
+				}
+
+				return http.StatusOK, nil
+			},
+		}
+	}
+	createResponseTTLHandler := func(providedNonce *uint64) *mock.ResponseTTLHandlerStub {
+		return &mock.ResponseTTLHandlerStub{
+			TTLForHyperblockNonceCalled: func(nonce uint64) time.Duration {
+				*providedNonce = nonce
+				return cache.NoExpiration
+			},
+			NonFinalTTLCalled: func() time.Duration {
+				return time.Second
+			},
+		}
+	}
+
+	t.Run("executed and notarized transaction should use the hyperblock finality", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		providedNonce := uint64(0)
+		responseCache, _ := cache.NewResponseCache(10)
+		tp, _ := process.NewTransactionProcessor(
+			createProcessorStub(&numCalls, transaction.TxStatusSuccess, 100),
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			responseCache,
+			createResponseTTLHandler(&providedNonce),
+			config.TransactionBroadcastConfig{},
+		)
+
+		firstTx, err := tp.GetTransaction(context.Background(), "hash", false)
+		require.Nil(t, err)
+		secondTx, err := tp.GetTransaction(context.Background(), "hash", false)
+		require.Nil(t, err)
+
+		require.Equal(t, 1, numCalls)
+		require.Equal(t, uint64(100), providedNonce)
+		require.Equal(t, firstTx, secondTx)
+	})
+	t.Run("pending transaction should use the non-final ttl", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		providedNonce := uint64(0)
+		var providedTTL time.Duration
+		responseCache := &mock.ResponseCacheStub{
+			PutCalled: func(key string, value interface{}, ttl time.Duration) {
+				providedTTL = ttl
+			},
+		}
+		tp, _ := process.NewTransactionProcessor(
+			createProcessorStub(&numCalls, transaction.TxStatusPending, 0),
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			responseCache,
+			createResponseTTLHandler(&providedNonce),
+			config.TransactionBroadcastConfig{},
+		)
+
+		_, err := tp.GetTransaction(context.Background(), "hash", false)
+		require.Nil(t, err)
+		require.Equal(t, time.Second, providedTTL)
+		require.Zero(t, providedNonce)
+	})
+	t.Run("transaction with results should use the non-final ttl", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		providedNonce := uint64(0)
+		var providedTTL time.Duration
+		responseCache := &mock.ResponseCacheStub{
+			PutCalled: func(key string, value interface{}, ttl time.Duration) {
+				providedTTL = ttl
+			},
+		}
+		tp, _ := process.NewTransactionProcessor(
+			createProcessorStub(&numCalls, transaction.TxStatusSuccess, 100),
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			responseCache,
+			createResponseTTLHandler(&providedNonce),
+			config.TransactionBroadcastConfig{},
+		)
+
+		_, err := tp.GetTransaction(context.Background(), "hash", true)
+		require.Nil(t, err)
+		require.Equal(t, time.Second, providedTTL)
+		require.Zero(t, providedNonce)
+	})
+}
+
 func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttpError(t *testing.T) {
 	t.Parallel()
 
@@ -1155,9 +1603,12 @@ func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttp
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.True(t, secondObserverWasCalled)
 }
 
@@ -1201,9 +1652,12 @@ func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfN
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 }
 
 func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterSource(t *testing.T) {
@@ -1314,9 +1768,12 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), true)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), true)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 	assert.Equal(t, 3, len(tx.SmartContractResults))
@@ -1329,10 +1786,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPool, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("")
+		txs, err := tp.GetTransactionsPool(context.Background(), "")
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1370,10 +1827,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1483,7 +1940,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1491,7 +1948,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTxSh0, scrTxSh1},
 			Rewards:              []data.WrappedTransaction{rewardsTxSh0, rewardsTxSh1},
 		}
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
@@ -1500,10 +1957,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPoolForShard, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "")
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1533,10 +1990,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1628,7 +2085,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1636,7 +2093,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTx0, scrTx1},
 			Rewards:              []data.WrappedTransaction{rewardsTx0, rewardsTx1},
 		}
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
@@ -1676,18 +2133,18 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 
-		nonce, err := tp.GetLastPoolNonceForSender(providedSenderStr)
+		nonce, err := tp.GetLastPoolNonceForSender(context.Background(), providedSenderStr)
 		assert.Equal(t, uint64(0), nonce)
 		assert.Nil(t, err)
 
-		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(providedSenderStr)
+		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(context.Background(), providedSenderStr)
 		assert.NotNil(t, nonceGaps)
 		assert.NoError(t, err)
 	})
@@ -1753,18 +2210,18 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &disabled.ResponseCache{}, &disabled.ResponseTTLProvider{}, config.TransactionBroadcastConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, &providedPool, txs)
 
-		nonce, err := tp.GetLastPoolNonceForSender(providedSenderStr)
+		nonce, err := tp.GetLastPoolNonceForSender(context.Background(), providedSenderStr)
 		assert.Equal(t, lastNonce, nonce)
 		assert.Nil(t, err)
 
-		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(providedSenderStr)
+		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(context.Background(), providedSenderStr)
 		assert.Nil(t, err)
 		assert.Equal(t, providedGaps, nonceGaps.Gaps)
 	})
@@ -2061,9 +2518,12 @@ func TestTransactionProcessor_GetProcessedTransactionStatus(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
-	status, err := tp.GetProcessedTransactionStatus(string(hash0))
+	status, err := tp.GetProcessedTransactionStatus(context.Background(), string(hash0))
 	assert.Nil(t, err)
 	assert.Equal(t, string(transaction.TxStatusPending), status.Status) // not a move balance tx with missing finish markers
 }
@@ -2104,6 +2564,9 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardTxWithPendingSCR(t *te
 		funcNewTxCostHandler,
 		logsMerger,
 		false,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
 	status := tp.ComputeTransactionStatus(txWithSCRs.Transaction, true)
@@ -2188,6 +2651,9 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardRelayedV3WithMoveBalan
 		funcNewTxCostHandler,
 		logsMerger,
 		false,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
 	)
 
 	status := tp.ComputeTransactionStatus(txWithSCRs.Transaction, true)
@@ -0,0 +1,238 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	signatureLength               = 64
+	minTxVersionForOptions        = core.InitialVersionOfTransaction + 1
+	knownTransactionOptions       = transaction.MaskSignedWithHash | transaction.MaskGuardedTransaction
+	fieldSender                   = "sender"
+	fieldReceiver                 = "receiver"
+	fieldValue                    = "value"
+	fieldGasPrice                 = "gasPrice"
+	fieldGasLimit                 = "gasLimit"
+	fieldChainID                  = "chainID"
+	fieldVersion                  = "version"
+	fieldOptions                  = "options"
+	fieldSignature                = "signature"
+	fieldGuardian                 = "guardian"
+	fieldGuardianSignature        = "guardianSignature"
+	fieldRelayer                  = "relayer"
+	fieldRelayerSignature         = "relayerSignature"
+	missingFieldMessage           = "the field is missing"
+	invalidAddressMessage         = "invalid address: %s"
+	invalidSignatureMessage       = "the signature does not match the transaction"
+	invalidSignatureFormatMessage = "the signature must be the hex encoding of %d bytes"
+)
+
+var txSigningKeyGenerator = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+// transactionValidation gathers the problems found while validating a transaction
+type transactionValidation struct {
+	tp       *TransactionProcessor
+	tx       *data.Transaction
+	problems []*data.TransactionValidationProblem
+}
+
+// ValidateTransaction runs on the transaction all the checks which can be done locally, against the provided network
+// config, without sending the transaction to the observers. All the problems found are returned at once
+func (tp *TransactionProcessor) ValidateTransaction(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult {
+	validation := &transactionValidation{
+		tp:       tp,
+		tx:       tx,
+		problems: make([]*data.TransactionValidationProblem, 0),
+	}
+
+	validation.checkAddresses()
+	validation.checkValue()
+	validation.checkNetworkConfig(networkConfig)
+	validation.checkOptions()
+	validation.checkSignatures()
+
+	return &data.TransactionValidationResult{
+		Valid:    len(validation.problems) == 0,
+		Problems: validation.problems,
+	}
+}
+
+func (tv *transactionValidation) addProblem(field string, format string, args ...interface{}) {
+	tv.problems = append(tv.problems, &data.TransactionValidationProblem{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (tv *transactionValidation) checkAddresses() {
+	tv.checkAddress(fieldSender, tv.tx.Sender, true)
+	tv.checkAddress(fieldReceiver, tv.tx.Receiver, true)
+	tv.checkAddress(fieldGuardian, tv.tx.GuardianAddr, false)
+	tv.checkAddress(fieldRelayer, tv.tx.RelayerAddr, false)
+}
+
+func (tv *transactionValidation) checkAddress(field string, address string, isMandatory bool) {
+	if len(address) == 0 {
+		if isMandatory {
+			tv.addProblem(field, missingFieldMessage)
+		}
+		return
+	}
+
+	_, err := tv.tp.pubKeyConverter.Decode(address)
+	if err != nil {
+		tv.addProblem(field, invalidAddressMessage, err.Error())
+	}
+}
+
+func (tv *transactionValidation) checkValue() {
+	value, ok := big.NewInt(0).SetString(tv.tx.Value, 10)
+	if !ok {
+		tv.addProblem(fieldValue, "the value must be a base 10 integer")
+		return
+	}
+	if value.Sign() < 0 {
+		tv.addProblem(fieldValue, "the value must not be negative")
+	}
+}
+
+func (tv *transactionValidation) checkNetworkConfig(networkConfig *data.NetworkConfig) {
+	cfg := networkConfig.Config
+
+	if len(tv.tx.ChainID) == 0 {
+		tv.addProblem(fieldChainID, missingFieldMessage)
+	} else if tv.tx.ChainID != cfg.ChainID {
+		tv.addProblem(fieldChainID, "the chain ID does not match the network chain ID %s", cfg.ChainID)
+	}
+
+	if tv.tx.Version == 0 {
+		tv.addProblem(fieldVersion, missingFieldMessage)
+	} else if tv.tx.Version < cfg.MinTransactionVersion {
+		tv.addProblem(fieldVersion, "the version is lower than the network minimum version %d", cfg.MinTransactionVersion)
+	}
+
+	if tv.tx.GasPrice < cfg.MinGasPrice {
+		tv.addProblem(fieldGasPrice, "the gas price is lower than the network minimum gas price %d", cfg.MinGasPrice)
+	}
+
+	dataGasLimit := cfg.GasPerDataByte * uint64(len(tv.tx.Data))
+	guardedGasLimit := uint64(0)
+	if tv.isGuarded() {
+		guardedGasLimit = cfg.ExtraGasLimitGuarded
+	}
+	minGasLimit := cfg.MinGasLimit + dataGasLimit + guardedGasLimit
+	if tv.tx.GasLimit < minGasLimit {
+		tv.addProblem(fieldGasLimit,
+			"the gas limit is lower than the required %d: network minimum %d, %d for the %d bytes of the data field, %d for the guardian",
+			minGasLimit, cfg.MinGasLimit, dataGasLimit, len(tv.tx.Data), guardedGasLimit)
+	}
+	if cfg.MaxGasPerTransaction > 0 && tv.tx.GasLimit > cfg.MaxGasPerTransaction {
+		tv.addProblem(fieldGasLimit, "the gas limit is higher than the network maximum gas limit %d", cfg.MaxGasPerTransaction)
+	}
+}
+
+func (tv *transactionValidation) isGuarded() bool {
+	return tv.tx.Options&transaction.MaskGuardedTransaction > 0
+}
+
+func (tv *transactionValidation) checkOptions() {
+	if tv.tx.Options == 0 {
+		return
+	}
+
+	if tv.tx.Version < minTxVersionForOptions {
+		tv.addProblem(fieldOptions, "the options require at least the version %d", minTxVersionForOptions)
+	}
+	if tv.tx.Options&^knownTransactionOptions > 0 {
+		tv.addProblem(fieldOptions, "unknown options bits are set")
+	}
+}
+
+func (tv *transactionValidation) checkSignatures() {
+	message, err := tv.dataForSigning()
+	if err != nil {
+		tv.addProblem(fieldSignature, "cannot serialize the transaction for signing: %s", err.Error())
+		return
+	}
+
+	if len(tv.tx.Signature) == 0 {
+		tv.addProblem(fieldSignature, missingFieldMessage)
+	} else {
+		tv.checkSignature(fieldSignature, tv.tx.Sender, tv.tx.Signature, message)
+	}
+
+	tv.checkCoSignature(fieldGuardian, tv.tx.GuardianAddr, fieldGuardianSignature, tv.tx.GuardianSignature, message)
+	if tv.isGuarded() && len(tv.tx.GuardianAddr) == 0 {
+		tv.addProblem(fieldGuardian, "the guarded transaction option requires a guardian")
+	}
+	if !tv.isGuarded() && len(tv.tx.GuardianAddr) > 0 {
+		tv.addProblem(fieldOptions, "a transaction having a guardian must set the guarded transaction option")
+	}
+
+	tv.checkCoSignature(fieldRelayer, tv.tx.RelayerAddr, fieldRelayerSignature, tv.tx.RelayerSignature, message)
+}
+
+// checkCoSignature checks that the guardian or the relayer, if any, signed the transaction
+func (tv *transactionValidation) checkCoSignature(addressField string, address string, signatureField string, signature string, message []byte) {
+	hasAddress := len(address) > 0
+	hasSignature := len(signature) > 0
+	if hasAddress && !hasSignature {
+		tv.addProblem(signatureField, missingFieldMessage)
+	}
+	if !hasAddress && hasSignature {
+		tv.addProblem(addressField, "the field is missing although its signature is provided")
+	}
+	if hasAddress && hasSignature {
+		tv.checkSignature(signatureField, address, signature, message)
+	}
+}
+
+// dataForSigning mirrors the node: the transactions having the hash signing option set are signed over the hash of
+// the serialized transaction
+func (tv *transactionValidation) dataForSigning() ([]byte, error) {
+	message, err := marshalTxForSigning(tv.tx)
+	if err != nil {
+		return nil, err
+	}
+
+	shouldSignOnTxHash := tv.tx.Version > core.InitialVersionOfTransaction && tv.tx.Options&transaction.MaskSignedWithHash > 0
+	if !shouldSignOnTxHash {
+		return message, nil
+	}
+
+	return keccak.NewKeccak().Compute(string(message)), nil
+}
+
+func (tv *transactionValidation) checkSignature(field string, address string, signature string, message []byte) {
+	signatureBytes, err := hex.DecodeString(signature)
+	if err != nil || len(signatureBytes) != signatureLength {
+		tv.addProblem(field, invalidSignatureFormatMessage, signatureLength)
+		return
+	}
+
+	// the invalid addresses are already reported
+	addressBytes, err := tv.tp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return
+	}
+
+	publicKey, err := txSigningKeyGenerator.PublicKeyFromByteArray(addressBytes)
+	if err != nil {
+		tv.addProblem(field, "cannot verify the signature: %s", err.Error())
+		return
+	}
+
+	err = getSingleSigner().Verify(publicKey, message, signatureBytes)
+	if err != nil {
+		tv.addProblem(field, invalidSignatureMessage)
+	}
+}
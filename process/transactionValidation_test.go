@@ -0,0 +1,261 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var txValidationKeyGenerator = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+type testSigner struct {
+	privateKey crypto.PrivateKey
+	address    string
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	privateKey, publicKey := txValidationKeyGenerator.GeneratePair()
+	publicKeyBytes, err := publicKey.ToByteArray()
+	require.NoError(t, err)
+
+	return &testSigner{
+		privateKey: privateKey,
+		address:    testPubkeyConverter.SilentEncode(publicKeyBytes, testLogger),
+	}
+}
+
+// sign signs the transaction over the data computed the way the node does
+func (ts *testSigner) sign(t *testing.T, tx *data.Transaction) string {
+	value, _ := big.NewInt(0).SetString(tx.Value, 10)
+	receiver, _ := testPubkeyConverter.Decode(tx.Receiver)
+	sender, _ := testPubkeyConverter.Decode(tx.Sender)
+	nodeTx := &transaction.Transaction{
+		Nonce:    tx.Nonce,
+		Value:    value,
+		RcvAddr:  receiver,
+		SndAddr:  sender,
+		GasPrice: tx.GasPrice,
+		GasLimit: tx.GasLimit,
+		Data:     tx.Data,
+		ChainID:  []byte(tx.ChainID),
+		Version:  tx.Version,
+		Options:  tx.Options,
+	}
+	if len(tx.GuardianAddr) > 0 {
+		nodeTx.GuardianAddr, _ = testPubkeyConverter.Decode(tx.GuardianAddr)
+	}
+	if len(tx.RelayerAddr) > 0 {
+		nodeTx.RelayerAddr, _ = testPubkeyConverter.Decode(tx.RelayerAddr)
+	}
+
+	message, err := nodeTx.GetDataForSigning(testPubkeyConverter, &marshal.JsonMarshalizer{}, keccak.NewKeccak())
+	require.NoError(t, err)
+
+	signature, err := (&singlesig.Ed25519Signer{}).Sign(ts.privateKey, message)
+	require.NoError(t, err)
+
+	return hex.EncodeToString(signature)
+}
+
+func createTestNetworkConfig() *data.NetworkConfig {
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.ChainID = "T"
+	networkConfig.Config.MinGasLimit = 50000
+	networkConfig.Config.MinGasPrice = 1000000000
+	networkConfig.Config.MinTransactionVersion = 1
+	networkConfig.Config.GasPerDataByte = 1500
+	networkConfig.Config.MaxGasPerTransaction = 600000000
+	networkConfig.Config.ExtraGasLimitGuarded = 50000
+
+	return networkConfig
+}
+
+func createTransactionProcessorForValidation() *process.TransactionProcessor {
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{},
+		testPubkeyConverter,
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&disabled.ResponseCache{},
+		&disabled.ResponseTTLProvider{},
+		config.TransactionBroadcastConfig{},
+	)
+
+	return tp
+}
+
+func problemFields(result *data.TransactionValidationResult) []string {
+	fields := make([]string, 0, len(result.Problems))
+	for _, problem := range result.Problems {
+		fields = append(fields, problem.Field)
+	}
+
+	return fields
+}
+
+func TestTransactionProcessor_ValidateTransaction(t *testing.T) {
+	t.Parallel()
+
+	sender := newTestSigner(t)
+	receiver := newTestSigner(t)
+	guardian := newTestSigner(t)
+	relayer := newTestSigner(t)
+	tp := createTransactionProcessorForValidation()
+
+	createTx := func() *data.Transaction {
+		return &data.Transaction{
+			Nonce:    7,
+			Value:    "1000000000000000000",
+			Receiver: receiver.address,
+			Sender:   sender.address,
+			GasPrice: 1000000000,
+			GasLimit: 56000,
+			Data:     []byte("test"),
+			ChainID:  "T",
+			Version:  1,
+		}
+	}
+
+	t.Run("valid transaction should have no problem", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Signature = sender.sign(t, tx)
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Problems)
+	})
+	t.Run("valid transaction signed over the hash should have no problem", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Version = 2
+		tx.Options = transaction.MaskSignedWithHash
+		tx.Signature = sender.sign(t, tx)
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Problems)
+	})
+	t.Run("valid guarded and relayed transaction should have no problem", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GasLimit = 106000
+		tx.GuardianAddr = guardian.address
+		tx.RelayerAddr = relayer.address
+		tx.Signature = sender.sign(t, tx)
+		tx.GuardianSignature = guardian.sign(t, tx)
+		tx.RelayerSignature = relayer.sign(t, tx)
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Problems)
+	})
+	t.Run("all problems should be reported at once", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Receiver = "invalid"
+		tx.Value = "-1"
+		tx.GasPrice = 1
+		tx.GasLimit = 50000
+		tx.ChainID = "D"
+		tx.Options = 8
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		assert.False(t, result.Valid)
+		assert.Equal(t,
+			[]string{"receiver", "value", "chainID", "gasPrice", "gasLimit", "options", "options", "signature"},
+			problemFields(result),
+		)
+	})
+	t.Run("missing fields should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		result := tp.ValidateTransaction(&data.Transaction{Value: "0"}, createTestNetworkConfig())
+		assert.False(t, result.Valid)
+		assert.Equal(t,
+			[]string{"sender", "receiver", "chainID", "version", "gasPrice", "gasLimit", "signature"},
+			problemFields(result),
+		)
+	})
+	t.Run("gas limit above the maximum should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.GasLimit = 600000001
+		tx.Signature = sender.sign(t, tx)
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		assert.Equal(t, []string{"gasLimit"}, problemFields(result))
+	})
+	t.Run("signature of another transaction should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Signature = sender.sign(t, tx)
+		tx.Nonce++
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		require.Equal(t, []string{"signature"}, problemFields(result))
+		assert.Equal(t, "the signature does not match the transaction", result.Problems[0].Message)
+	})
+	t.Run("malformed signature should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Signature = "aabb"
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		require.Equal(t, []string{"signature"}, problemFields(result))
+		assert.Contains(t, result.Problems[0].Message, "hex encoding of 64 bytes")
+	})
+	t.Run("guardian and relayer inconsistencies should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GasLimit = 106000
+		tx.RelayerAddr = relayer.address
+		tx.Signature = sender.sign(t, tx)
+		tx.GuardianSignature = guardian.sign(t, tx)
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		assert.Equal(t, []string{"guardian", "guardian", "relayerSignature"}, problemFields(result))
+	})
+	t.Run("guardian without the guarded option should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.Version = 2
+		tx.GuardianAddr = guardian.address
+		tx.Signature = sender.sign(t, tx)
+		tx.GuardianSignature = sender.sign(t, tx)
+
+		result := tp.ValidateTransaction(tx, createTestNetworkConfig())
+		assert.Equal(t, []string{"guardianSignature", "options"}, problemFields(result))
+	})
+}
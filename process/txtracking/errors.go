@@ -0,0 +1,52 @@
+package txtracking
+
+import "errors"
+
+// ErrNilTransactionStatusProvider signals that a nil transaction status provider has been provided
+var ErrNilTransactionStatusProvider = errors.New("nil transaction status provider")
+
+// ErrNilCallbackNotifier signals that a nil callback notifier has been provided
+var ErrNilCallbackNotifier = errors.New("nil callback notifier")
+
+// ErrInvalidPollInterval signals that an invalid poll interval has been provided
+var ErrInvalidPollInterval = errors.New("invalid poll interval")
+
+// ErrInvalidTrackingDeadline signals that an invalid tracking deadline has been provided
+var ErrInvalidTrackingDeadline = errors.New("invalid tracking deadline")
+
+// ErrInvalidMaxTrackedTransactions signals that an invalid maximum number of tracked transactions has been provided
+var ErrInvalidMaxTrackedTransactions = errors.New("invalid maximum number of tracked transactions")
+
+// ErrTooManyTrackedTransactions signals that the maximum number of tracked transactions has been reached
+var ErrTooManyTrackedTransactions = errors.New("too many tracked transactions")
+
+// ErrInvalidMaxCallbacksPerTransaction signals that an invalid maximum number of callbacks per transaction has been
+// provided
+var ErrInvalidMaxCallbacksPerTransaction = errors.New("invalid maximum number of callbacks per transaction")
+
+// ErrTooManyCallbacks signals that the maximum number of callbacks of a tracked transaction has been reached
+var ErrTooManyCallbacks = errors.New("too many callbacks for the transaction")
+
+// ErrInvalidCallbackURL signals that an invalid callback URL has been provided
+var ErrInvalidCallbackURL = errors.New("invalid callback URL")
+
+// ErrCallbackHostNotAllowed signals that the host of the callback URL is not in the allowed hosts list
+var ErrCallbackHostNotAllowed = errors.New("callback host not allowed")
+
+// ErrCallbackAddressNotAllowed signals that the callback host resolved to a loopback, private or link-local address
+var ErrCallbackAddressNotAllowed = errors.New("callback address not allowed")
+
+// ErrEmptySigningKey signals that an empty callback signing key has been provided
+var ErrEmptySigningKey = errors.New("empty callback signing key")
+
+// ErrInvalidCallbackTimeout signals that an invalid callback timeout has been provided
+var ErrInvalidCallbackTimeout = errors.New("invalid callback timeout")
+
+// ErrInvalidCallbackMaxRetries signals that an invalid maximum number of callback retries has been provided
+var ErrInvalidCallbackMaxRetries = errors.New("invalid maximum number of callback retries")
+
+// ErrInvalidCallbackRetryDelay signals that an invalid callback retry delay has been provided
+var ErrInvalidCallbackRetryDelay = errors.New("invalid callback retry delay")
+
+// ErrCallbackFailed signals that the callback could not be delivered
+var ErrCallbackFailed = errors.New("callback failed")
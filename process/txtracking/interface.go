@@ -0,0 +1,18 @@
+package txtracking
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionStatusProvider defines a component able to compute the process status of a transaction
+type TransactionStatusProvider interface {
+	GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+}
+
+// CallbackNotifier defines a component able to deliver the final status of a tracked transaction to a callback URL
+type CallbackNotifier interface {
+	Notify(ctx context.Context, callbackURL string, payload *data.TransactionTrackingCallback) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,312 @@
+package txtracking
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/txtracking")
+
+// ArgsTransactionTracker holds the arguments needed for creating a transaction tracker
+type ArgsTransactionTracker struct {
+	StatusProvider             TransactionStatusProvider
+	Notifier                   CallbackNotifier
+	PollInterval               time.Duration
+	Deadline                   time.Duration
+	MaxTrackedTransactions     int
+	MaxCallbacksPerTransaction int
+	AllowedCallbackHosts       []string
+}
+
+type trackedTransaction struct {
+	callbackURLs []string
+	deadline     time.Time
+	lastStatus   *data.ProcessStatusResponse
+}
+
+// transactionTracker periodically computes the process status of the tracked transactions and, once a transaction
+// reaches a final status or its deadline passes, posts the status to the callback URLs of the transaction
+type transactionTracker struct {
+	statusProvider             TransactionStatusProvider
+	notifier                   CallbackNotifier
+	pollInterval               time.Duration
+	deadline                   time.Duration
+	maxTrackedTransactions     int
+	maxCallbacksPerTransaction int
+	allowedCallbackHosts       map[string]struct{}
+	ctx                        context.Context
+	cancelFunc                 func()
+
+	mutTracked sync.Mutex
+	tracked    map[string]*trackedTransaction
+}
+
+// NewTransactionTracker returns a new instance of transactionTracker
+func NewTransactionTracker(args ArgsTransactionTracker) (*transactionTracker, error) {
+	if args.StatusProvider == nil {
+		return nil, ErrNilTransactionStatusProvider
+	}
+	if check.IfNil(args.Notifier) {
+		return nil, ErrNilCallbackNotifier
+	}
+	if args.PollInterval <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPollInterval, args.PollInterval)
+	}
+	if args.Deadline <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTrackingDeadline, args.Deadline)
+	}
+	if args.MaxTrackedTransactions <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxTrackedTransactions, args.MaxTrackedTransactions)
+	}
+	if args.MaxCallbacksPerTransaction <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxCallbacksPerTransaction, args.MaxCallbacksPerTransaction)
+	}
+
+	allowedCallbackHosts := make(map[string]struct{}, len(args.AllowedCallbackHosts))
+	for _, host := range args.AllowedCallbackHosts {
+		allowedCallbackHosts[host] = struct{}{}
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return &transactionTracker{
+		statusProvider:             args.StatusProvider,
+		notifier:                   args.Notifier,
+		pollInterval:               args.PollInterval,
+		deadline:                   args.Deadline,
+		maxTrackedTransactions:     args.MaxTrackedTransactions,
+		maxCallbacksPerTransaction: args.MaxCallbacksPerTransaction,
+		allowedCallbackHosts:       allowedCallbackHosts,
+		ctx:                        ctx,
+		cancelFunc:                 cancelFunc,
+		tracked:                    make(map[string]*trackedTransaction),
+	}, nil
+}
+
+// IsEnabled returns true as the transactions tracking is enabled
+func (tt *transactionTracker) IsEnabled() bool {
+	return true
+}
+
+// CheckTracking returns nil if a transaction can be tracked with the provided callback URL
+func (tt *transactionTracker) CheckTracking(callbackURL string) error {
+	err := tt.checkCallbackURL(callbackURL)
+	if err != nil {
+		return err
+	}
+
+	tt.mutTracked.Lock()
+	defer tt.mutTracked.Unlock()
+
+	return tt.checkCapacity()
+}
+
+// Track starts tracking the transaction, whose final status will be posted to the callback URL. An already tracked
+// transaction gets the callback URL added, up to the maximum number of callbacks per transaction
+func (tt *transactionTracker) Track(txHash string, callbackURL string) error {
+	err := tt.checkCallbackURL(callbackURL)
+	if err != nil {
+		return err
+	}
+
+	tt.mutTracked.Lock()
+	defer tt.mutTracked.Unlock()
+
+	trackedTx, found := tt.tracked[txHash]
+	if found {
+		return tt.addCallbackURL(trackedTx, callbackURL)
+	}
+
+	err = tt.checkCapacity()
+	if err != nil {
+		return err
+	}
+
+	tt.tracked[txHash] = &trackedTransaction{
+		callbackURLs: []string{callbackURL},
+		deadline:     time.Now().Add(tt.deadline),
+	}
+
+	return nil
+}
+
+// addCallbackURL must be called under mutTracked
+func (tt *transactionTracker) addCallbackURL(trackedTx *trackedTransaction, callbackURL string) error {
+	if containsString(trackedTx.callbackURLs, callbackURL) {
+		return nil
+	}
+	if len(trackedTx.callbackURLs) >= tt.maxCallbacksPerTransaction {
+		return fmt.Errorf("%w: the limit is %d", ErrTooManyCallbacks, tt.maxCallbacksPerTransaction)
+	}
+
+	trackedTx.callbackURLs = append(trackedTx.callbackURLs, callbackURL)
+
+	return nil
+}
+
+func (tt *transactionTracker) checkCallbackURL(callbackURL string) error {
+	parsedURL, err := url.Parse(callbackURL)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCallbackURL, err.Error())
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return fmt.Errorf("%w: the scheme must be http or https", ErrInvalidCallbackURL)
+	}
+	if len(parsedURL.Hostname()) == 0 {
+		return fmt.Errorf("%w: missing host", ErrInvalidCallbackURL)
+	}
+
+	// an empty allowed hosts list rejects all the callbacks, so the proxy cannot be used to reach arbitrary hosts
+	_, isAllowed := tt.allowedCallbackHosts[parsedURL.Hostname()]
+	if !isAllowed {
+		return fmt.Errorf("%w: %s", ErrCallbackHostNotAllowed, parsedURL.Hostname())
+	}
+
+	return nil
+}
+
+// checkCapacity must be called under mutTracked
+func (tt *transactionTracker) checkCapacity() error {
+	if len(tt.tracked) >= tt.maxTrackedTransactions {
+		return fmt.Errorf("%w: the limit is %d", ErrTooManyTrackedTransactions, tt.maxTrackedTransactions)
+	}
+
+	return nil
+}
+
+// Start starts checking the status of the tracked transactions
+func (tt *transactionTracker) Start() {
+	go tt.trackTransactions()
+}
+
+func (tt *transactionTracker) trackTransactions() {
+	timer := time.NewTimer(tt.pollInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(tt.pollInterval)
+
+		select {
+		case <-timer.C:
+			tt.checkTrackedTransactions()
+		case <-tt.ctx.Done():
+			log.Debug("finishing transactionTracker...")
+			return
+		}
+	}
+}
+
+func (tt *transactionTracker) checkTrackedTransactions() {
+	for _, txHash := range tt.getTrackedHashes() {
+		if tt.ctx.Err() != nil {
+			return
+		}
+
+		status, err := tt.statusProvider.GetProcessedTransactionStatus(tt.ctx, txHash)
+		if err != nil {
+			log.Debug("cannot get the status of a tracked transaction", "hash", txHash, "error", err.Error())
+			status = nil
+		}
+
+		tt.handleStatus(txHash, status, time.Now())
+	}
+}
+
+func (tt *transactionTracker) getTrackedHashes() []string {
+	tt.mutTracked.Lock()
+	defer tt.mutTracked.Unlock()
+
+	hashes := make([]string, 0, len(tt.tracked))
+	for txHash := range tt.tracked {
+		hashes = append(hashes, txHash)
+	}
+
+	return hashes
+}
+
+func (tt *transactionTracker) handleStatus(txHash string, status *data.ProcessStatusResponse, now time.Time) {
+	tt.mutTracked.Lock()
+	trackedTx, found := tt.tracked[txHash]
+	if !found {
+		tt.mutTracked.Unlock()
+		return
+	}
+
+	if status != nil {
+		trackedTx.lastStatus = status
+	}
+	isFinal := status != nil && isFinalStatus(status.Status)
+	isTimedOut := !isFinal && now.After(trackedTx.deadline)
+	if !isFinal && !isTimedOut {
+		tt.mutTracked.Unlock()
+		return
+	}
+
+	delete(tt.tracked, txHash)
+	tt.mutTracked.Unlock()
+
+	payload := &data.TransactionTrackingCallback{
+		TxHash:   txHash,
+		TimedOut: isTimedOut,
+	}
+	payload.Status = string(data.TxStatusUnknown)
+	if trackedTx.lastStatus != nil {
+		payload.ProcessStatusResponse = *trackedTx.lastStatus
+	}
+
+	for _, callbackURL := range trackedTx.callbackURLs {
+		go tt.notify(callbackURL, payload)
+	}
+}
+
+func (tt *transactionTracker) notify(callbackURL string, payload *data.TransactionTrackingCallback) {
+	err := tt.notifier.Notify(tt.ctx, callbackURL, payload)
+	if err != nil {
+		log.Warn("cannot deliver the status of a tracked transaction",
+			"hash", payload.TxHash,
+			"url", callbackURL,
+			"error", err.Error())
+		return
+	}
+
+	log.Debug("delivered the status of a tracked transaction", "hash", payload.TxHash, "status", payload.Status)
+}
+
+func isFinalStatus(status string) bool {
+	switch transaction.TxStatus(status) {
+	case transaction.TxStatusSuccess, transaction.TxStatusFail, transaction.TxStatusInvalid:
+		return true
+	default:
+		return false
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Close stops tracking the transactions and cancels the pending callbacks
+func (tt *transactionTracker) Close() error {
+	tt.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tt *transactionTracker) IsInterfaceNil() bool {
+	return tt == nil
+}
@@ -0,0 +1,259 @@
+package txtracking_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/multiversx/mx-chain-proxy-go/process/txtracking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const callbackURL = "https://wallet.example.com/callback"
+
+func createMockArgsTransactionTracker() txtracking.ArgsTransactionTracker {
+	return txtracking.ArgsTransactionTracker{
+		StatusProvider:             &mock.TransactionStatusProviderStub{},
+		Notifier:                   &mock.CallbackNotifierStub{},
+		PollInterval:               10 * time.Millisecond,
+		Deadline:                   time.Minute,
+		MaxTrackedTransactions:     10,
+		MaxCallbacksPerTransaction: 2,
+		AllowedCallbackHosts:       []string{"wallet.example.com"},
+	}
+}
+
+type notificationsRecorder struct {
+	mut           sync.Mutex
+	notifications map[string]*data.TransactionTrackingCallback
+}
+
+func newNotificationsRecorder() *notificationsRecorder {
+	return &notificationsRecorder{
+		notifications: make(map[string]*data.TransactionTrackingCallback),
+	}
+}
+
+func (nr *notificationsRecorder) notify(callbackURL string, payload *data.TransactionTrackingCallback) error {
+	nr.mut.Lock()
+	nr.notifications[callbackURL] = payload
+	nr.mut.Unlock()
+
+	return nil
+}
+
+func (nr *notificationsRecorder) get(callbackURL string) (*data.TransactionTrackingCallback, int) {
+	nr.mut.Lock()
+	defer nr.mut.Unlock()
+
+	return nr.notifications[callbackURL], len(nr.notifications)
+}
+
+func TestNewTransactionTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionTracker()
+		args.StatusProvider = nil
+		tracker, err := txtracking.NewTransactionTracker(args)
+
+		assert.Nil(t, tracker)
+		assert.Equal(t, txtracking.ErrNilTransactionStatusProvider, err)
+	})
+	t.Run("nil notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionTracker()
+		args.Notifier = nil
+		tracker, err := txtracking.NewTransactionTracker(args)
+
+		assert.Nil(t, tracker)
+		assert.Equal(t, txtracking.ErrNilCallbackNotifier, err)
+	})
+	t.Run("invalid poll interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionTracker()
+		args.PollInterval = 0
+		tracker, err := txtracking.NewTransactionTracker(args)
+
+		assert.Nil(t, tracker)
+		assert.True(t, errors.Is(err, txtracking.ErrInvalidPollInterval))
+	})
+	t.Run("invalid deadline should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionTracker()
+		args.Deadline = 0
+		tracker, err := txtracking.NewTransactionTracker(args)
+
+		assert.Nil(t, tracker)
+		assert.True(t, errors.Is(err, txtracking.ErrInvalidTrackingDeadline))
+	})
+	t.Run("invalid maximum number of tracked transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionTracker()
+		args.MaxTrackedTransactions = 0
+		tracker, err := txtracking.NewTransactionTracker(args)
+
+		assert.Nil(t, tracker)
+		assert.True(t, errors.Is(err, txtracking.ErrInvalidMaxTrackedTransactions))
+	})
+	t.Run("invalid maximum number of callbacks per transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionTracker()
+		args.MaxCallbacksPerTransaction = 0
+		tracker, err := txtracking.NewTransactionTracker(args)
+
+		assert.Nil(t, tracker)
+		assert.True(t, errors.Is(err, txtracking.ErrInvalidMaxCallbacksPerTransaction))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, err := txtracking.NewTransactionTracker(createMockArgsTransactionTracker())
+
+		assert.Nil(t, err)
+		assert.False(t, tracker.IsInterfaceNil())
+		assert.True(t, tracker.IsEnabled())
+	})
+}
+
+func TestTransactionTracker_CheckTracking(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTransactionTracker()
+	args.MaxTrackedTransactions = 1
+	tracker, _ := txtracking.NewTransactionTracker(args)
+
+	assert.True(t, errors.Is(tracker.CheckTracking("not a url"), txtracking.ErrInvalidCallbackURL))
+	assert.True(t, errors.Is(tracker.CheckTracking("ftp://wallet.example.com/callback"), txtracking.ErrInvalidCallbackURL))
+	assert.True(t, errors.Is(tracker.CheckTracking("https:///callback"), txtracking.ErrInvalidCallbackURL))
+	assert.True(t, errors.Is(tracker.CheckTracking("http://169.254.169.254/latest"), txtracking.ErrCallbackHostNotAllowed))
+	assert.Nil(t, tracker.CheckTracking(callbackURL))
+
+	require.Nil(t, tracker.Track("hash1", callbackURL))
+	assert.True(t, errors.Is(tracker.CheckTracking(callbackURL), txtracking.ErrTooManyTrackedTransactions))
+	assert.True(t, errors.Is(tracker.Track("hash2", callbackURL), txtracking.ErrTooManyTrackedTransactions))
+
+	// an already tracked transaction can still get new callbacks
+	assert.Nil(t, tracker.Track("hash1", "https://wallet.example.com/other-callback"))
+}
+
+func TestTransactionTracker_TrackShouldLimitTheCallbacksPerTransaction(t *testing.T) {
+	t.Parallel()
+
+	tracker, _ := txtracking.NewTransactionTracker(createMockArgsTransactionTracker())
+
+	require.Nil(t, tracker.Track("hash", callbackURL))
+	require.Nil(t, tracker.Track("hash", "https://wallet.example.com/callback2"))
+
+	err := tracker.Track("hash", "https://wallet.example.com/callback3")
+	assert.True(t, errors.Is(err, txtracking.ErrTooManyCallbacks))
+
+	// the already added callbacks and the other transactions are not affected by the limit
+	assert.Nil(t, tracker.Track("hash", callbackURL))
+	assert.Nil(t, tracker.Track("other-hash", "https://wallet.example.com/callback3"))
+}
+
+func TestTransactionTracker_EmptyAllowedCallbackHostsShouldRejectAllCallbacks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTransactionTracker()
+	args.AllowedCallbackHosts = nil
+	tracker, _ := txtracking.NewTransactionTracker(args)
+
+	assert.True(t, errors.Is(tracker.CheckTracking(callbackURL), txtracking.ErrCallbackHostNotAllowed))
+	assert.True(t, errors.Is(tracker.CheckTracking("http://127.0.0.1:8080/callback"), txtracking.ErrCallbackHostNotAllowed))
+	assert.True(t, errors.Is(tracker.Track("hash", callbackURL), txtracking.ErrCallbackHostNotAllowed))
+}
+
+func TestTransactionTracker_ShouldNotifyOnFinalStatus(t *testing.T) {
+	t.Parallel()
+
+	recorder := newNotificationsRecorder()
+	mutStatus := sync.Mutex{}
+	status := string(transaction.TxStatusPending)
+	args := createMockArgsTransactionTracker()
+	args.StatusProvider = &mock.TransactionStatusProviderStub{
+		GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+			mutStatus.Lock()
+			defer mutStatus.Unlock()
+
+			return &data.ProcessStatusResponse{Status: status, Reason: "reason"}, nil
+		},
+	}
+	args.Notifier = &mock.CallbackNotifierStub{
+		NotifyCalled: recorder.notify,
+	}
+	tracker, _ := txtracking.NewTransactionTracker(args)
+	tracker.Start()
+	defer func() {
+		_ = tracker.Close()
+	}()
+
+	otherCallbackURL := "https://wallet.example.com/other-callback"
+	require.Nil(t, tracker.Track("hash", callbackURL))
+	require.Nil(t, tracker.Track("hash", otherCallbackURL))
+
+	time.Sleep(50 * time.Millisecond)
+	_, numNotifications := recorder.get(callbackURL)
+	assert.Zero(t, numNotifications)
+
+	mutStatus.Lock()
+	status = string(transaction.TxStatusFail)
+	mutStatus.Unlock()
+
+	time.Sleep(50 * time.Millisecond)
+	payload, numNotifications := recorder.get(callbackURL)
+	assert.Equal(t, 2, numNotifications)
+	expectedPayload := &data.TransactionTrackingCallback{
+		TxHash: "hash",
+		ProcessStatusResponse: data.ProcessStatusResponse{
+			Status: string(transaction.TxStatusFail),
+			Reason: "reason",
+		},
+	}
+	assert.Equal(t, expectedPayload, payload)
+
+	// the transaction is no longer tracked, so it can be tracked again
+	assert.Nil(t, tracker.CheckTracking(callbackURL))
+}
+
+func TestTransactionTracker_ShouldNotifyWhenTheDeadlinePasses(t *testing.T) {
+	t.Parallel()
+
+	recorder := newNotificationsRecorder()
+	args := createMockArgsTransactionTracker()
+	args.Deadline = 30 * time.Millisecond
+	args.StatusProvider = &mock.TransactionStatusProviderStub{
+		GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+			return nil, errors.New("transaction not found")
+		},
+	}
+	args.Notifier = &mock.CallbackNotifierStub{
+		NotifyCalled: recorder.notify,
+	}
+	tracker, _ := txtracking.NewTransactionTracker(args)
+	tracker.Start()
+	defer func() {
+		_ = tracker.Close()
+	}()
+
+	require.Nil(t, tracker.Track("hash", callbackURL))
+
+	time.Sleep(100 * time.Millisecond)
+	payload, _ := recorder.get(callbackURL)
+	require.NotNil(t, payload)
+	assert.True(t, payload.TimedOut)
+	assert.Equal(t, string(data.TxStatusUnknown), payload.Status)
+}
@@ -0,0 +1,195 @@
+package txtracking
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// SignatureHeader is the header holding the signature of a callback request
+	SignatureHeader = "X-Signature"
+	// SignatureTimestampHeader is the header holding the unix timestamp included in the signature of a callback request
+	SignatureTimestampHeader = "X-Signature-Timestamp"
+
+	signaturePrefix       = "sha256="
+	maxCallbackRetryDelay = time.Minute
+)
+
+// ArgsWebhookNotifier holds the arguments needed for creating a webhook notifier
+type ArgsWebhookNotifier struct {
+	SigningKey            string
+	RequestTimeout        time.Duration
+	MaxRetries            int
+	RetryDelay            time.Duration
+	AllowPrivateAddresses bool
+}
+
+// webhookNotifier posts the signed callback payloads, retrying with an exponential backoff the requests that fail
+type webhookNotifier struct {
+	httpClient *http.Client
+	signingKey []byte
+	maxRetries int
+	retryDelay time.Duration
+}
+
+// NewWebhookNotifier returns a new instance of webhookNotifier
+func NewWebhookNotifier(args ArgsWebhookNotifier) (*webhookNotifier, error) {
+	if len(args.SigningKey) == 0 {
+		return nil, ErrEmptySigningKey
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCallbackTimeout, args.RequestTimeout)
+	}
+	if args.MaxRetries < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCallbackMaxRetries, args.MaxRetries)
+	}
+	if args.RetryDelay <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCallbackRetryDelay, args.RetryDelay)
+	}
+
+	dialer := &net.Dialer{
+		Timeout: args.RequestTimeout,
+	}
+	if !args.AllowPrivateAddresses {
+		dialer.Control = checkDialedAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	httpClient := &http.Client{
+		Transport: transport,
+		Timeout:   args.RequestTimeout,
+		// the redirects are not followed, so the callbacks cannot be sent to hosts other than the allowed ones
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return &webhookNotifier{
+		httpClient: httpClient,
+		signingKey: []byte(args.SigningKey),
+		maxRetries: args.MaxRetries,
+		retryDelay: args.RetryDelay,
+	}, nil
+}
+
+// checkDialedAddress refuses the connections to the loopback, private, link-local, unspecified and multicast addresses.
+// The check is done on the resolved address, right before dialing, so a public host name resolving to an internal
+// address cannot be used to reach the services behind the proxy
+func checkDialedAddress(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("%w: %s", ErrCallbackAddressNotAllowed, host)
+	}
+
+	isInternal := ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified()
+	if isInternal {
+		return fmt.Errorf("%w: %s", ErrCallbackAddressNotAllowed, host)
+	}
+
+	return nil
+}
+
+// Notify posts the payload to the callback URL until a 2xx answer is received or the retries are exhausted
+func (wn *webhookNotifier) Notify(ctx context.Context, callbackURL string, payload *data.TransactionTrackingCallback) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = wn.post(ctx, callbackURL, body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= wn.maxRetries {
+			return fmt.Errorf("%w after %d attempts: %s", ErrCallbackFailed, attempt+1, err.Error())
+		}
+
+		log.Debug("callback failed, retrying", "url", callbackURL, "attempt", attempt+1, "error", err.Error())
+
+		select {
+		case <-time.After(wn.computeRetryDelay(attempt)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (wn *webhookNotifier) post(ctx context.Context, callbackURL string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Multiversx Proxy / 1.0.0 <Transaction tracking>")
+	req.Header.Set(SignatureTimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, signaturePrefix+wn.sign(timestamp, body))
+
+	resp, err := wn.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("callback answered with status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// sign computes the HMAC-SHA256 of the timestamp, a dot and the body. Including the timestamp allows the receivers to
+// reject the replayed callbacks
+func (wn *webhookNotifier) sign(timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, wn.signingKey)
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (wn *webhookNotifier) computeRetryDelay(attempt int) time.Duration {
+	delay := wn.retryDelay
+	for i := 0; i < attempt && delay < maxCallbackRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxCallbackRetryDelay {
+		return maxCallbackRetryDelay
+	}
+
+	return delay
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wn *webhookNotifier) IsInterfaceNil() bool {
+	return wn == nil
+}
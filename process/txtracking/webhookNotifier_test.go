@@ -0,0 +1,213 @@
+package txtracking_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/txtracking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const signingKey = "signing key"
+
+func createMockArgsWebhookNotifier() txtracking.ArgsWebhookNotifier {
+	return txtracking.ArgsWebhookNotifier{
+		SigningKey:     signingKey,
+		RequestTimeout: time.Second,
+		MaxRetries:     2,
+		RetryDelay:     time.Millisecond,
+		// the test servers listen on the loopback interface
+		AllowPrivateAddresses: true,
+	}
+}
+
+func TestNewWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty signing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier()
+		args.SigningKey = ""
+		notifier, err := txtracking.NewWebhookNotifier(args)
+
+		assert.Nil(t, notifier)
+		assert.Equal(t, txtracking.ErrEmptySigningKey, err)
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier()
+		args.RequestTimeout = 0
+		notifier, err := txtracking.NewWebhookNotifier(args)
+
+		assert.Nil(t, notifier)
+		assert.True(t, errors.Is(err, txtracking.ErrInvalidCallbackTimeout))
+	})
+	t.Run("invalid max retries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier()
+		args.MaxRetries = -1
+		notifier, err := txtracking.NewWebhookNotifier(args)
+
+		assert.Nil(t, notifier)
+		assert.True(t, errors.Is(err, txtracking.ErrInvalidCallbackMaxRetries))
+	})
+	t.Run("invalid retry delay should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier()
+		args.RetryDelay = 0
+		notifier, err := txtracking.NewWebhookNotifier(args)
+
+		assert.Nil(t, notifier)
+		assert.True(t, errors.Is(err, txtracking.ErrInvalidCallbackRetryDelay))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, err := txtracking.NewWebhookNotifier(createMockArgsWebhookNotifier())
+
+		assert.Nil(t, err)
+		assert.False(t, notifier.IsInterfaceNil())
+	})
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	payload := &data.TransactionTrackingCallback{
+		TxHash: "hash",
+		ProcessStatusResponse: data.ProcessStatusResponse{
+			Status: "success",
+		},
+	}
+
+	t.Run("should post the signed payload", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&numCalls, 1)
+
+			body, _ := io.ReadAll(r.Body)
+			mac := hmac.New(sha256.New, []byte(signingKey))
+			_, _ = mac.Write([]byte(r.Header.Get(txtracking.SignatureTimestampHeader) + "."))
+			_, _ = mac.Write(body)
+			assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(txtracking.SignatureHeader))
+
+			receivedPayload := &data.TransactionTrackingCallback{}
+			assert.Nil(t, json.Unmarshal(body, receivedPayload))
+			assert.Equal(t, payload, receivedPayload)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notifier, _ := txtracking.NewWebhookNotifier(createMockArgsWebhookNotifier())
+		err := notifier.Notify(context.Background(), server.URL, payload)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
+	})
+	t.Run("should retry the failed requests", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&numCalls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		notifier, _ := txtracking.NewWebhookNotifier(createMockArgsWebhookNotifier())
+		err := notifier.Notify(context.Background(), server.URL, payload)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+	})
+	t.Run("should error when the retries are exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&numCalls, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		notifier, _ := txtracking.NewWebhookNotifier(createMockArgsWebhookNotifier())
+		err := notifier.Notify(context.Background(), server.URL, payload)
+
+		require.True(t, errors.Is(err, txtracking.ErrCallbackFailed))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+	})
+	t.Run("should not follow redirects", func(t *testing.T) {
+		t.Parallel()
+
+		numRedirectedCalls := int32(0)
+		redirectedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&numRedirectedCalls, 1)
+		}))
+		defer redirectedServer.Close()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, redirectedServer.URL, http.StatusTemporaryRedirect)
+		}))
+		defer server.Close()
+
+		args := createMockArgsWebhookNotifier()
+		args.MaxRetries = 0
+		notifier, _ := txtracking.NewWebhookNotifier(args)
+		err := notifier.Notify(context.Background(), server.URL, payload)
+
+		assert.True(t, errors.Is(err, txtracking.ErrCallbackFailed))
+		assert.Zero(t, atomic.LoadInt32(&numRedirectedCalls))
+	})
+	t.Run("should refuse the private addresses", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&numCalls, 1)
+		}))
+		defer server.Close()
+
+		args := createMockArgsWebhookNotifier()
+		args.MaxRetries = 0
+		args.AllowPrivateAddresses = false
+		notifier, _ := txtracking.NewWebhookNotifier(args)
+
+		err := notifier.Notify(context.Background(), server.URL, payload)
+		assert.True(t, errors.Is(err, txtracking.ErrCallbackFailed))
+		assert.Contains(t, err.Error(), txtracking.ErrCallbackAddressNotAllowed.Error())
+
+		for _, callbackURL := range []string{
+			"http://10.0.0.1:1/callback",
+			"http://192.168.1.1:1/callback",
+			"http://169.254.169.254:1/latest",
+			"http://[::1]:1/callback",
+			"http://0.0.0.0:1/callback",
+		} {
+			err = notifier.Notify(context.Background(), callbackURL, payload)
+			assert.Contains(t, err.Error(), txtracking.ErrCallbackAddressNotAllowed.Error(), callbackURL)
+		}
+		assert.Zero(t, atomic.LoadInt32(&numCalls))
+	})
+}
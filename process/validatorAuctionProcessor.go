@@ -1,12 +1,14 @@
 package process
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // GetAuctionList returns the auction list from a metachain observer node
-func (vsp *ValidatorStatisticsProcessor) GetAuctionList() (*data.AuctionListResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) GetAuctionList(ctx context.Context) (*data.AuctionListResponse, error) {
 	observers, errFetchObs := vsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if errFetchObs != nil {
 		return nil, errFetchObs
@@ -14,7 +16,7 @@ func (vsp *ValidatorStatisticsProcessor) GetAuctionList() (*data.AuctionListResp
 
 	var valStatsResponse data.AuctionListAPIResponse
 	for _, observer := range observers {
-		_, err := vsp.proc.CallGetRestEndPoint(observer.Address, auctionListPath, &valStatsResponse)
+		_, err := vsp.proc.CallGetRestEndPoint(ctx, observer.Address, auctionListPath, &valStatsResponse)
 		if err == nil {
 			log.Info("auction list fetched from API", "observer", observer.Address)
 			return &valStatsResponse.Data, nil
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"sync/atomic"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -56,8 +58,8 @@ func TestValidatorStatisticsProcessor_GetAuctionList(t *testing.T) {
 				return 0, nil
 			},
 		}
-		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second)
-		resp, err := vsp.GetAuctionList()
+		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
+		resp, err := vsp.GetAuctionList(context.Background())
 		require.Nil(t, err)
 		require.Equal(t, expectedResp.Data, *resp)
 	})
@@ -79,9 +81,9 @@ func TestValidatorStatisticsProcessor_GetAuctionList(t *testing.T) {
 				return 0, nil
 			},
 		}
-		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second)
+		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
 
-		resp, err := vsp.GetAuctionList()
+		resp, err := vsp.GetAuctionList(context.Background())
 		require.Equal(t, errGetObservers, err)
 		require.Nil(t, resp)
 		require.Equal(t, int32(0), callGetRestEndPointCalledCt)
@@ -109,9 +111,9 @@ func TestValidatorStatisticsProcessor_GetAuctionList(t *testing.T) {
 				return 0, errCallEndpoint
 			},
 		}
-		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second)
+		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
 
-		resp, err := vsp.GetAuctionList()
+		resp, err := vsp.GetAuctionList(context.Background())
 		require.Equal(t, ErrAuctionListNotAvailable, err)
 		require.Nil(t, resp)
 	})
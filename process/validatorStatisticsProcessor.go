@@ -2,6 +2,7 @@ package process
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -19,6 +20,8 @@ type ValidatorStatisticsProcessor struct {
 	proc                  Processor
 	cacher                ValidatorStatisticsCacheHandler
 	cacheValidityDuration time.Duration
+	mutCacheValidity      sync.RWMutex
+	cacheUpdateLease      CacheUpdateLeaseHandler
 	cancelFunc            func()
 }
 
@@ -27,6 +30,7 @@ func NewValidatorStatisticsProcessor(
 	proc Processor,
 	cacher ValidatorStatisticsCacheHandler,
 	cacheValidityDuration time.Duration,
+	cacheUpdateLease CacheUpdateLeaseHandler,
 ) (*ValidatorStatisticsProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -37,17 +41,21 @@ func NewValidatorStatisticsProcessor(
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
+	if check.IfNil(cacheUpdateLease) {
+		return nil, ErrNilCacheUpdateLease
+	}
 	hbp := &ValidatorStatisticsProcessor{
 		proc:                  proc,
 		cacher:                cacher,
 		cacheValidityDuration: cacheValidityDuration,
+		cacheUpdateLease:      cacheUpdateLease,
 	}
 
 	return hbp, nil
 }
 
 // GetValidatorStatistics will simply forward the validator statistics data from an observer
-func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	valStatsToReturn, err := vsp.cacher.LoadValStats()
 	if err == nil {
 		return &data.ValidatorStatisticsResponse{Statistics: valStatsToReturn}, nil
@@ -55,10 +63,10 @@ func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.Validat
 
 	log.Info("validator statistics: cannot get from cache. Will fetch from API", "error", err.Error())
 
-	return vsp.getValidatorStatisticsFromApi()
+	return vsp.getValidatorStatisticsFromApi(ctx)
 }
 
-func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.ValidatorStatisticsResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	observers, errFetchObs := vsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if errFetchObs != nil {
 		return nil, errFetchObs
@@ -67,7 +75,7 @@ func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.
 	var valStatsResponse data.ValidatorStatisticsApiResponse
 	var err error
 	for _, observer := range observers {
-		_, err = vsp.proc.CallGetRestEndPoint(observer.Address, validatorStatisticsPath, &valStatsResponse)
+		_, err = vsp.proc.CallGetRestEndPoint(ctx, observer.Address, validatorStatisticsPath, &valStatsResponse)
 		if err == nil {
 			log.Info("validator statistics fetched from API", "observer", observer.Address)
 			return &valStatsResponse.Data, nil
@@ -88,17 +96,17 @@ func (vsp *ValidatorStatisticsProcessor) StartCacheUpdate() {
 	ctx, vsp.cancelFunc = context.WithCancel(context.Background())
 
 	go func(ctx context.Context) {
-		timer := time.NewTimer(vsp.cacheValidityDuration)
+		timer := time.NewTimer(vsp.getCacheValidityDuration())
 		defer timer.Stop()
 
-		vsp.handleCacheUpdate()
+		vsp.handleCacheUpdate(ctx)
 
 		for {
-			timer.Reset(vsp.cacheValidityDuration)
+			timer.Reset(vsp.getCacheValidityDuration())
 
 			select {
 			case <-timer.C:
-				vsp.handleCacheUpdate()
+				vsp.handleCacheUpdate(ctx)
 			case <-ctx.Done():
 				log.Debug("finishing ValidatorStatisticsProcessor cache update...")
 				return
@@ -107,8 +115,13 @@ func (vsp *ValidatorStatisticsProcessor) StartCacheUpdate() {
 	}(ctx)
 }
 
-func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate() {
-	valStats, err := vsp.getValidatorStatisticsFromApi()
+func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate(ctx context.Context) {
+	if !vsp.cacheUpdateLease.TryAcquire() {
+		log.Debug("validator statistics: cache is updated by another proxy")
+		return
+	}
+
+	valStats, err := vsp.getValidatorStatisticsFromApi(ctx)
 	if err != nil {
 		log.Warn("validator statistics: get from API", "error", err.Error())
 	}
@@ -121,6 +134,26 @@ func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate() {
 	}
 }
 
+// SetCacheValidityDuration changes the period of the cache updates, starting with the next update
+func (vsp *ValidatorStatisticsProcessor) SetCacheValidityDuration(cacheValidityDuration time.Duration) error {
+	if cacheValidityDuration <= 0 {
+		return ErrInvalidCacheValidityDuration
+	}
+
+	vsp.mutCacheValidity.Lock()
+	vsp.cacheValidityDuration = cacheValidityDuration
+	vsp.mutCacheValidity.Unlock()
+
+	return nil
+}
+
+func (vsp *ValidatorStatisticsProcessor) getCacheValidityDuration() time.Duration {
+	vsp.mutCacheValidity.RLock()
+	defer vsp.mutCacheValidity.RUnlock()
+
+	return vsp.cacheValidityDuration
+}
+
 // Close will handle the closing of the cache update go routine
 func (vsp *ValidatorStatisticsProcessor) Close() error {
 	if vsp.cancelFunc != nil {
@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,7 +17,7 @@ import (
 func TestNewValidatorStatisticsProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(nil, &mock.ValStatsCacherMock{}, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(nil, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -24,7 +26,7 @@ func TestNewValidatorStatisticsProcessor_NilProcessorShouldErr(t *testing.T) {
 func TestNewValidatorStatisticsProcessor_NilCacherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, nil, time.Second, &disabled.CacheUpdateLease{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilValidatorStatisticsCacher, err)
@@ -33,16 +35,25 @@ func TestNewValidatorStatisticsProcessor_NilCacherShouldErr(t *testing.T) {
 func TestNewValidatorStatisticsProcessor_InvalidCacheValidityDurationShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, -time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, -time.Second, &disabled.CacheUpdateLease{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrInvalidCacheValidityDuration, err)
 }
 
+func TestNewValidatorStatisticsProcessor_NilCacheUpdateLeaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second, nil)
+
+	assert.Nil(t, hp)
+	assert.Equal(t, process.ErrNilCacheUpdateLease, err)
+}
+
 func TestNewValidatorStatisticsProcessor_WithOkProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hbp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
+	hbp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
 
 	assert.NotNil(t, hbp)
 	assert.Nil(t, err)
@@ -51,10 +62,10 @@ func TestNewValidatorStatisticsProcessor_WithOkProcessorShouldErr(t *testing.T)
 func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataWrongValuesShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheUpdateLease{})
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -78,11 +89,12 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataOkValuesShouldPa
 	},
 		&mock.ValStatsCacherMock{},
 		time.Second,
+		&disabled.CacheUpdateLease{},
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 }
@@ -105,11 +117,12 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsNoMetaObserverShould
 	},
 		&mock.ValStatsCacherMock{},
 		time.Second,
+		&disabled.CacheUpdateLease{},
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, res)
 	assert.Error(t, err)
 }
@@ -132,10 +145,11 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 		},
 		cacher,
 		time.Second,
+		&disabled.CacheUpdateLease{},
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetValidatorStatistics()
+	_, err = hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -147,10 +161,10 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 		"key0": {TempRating: 50.7},
 	}
 	cacher := &mock.ValStatsCacherMock{Data: valStatsMap}
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond, &disabled.CacheUpdateLease{})
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, res.Statistics, valStatsMap)
@@ -171,7 +185,9 @@ func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
 		},
 	},
 		cacher,
-		25*time.Millisecond)
+		25*time.Millisecond,
+		&disabled.CacheUpdateLease{},
+	)
 
 	assert.Nil(t, err)
 	hp.StartCacheUpdate()
@@ -190,3 +206,65 @@ func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
 	time.Sleep(5 * time.Millisecond)
 	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
 }
+
+func TestValidatorStatisticsProcessor_CacheShouldNotUpdateWithoutLease(t *testing.T) {
+	t.Parallel()
+
+	numOfTimesHttpWasCalled := int32(0)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "obs1", ShardId: core.MetachainShardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
+			return 0, nil
+		},
+	},
+		&mock.ValStatsCacherMock{},
+		25*time.Millisecond,
+		&mock.CacheUpdateLeaseStub{},
+	)
+	assert.Nil(t, err)
+
+	hp.StartCacheUpdate()
+	time.Sleep(40 * time.Millisecond)
+	_ = hp.Close()
+
+	assert.Zero(t, atomic.LoadInt32(&numOfTimesHttpWasCalled))
+}
+
+func TestValidatorStatisticsProcessor_SetCacheValidityDuration(t *testing.T) {
+	t.Parallel()
+
+	numOfTimesHttpWasCalled := int32(0)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "obs1", ShardId: core.MetachainShardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
+			return 0, nil
+		},
+	},
+		&mock.ValStatsCacherMock{},
+		25*time.Millisecond,
+		&disabled.CacheUpdateLease{},
+	)
+	assert.Nil(t, err)
+
+	err = hp.SetCacheValidityDuration(0)
+	assert.Equal(t, process.ErrInvalidCacheValidityDuration, err)
+
+	hp.StartCacheUpdate()
+	defer func() {
+		_ = hp.Close()
+	}()
+
+	// the update already scheduled uses the old duration, the next ones the new duration
+	time.Sleep(10 * time.Millisecond)
+	err = hp.SetCacheValidityDuration(time.Hour)
+	assert.Nil(t, err)
+
+	time.Sleep(80 * time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+}
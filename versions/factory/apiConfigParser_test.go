@@ -48,3 +48,25 @@ func TestApiConfigParser_GetConfigForVersion(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, 3, len(endpointConfig.Routes))
 }
+
+func TestApiConfigParser_NonceReservationShouldBeSecured(t *testing.T) {
+	acp, err := NewApiConfigParser("../../cmd/proxy/config/apiConfig")
+	require.NoError(t, err)
+
+	for _, version := range []string{"v1_0", "v_next"} {
+		res, err := acp.GetConfigForVersion(version)
+		require.NoError(t, err)
+
+		found := false
+		for _, route := range res.APIPackages["transaction"].Routes {
+			if route.Name != "/next-nonce/:address" {
+				continue
+			}
+
+			found = true
+			require.True(t, route.Secured, version)
+			require.Equal(t, []string{"nonce-reservation"}, route.Scopes, version)
+		}
+		require.True(t, found, version)
+	}
+}
@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/api"
 	apiv_next "github.com/multiversx/mx-chain-proxy-go/api/groups/v_next"
@@ -29,6 +31,17 @@ type FacadeArgs struct {
 	ESDTSuppliesProcessor        facade.ESDTSupplyProcessor
 	StatusProcessor              facade.StatusProcessor
 	AboutInfoProcessor           facade.AboutInfoProcessor
+	TransactionTracker           facade.TransactionTracker
+	HyperblockStreamer           facade.HyperblockStreamer
+	NonceReserver                facade.NonceReserver
+	NonceGapDiagnoser            facade.NonceGapDiagnoser
+}
+
+// apiConfigFilesForVersions maps the versions to the api routes config files they are loaded from
+var apiConfigFilesForVersions = map[string]string{
+	"":       "v1_0",
+	"v1.0":   "v1_0",
+	"v_next": "v_next",
 }
 
 // CreateVersionsRegistry creates the version registry instances and populates it with the versions and their handlers
@@ -55,6 +68,32 @@ func CreateVersionsRegistry(facadeArgs FacadeArgs, apiConfigParser ApiConfigPars
 	return versionsRegistry, nil
 }
 
+// LoadApiConfigs loads again the api routes configs of all the registered versions, keyed by version. The configs are
+// only returned, the caller being responsible for swapping them into the versions registry
+func LoadApiConfigs(versionsRegistry data.VersionsRegistryHandler, apiConfigParser ApiConfigParser) (map[string]data.ApiRoutesConfig, error) {
+	versionsMap, err := versionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	apiConfigs := make(map[string]data.ApiRoutesConfig, len(versionsMap))
+	for version := range versionsMap {
+		configFile, found := apiConfigFilesForVersions[version]
+		if !found {
+			return nil, fmt.Errorf("%w: no api config file for version %q", versions.ErrVersionNotFound, version)
+		}
+
+		apiConfig, errLoad := apiConfigParser.GetConfigForVersion(configFile)
+		if errLoad != nil {
+			return nil, fmt.Errorf("%w while loading the api config of version %q", errLoad, version)
+		}
+
+		apiConfigs[version] = *apiConfig
+	}
+
+	return apiConfigs, nil
+}
+
 func addVersionV1_0AsDefault(versionRegistry data.VersionsRegistryHandler, apiConfigParser ApiConfigParser) error {
 	versionsMap, err := versionRegistry.GetAllVersions()
 	if err != nil {
@@ -111,6 +150,10 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ProxyFacade
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
 		AboutInfoProcessor:           facadeArgs.AboutInfoProcessor,
+		TransactionTracker:           facadeArgs.TransactionTracker,
+		HyperblockStreamer:           facadeArgs.HyperblockStreamer,
+		NonceReserver:                facadeArgs.NonceReserver,
+		NonceGapDiagnoser:            facadeArgs.NonceGapDiagnoser,
 	}
 
 	commonFacade, err := createVersionedFacade(v1_0HandlerArgs)
@@ -169,6 +212,10 @@ func createVersionV_nextFacade(facadeArgs FacadeArgs) (data.FacadeHandler, error
 		PubKeyConverter:              facadeArgs.PubKeyConverter,
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
+		TransactionTracker:           facadeArgs.TransactionTracker,
+		HyperblockStreamer:           facadeArgs.HyperblockStreamer,
+		NonceReserver:                facadeArgs.NonceReserver,
+		NonceGapDiagnoser:            facadeArgs.NonceGapDiagnoser,
 	}
 
 	commonFacade, err := createVersionedFacade(v_nextHandlerArgs)
@@ -208,5 +255,9 @@ func createVersionedFacade(args FacadeArgs) (data.FacadeHandler, error) {
 		args.ESDTSuppliesProcessor,
 		args.StatusProcessor,
 		args.AboutInfoProcessor,
+		args.TransactionTracker,
+		args.HyperblockStreamer,
+		args.NonceReserver,
+		args.NonceGapDiagnoser,
 	)
 }
@@ -42,7 +42,32 @@ func (vm *versionsRegistry) GetAllVersions() (map[string]*data.VersionData, erro
 		return nil, ErrNoVersionIsSet
 	}
 
-	return vm.versions, nil
+	versionsCopy := make(map[string]*data.VersionData, len(vm.versions))
+	for version, versionData := range vm.versions {
+		versionsCopy[version] = versionData
+	}
+
+	return versionsCopy, nil
+}
+
+// UpdateApiConfig replaces the api routes config of an existing version. The version data is replaced as a whole, so
+// the callers that already fetched it keep using a consistent instance
+func (vm *versionsRegistry) UpdateApiConfig(version string, apiConfig data.ApiRoutesConfig) error {
+	vm.Lock()
+	defer vm.Unlock()
+
+	versionData, found := vm.versions[version]
+	if !found {
+		return ErrVersionNotFound
+	}
+
+	vm.versions[version] = &data.VersionData{
+		Facade:     versionData.Facade,
+		ApiHandler: versionData.ApiHandler,
+		ApiConfig:  apiConfig,
+	}
+
+	return nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface